@@ -0,0 +1,379 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/audit"
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/hazard"
+	"github.com/sunDar0/winding-road-finder/backend/domain/recommendation"
+	"github.com/sunDar0/winding-road-finder/backend/domain/region"
+	"github.com/sunDar0/winding-road-finder/backend/domain/review"
+	"github.com/sunDar0/winding-road-finder/backend/domain/style"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+)
+
+// AdminCommandService는 큐레이터의 코스·추천 데이터 수정과 위험 신고·리뷰 관리를 담당합니다.
+// 모든 작업은 작업자와 변경 전후 차이를 작업 기록에 남깁니다. 코스는 코스 이벤트로만 저장하고 courses.json은 고치지 않으며,
+// 현재 코스도 이벤트를 재생해 읽습니다.
+// 작업 기록은 변경과 같은 잠금 안에서 변경보다 먼저 남기므로, 기록하지 못하면 아무것도 바꾸지 않습니다.
+// 기록 뒤 저장에 실패하면 기록만 남을 수 있지만, 바뀐 내용이 기록에서 빠지는 일은 없습니다.
+type AdminCommandService struct {
+	events     course.EventStore
+	recRepo    recommendation.RecommendationCommandRepository
+	hazardRepo hazard.HazardRepository
+	reviewRepo review.ReviewRepository
+	auditRepo  audit.AuditRepository
+	styleRepo  style.StyleRepository
+	regionRepo region.RegionRepository
+	images     course.ImageGenerator
+	now        func() time.Time
+	// courseMu는 코스 이벤트 저장을 한 작업씩 처리합니다.
+	courseMu sync.Mutex
+	// recMu는 추천 데이터 파일 수정을 한 작업씩 처리합니다.
+	recMu sync.Mutex
+}
+
+// NewAdminCommandService는 관리자 서비스를 만듭니다. images가 nil이면 이미지 다시 생성은 course.ErrImagesUnavailable을 반환합니다.
+func NewAdminCommandService(events course.EventStore, recRepo recommendation.RecommendationCommandRepository, hazardRepo hazard.HazardRepository, reviewRepo review.ReviewRepository, auditRepo audit.AuditRepository, styleRepo style.StyleRepository, regionRepo region.RegionRepository, images course.ImageGenerator) *AdminCommandService {
+	return &AdminCommandService{
+		events:     events,
+		recRepo:    recRepo,
+		hazardRepo: hazardRepo,
+		reviewRepo: reviewRepo,
+		auditRepo:  auditRepo,
+		styleRepo:  styleRepo,
+		regionRepo: regionRepo,
+		images:     images,
+		now:        time.Now,
+	}
+}
+
+// CreateCourse는 코스를 추가합니다. 스타일은 slug로 정규화해 저장합니다.
+func (svc *AdminCommandService) CreateCourse(principal *user.Principal, c *course.CourseAggregate, reason string) error {
+	return svc.createCourse(principal, c, reason, nil)
+}
+
+// UpdateCourse는 코스 전체를 c로 바꿉니다.
+func (svc *AdminCommandService) UpdateCourse(principal *user.Principal, id int, c *course.CourseAggregate, reason string) error {
+	return svc.updateCourse(principal, id, c, reason, nil)
+}
+
+// createCourse와 updateCourse는 check가 있으면 잠금을 잡고 코스 ID를 정한 뒤, 작업 기록과 저장 전에 호출합니다.
+// 확인과 게시 사이에 다른 코스 변경이 끼어들지 않아야 하는 수정안 승인과,
+// 게시할 코스 ID로 검토 결과를 먼저 저장해야 하는 제보 수락에서 사용합니다.
+func (svc *AdminCommandService) createCourse(principal *user.Principal, c *course.CourseAggregate, reason string, check func(id int) error) error {
+	svc.courseMu.Lock()
+	defer svc.courseMu.Unlock()
+	if err := svc.prepareCourse(c); err != nil {
+		return err
+	}
+	id, err := svc.nextCourseID()
+	if err != nil {
+		return err
+	}
+	if check != nil {
+		if err := check(id); err != nil {
+			return err
+		}
+	}
+	c.ID = id
+	if err := svc.record(principal, audit.ActionCreate, audit.TargetCourse, c.ID, nil, c); err != nil {
+		return err
+	}
+	return svc.saveCourse(principal, nil, c, reason)
+}
+
+func (svc *AdminCommandService) updateCourse(principal *user.Principal, id int, c *course.CourseAggregate, reason string, check func(id int) error) error {
+	svc.courseMu.Lock()
+	defer svc.courseMu.Unlock()
+	if check != nil {
+		if err := check(id); err != nil {
+			return err
+		}
+	}
+	before, err := svc.findCourse(id)
+	if err != nil {
+		return err
+	}
+	c.ID = id
+	if err := svc.prepareCourse(c); err != nil {
+		return err
+	}
+	if err := svc.record(principal, audit.ActionUpdate, audit.TargetCourse, id, before, c); err != nil {
+		return err
+	}
+	return svc.saveCourse(principal, before, c, reason)
+}
+
+// DeleteCourse는 코스를 지웁니다. 추천이 직접 가리키는 코스면 course.ErrCourseInUse를 반환합니다.
+func (svc *AdminCommandService) DeleteCourse(principal *user.Principal, id int, reason string) error {
+	svc.courseMu.Lock()
+	defer svc.courseMu.Unlock()
+	before, err := svc.findCourse(id)
+	if err != nil {
+		return err
+	}
+	recs, err := svc.recRepo.FindAll()
+	if err != nil {
+		return err
+	}
+	for _, rec := range recs {
+		if slices.Contains(rec.CourseRefs(), id) {
+			return fmt.Errorf("%w: 추천 %d", course.ErrCourseInUse, rec.ID)
+		}
+	}
+	if err := svc.record(principal, audit.ActionDelete, audit.TargetCourse, id, before, nil); err != nil {
+		return err
+	}
+	return svc.saveCourse(principal, before, nil, reason)
+}
+
+// saveCourse는 before에서 after로의 변경을 코스 이벤트로 저장합니다. after가 nil이면 삭제합니다.
+// 이벤트 추가가 유일한 쓰기이므로, 에러를 반환했으면 변경은 조회에 반영되지 않습니다.
+func (svc *AdminCommandService) saveCourse(principal *user.Principal, before, after *course.CourseAggregate, reason string) error {
+	events, err := course.NewEvents(before, after, principal.UserID, reason, svc.now())
+	if err != nil {
+		return err
+	}
+	return svc.events.Append(events)
+}
+
+// nextCourseID는 새 코스 ID를 정합니다. 삭제된 코스의 이력과 섞이지 않도록 이벤트에 남은 모든 ID를 피합니다.
+func (svc *AdminCommandService) nextCourseID() (int, error) {
+	events, err := svc.events.FindAfter(0)
+	if err != nil {
+		return 0, err
+	}
+	id := 1
+	for _, e := range events {
+		id = max(id, e.CourseID+1)
+	}
+	return id, nil
+}
+
+// RenderCourseImages는 코스의 썸네일·상세 지도 이미지를 다시 만듭니다.
+func (svc *AdminCommandService) RenderCourseImages(ctx context.Context, principal *user.Principal, id int) (err error) {
+	ctx, span := tracer.Start(ctx, "AdminCommandService.RenderCourseImages", trace.WithAttributes(attribute.Int("course.id", id)))
+	defer func() { endSpan(span, err) }()
+	if svc.images == nil {
+		return course.ErrImagesUnavailable
+	}
+	_, repoSpan := tracer.Start(ctx, "EventStore.FindByCourse")
+	c, err := svc.findCourse(id)
+	endSpan(repoSpan, err)
+	if err != nil {
+		return err
+	}
+	if err := svc.images.GenerateImageForCourse(ctx, c); err != nil {
+		return fmt.Errorf("%w: %v", course.ErrImageGeneration, err)
+	}
+	return svc.record(principal, audit.ActionRenderImages, audit.TargetCourse, id, nil, nil)
+}
+
+// currentCourse는 코스 이벤트를 재생해 현재 코스를 만듭니다. 없거나 삭제된 코스면 nil입니다.
+func (svc *AdminCommandService) currentCourse(id int) (*course.CourseAggregate, error) {
+	events, err := svc.events.FindByCourse(id)
+	if err != nil {
+		return nil, err
+	}
+	return course.Replay(events)
+}
+
+func (svc *AdminCommandService) findCourse(id int) (*course.CourseAggregate, error) {
+	c, err := svc.currentCourse(id)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil {
+		return nil, course.ErrCourseNotFound
+	}
+	return c, nil
+}
+
+// prepareCourse는 코스를 검증하고 스타일을 slug로 정규화합니다. 지역은 시·도 이름이어야 합니다.
+func (svc *AdminCommandService) prepareCourse(c *course.CourseAggregate) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+	styles, err := svc.styleRepo.FindAll()
+	if err != nil {
+		return err
+	}
+	if c.Styles, err = style.NewTaxonomy(styles).Normalize(c.Styles); err != nil {
+		return err
+	}
+	regions, err := svc.regionRepo.FindAll()
+	if err != nil {
+		return err
+	}
+	if r := region.NewDirectory(regions).Resolve(c.Region); r == nil {
+		return fmt.Errorf("%w: 알 수 없는 지역 %s", course.ErrInvalidCourse, c.Region)
+	}
+	return nil
+}
+
+// CreateRecommendation은 추천을 추가합니다.
+func (svc *AdminCommandService) CreateRecommendation(principal *user.Principal, rec *recommendation.Recommendation) error {
+	svc.recMu.Lock()
+	defer svc.recMu.Unlock()
+	if err := svc.validateRecommendation(rec); err != nil {
+		return err
+	}
+	id, err := svc.recRepo.NextID()
+	if err != nil {
+		return err
+	}
+	rec.ID = id
+	if err := svc.record(principal, audit.ActionCreate, audit.TargetRecommendation, rec.ID, nil, rec); err != nil {
+		return err
+	}
+	return svc.recRepo.Save(rec)
+}
+
+// UpdateRecommendation은 추천 전체를 rec로 바꿉니다.
+func (svc *AdminCommandService) UpdateRecommendation(principal *user.Principal, id int, rec *recommendation.Recommendation) error {
+	svc.recMu.Lock()
+	defer svc.recMu.Unlock()
+	before, err := svc.findRecommendation(id)
+	if err != nil {
+		return err
+	}
+	rec.ID = id
+	if err := svc.validateRecommendation(rec); err != nil {
+		return err
+	}
+	if err := svc.record(principal, audit.ActionUpdate, audit.TargetRecommendation, id, before, rec); err != nil {
+		return err
+	}
+	return svc.recRepo.Save(rec)
+}
+
+// ReorderRecommendation은 추천 코스 순서를 바꿉니다. 규칙 추천은 고정 코스 순서를 바꿉니다.
+func (svc *AdminCommandService) ReorderRecommendation(principal *user.Principal, id int, order []int) error {
+	svc.recMu.Lock()
+	defer svc.recMu.Unlock()
+	before, err := svc.findRecommendation(id)
+	if err != nil {
+		return err
+	}
+	after := *before
+	if err := after.Reorder(order); err != nil {
+		return err
+	}
+	if err := svc.record(principal, audit.ActionReorder, audit.TargetRecommendation, id, before, &after); err != nil {
+		return err
+	}
+	return svc.recRepo.Save(&after)
+}
+
+// DeleteRecommendation은 추천을 지웁니다.
+func (svc *AdminCommandService) DeleteRecommendation(principal *user.Principal, id int) error {
+	svc.recMu.Lock()
+	defer svc.recMu.Unlock()
+	before, err := svc.findRecommendation(id)
+	if err != nil {
+		return err
+	}
+	if err := svc.record(principal, audit.ActionDelete, audit.TargetRecommendation, id, before, nil); err != nil {
+		return err
+	}
+	return svc.recRepo.Delete(id)
+}
+
+func (svc *AdminCommandService) findRecommendation(id int) (*recommendation.Recommendation, error) {
+	rec, err := svc.recRepo.FindById(id)
+	if err != nil {
+		return nil, err
+	}
+	if rec == nil {
+		return nil, recommendation.ErrRecommendationNotFound
+	}
+	return rec, nil
+}
+
+// validateRecommendation은 추천을 검증합니다. 규칙은 현재 스타일 분류와 지역 목록으로 해석할 수 있어야 하고,
+// 가리키는 코스는 모두 있어야 합니다.
+func (svc *AdminCommandService) validateRecommendation(rec *recommendation.Recommendation) error {
+	if err := rec.Validate(); err != nil {
+		return err
+	}
+	if rec.IsDynamic() {
+		styles, err := svc.styleRepo.FindAll()
+		if err != nil {
+			return err
+		}
+		regions, err := svc.regionRepo.FindAll()
+		if err != nil {
+			return err
+		}
+		if _, err := recommendation.CompileRule(rec.Rule, style.NewTaxonomy(styles), region.NewDirectory(regions)); err != nil {
+			return err
+		}
+	}
+	for _, id := range rec.CourseRefs() {
+		c, err := svc.currentCourse(id)
+		if err != nil {
+			return err
+		}
+		if c == nil {
+			return fmt.Errorf("%w: %d", recommendation.ErrUnknownCourse, id)
+		}
+	}
+	return nil
+}
+
+// SetHazardHidden은 위험 신고를 숨기거나 다시 보이게 합니다. 작업 기록은 저장소 잠금 안에서 저장 전에 남깁니다.
+func (svc *AdminCommandService) SetHazardHidden(principal *user.Principal, id int, hidden bool) error {
+	h, err := svc.hazardRepo.Update(id, func(h *hazard.Hazard) error {
+		before := *h
+		h.Hidden = hidden
+		return svc.record(principal, hideAction(hidden), audit.TargetHazard, id, &before, h)
+	})
+	if err != nil {
+		return err
+	}
+	if h == nil {
+		return hazard.ErrHazardNotFound
+	}
+	return nil
+}
+
+// SetReviewHidden은 리뷰를 숨기거나 다시 보이게 합니다. 작업 기록은 저장소 잠금 안에서 저장 전에 남깁니다.
+func (svc *AdminCommandService) SetReviewHidden(principal *user.Principal, id int, hidden bool) error {
+	r, err := svc.reviewRepo.Update(id, func(r *review.Review) error {
+		before := *r
+		r.Hidden = hidden
+		return svc.record(principal, hideAction(hidden), audit.TargetReview, id, &before, r)
+	})
+	if err != nil {
+		return err
+	}
+	if r == nil {
+		return review.ErrReviewNotFound
+	}
+	return nil
+}
+
+func hideAction(hidden bool) audit.Action {
+	if hidden {
+		return audit.ActionHide
+	}
+	return audit.ActionUnhide
+}
+
+// record는 작업 기록을 남깁니다. 변경을 저장하기 전에 호출합니다.
+func (svc *AdminCommandService) record(principal *user.Principal, action audit.Action, targetType audit.TargetType, targetID int, before, after any) error {
+	e, err := audit.NewEntry(principal, action, targetType, targetID, before, after, svc.now())
+	if err != nil {
+		return err
+	}
+	return svc.auditRepo.Append(e)
+}
@@ -0,0 +1,306 @@
+package command_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/application/command"
+	"github.com/sunDar0/winding-road-finder/backend/domain/audit"
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/hazard"
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+	"github.com/sunDar0/winding-road-finder/backend/domain/recommendation"
+	"github.com/sunDar0/winding-road-finder/backend/domain/region"
+	"github.com/sunDar0/winding-road-finder/backend/domain/review"
+	"github.com/sunDar0/winding-road-finder/backend/domain/style"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+	commandRepo "github.com/sunDar0/winding-road-finder/backend/infrastructure/persistence/command"
+)
+
+type stubStyles struct{}
+
+func (stubStyles) FindAll() ([]*style.Style, error)        { return nil, nil }
+func (stubStyles) FindBySlug(string) (*style.Style, error) { return nil, nil }
+
+// stubRegions는 코스 추가 검증을 통과할 수 있도록 경기도만 알려 줍니다.
+type stubRegions struct{}
+
+func (stubRegions) FindAll() ([]*region.Region, error) {
+	return []*region.Region{{Code: "41", Name: i18n.Text("경기도"), Level: region.LevelSido}}, nil
+}
+func (stubRegions) FindByCode(string) (*region.Region, error) { return nil, nil }
+
+var errAuditUnavailable = errors.New("작업 기록을 남길 수 없음")
+
+// failingAudit은 기록을 남기지 못하는 작업 기록 저장소입니다.
+type failingAudit struct{}
+
+func (failingAudit) Append(*audit.Entry) error                 { return errAuditUnavailable }
+func (failingAudit) Find(audit.Filter) ([]*audit.Entry, error) { return nil, nil }
+
+var errEventsUnavailable = errors.New("코스 이벤트를 저장할 수 없음")
+
+// failingEvents는 fail이 참이면 이벤트를 저장하지 못하는 코스 이벤트 저장소입니다.
+type failingEvents struct {
+	*commandRepo.CourseEventStoreImpl
+	fail bool
+}
+
+func (e *failingEvents) Append(events []*course.Event) error {
+	if e.fail {
+		return errEventsUnavailable
+	}
+	return e.CourseEventStoreImpl.Append(events)
+}
+
+// eventCourses는 이벤트를 재생해 현재 코스를 읽습니다.
+type eventCourses struct {
+	events *commandRepo.CourseEventStoreImpl
+}
+
+func (c eventCourses) FindByID(id int) (*course.CourseAggregate, error) {
+	events, err := c.events.FindByCourse(id)
+	if err != nil {
+		return nil, err
+	}
+	return course.Replay(events)
+}
+
+// NextID는 지금까지 이벤트에 나온 가장 큰 코스 ID+1입니다.
+func (c eventCourses) NextID() (int, error) {
+	events, err := c.events.FindAfter(0)
+	if err != nil {
+		return 0, err
+	}
+	id := 1
+	for _, e := range events {
+		id = max(id, e.CourseID+1)
+	}
+	return id, nil
+}
+
+// lastSeq는 마지막으로 저장된 코스 이벤트의 순번입니다.
+func lastSeq(t *testing.T, events *commandRepo.CourseEventStoreImpl) int {
+	t.Helper()
+	all, err := events.FindAfter(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) == 0 {
+		return 0
+	}
+	return all[len(all)-1].Seq
+}
+
+// adminFixture는 저장소의 코스 데이터 파일을 이벤트로 가져오고, 추천 데이터 파일을 임시 디렉터리에 복사해 만든 관리자 서비스입니다.
+type adminFixture struct {
+	svc      *command.AdminCommandService
+	recsPath string
+	courses  eventCourses
+	events   *commandRepo.CourseEventStoreImpl
+	// appends는 관리자 서비스가 코스 이벤트를 저장하는 곳으로, fail을 켜면 저장에 실패합니다.
+	appends *failingEvents
+	recs    *commandRepo.RecommendationCommandRepositoryImpl
+	hazards *commandRepo.HazardCommandRepositoryImpl
+	reviews *commandRepo.ReviewCommandRepositoryImpl
+	audit   *commandRepo.AuditCommandRepositoryImpl
+}
+
+func newAdminFixture(t *testing.T, auditRepo audit.AuditRepository) *adminFixture {
+	t.Helper()
+	dir := t.TempDir()
+	f := &adminFixture{
+		recsPath: filepath.Join(dir, "recommendations.json"),
+		events:   commandRepo.NewCourseEventStore(dir, discardLogger),
+		hazards:  commandRepo.NewHazardCommandRepository(dir),
+		reviews:  commandRepo.NewReviewCommandRepository(dir),
+		audit:    commandRepo.NewAuditCommandRepository(dir),
+	}
+	f.courses = eventCourses{f.events}
+	f.appends = &failingEvents{CourseEventStoreImpl: f.events}
+	data, err := os.ReadFile(filepath.Join("..", "..", "data", "recommendations.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(f.recsPath, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	// 서버가 시작할 때처럼 courses.json의 코스를 작업자 없는 생성 이벤트로 가져옵니다.
+	data, err = os.ReadFile(filepath.Join("..", "..", "data", "courses.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var courses []*course.CourseAggregate
+	if err := json.Unmarshal(data, &courses); err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range courses {
+		created, err := course.NewEvents(nil, c, 0, "", time.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := f.events.Append(created); err != nil {
+			t.Fatal(err)
+		}
+	}
+	f.recs = commandRepo.NewRecommendationCommandRepository(f.recsPath)
+	if auditRepo == nil {
+		auditRepo = f.audit
+	}
+	f.svc = command.NewAdminCommandService(f.appends, f.recs, f.hazards, f.reviews, auditRepo, stubStyles{}, stubRegions{}, nil)
+	return f
+}
+
+var curator = &user.Principal{UserID: 1, Email: "curator@example.com", Role: user.RoleAdmin}
+
+func readFile(t *testing.T, path string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// 작업 기록을 남기지 못하면 어떤 변경도 저장하지 않는다.
+func TestAdminAuditFailureLeavesDataUnchanged(t *testing.T) {
+	f := newAdminFixture(t, failingAudit{})
+	now := time.Now()
+	h := &hazard.Hazard{CourseID: 1, ReporterID: 2, Type: hazard.TypeGravel, Severity: hazard.SeverityLow, ReportedAt: now, ExpiresAt: now.Add(time.Hour)}
+	if err := f.hazards.Save(h); err != nil {
+		t.Fatal(err)
+	}
+	r := &review.Review{CourseID: 1, UserID: 2, Text: "좋아요", CreatedAt: now}
+	if err := f.reviews.Save(r); err != nil {
+		t.Fatal(err)
+	}
+	seq, recs := lastSeq(t, f.events), readFile(t, f.recsPath)
+	first, err := f.recs.FindById(1)
+	if err != nil || first == nil {
+		t.Fatalf("recommendation 1 = %v, %v", first, err)
+	}
+	order := slices.Clone(first.CourseIds)
+	if first.IsDynamic() {
+		order = slices.Clone(first.PinnedIds)
+	}
+	slices.Reverse(order)
+
+	newRec := func() *recommendation.Recommendation {
+		return &recommendation.Recommendation{Title: i18n.Text("새 추천"), CourseIds: []int{1, 2}}
+	}
+	tests := []struct {
+		name string
+		run  func() error
+	}{
+		{"코스 삭제", func() error { return f.svc.DeleteCourse(curator, 60, "정리") }},
+		{"추천 추가", func() error { return f.svc.CreateRecommendation(curator, newRec()) }},
+		{"추천 수정", func() error { return f.svc.UpdateRecommendation(curator, 1, newRec()) }},
+		{"추천 순서 변경", func() error { return f.svc.ReorderRecommendation(curator, 1, order) }},
+		{"추천 삭제", func() error { return f.svc.DeleteRecommendation(curator, 1) }},
+		{"위험 신고 숨김", func() error { return f.svc.SetHazardHidden(curator, h.ID, true) }},
+		{"리뷰 숨김", func() error { return f.svc.SetReviewHidden(curator, r.ID, true) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.run(); !errors.Is(err, errAuditUnavailable) {
+				t.Fatalf("error = %v, want %v", err, errAuditUnavailable)
+			}
+		})
+	}
+
+	if !bytes.Equal(readFile(t, f.recsPath), recs) {
+		t.Fatal("recommendations file changed")
+	}
+	if got := lastSeq(t, f.events); got != seq {
+		t.Fatalf("last event seq = %d, want %d", got, seq)
+	}
+	if got, err := f.hazards.FindByID(h.ID); err != nil || got.Hidden {
+		t.Fatalf("hazard hidden = %v, %v; want false", got.Hidden, err)
+	}
+	if got, err := f.reviews.FindByID(r.ID); err != nil || got.Hidden {
+		t.Fatalf("review hidden = %v, %v; want false", got.Hidden, err)
+	}
+}
+
+// 동시에 추가한 추천은 모두 다른 ID를 받고, 작업 기록의 대상 ID와 저장된 ID가 일치한다.
+func TestAdminConcurrentCreateRecommendation(t *testing.T) {
+	f := newAdminFixture(t, nil)
+	before, err := f.recs.FindAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const n = 10
+	created := make([]int, n)
+	var wg sync.WaitGroup
+	for i := range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rec := &recommendation.Recommendation{Title: i18n.Text("동시 추천"), CourseIds: []int{i + 1}}
+			if err := f.svc.CreateRecommendation(curator, rec); err != nil {
+				t.Error(err)
+			}
+			created[i] = rec.ID
+		}()
+	}
+	wg.Wait()
+
+	after, err := f.recs.FindAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(after) != len(before)+n {
+		t.Fatalf("len(recommendations) = %d, want %d", len(after), len(before)+n)
+	}
+	entries, err := f.audit.Find(audit.Filter{TargetType: audit.TargetRecommendation})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var audited []int
+	for _, e := range entries {
+		audited = append(audited, e.TargetID)
+	}
+	slices.Sort(created)
+	slices.Sort(audited)
+	if !slices.Equal(slices.Compact(slices.Clone(created)), created) || !slices.Equal(audited, created) {
+		t.Fatalf("created IDs = %v, audited IDs = %v; want the same distinct IDs", created, audited)
+	}
+	for _, id := range created {
+		rec, err := f.recs.FindById(id)
+		if err != nil || rec == nil || len(rec.CourseIds) != 1 || rec.CourseIds[0] > n {
+			t.Fatalf("recommendation %d = %+v, %v", id, rec, err)
+		}
+	}
+}
+
+// 숨김은 저장과 함께 변경 전후 차이를 기록한다.
+func TestAdminSetHazardHiddenRecordsChange(t *testing.T) {
+	f := newAdminFixture(t, nil)
+	now := time.Now()
+	h := &hazard.Hazard{CourseID: 1, ReporterID: 2, Type: hazard.TypeGravel, Severity: hazard.SeverityLow, ReportedAt: now, ExpiresAt: now.Add(time.Hour)}
+	if err := f.hazards.Save(h); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.svc.SetHazardHidden(curator, h.ID, true); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.svc.SetHazardHidden(curator, 99, true); !errors.Is(err, hazard.ErrHazardNotFound) {
+		t.Fatalf("SetHazardHidden(99) error = %v, want %v", err, hazard.ErrHazardNotFound)
+	}
+	entries, err := f.audit.Find(audit.Filter{TargetType: audit.TargetHazard})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Action != audit.ActionHide || entries[0].TargetID != h.ID ||
+		len(entries[0].Changes) != 1 || entries[0].Changes[0].Path != "Hidden" {
+		t.Fatalf("audit entries = %+v", entries)
+	}
+}
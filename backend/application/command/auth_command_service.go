@@ -0,0 +1,114 @@
+package command
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+)
+
+// AuthCommandService는 회원가입, 로그인, 토큰 재발급을 담당합니다.
+type AuthCommandService struct {
+	repo   user.UserRepository
+	tokens user.TokenService
+	logger *slog.Logger
+	now    func() time.Time
+}
+
+func NewAuthCommandService(repo user.UserRepository, tokens user.TokenService, logger *slog.Logger) *AuthCommandService {
+	return &AuthCommandService{repo: repo, tokens: tokens, logger: logger, now: time.Now}
+}
+
+// Signup은 viewer 권한의 새 사용자를 만들고 토큰을 발급합니다.
+func (svc *AuthCommandService) Signup(email, displayName, password string) (*user.User, user.TokenPair, error) {
+	u, err := user.NewUser(email, displayName, password, svc.now())
+	if err != nil {
+		return nil, user.TokenPair{}, err
+	}
+	existing, err := svc.repo.FindByEmail(u.Email)
+	if err != nil {
+		return nil, user.TokenPair{}, err
+	}
+	if existing != nil {
+		return nil, user.TokenPair{}, user.ErrEmailTaken
+	}
+	if err := svc.repo.Save(u); err != nil {
+		return nil, user.TokenPair{}, err
+	}
+	tokens, err := svc.tokens.Issue(u)
+	return u, tokens, err
+}
+
+// Login은 이메일과 비밀번호를 확인하고 토큰을 발급합니다.
+// 가입되지 않은 이메일도 고정 해시와 비교한 뒤 user.ErrInvalidCredentials를 반환해 응답이나 응답 시간으로 가입 여부를 노출하지 않습니다.
+func (svc *AuthCommandService) Login(email, password string) (*user.User, user.TokenPair, error) {
+	email, err := user.NormalizeEmail(email)
+	if err != nil {
+		return nil, user.TokenPair{}, user.ErrInvalidCredentials
+	}
+	u, err := svc.repo.FindByEmail(email)
+	if err != nil {
+		return nil, user.TokenPair{}, err
+	}
+	if u == nil {
+		return nil, user.TokenPair{}, user.RejectPassword(password)
+	}
+	if err := u.Authenticate(password); err != nil {
+		return nil, user.TokenPair{}, err
+	}
+	tokens, err := svc.tokens.Issue(u)
+	return u, tokens, err
+}
+
+// Refresh는 리프레시 토큰으로 새 토큰을 발급합니다. 삭제된 사용자의 토큰은 user.ErrInvalidToken입니다.
+func (svc *AuthCommandService) Refresh(refreshToken string) (*user.User, user.TokenPair, error) {
+	id, err := svc.tokens.ParseRefresh(refreshToken)
+	if err != nil {
+		return nil, user.TokenPair{}, err
+	}
+	u, err := svc.repo.FindByID(id)
+	if err != nil {
+		return nil, user.TokenPair{}, err
+	}
+	if u == nil {
+		return nil, user.TokenPair{}, user.ErrInvalidToken
+	}
+	tokens, err := svc.tokens.Issue(u)
+	return u, tokens, err
+}
+
+// EnsureAdmin은 관리자 계정이 없으면 만들고, 있으면 admin 권한을 부여합니다. 서버 시작 시 초기 관리자를 준비할 때 사용합니다.
+// 계정이 이미 있으면 password를 설정값으로 보고, 저장된 비밀번호와 다르면 바꾼 뒤 로그를 남깁니다.
+func (svc *AuthCommandService) EnsureAdmin(email, password string) error {
+	email, err := user.NormalizeEmail(email)
+	if err != nil {
+		return err
+	}
+	u, err := svc.repo.FindByEmail(email)
+	if err != nil {
+		return err
+	}
+	changed := false
+	if u == nil {
+		if u, err = user.NewUser(email, "", password, svc.now()); err != nil {
+			return err
+		}
+		changed = true
+	} else if u.Authenticate(password) != nil {
+		if err := u.ChangePassword(password, svc.now()); err != nil {
+			return err
+		}
+		svc.logger.Warn("ADMIN_PASSWORD가 저장된 관리자 비밀번호와 달라 새 값으로 바꿨습니다", "email", email, "user_id", u.ID)
+		changed = true
+	}
+	if u.Role != user.RoleAdmin {
+		if err := u.ChangeRole(user.RoleAdmin, svc.now()); err != nil {
+			return err
+		}
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return svc.repo.Save(u)
+}
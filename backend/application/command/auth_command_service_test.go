@@ -0,0 +1,105 @@
+package command_test
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/application/command"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+	"github.com/sunDar0/winding-road-finder/backend/infrastructure/auth"
+	commandRepo "github.com/sunDar0/winding-road-finder/backend/infrastructure/persistence/command"
+)
+
+var discardLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+func newAuthService(t *testing.T) (*command.AuthCommandService, *commandRepo.UserCommandRepositoryImpl, *auth.JWTTokenService) {
+	t.Helper()
+	repo := commandRepo.NewUserCommandRepository(t.TempDir())
+	tokens := auth.NewJWTTokenService([]byte("test-secret"), time.Minute, time.Hour)
+	return command.NewAuthCommandService(repo, tokens, discardLogger), repo, tokens
+}
+
+func TestSignupLoginRefresh(t *testing.T) {
+	svc, _, tokens := newAuthService(t)
+
+	u, pair, err := svc.Signup(" Driver@Example.com ", "", "password1")
+	if err != nil {
+		t.Fatalf("Signup: %v", err)
+	}
+	if u.Email != "driver@example.com" || u.DisplayName != "driver" || u.Role != user.RoleViewer {
+		t.Fatalf("Signup user = %+v", u)
+	}
+	principal, err := tokens.Verify(pair.AccessToken)
+	if err != nil || principal.UserID != u.ID {
+		t.Fatalf("Verify(access) = %+v, %v", principal, err)
+	}
+	if _, _, err := svc.Signup("driver@example.com", "", "password2"); !errors.Is(err, user.ErrEmailTaken) {
+		t.Fatalf("duplicate Signup error = %v, want ErrEmailTaken", err)
+	}
+
+	if _, _, err := svc.Login("driver@example.com", "wrong-password"); !errors.Is(err, user.ErrInvalidCredentials) {
+		t.Fatalf("Login(wrong password) error = %v", err)
+	}
+	if _, _, err := svc.Login("nobody@example.com", "password1"); !errors.Is(err, user.ErrInvalidCredentials) {
+		t.Fatalf("Login(unknown email) error = %v", err)
+	}
+	if _, _, err := svc.Login("DRIVER@example.com", "password1"); err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+
+	if _, _, err := svc.Refresh(pair.AccessToken); err == nil {
+		t.Fatal("Refresh accepted an access token")
+	}
+	refreshed, _, err := svc.Refresh(pair.RefreshToken)
+	if err != nil || refreshed.ID != u.ID {
+		t.Fatalf("Refresh = %+v, %v", refreshed, err)
+	}
+}
+
+func TestEnsureAdmin(t *testing.T) {
+	svc, repo, _ := newAuthService(t)
+
+	// 없으면 만든다.
+	if err := svc.EnsureAdmin("admin@example.com", "password1"); err != nil {
+		t.Fatalf("EnsureAdmin(new): %v", err)
+	}
+	admin, _ := repo.FindByEmail("admin@example.com")
+	if admin == nil || admin.Role != user.RoleAdmin {
+		t.Fatalf("admin = %+v", admin)
+	}
+
+	// 바뀐 ADMIN_PASSWORD는 적용된다.
+	if err := svc.EnsureAdmin("admin@example.com", "password2"); err != nil {
+		t.Fatalf("EnsureAdmin(changed password): %v", err)
+	}
+	if _, _, err := svc.Login("admin@example.com", "password1"); !errors.Is(err, user.ErrInvalidCredentials) {
+		t.Fatalf("old password still works: %v", err)
+	}
+	if _, _, err := svc.Login("admin@example.com", "password2"); err != nil {
+		t.Fatalf("new password rejected: %v", err)
+	}
+
+	// 약한 비밀번호는 저장된 비밀번호를 바꾸지 않는다.
+	if err := svc.EnsureAdmin("admin@example.com", "short"); !errors.Is(err, user.ErrWeakPassword) {
+		t.Fatalf("EnsureAdmin(weak password) error = %v", err)
+	}
+	if _, _, err := svc.Login("admin@example.com", "password2"); err != nil {
+		t.Fatalf("password changed by rejected value: %v", err)
+	}
+
+	// 기존 viewer는 admin으로 올린다.
+	viewer, _, err := svc.Signup("viewer@example.com", "", "password1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.EnsureAdmin("viewer@example.com", "password1"); err != nil {
+		t.Fatalf("EnsureAdmin(existing viewer): %v", err)
+	}
+	promoted, _ := repo.FindByID(viewer.ID)
+	if promoted.Role != user.RoleAdmin {
+		t.Fatalf("role = %s, want admin", promoted.Role)
+	}
+}
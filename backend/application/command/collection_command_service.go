@@ -0,0 +1,137 @@
+package command
+
+import (
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/collection"
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+)
+
+// CollectionCommandService는 사용자 컬렉션 생성과 편집을 담당합니다.
+// 다른 사용자의 컬렉션은 존재 여부를 드러내지 않도록 collection.ErrCollectionNotFound로 처리합니다.
+type CollectionCommandService struct {
+	repo       collection.CollectionRepository
+	courseRepo course.CourseQueryRepository
+	now        func() time.Time
+}
+
+func NewCollectionCommandService(repo collection.CollectionRepository, courseRepo course.CourseQueryRepository) *CollectionCommandService {
+	return &CollectionCommandService{repo: repo, courseRepo: courseRepo, now: time.Now}
+}
+
+// CollectionChanges는 컬렉션 속성 변경 요청입니다. nil인 항목은 바꾸지 않습니다.
+type CollectionChanges struct {
+	Name   *string
+	Public *bool
+}
+
+// Create는 컬렉션을 만들고 courseIDs 순서대로 코스를 담습니다.
+func (svc *CollectionCommandService) Create(principal *user.Principal, name string, courseIDs []int, public bool) (*collection.Collection, error) {
+	now := svc.now()
+	c, err := collection.NewCollection(principal.UserID, name, now)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range courseIDs {
+		if err := svc.addCourse(c, id, now); err != nil {
+			return nil, err
+		}
+	}
+	if public {
+		c.Publish(randomToken(), now)
+	}
+	if err := svc.repo.Save(c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Update는 이름과 공개 여부를 바꿉니다. 비공개로 바꾸면 기존 공유 링크가 무효화되고, 다시 공개하면 새 링크가 발급됩니다.
+func (svc *CollectionCommandService) Update(principal *user.Principal, id int, changes CollectionChanges) error {
+	return svc.modify(principal, id, func(c *collection.Collection, now time.Time) error {
+		if changes.Name != nil {
+			if err := c.Rename(*changes.Name, now); err != nil {
+				return err
+			}
+		}
+		if changes.Public != nil {
+			if *changes.Public {
+				c.Publish(randomToken(), now)
+			} else {
+				c.Unpublish(now)
+			}
+		}
+		return nil
+	})
+}
+
+// AddCourse는 코스를 컬렉션 끝에 추가합니다. 없는 코스면 collection.ErrUnknownCourse를 반환합니다.
+func (svc *CollectionCommandService) AddCourse(principal *user.Principal, id, courseID int) error {
+	return svc.modify(principal, id, func(c *collection.Collection, now time.Time) error {
+		return svc.addCourse(c, courseID, now)
+	})
+}
+
+// RemoveCourse는 코스를 컬렉션에서 뺍니다.
+func (svc *CollectionCommandService) RemoveCourse(principal *user.Principal, id, courseID int) error {
+	return svc.modify(principal, id, func(c *collection.Collection, now time.Time) error {
+		c.RemoveCourse(courseID, now)
+		return nil
+	})
+}
+
+// Reorder는 컬렉션의 코스 순서를 바꿉니다.
+func (svc *CollectionCommandService) Reorder(principal *user.Principal, id int, order []int) error {
+	return svc.modify(principal, id, func(c *collection.Collection, now time.Time) error {
+		return c.Reorder(order, now)
+	})
+}
+
+// Delete는 컬렉션을 삭제합니다.
+func (svc *CollectionCommandService) Delete(principal *user.Principal, id int) error {
+	if _, err := svc.findOwned(principal, id); err != nil {
+		return err
+	}
+	return svc.repo.Delete(id)
+}
+
+// modify는 소유자를 확인한 뒤 컬렉션을 변경해 저장합니다.
+// 저장소 잠금 안에서 읽고 저장하므로 동시에 들어온 편집이 서로를 덮어쓰지 않습니다.
+func (svc *CollectionCommandService) modify(principal *user.Principal, id int, change func(*collection.Collection, time.Time) error) error {
+	c, err := svc.repo.Update(id, func(c *collection.Collection) error {
+		if !c.OwnedBy(principal.UserID) {
+			return collection.ErrCollectionNotFound
+		}
+		return change(c, svc.now())
+	})
+	if err != nil {
+		return err
+	}
+	if c == nil {
+		return collection.ErrCollectionNotFound
+	}
+	return nil
+}
+
+func (svc *CollectionCommandService) findOwned(principal *user.Principal, id int) (*collection.Collection, error) {
+	c, err := svc.repo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil || !c.OwnedBy(principal.UserID) {
+		return nil, collection.ErrCollectionNotFound
+	}
+	return c, nil
+}
+
+func (svc *CollectionCommandService) addCourse(c *collection.Collection, courseID int, now time.Time) error {
+	found, err := svc.courseRepo.FindByID(courseID)
+	if err != nil {
+		return err
+	}
+	if found == nil {
+		return collection.ErrUnknownCourse
+	}
+	return c.AddCourse(courseID, now)
+}
@@ -0,0 +1,87 @@
+package command_test
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/sunDar0/winding-road-finder/backend/application/command"
+	"github.com/sunDar0/winding-road-finder/backend/domain/collection"
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+	commandRepo "github.com/sunDar0/winding-road-finder/backend/infrastructure/persistence/command"
+)
+
+// stubCourses는 1~100번 코스만 있는 조회 저장소입니다.
+type stubCourses struct{}
+
+func (stubCourses) FindAll(course.CourseFilter) ([]*course.CourseAggregate, error) { return nil, nil }
+
+func (stubCourses) FindByID(id int) (*course.CourseAggregate, error) {
+	if id < 1 || id > 100 {
+		return nil, nil
+	}
+	return &course.CourseAggregate{ID: id}, nil
+}
+
+func TestCollectionConcurrentAddCourse(t *testing.T) {
+	dir := t.TempDir()
+	svc := command.NewCollectionCommandService(commandRepo.NewCollectionCommandRepository(dir), stubCourses{})
+	owner := &user.Principal{UserID: 1, Role: user.RoleViewer}
+	c, err := svc.Create(owner, "주말 코스", nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 동시에 담은 코스가 하나도 빠지지 않는다.
+	var wg sync.WaitGroup
+	for courseID := 1; courseID <= 20; courseID++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := svc.AddCourse(owner, c.ID, courseID); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	stored, err := commandRepo.NewCollectionCommandRepository(dir).FindByID(c.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := slices.Sorted(slices.Values(stored.CourseIDs))
+	if len(got) != 20 || got[0] != 1 || got[19] != 20 {
+		t.Fatalf("CourseIDs = %v, want 1..20", got)
+	}
+}
+
+func TestCollectionModifyChecksOwner(t *testing.T) {
+	svc := command.NewCollectionCommandService(commandRepo.NewCollectionCommandRepository(t.TempDir()), stubCourses{})
+	owner := &user.Principal{UserID: 1, Role: user.RoleViewer}
+	other := &user.Principal{UserID: 2, Role: user.RoleViewer}
+	c, err := svc.Create(owner, "주말 코스", []int{1}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name      string
+		principal *user.Principal
+		id        int
+		courseID  int
+		want      error
+	}{
+		{"다른 사용자의 컬렉션", other, c.ID, 2, collection.ErrCollectionNotFound},
+		{"없는 컬렉션", owner, 99, 2, collection.ErrCollectionNotFound},
+		{"없는 코스", owner, c.ID, 999, collection.ErrUnknownCourse},
+		{"소유자", owner, c.ID, 2, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := svc.AddCourse(tt.principal, tt.id, tt.courseID); !errors.Is(err, tt.want) {
+				t.Fatalf("AddCourse error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
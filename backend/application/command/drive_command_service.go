@@ -0,0 +1,115 @@
+package command
+
+import (
+	"io"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/drive"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+)
+
+// DriveCommandService는 주행 기록 작성과 편집을 담당합니다.
+// 다른 사용자의 기록은 존재 여부를 드러내지 않도록 drive.ErrDriveNotFound로 처리합니다.
+type DriveCommandService struct {
+	repo       drive.DriveRepository
+	courseRepo course.CourseQueryRepository
+	now        func() time.Time
+}
+
+func NewDriveCommandService(repo drive.DriveRepository, courseRepo course.CourseQueryRepository) *DriveCommandService {
+	return &DriveCommandService{repo: repo, courseRepo: courseRepo, now: time.Now}
+}
+
+// Record는 주행 기록을 저장합니다. 없는 코스면 drive.ErrUnknownCourse를 반환합니다.
+func (svc *DriveCommandService) Record(principal *user.Principal, details drive.Details) (*drive.Drive, error) {
+	if err := svc.checkCourse(details.CourseID); err != nil {
+		return nil, err
+	}
+	d, err := drive.NewDrive(principal.UserID, details, svc.now())
+	if err != nil {
+		return nil, err
+	}
+	if err := svc.repo.Save(d); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Update는 주행 기록의 입력 항목을 바꿉니다. 궤적은 유지합니다.
+func (svc *DriveCommandService) Update(principal *user.Principal, id int, details drive.Details) error {
+	if err := svc.checkCourse(details.CourseID); err != nil {
+		return err
+	}
+	return svc.modify(principal, id, func(d *drive.Drive, now time.Time) error {
+		return d.Update(details, now)
+	})
+}
+
+// UploadTrack은 GPX 문서를 읽어 주행 기록에 궤적을 붙입니다.
+func (svc *DriveCommandService) UploadTrack(principal *user.Principal, id int, gpx io.Reader) error {
+	track, err := drive.ParseGPX(gpx)
+	if err != nil {
+		return err
+	}
+	return svc.modify(principal, id, func(d *drive.Drive, now time.Time) error {
+		d.AttachTrack(track, now)
+		return nil
+	})
+}
+
+// DeleteTrack은 주행 기록의 궤적을 지웁니다.
+func (svc *DriveCommandService) DeleteTrack(principal *user.Principal, id int) error {
+	return svc.modify(principal, id, func(d *drive.Drive, now time.Time) error {
+		d.DetachTrack(now)
+		return nil
+	})
+}
+
+// Delete는 주행 기록을 삭제합니다.
+func (svc *DriveCommandService) Delete(principal *user.Principal, id int) error {
+	if _, err := svc.findOwned(principal, id); err != nil {
+		return err
+	}
+	return svc.repo.Delete(id)
+}
+
+// modify는 작성자를 확인한 뒤 주행 기록을 변경해 저장합니다.
+// 저장소 잠금 안에서 읽고 저장하므로 동시에 들어온 편집이 서로를 덮어쓰지 않습니다.
+func (svc *DriveCommandService) modify(principal *user.Principal, id int, change func(*drive.Drive, time.Time) error) error {
+	d, err := svc.repo.Update(id, func(d *drive.Drive) error {
+		if !d.OwnedBy(principal.UserID) {
+			return drive.ErrDriveNotFound
+		}
+		return change(d, svc.now())
+	})
+	if err != nil {
+		return err
+	}
+	if d == nil {
+		return drive.ErrDriveNotFound
+	}
+	return nil
+}
+
+func (svc *DriveCommandService) findOwned(principal *user.Principal, id int) (*drive.Drive, error) {
+	d, err := svc.repo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if d == nil || !d.OwnedBy(principal.UserID) {
+		return nil, drive.ErrDriveNotFound
+	}
+	return d, nil
+}
+
+func (svc *DriveCommandService) checkCourse(courseID int) error {
+	c, err := svc.courseRepo.FindByID(courseID)
+	if err != nil {
+		return err
+	}
+	if c == nil {
+		return drive.ErrUnknownCourse
+	}
+	return nil
+}
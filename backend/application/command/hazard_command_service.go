@@ -0,0 +1,55 @@
+package command
+
+import (
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/hazard"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+)
+
+// HazardCommandService는 위험 신고와 확인·해소 투표를 담당합니다.
+type HazardCommandService struct {
+	repo       hazard.HazardRepository
+	courseRepo course.CourseQueryRepository
+	now        func() time.Time
+}
+
+func NewHazardCommandService(repo hazard.HazardRepository, courseRepo course.CourseQueryRepository) *HazardCommandService {
+	return &HazardCommandService{repo: repo, courseRepo: courseRepo, now: time.Now}
+}
+
+// Report는 코스 위의 위험 요소를 신고합니다. 없는 코스면 hazard.ErrUnknownCourse를 반환합니다.
+func (svc *HazardCommandService) Report(principal *user.Principal, courseID int, t hazard.Type, severity hazard.Severity, location course.CourseGeolocation, note string) (*hazard.Hazard, error) {
+	c, err := svc.courseRepo.FindByID(courseID)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil {
+		return nil, hazard.ErrUnknownCourse
+	}
+	h, err := hazard.Report(c, principal.UserID, t, severity, location, note, svc.now())
+	if err != nil {
+		return nil, err
+	}
+	if err := svc.repo.Save(h); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// Vote는 신고에 확인 또는 해소 투표를 합니다. 만료되었거나 해소된 신고에도 투표할 수 있어 다시 활성화될 수 있습니다.
+// 관리자가 숨긴 신고는 hazard.ErrHazardNotFound입니다.
+// 저장소 잠금 안에서 읽고 저장하므로 동시에 들어온 투표가 서로를 덮어쓰지 않습니다.
+func (svc *HazardCommandService) Vote(principal *user.Principal, id int, v hazard.Vote) (*hazard.Hazard, error) {
+	h, err := svc.repo.Update(id, func(h *hazard.Hazard) error {
+		return h.CastVote(principal.UserID, v, svc.now())
+	})
+	if err != nil {
+		return nil, err
+	}
+	if h == nil {
+		return nil, hazard.ErrHazardNotFound
+	}
+	return h, nil
+}
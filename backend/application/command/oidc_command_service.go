@@ -0,0 +1,246 @@
+package command
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+)
+
+// ErrInvalidState는 알 수 없거나 만료된 로그인 요청(state)입니다.
+var ErrInvalidState = errors.New("알 수 없거나 만료된 로그인 요청입니다")
+
+const (
+	// loginRequestTTL은 인가 요청을 시작한 뒤 콜백까지 허용하는 시간입니다.
+	loginRequestTTL = 10 * time.Minute
+	// loginTicketTTL은 콜백 뒤 프런트엔드가 토큰을 받아 가기까지 허용하는 시간입니다.
+	loginTicketTTL = time.Minute
+)
+
+// pendingLogin은 인가 요청을 시작할 때 만든 PKCE/nonce 값입니다. 콜백에서 state로 찾아 한 번만 사용합니다.
+type pendingLogin struct {
+	provider     string
+	codeVerifier string
+	nonce        string
+	// linkUserID가 있으면 로그인 대신 해당 사용자에게 외부 계정을 연결합니다.
+	linkUserID int
+	expiresAt  time.Time
+}
+
+// loginTicket은 콜백에서 로그인한 사용자에게 토큰을 한 번 발급하기 위한 값입니다.
+type loginTicket struct {
+	userID    int
+	expiresAt time.Time
+}
+
+// OIDCCommandService는 외부 공급자 로그인(OIDC 인가 코드 + PKCE)과 계정 연결을 담당합니다.
+type OIDCCommandService struct {
+	repo      user.UserRepository
+	tokens    user.TokenService
+	providers map[string]user.IdentityProvider
+	now       func() time.Time
+
+	mu      sync.Mutex
+	pending map[string]pendingLogin
+	tickets map[string]loginTicket
+}
+
+func NewOIDCCommandService(repo user.UserRepository, tokens user.TokenService, providers []user.IdentityProvider) *OIDCCommandService {
+	svc := &OIDCCommandService{
+		repo:      repo,
+		tokens:    tokens,
+		providers: make(map[string]user.IdentityProvider, len(providers)),
+		now:       time.Now,
+		pending:   map[string]pendingLogin{},
+		tickets:   map[string]loginTicket{},
+	}
+	for _, p := range providers {
+		svc.providers[p.Name()] = p
+	}
+	return svc
+}
+
+// Providers는 설정된 공급자 이름 목록을 반환합니다.
+func (svc *OIDCCommandService) Providers() []string {
+	names := make([]string, 0, len(svc.providers))
+	for name := range svc.providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LoginRequest는 StartLogin이 만든 인가 요청입니다.
+// State는 요청을 시작한 브라우저에만 (HttpOnly 쿠키로) 전달하고, 콜백에서 CompleteLogin의 boundState로 다시 넘겨야 합니다.
+type LoginRequest struct {
+	URL   string
+	State string
+}
+
+// LoginResult는 콜백 처리 결과입니다.
+type LoginResult struct {
+	User *user.User
+	// Linked는 로그인한 사용자가 시작한 계정 연결이었는지 여부입니다. 이때는 토큰을 발급하지 않습니다.
+	Linked bool
+	// Ticket은 RedeemTicket으로 토큰을 한 번 받을 수 있는 값입니다. 계정 연결이면 비어 있습니다.
+	Ticket string
+}
+
+// StartLogin은 인가 요청 URL을 만듭니다. principal이 있으면 콜백에서 그 사용자에게 외부 계정을 연결합니다.
+func (svc *OIDCCommandService) StartLogin(ctx context.Context, providerName string, principal *user.Principal) (LoginRequest, error) {
+	provider, ok := svc.providers[providerName]
+	if !ok {
+		return LoginRequest{}, user.ErrUnknownProvider
+	}
+	state, nonce, verifier := randomToken(), randomToken(), randomToken()
+	challenge := sha256.Sum256([]byte(verifier))
+	url, err := provider.AuthCodeURL(ctx, state, nonce, base64.RawURLEncoding.EncodeToString(challenge[:]))
+	if err != nil {
+		return LoginRequest{}, err
+	}
+
+	login := pendingLogin{provider: providerName, codeVerifier: verifier, nonce: nonce, expiresAt: svc.now().Add(loginRequestTTL)}
+	if principal != nil {
+		login.linkUserID = principal.UserID
+	}
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	svc.purgeExpired()
+	svc.pending[state] = login
+	return LoginRequest{URL: url, State: state}, nil
+}
+
+// CompleteLogin은 콜백의 인가 코드를 교환해 사용자를 결정합니다. boundState는 요청을 시작한 브라우저가 가진 state로,
+// 콜백의 state와 다르면 다른 브라우저에서 시작한 요청으로 보고 ErrInvalidState를 반환합니다.
+//
+// 사용자는 다음 순서로 결정합니다. 같은 이메일의 계정이 있어도 자동으로 연결하지 않습니다.
+//  1. 인가 요청을 로그인한 사용자가 시작했으면 그 사용자에게 외부 계정을 연결
+//  2. 외부 계정이 이미 연결된 사용자
+//  3. 공급자가 인증한 이메일의 계정이 이미 있으면 user.ErrLinkRequired (로그인한 뒤 연결해야 함)
+//  4. 새 사용자 생성 (이메일이 없거나 인증되지 않았으면 이메일 없는 계정)
+func (svc *OIDCCommandService) CompleteLogin(ctx context.Context, providerName, code, state, boundState string) (LoginResult, error) {
+	if boundState == "" || subtle.ConstantTimeCompare([]byte(state), []byte(boundState)) != 1 {
+		return LoginResult{}, ErrInvalidState
+	}
+	login, err := svc.takePending(providerName, state)
+	if err != nil {
+		return LoginResult{}, err
+	}
+	claims, err := svc.providers[providerName].Exchange(ctx, code, login.codeVerifier, login.nonce)
+	if err != nil {
+		return LoginResult{}, err
+	}
+	u, err := svc.resolveUser(login, claims)
+	if err != nil {
+		return LoginResult{}, err
+	}
+	if login.linkUserID != 0 {
+		return LoginResult{User: u, Linked: true}, nil
+	}
+	ticket := randomToken()
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	svc.tickets[ticket] = loginTicket{userID: u.ID, expiresAt: svc.now().Add(loginTicketTTL)}
+	return LoginResult{User: u, Ticket: ticket}, nil
+}
+
+// RedeemTicket은 CompleteLogin이 발급한 일회용 ticket으로 토큰을 발급합니다.
+func (svc *OIDCCommandService) RedeemTicket(ticket string) (*user.User, user.TokenPair, error) {
+	svc.mu.Lock()
+	t, ok := svc.tickets[ticket]
+	delete(svc.tickets, ticket)
+	svc.mu.Unlock()
+	if !ok || svc.now().After(t.expiresAt) {
+		return nil, user.TokenPair{}, ErrInvalidState
+	}
+	u, err := svc.repo.FindByID(t.userID)
+	if err != nil {
+		return nil, user.TokenPair{}, err
+	}
+	if u == nil {
+		return nil, user.TokenPair{}, user.ErrUserNotFound
+	}
+	tokens, err := svc.tokens.Issue(u)
+	return u, tokens, err
+}
+
+func (svc *OIDCCommandService) resolveUser(login pendingLogin, claims user.ExternalClaims) (*user.User, error) {
+	now := svc.now()
+	linked, err := svc.repo.FindByIdentity(claims.Provider, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	if login.linkUserID != 0 {
+		if linked != nil && linked.ID != login.linkUserID {
+			return nil, user.ErrIdentityLinked
+		}
+		u, err := svc.repo.FindByID(login.linkUserID)
+		if err != nil {
+			return nil, err
+		}
+		if u == nil {
+			return nil, user.ErrUserNotFound
+		}
+		u.LinkIdentity(claims, now)
+		return u, svc.repo.Save(u)
+	}
+
+	if linked != nil {
+		return linked, nil
+	}
+	u, err := user.NewExternalUser(claims, now)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := svc.repo.FindByEmail(u.Email)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, user.ErrLinkRequired
+	}
+	return u, svc.repo.Save(u)
+}
+
+// takePending은 state에 해당하는 로그인 요청을 꺼냅니다. 재사용을 막기 위해 찾은 요청은 바로 삭제합니다.
+func (svc *OIDCCommandService) takePending(providerName, state string) (pendingLogin, error) {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	login, ok := svc.pending[state]
+	delete(svc.pending, state)
+	if !ok || login.provider != providerName || svc.now().After(login.expiresAt) {
+		return pendingLogin{}, ErrInvalidState
+	}
+	return login, nil
+}
+
+func (svc *OIDCCommandService) purgeExpired() {
+	now := svc.now()
+	for state, login := range svc.pending {
+		if now.After(login.expiresAt) {
+			delete(svc.pending, state)
+		}
+	}
+	for ticket, t := range svc.tickets {
+		if now.After(t.expiresAt) {
+			delete(svc.tickets, ticket)
+		}
+	}
+}
+
+// randomToken은 state, nonce, PKCE code_verifier, 공유 링크로 쓰는 추측 불가능한 문자열을 만듭니다.
+func randomToken() string {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,262 @@
+package command_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/application/command"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+	"github.com/sunDar0/winding-road-finder/backend/infrastructure/auth"
+	"github.com/sunDar0/winding-road-finder/backend/infrastructure/external/oidc"
+	commandRepo "github.com/sunDar0/winding-road-finder/backend/infrastructure/persistence/command"
+)
+
+// oidcFixture는 모의 OIDC 서버를 띄우고 그 서버를 쓰는 서비스를 준비합니다.
+type oidcFixture struct {
+	svc   *command.OIDCCommandService
+	auth  *command.AuthCommandService
+	users *commandRepo.UserCommandRepositoryImpl
+}
+
+func newOIDCFixture(t *testing.T, wrap func(user.IdentityProvider) user.IdentityProvider) *oidcFixture {
+	t.Helper()
+	var mock *oidc.MockServer
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { mock.ServeHTTP(w, r) }))
+	t.Cleanup(srv.Close)
+	var err error
+	if mock, err = oidc.NewMockServer(srv.URL, nil); err != nil {
+		t.Fatal(err)
+	}
+	var provider user.IdentityProvider = oidc.NewProvider(oidc.Config{
+		Name:        "mock",
+		Issuer:      srv.URL,
+		ClientID:    "winding-road-finder",
+		RedirectURL: "http://localhost:8080/api/auth/oidc/mock/callback",
+	})
+	if wrap != nil {
+		provider = wrap(provider)
+	}
+	users := commandRepo.NewUserCommandRepository(t.TempDir())
+	tokens := auth.NewJWTTokenService([]byte("test-secret"), time.Minute, time.Hour)
+	return &oidcFixture{
+		svc:   command.NewOIDCCommandService(users, tokens, []user.IdentityProvider{provider}),
+		auth:  command.NewAuthCommandService(users, tokens, discardLogger),
+		users: users,
+	}
+}
+
+// authorize는 인가 요청을 시작하고 모의 서버의 인가 엔드포인트를 거쳐 콜백으로 받을 code, state를 반환합니다.
+func (f *oidcFixture) authorize(t *testing.T, principal *user.Principal, loginHint string) (login command.LoginRequest, code, state string) {
+	t.Helper()
+	login, err := f.svc.StartLogin(context.Background(), "mock", principal)
+	if err != nil {
+		t.Fatalf("StartLogin: %v", err)
+	}
+	authURL, _ := url.Parse(login.URL)
+	q := authURL.Query()
+	if q.Get("code_challenge_method") != "S256" || q.Get("code_challenge") == "" || q.Get("nonce") == "" || q.Get("state") != login.State {
+		t.Fatalf("authorization URL is missing PKCE/nonce/state: %s", login.URL)
+	}
+	q.Set("login_hint", loginHint)
+	authURL.RawQuery = q.Encode()
+
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	resp, err := client.Get(authURL.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	callback, err := resp.Location()
+	if err != nil {
+		t.Fatalf("authorize did not redirect: %d", resp.StatusCode)
+	}
+	return login, callback.Query().Get("code"), callback.Query().Get("state")
+}
+
+// login은 같은 브라우저에서 로그인 흐름을 끝내고 ticket으로 토큰을 받습니다.
+func (f *oidcFixture) login(t *testing.T, loginHint string) (*user.User, error) {
+	t.Helper()
+	login, code, state := f.authorize(t, nil, loginHint)
+	result, err := f.svc.CompleteLogin(context.Background(), "mock", code, state, login.State)
+	if err != nil {
+		return nil, err
+	}
+	u, tokens, err := f.svc.RedeemTicket(result.Ticket)
+	if err != nil {
+		t.Fatalf("RedeemTicket: %v", err)
+	}
+	if tokens.AccessToken == "" || u.ID != result.User.ID {
+		t.Fatalf("RedeemTicket = %+v, %+v", u, tokens)
+	}
+	return u, nil
+}
+
+func TestOIDCLoginCreatesUserAndTicketIsSingleUse(t *testing.T) {
+	f := newOIDCFixture(t, nil)
+	login, code, state := f.authorize(t, nil, "driver@example.com")
+	result, err := f.svc.CompleteLogin(context.Background(), "mock", code, state, login.State)
+	if err != nil {
+		t.Fatalf("CompleteLogin: %v", err)
+	}
+	if result.Linked || result.Ticket == "" {
+		t.Fatalf("result = %+v", result)
+	}
+	if u := result.User; u.Email != "driver@example.com" || u.DisplayName != "드라이버" || u.PasswordHash != "" || !u.HasIdentity("mock", "mock-1001") {
+		t.Fatalf("new user = %+v", u)
+	}
+	if _, _, err := f.svc.RedeemTicket(result.Ticket); err != nil {
+		t.Fatalf("RedeemTicket: %v", err)
+	}
+	if _, _, err := f.svc.RedeemTicket(result.Ticket); !errors.Is(err, command.ErrInvalidState) {
+		t.Fatalf("second RedeemTicket error = %v, want ErrInvalidState", err)
+	}
+
+	// 같은 외부 계정으로 다시 로그인하면 같은 사용자다.
+	again, err := f.login(t, "driver@example.com")
+	if err != nil || again.ID != result.User.ID {
+		t.Fatalf("second login = %+v, %v", again, err)
+	}
+}
+
+func TestOIDCStateIsSingleUseAndBoundToBrowser(t *testing.T) {
+	f := newOIDCFixture(t, nil)
+	ctx := context.Background()
+
+	// 다른 브라우저(쿠키 없음, 또는 다른 요청의 state 쿠키)로 온 콜백은 거부하고, 원래 요청은 그대로 남는다.
+	login, code, state := f.authorize(t, nil, "driver@example.com")
+	other, _, _ := f.authorize(t, nil, "driver@example.com")
+	for _, bound := range []string{"", other.State} {
+		if _, err := f.svc.CompleteLogin(ctx, "mock", code, state, bound); !errors.Is(err, command.ErrInvalidState) {
+			t.Fatalf("CompleteLogin(bound=%q) error = %v, want ErrInvalidState", bound, err)
+		}
+	}
+	if _, err := f.svc.CompleteLogin(ctx, "mock", code, state, login.State); err != nil {
+		t.Fatalf("CompleteLogin from starting browser: %v", err)
+	}
+
+	// 같은 state를 다시 쓰면 거부한다.
+	if _, err := f.svc.CompleteLogin(ctx, "mock", code, state, login.State); !errors.Is(err, command.ErrInvalidState) {
+		t.Fatalf("reused state error = %v, want ErrInvalidState", err)
+	}
+	// 다른 공급자 이름으로 온 콜백도 거부한다.
+	login, code, state = f.authorize(t, nil, "driver@example.com")
+	if _, err := f.svc.CompleteLogin(ctx, "google", code, state, login.State); !errors.Is(err, command.ErrInvalidState) {
+		t.Fatalf("provider mismatch error = %v, want ErrInvalidState", err)
+	}
+}
+
+// tamperingProvider는 토큰 교환 때 PKCE code_verifier나 nonce를 바꿔 보내는 공급자입니다.
+type tamperingProvider struct {
+	user.IdentityProvider
+	verifier, nonce string
+}
+
+func (p tamperingProvider) Exchange(ctx context.Context, code, codeVerifier, nonce string) (user.ExternalClaims, error) {
+	if p.verifier != "" {
+		codeVerifier = p.verifier
+	}
+	if p.nonce != "" {
+		nonce = p.nonce
+	}
+	return p.IdentityProvider.Exchange(ctx, code, codeVerifier, nonce)
+}
+
+func TestOIDCRejectsWrongVerifierAndNonce(t *testing.T) {
+	tests := []struct {
+		name   string
+		tamper tamperingProvider
+	}{
+		{"PKCE code_verifier", tamperingProvider{verifier: "not-the-verifier"}},
+		{"nonce", tamperingProvider{nonce: "not-the-nonce"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newOIDCFixture(t, func(p user.IdentityProvider) user.IdentityProvider {
+				tt.tamper.IdentityProvider = p
+				return tt.tamper
+			})
+			login, code, state := f.authorize(t, nil, "driver@example.com")
+			if _, err := f.svc.CompleteLogin(context.Background(), "mock", code, state, login.State); !errors.Is(err, oidc.ErrProvider) {
+				t.Fatalf("CompleteLogin error = %v, want ErrProvider", err)
+			}
+			if u, _ := f.users.FindByIdentity("mock", "mock-1001"); u != nil {
+				t.Fatalf("user created despite failed verification: %+v", u)
+			}
+		})
+	}
+}
+
+func TestOIDCDoesNotAutoLinkByEmail(t *testing.T) {
+	f := newOIDCFixture(t, nil)
+	local, _, err := f.auth.Signup("driver@example.com", "", "password1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 공급자가 인증한 같은 이메일이어도 기존 비밀번호 계정에 연결하지 않는다.
+	if _, err := f.login(t, "driver@example.com"); !errors.Is(err, user.ErrLinkRequired) {
+		t.Fatalf("login error = %v, want ErrLinkRequired", err)
+	}
+	if u, _ := f.users.FindByIdentity("mock", "mock-1001"); u != nil {
+		t.Fatalf("identity linked without consent: %+v", u)
+	}
+
+	// 로그인한 사용자가 시작한 연결 흐름으로만 연결된다. 연결에는 토큰을 발급하지 않는다.
+	principal := local.Principal()
+	login, code, state := f.authorize(t, &principal, "driver@example.com")
+	result, err := f.svc.CompleteLogin(context.Background(), "mock", code, state, login.State)
+	if err != nil {
+		t.Fatalf("link CompleteLogin: %v", err)
+	}
+	if !result.Linked || result.Ticket != "" || result.User.ID != local.ID {
+		t.Fatalf("link result = %+v", result)
+	}
+	u, err := f.login(t, "driver@example.com")
+	if err != nil || u.ID != local.ID {
+		t.Fatalf("login after link = %+v, %v", u, err)
+	}
+
+	// 이미 연결된 외부 계정을 다른 사용자에게 연결할 수 없다.
+	other, _, err := f.auth.Signup("other@example.com", "", "password1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	principal = other.Principal()
+	login, code, state = f.authorize(t, &principal, "driver@example.com")
+	if _, err := f.svc.CompleteLogin(context.Background(), "mock", code, state, login.State); !errors.Is(err, user.ErrIdentityLinked) {
+		t.Fatalf("link to second user error = %v, want ErrIdentityLinked", err)
+	}
+}
+
+func TestOIDCUnverifiedOrMissingEmail(t *testing.T) {
+	f := newOIDCFixture(t, nil)
+	if _, _, err := f.auth.Signup("unverified@example.com", "", "password1"); err != nil {
+		t.Fatal(err)
+	}
+
+	// 인증되지 않은 이메일은 계정 이메일로 쓰지 않으므로 같은 이메일의 계정과 충돌하지 않는다.
+	unverified, err := f.login(t, "mock-1002")
+	if err != nil {
+		t.Fatalf("unverified login: %v", err)
+	}
+	if unverified.Email != "" || unverified.DisplayName != "미인증 사용자" {
+		t.Fatalf("unverified user = %+v", unverified)
+	}
+
+	// 카카오처럼 이메일이 없어도 이메일 없는 계정을 만든다. 이메일 없는 계정이 여럿이어도 된다.
+	noEmail, err := f.login(t, "mock-1003")
+	if err != nil {
+		t.Fatalf("login without email: %v", err)
+	}
+	if noEmail.Email != "" || noEmail.ID == unverified.ID || !noEmail.HasIdentity("mock", "mock-1003") {
+		t.Fatalf("user without email = %+v", noEmail)
+	}
+	if u, _ := f.users.FindByEmail(""); u != nil {
+		t.Fatalf("FindByEmail(\"\") = %+v, want nil", u)
+	}
+}
@@ -0,0 +1,102 @@
+package command
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/photo"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+)
+
+// PhotoCommandService는 코스 사진 업로드와 삭제를 담당합니다.
+// 업로드한 사진은 크기별 변형 이미지로 다시 인코딩해 저장하므로, 원본의 EXIF(촬영 위치 등)는 공개되지 않습니다.
+type PhotoCommandService struct {
+	repo       photo.PhotoRepository
+	courseRepo course.CourseQueryRepository
+	processor  photo.ImageProcessor
+	store      photo.MediaStore
+	logger     *slog.Logger
+	now        func() time.Time
+}
+
+func NewPhotoCommandService(repo photo.PhotoRepository, courseRepo course.CourseQueryRepository, processor photo.ImageProcessor, store photo.MediaStore, logger *slog.Logger) *PhotoCommandService {
+	return &PhotoCommandService{repo: repo, courseRepo: courseRepo, processor: processor, store: store, logger: logger, now: time.Now}
+}
+
+// Upload는 코스에 사진을 올립니다. EXIF에 촬영 위치가 있으면 코스 경로 위에 배치합니다.
+// 변형 이미지를 저장하다 실패하면 이미 저장한 파일을 지우고 photo.ErrStoreUnavailable을 감싼 에러를 반환합니다.
+func (svc *PhotoCommandService) Upload(ctx context.Context, principal *user.Principal, courseID int, data []byte, caption string) (_ *photo.Photo, err error) {
+	ctx, span := tracer.Start(ctx, "PhotoCommandService.Upload")
+	defer func() { endSpan(span, err) }()
+	caption, err = photo.NormalizeCaption(caption)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > photo.MaxUploadBytes {
+		return nil, photo.ErrImageTooLarge
+	}
+	c, err := tracedFindCourse(ctx, svc.courseRepo, courseID)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil {
+		return nil, photo.ErrUnknownCourse
+	}
+	img, err := svc.processor.Process(data, photo.DefaultVariants)
+	if err != nil {
+		return nil, err
+	}
+	prefix := fmt.Sprintf("courses/%d/%s", courseID, randomToken())
+	variants := make([]photo.Variant, 0, len(img.Variants))
+	for _, v := range img.Variants {
+		key := fmt.Sprintf("%s/%s.jpg", prefix, v.Name)
+		if err := svc.store.Put(ctx, key, bytes.NewReader(v.Data), int64(len(v.Data)), v.ContentType); err != nil {
+			svc.removeFiles(ctx, variants)
+			return nil, err
+		}
+		variants = append(variants, photo.Variant{Name: v.Name, Width: v.Width, Height: v.Height, Key: key})
+	}
+	p, err := photo.New(c, principal.UserID, caption, img, variants, svc.now())
+	if err == nil {
+		err = svc.repo.Save(p)
+	}
+	if err != nil {
+		svc.removeFiles(ctx, variants)
+		return nil, err
+	}
+	return p, nil
+}
+
+// Delete는 사진을 지웁니다. 올린 사람과 editor 이상 권한 사용자만 지울 수 있으며,
+// 그 밖에는 photo.ErrPhotoNotFound로 처리합니다. 목록에서 먼저 빼고 파일은 나중에 지우므로,
+// 파일 삭제에 실패해도 사진은 더 이상 보이지 않습니다.
+func (svc *PhotoCommandService) Delete(ctx context.Context, principal *user.Principal, id int) (err error) {
+	ctx, span := tracer.Start(ctx, "PhotoCommandService.Delete")
+	defer func() { endSpan(span, err) }()
+	p, err := svc.repo.FindByID(id)
+	if err != nil {
+		return err
+	}
+	if p == nil || !(p.UploadedBy(principal.UserID) || principal.Role.Allows(user.RoleEditor)) {
+		return photo.ErrPhotoNotFound
+	}
+	if err := svc.repo.Delete(id); err != nil {
+		return err
+	}
+	svc.removeFiles(ctx, p.Variants)
+	return nil
+}
+
+// removeFiles는 변형 이미지 파일을 지웁니다. 요청이 취소되어도 끝까지 지우며, 실패하면 기록만 남기고 넘어갑니다.
+func (svc *PhotoCommandService) removeFiles(ctx context.Context, variants []photo.Variant) {
+	ctx = context.WithoutCancel(ctx)
+	for _, v := range variants {
+		if err := svc.store.Delete(ctx, v.Key); err != nil {
+			svc.logger.WarnContext(ctx, "사진 파일을 지우지 못했습니다", "key", v.Key, "error", err)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package command
+
+import (
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/review"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+)
+
+// ReviewCommandService는 코스 리뷰 작성을 담당합니다.
+type ReviewCommandService struct {
+	repo       review.ReviewRepository
+	courseRepo course.CourseQueryRepository
+	now        func() time.Time
+}
+
+func NewReviewCommandService(repo review.ReviewRepository, courseRepo course.CourseQueryRepository) *ReviewCommandService {
+	return &ReviewCommandService{repo: repo, courseRepo: courseRepo, now: time.Now}
+}
+
+// PostReview는 로그인한 사용자의 리뷰를 저장합니다. 코스가 없으면 nil을 반환합니다.
+// 사용자당 코스 하나에 리뷰 하나만 남길 수 있습니다.
+func (svc *ReviewCommandService) PostReview(principal *user.Principal, courseID int, ratings course.CourseRatings, text string, visitedOn time.Time) (*review.Review, error) {
+	c, err := svc.courseRepo.FindByID(courseID)
+	if err != nil || c == nil {
+		return nil, err
+	}
+	r, err := review.NewReview(courseID, principal.UserID, ratings, text, visitedOn, svc.now())
+	if err != nil {
+		return nil, err
+	}
+	if err := svc.repo.Save(r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
@@ -0,0 +1,178 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/revision"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+)
+
+// RevisionCommandService는 코스 수정안 작성과 검토(제출, 승인, 반려)를 담당합니다.
+// 승인한 수정안은 관리자 코스 수정과 같은 경로로 게시되어 코스 이벤트와 작업 기록이 남습니다.
+// 다른 사용자의 수정안은 존재 여부를 드러내지 않도록 revision.ErrRevisionNotFound로 처리합니다.
+type RevisionCommandService struct {
+	repo    revision.RevisionRepository
+	history course.CourseHistoryRepository
+	admin   *AdminCommandService
+	now     func() time.Time
+	// mu는 편집과 검토가 같은 수정안을 동시에 고치지 않도록 수정안 변경을 한 작업씩 처리합니다.
+	mu sync.Mutex
+}
+
+func NewRevisionCommandService(repo revision.RevisionRepository, history course.CourseHistoryRepository, admin *AdminCommandService) *RevisionCommandService {
+	return &RevisionCommandService{repo: repo, history: history, admin: admin, now: time.Now}
+}
+
+// Create는 수정안 초안을 만듭니다. courseID가 0이면 새 코스 제안이며, 아니면 현재 코스 버전을 기준으로 삼습니다.
+func (svc *RevisionCommandService) Create(principal *user.Principal, courseID int, c *course.CourseAggregate, summary string) (*revision.Revision, error) {
+	if err := svc.admin.prepareCourse(c); err != nil {
+		return nil, err
+	}
+	base, err := svc.currentVersion(courseID)
+	if err != nil {
+		return nil, err
+	}
+	r, err := revision.New(principal.UserID, courseID, base, c, summary, svc.now())
+	if err != nil {
+		return nil, err
+	}
+	if err := svc.repo.Save(r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Update는 작성자의 초안(또는 반려된 수정안)을 c로 바꾸고 현재 코스 버전을 새 기준으로 삼습니다.
+func (svc *RevisionCommandService) Update(principal *user.Principal, id int, c *course.CourseAggregate, summary string) error {
+	return svc.modify(principal, id, func(r *revision.Revision, now time.Time) error {
+		if err := svc.admin.prepareCourse(c); err != nil {
+			return err
+		}
+		base, err := svc.currentVersion(r.CourseID)
+		if err != nil {
+			return err
+		}
+		return r.Edit(c, summary, base, now)
+	})
+}
+
+// Submit은 작성자의 초안을 검토 요청합니다.
+func (svc *RevisionCommandService) Submit(principal *user.Principal, id int) error {
+	return svc.modify(principal, id, func(r *revision.Revision, now time.Time) error {
+		return r.Submit(now)
+	})
+}
+
+// Approve는 검토 요청된 수정안을 게시하고 승인 상태로 바꿉니다.
+// 수정안을 만든 뒤 코스가 바뀌었으면 revision.ErrOutdated를 반환하며, 작성자가 다시 편집해 제출해야 합니다.
+// 수정안은 게시할 코스 ID로 먼저 승인해 저장하므로, 게시한 수정안이 검토 요청 상태로 남아 다시 승인되지 않습니다.
+// 게시는 코스 이벤트 추가 한 번이 마지막 쓰기이므로, 게시가 에러를 반환했으면 코스는 바뀌지 않았고 수정안을 검토 요청 상태로 되돌립니다.
+func (svc *RevisionCommandService) Approve(principal *user.Principal, id int, comment string) error {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	r, err := svc.find(id)
+	if err != nil {
+		return err
+	}
+	approved := *r
+	proposed := *r.Course
+	approved.Course = &proposed
+	saved := false
+	markApproved := func(courseID int) error {
+		current, err := svc.currentVersion(r.CourseID)
+		if err != nil {
+			return err
+		}
+		if err := r.CheckReviewable(principal.UserID, current); err != nil {
+			return err
+		}
+		if err := approved.Approve(principal.UserID, courseID, comment, svc.now()); err != nil {
+			return err
+		}
+		if err := svc.repo.Save(&approved); err != nil {
+			return err
+		}
+		saved = true
+		return nil
+	}
+	reason := fmt.Sprintf("수정안 %d: %s", r.ID, r.Summary)
+	if r.CourseID == 0 {
+		err = svc.admin.createCourse(principal, approved.Course, reason, markApproved)
+	} else {
+		err = svc.admin.updateCourse(principal, r.CourseID, approved.Course, reason, markApproved)
+	}
+	if err != nil && saved {
+		if rollbackErr := svc.repo.Save(r); rollbackErr != nil {
+			return errors.Join(err, fmt.Errorf("수정안 %d를 검토 요청 상태로 되돌리지 못했습니다: %w", r.ID, rollbackErr))
+		}
+	}
+	return err
+}
+
+// Reject는 검토 요청된 수정안을 반려합니다.
+func (svc *RevisionCommandService) Reject(principal *user.Principal, id int, comment string) error {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	r, err := svc.find(id)
+	if err != nil {
+		return err
+	}
+	if err := r.Reject(principal.UserID, comment, svc.now()); err != nil {
+		return err
+	}
+	return svc.repo.Save(r)
+}
+
+func (svc *RevisionCommandService) find(id int) (*revision.Revision, error) {
+	r, err := svc.repo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if r == nil {
+		return nil, revision.ErrRevisionNotFound
+	}
+	return r, nil
+}
+
+// currentVersion은 게시된 코스의 현재 이벤트 버전입니다. courseID가 0이면 0입니다.
+func (svc *RevisionCommandService) currentVersion(courseID int) (int, error) {
+	if courseID == 0 {
+		return 0, nil
+	}
+	events, err := svc.history.FindEvents(courseID)
+	if err != nil {
+		return 0, err
+	}
+	live, err := course.Replay(events)
+	if err != nil {
+		return 0, err
+	}
+	if live == nil {
+		if len(events) == 0 {
+			return 0, course.ErrCourseNotFound
+		}
+		return 0, revision.ErrCourseDeleted
+	}
+	return course.LatestVersion(events), nil
+}
+
+// modify는 작성자의 수정안을 불러와 fn으로 바꾼 뒤 저장합니다.
+func (svc *RevisionCommandService) modify(principal *user.Principal, id int, fn func(r *revision.Revision, now time.Time) error) error {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	r, err := svc.repo.FindByID(id)
+	if err != nil {
+		return err
+	}
+	if r == nil || !r.WrittenBy(principal.UserID) {
+		return revision.ErrRevisionNotFound
+	}
+	if err := fn(r, svc.now()); err != nil {
+		return err
+	}
+	return svc.repo.Save(r)
+}
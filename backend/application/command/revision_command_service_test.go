@@ -0,0 +1,157 @@
+package command_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/application/command"
+	"github.com/sunDar0/winding-road-finder/backend/domain/audit"
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+	"github.com/sunDar0/winding-road-finder/backend/domain/revision"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+	commandRepo "github.com/sunDar0/winding-road-finder/backend/infrastructure/persistence/command"
+)
+
+var errRevisionsUnavailable = errors.New("수정안을 저장할 수 없음")
+
+// failingRevisions는 failSave가 참이면 저장하지 못하는 수정안 저장소입니다.
+type failingRevisions struct {
+	*commandRepo.RevisionCommandRepositoryImpl
+	failSave bool
+}
+
+func (r *failingRevisions) Save(rev *revision.Revision) error {
+	if r.failSave {
+		return errRevisionsUnavailable
+	}
+	return r.RevisionCommandRepositoryImpl.Save(rev)
+}
+
+// eventHistory는 관리자 서비스가 쓰는 이벤트 저장소에서 코스 이력을 읽습니다.
+type eventHistory struct {
+	events *commandRepo.CourseEventStoreImpl
+}
+
+func (h eventHistory) FindEvents(courseID int) ([]*course.Event, error) {
+	return h.events.FindByCourse(courseID)
+}
+
+func (h eventHistory) FindAt(int, time.Time) (*course.CourseAggregate, error) {
+	return nil, nil
+}
+
+var editor = &user.Principal{UserID: 2, Email: "editor@example.com", Role: user.RoleAdmin}
+
+// proposedCourse는 1번 코스의 이름만 바꾼 제안입니다.
+func proposedCourse(t *testing.T, f *adminFixture) *course.CourseAggregate {
+	t.Helper()
+	c, err := f.courses.FindByID(1)
+	if err != nil || c == nil {
+		t.Fatalf("course 1 = %v, %v", c, err)
+	}
+	c.Name = i18n.Text("중미산 ~ 유명산 (수정)")
+	c.Styles = nil
+	return c
+}
+
+func TestRevisionApprove(t *testing.T) {
+	tests := []struct {
+		name     string
+		courseID int
+		audit    audit.AuditRepository
+		failSave bool
+		// failAppend는 코스 이벤트를 저장하지 못하는 경우입니다.
+		failAppend bool
+		// changed는 검토 요청 뒤 다른 관리자가 코스를 고친 경우입니다.
+		changed  bool
+		reviewer *user.Principal
+		want     error
+	}{
+		{"코스 수정 승인", 1, nil, false, false, false, curator, nil},
+		{"새 코스 제안 승인", 0, nil, false, false, false, curator, nil},
+		{"작업 기록에 실패하면 검토 요청으로 남는다", 1, failingAudit{}, false, false, false, curator, errAuditUnavailable},
+		{"새 코스도 작업 기록에 실패하면 검토 요청으로 남는다", 0, failingAudit{}, false, false, false, curator, errAuditUnavailable},
+		{"코스 이벤트를 저장하지 못하면 검토 요청으로 남는다", 1, nil, false, true, false, curator, errEventsUnavailable},
+		{"새 코스도 이벤트를 저장하지 못하면 검토 요청으로 남는다", 0, nil, false, true, false, curator, errEventsUnavailable},
+		{"수정안을 저장하지 못하면 게시하지 않는다", 1, nil, true, false, false, curator, errRevisionsUnavailable},
+		{"그사이 코스가 바뀌었다", 1, nil, false, false, true, curator, revision.ErrOutdated},
+		{"작성자는 승인할 수 없다", 1, nil, false, false, false, editor, revision.ErrSelfReview},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newAdminFixture(t, tt.audit)
+			live, err := f.courses.FindByID(1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			repo := &failingRevisions{RevisionCommandRepositoryImpl: commandRepo.NewRevisionCommandRepository(t.TempDir())}
+			svc := command.NewRevisionCommandService(repo, eventHistory{f.events}, f.svc)
+
+			r, err := svc.Create(editor, tt.courseID, proposedCourse(t, f), "이름 수정")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := svc.Submit(editor, r.ID); err != nil {
+				t.Fatal(err)
+			}
+			if tt.changed {
+				rated := live.Ratings
+				rated.Tech = max(1, rated.Tech-1)
+				data, err := json.Marshal(rated)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := f.events.Append([]*course.Event{{CourseID: 1, Type: course.EventRatingsChanged, ActorID: 3, At: time.Now(), Data: data}}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			nextID, err := f.courses.NextID()
+			if err != nil {
+				t.Fatal(err)
+			}
+			seq := lastSeq(t, f.events)
+
+			repo.failSave, f.appends.fail = tt.failSave, tt.failAppend
+			err = svc.Approve(tt.reviewer, r.ID, "확인했습니다")
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("Approve() error = %v, want %v", err, tt.want)
+			}
+			repo.failSave, f.appends.fail = false, false
+			got, err := repo.FindByID(r.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.want != nil {
+				if got.Status != revision.StatusSubmitted || got.CourseID != tt.courseID || got.Course.ID != tt.courseID || got.ReviewerID != 0 {
+					t.Fatalf("revision = %s, course %d (%d), reviewer %d; want submitted, %d", got.Status, got.CourseID, got.Course.ID, got.ReviewerID, tt.courseID)
+				}
+				if got := lastSeq(t, f.events); got != seq {
+					t.Fatalf("last event seq = %d, want %d", got, seq)
+				}
+				return
+			}
+			wantID := tt.courseID
+			if wantID == 0 {
+				wantID = nextID
+			}
+			if got.Status != revision.StatusApproved || got.CourseID != wantID || got.Course.ID != wantID || got.ReviewerID != curator.UserID {
+				t.Fatalf("revision = %s, course %d (%d), reviewer %d; want approved, %d, %d", got.Status, got.CourseID, got.Course.ID, got.ReviewerID, wantID, curator.UserID)
+			}
+			if c, err := f.courses.FindByID(wantID); err != nil || c == nil || c.Name.String() != "중미산 ~ 유명산 (수정)" {
+				t.Fatalf("published course %d = %+v, %v", wantID, c, err)
+			}
+			// 이미 승인한 수정안은 다시 게시하지 않는다.
+			published := lastSeq(t, f.events)
+			if err := svc.Approve(curator, r.ID, ""); !errors.Is(err, revision.ErrInvalidTransition) {
+				t.Fatalf("second Approve() error = %v, want %v", err, revision.ErrInvalidTransition)
+			}
+			if got := lastSeq(t, f.events); got != published {
+				t.Fatalf("second Approve() appended events up to seq %d, want %d", got, published)
+			}
+		})
+	}
+}
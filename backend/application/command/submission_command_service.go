@@ -0,0 +1,124 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+	"github.com/sunDar0/winding-road-finder/backend/domain/submission"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+)
+
+// SubmissionCommandService는 사용자의 새 코스 제보와 관리자의 수락·반려를 담당합니다.
+// 수락한 제보는 관리자 코스 추가와 같은 경로로 게시되어 코스 이벤트와 작업 기록이 남습니다.
+type SubmissionCommandService struct {
+	repo       submission.SubmissionRepository
+	courseRepo course.CourseQueryRepository
+	admin      *AdminCommandService
+	now        func() time.Time
+	// mu는 같은 제보를 두 번 수락해 코스가 중복으로 생기지 않도록 검토를 한 작업씩 처리합니다.
+	mu sync.Mutex
+}
+
+func NewSubmissionCommandService(repo submission.SubmissionRepository, courseRepo course.CourseQueryRepository, admin *AdminCommandService) *SubmissionCommandService {
+	return &SubmissionCommandService{repo: repo, courseRepo: courseRepo, admin: admin, now: time.Now}
+}
+
+// Submit은 새 코스를 제보하고, 출발지·도착지가 가깝거나 이름이 비슷한 기존 코스를 함께 반환합니다.
+// 중복 후보가 있어도 제보는 저장하며, 같은 코스인지는 관리자가 판단합니다.
+// 사진은 제보에 담지 않고, 게시된 코스에 사진 업로드로 올립니다.
+func (svc *SubmissionCommandService) Submit(principal *user.Principal, c *course.CourseAggregate, note string) (*submission.Submission, []submission.Duplicate, error) {
+	if err := svc.admin.prepareCourse(c); err != nil {
+		return nil, nil, err
+	}
+	s, err := submission.New(principal.UserID, c, note, svc.now())
+	if err != nil {
+		return nil, nil, err
+	}
+	existing, err := svc.courseRepo.FindAll(course.CourseFilter{})
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := svc.repo.Save(s); err != nil {
+		return nil, nil, err
+	}
+	return s, submission.FindDuplicates(c, existing), nil
+}
+
+// Accept는 검토 대기 중인 제보를 코스로 게시합니다. c가 nil이 아니면 제보 내용 대신 c를 게시하며,
+// 관리자가 번역이나 소개 문구를 채워 넣을 때 사용합니다.
+// 제보는 게시할 코스 ID로 먼저 수락해 저장하므로, 게시한 코스가 검토 대기 제보로 남아 다시 수락되지 않습니다.
+// 게시는 코스 이벤트 추가 한 번이 마지막 쓰기이므로, 게시가 에러를 반환했으면 코스는 바뀌지 않았고 제보를 검토 대기 상태로 되돌립니다.
+func (svc *SubmissionCommandService) Accept(principal *user.Principal, id int, c *course.CourseAggregate, comment string) error {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	s, err := svc.find(id)
+	if err != nil {
+		return err
+	}
+	if c == nil {
+		// 게시하면서 ID와 스타일을 고치므로, 되돌릴 때 쓰는 제보 내용과 나눠 둡니다.
+		proposed := *s.Course
+		c = &proposed
+	}
+	accepted := *s
+	if err := accepted.Accept(principal.UserID, 0, comment, svc.now()); err != nil {
+		return err
+	}
+	saved := false
+	markAccepted := func(courseID int) error {
+		accepted.CourseID = courseID
+		if err := svc.repo.Save(&accepted); err != nil {
+			return err
+		}
+		saved = true
+		return nil
+	}
+	reason := fmt.Sprintf("코스 제보 %d: %s", s.ID, s.Course.Name[i18n.DefaultLang])
+	if err := svc.admin.createCourse(principal, c, reason, markAccepted); err != nil {
+		if saved {
+			if rollbackErr := svc.repo.Save(s); rollbackErr != nil {
+				return errors.Join(err, fmt.Errorf("제보 %d를 검토 대기로 되돌리지 못했습니다: %w", s.ID, rollbackErr))
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+// Reject는 검토 대기 중인 제보를 반려합니다. duplicateOf가 0이 아니면 이미 있는 코스와 같아 반려한 것으로 기록합니다.
+func (svc *SubmissionCommandService) Reject(principal *user.Principal, id, duplicateOf int, comment string) error {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	s, err := svc.find(id)
+	if err != nil {
+		return err
+	}
+	if duplicateOf != 0 {
+		c, err := svc.courseRepo.FindByID(duplicateOf)
+		if err != nil {
+			return err
+		}
+		if c == nil {
+			return course.ErrCourseNotFound
+		}
+	}
+	if err := s.Reject(principal.UserID, duplicateOf, comment, svc.now()); err != nil {
+		return err
+	}
+	return svc.repo.Save(s)
+}
+
+func (svc *SubmissionCommandService) find(id int) (*submission.Submission, error) {
+	s, err := svc.repo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if s == nil {
+		return nil, submission.ErrSubmissionNotFound
+	}
+	return s, nil
+}
@@ -0,0 +1,112 @@
+package command_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/application/command"
+	"github.com/sunDar0/winding-road-finder/backend/domain/audit"
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+	"github.com/sunDar0/winding-road-finder/backend/domain/submission"
+	commandRepo "github.com/sunDar0/winding-road-finder/backend/infrastructure/persistence/command"
+)
+
+var errSubmissionsUnavailable = errors.New("제보를 저장할 수 없음")
+
+// failingSubmissions는 failSave가 참이면 저장하지 못하는 제보 저장소입니다.
+type failingSubmissions struct {
+	*commandRepo.SubmissionCommandRepositoryImpl
+	failSave bool
+}
+
+func (s *failingSubmissions) Save(sub *submission.Submission) error {
+	if s.failSave {
+		return errSubmissionsUnavailable
+	}
+	return s.SubmissionCommandRepositoryImpl.Save(sub)
+}
+
+// newPendingSubmission은 1번 코스를 바탕으로 한 검토 대기 제보를 저장합니다.
+func newPendingSubmission(t *testing.T, f *adminFixture, repo submission.SubmissionRepository) *submission.Submission {
+	t.Helper()
+	c, err := f.courses.FindByID(1)
+	if err != nil || c == nil {
+		t.Fatalf("course 1 = %v, %v", c, err)
+	}
+	c.Name = i18n.Text("새로 제보한 코스")
+	c.Styles = nil
+	s, err := submission.New(2, c, "", time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Save(s); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestSubmissionAccept(t *testing.T) {
+	tests := []struct {
+		name     string
+		audit    audit.AuditRepository
+		failSave bool
+		// failAppend는 코스 이벤트를 저장하지 못하는 경우입니다.
+		failAppend bool
+		want       error
+	}{
+		{"수락하면 게시한 코스 ID가 제보에 남는다", nil, false, false, nil},
+		// 제보는 먼저 수락으로 저장되지만 게시에 실패하면 검토 대기로 되돌린다.
+		{"작업 기록에 실패하면 제보는 검토 대기로 남는다", failingAudit{}, false, false, errAuditUnavailable},
+		{"코스 이벤트를 저장하지 못하면 제보는 검토 대기로 남는다", nil, false, true, errEventsUnavailable},
+		{"제보를 저장하지 못하면 코스를 게시하지 않는다", nil, true, false, errSubmissionsUnavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newAdminFixture(t, tt.audit)
+			repo := &failingSubmissions{SubmissionCommandRepositoryImpl: commandRepo.NewSubmissionCommandRepository(t.TempDir())}
+			pending := newPendingSubmission(t, f, repo)
+			nextID, err := f.courses.NextID()
+			if err != nil {
+				t.Fatal(err)
+			}
+			seq := lastSeq(t, f.events)
+			svc := command.NewSubmissionCommandService(repo, nil, f.svc)
+
+			repo.failSave, f.appends.fail = tt.failSave, tt.failAppend
+			err = svc.Accept(curator, pending.ID, nil, "좋은 코스입니다")
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("Accept() error = %v, want %v", err, tt.want)
+			}
+			repo.failSave, f.appends.fail = false, false
+			got, err := repo.FindByID(pending.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.want != nil {
+				if got.Status != submission.StatusPending || got.CourseID != 0 || got.Course.ID != 0 {
+					t.Fatalf("submission = %s, course %d (%d); want pending", got.Status, got.CourseID, got.Course.ID)
+				}
+				if got := lastSeq(t, f.events); got != seq {
+					t.Fatalf("last event seq = %d, want %d", got, seq)
+				}
+				return
+			}
+			if got.Status != submission.StatusAccepted || got.CourseID != nextID || got.ReviewerID != curator.UserID {
+				t.Fatalf("submission = %s, course %d, reviewer %d; want accepted, %d, %d", got.Status, got.CourseID, got.ReviewerID, nextID, curator.UserID)
+			}
+			if c, err := f.courses.FindByID(nextID); err != nil || c == nil || c.Name.String() != "새로 제보한 코스" {
+				t.Fatalf("published course = %+v, %v", c, err)
+			}
+			// 이미 수락한 제보는 다시 게시하지 않는다.
+			published := lastSeq(t, f.events)
+			if err := svc.Accept(curator, pending.ID, nil, ""); !errors.Is(err, submission.ErrAlreadyReviewed) {
+				t.Fatalf("second Accept() error = %v, want %v", err, submission.ErrAlreadyReviewed)
+			}
+			if got := lastSeq(t, f.events); got != published {
+				t.Fatalf("second Accept() appended events up to seq %d, want %d", got, published)
+			}
+		})
+	}
+}
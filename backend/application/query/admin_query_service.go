@@ -0,0 +1,98 @@
+package query
+
+import (
+	"sort"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/audit"
+	"github.com/sunDar0/winding-road-finder/backend/domain/hazard"
+	"github.com/sunDar0/winding-road-finder/backend/domain/review"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+)
+
+// AuditPage는 페이지 단위 관리자 작업 기록입니다.
+type AuditPage struct {
+	Entries []*audit.Entry
+	Total   int
+}
+
+// AdminQueryService는 관리자 작업 기록과 숨긴 항목을 포함한 리뷰·위험 신고 조회를 담당합니다.
+type AdminQueryService struct {
+	auditRepo  audit.AuditRepository
+	reviewRepo review.ReviewRepository
+	hazardRepo hazard.HazardRepository
+	userRepo   user.UserRepository
+	now        func() time.Time
+}
+
+func NewAdminQueryService(auditRepo audit.AuditRepository, reviewRepo review.ReviewRepository, hazardRepo hazard.HazardRepository, userRepo user.UserRepository) *AdminQueryService {
+	return &AdminQueryService{auditRepo: auditRepo, reviewRepo: reviewRepo, hazardRepo: hazardRepo, userRepo: userRepo, now: time.Now}
+}
+
+// GetAuditLog는 조건에 맞는 작업 기록을 최신순으로 page(1부터), size 단위로 반환합니다.
+func (svc *AdminQueryService) GetAuditLog(filter audit.Filter, page, size int) (*AuditPage, error) {
+	entries, err := svc.auditRepo.Find(filter)
+	if err != nil {
+		return nil, err
+	}
+	result := &AuditPage{Entries: []*audit.Entry{}, Total: len(entries)}
+	start, end, ok := pageRange(len(entries), page, size)
+	if !ok {
+		return result, nil
+	}
+	result.Entries = entries[start:end]
+	return result, nil
+}
+
+// GetReviews는 숨긴 리뷰를 포함해 최신순으로 반환합니다. courseID가 0이면 모든 코스의 리뷰입니다.
+func (svc *AdminQueryService) GetReviews(courseID, page, size int) (*ReviewPage, error) {
+	reviews, err := svc.reviewRepo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	var matched []*review.Review
+	for _, r := range reviews {
+		if courseID == 0 || r.CourseID == courseID {
+			matched = append(matched, r)
+		}
+	}
+	sort.SliceStable(matched, func(i, j int) bool {
+		return matched[i].CreatedAt.After(matched[j].CreatedAt)
+	})
+	result := &ReviewPage{Reviews: []*ReviewWithAuthor{}, Total: len(matched)}
+	start, end, ok := pageRange(len(matched), page, size)
+	if !ok {
+		return result, nil
+	}
+	for _, r := range matched[start:end] {
+		author, err := svc.userRepo.FindByID(r.UserID)
+		if err != nil {
+			return nil, err
+		}
+		result.Reviews = append(result.Reviews, &ReviewWithAuthor{Review: r, Author: author})
+	}
+	return result, nil
+}
+
+// GetHazards는 만료·해소·숨긴 신고를 포함해 최신순으로 반환합니다. courseID가 0이면 모든 코스의 신고입니다.
+func (svc *AdminQueryService) GetHazards(courseID int) ([]*hazard.Hazard, error) {
+	hazards, err := svc.hazardRepo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	var result []*hazard.Hazard
+	for _, h := range hazards {
+		if courseID == 0 || h.CourseID == courseID {
+			result = append(result, h)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].ReportedAt.After(result[j].ReportedAt)
+	})
+	return result, nil
+}
+
+// IsActive는 현재 시각 기준으로 신고가 활성인지 확인합니다.
+func (svc *AdminQueryService) IsActive(h *hazard.Hazard) bool {
+	return h.IsActive(svc.now())
+}
@@ -0,0 +1,65 @@
+package query
+
+import (
+	"github.com/sunDar0/winding-road-finder/backend/domain/collection"
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+	"github.com/sunDar0/winding-road-finder/backend/domain/recommendation"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+)
+
+// CollectionWithCourses는 컬렉션과 담긴 코스 상세정보를 나타냅니다.
+type CollectionWithCourses struct {
+	*collection.Collection
+	Courses []*course.CourseAggregate
+}
+
+// CollectionQueryService는 사용자 컬렉션 조회를 담당합니다.
+// 코스 목록은 추천 카테고리와 같은 경로(RecommendationQueryService.Render)로 구성합니다.
+type CollectionQueryService struct {
+	repo   collection.CollectionRepository
+	recSvc *RecommendationQueryService
+}
+
+func NewCollectionQueryService(repo collection.CollectionRepository, recSvc *RecommendationQueryService) *CollectionQueryService {
+	return &CollectionQueryService{repo: repo, recSvc: recSvc}
+}
+
+// GetMyCollections는 로그인한 사용자의 컬렉션 목록을 반환합니다.
+func (svc *CollectionQueryService) GetMyCollections(principal *user.Principal) ([]*collection.Collection, error) {
+	return svc.repo.FindByOwner(principal.UserID)
+}
+
+// GetMyCollection은 로그인한 사용자의 컬렉션을 코스 상세정보와 함께 반환합니다.
+// 없거나 다른 사용자의 컬렉션이면 nil을 반환합니다.
+func (svc *CollectionQueryService) GetMyCollection(principal *user.Principal, id int) (*CollectionWithCourses, error) {
+	c, err := svc.repo.FindByID(id)
+	if err != nil || c == nil || !c.OwnedBy(principal.UserID) {
+		return nil, err
+	}
+	rendered, err := svc.recSvc.Render(asRecommendation(c))
+	if err != nil {
+		return nil, err
+	}
+	return &CollectionWithCourses{Collection: c, Courses: rendered.Courses}, nil
+}
+
+// GetSharedCollection은 공유 토큰으로 공개 컬렉션을 추천 카테고리 형태로 반환합니다.
+// 없거나 비공개로 바뀐 컬렉션이면 nil을 반환합니다.
+func (svc *CollectionQueryService) GetSharedCollection(token string) (*RecommendationWithCourses, error) {
+	c, err := svc.repo.FindByShareToken(token)
+	if err != nil || c == nil {
+		return nil, err
+	}
+	return svc.recSvc.Render(asRecommendation(c))
+}
+
+// asRecommendation은 컬렉션을 코스가 고정된 추천 카테고리로 바꿉니다.
+func asRecommendation(c *collection.Collection) *recommendation.Recommendation {
+	return &recommendation.Recommendation{
+		ID:          c.ID,
+		Title:       i18n.Text(c.Name),
+		Description: i18n.LocalizedText{},
+		CourseIds:   c.CourseIDs,
+	}
+}
@@ -0,0 +1,84 @@
+package query
+
+import (
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/audit"
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+)
+
+// CourseHistoryEntry는 코스 이벤트 하나와 그 이벤트로 바뀐 필드, 작업자입니다.
+// 데이터 파일에서 가져온 변경이거나 작업자 계정을 찾지 못하면 Actor는 nil입니다.
+type CourseHistoryEntry struct {
+	Event   *course.Event
+	Actor   *user.User
+	Changes []audit.Change
+}
+
+// CourseHistory는 조회 시점(At)까지의 코스 변경 이력과 그 시점의 코스 상태입니다. 그때 코스가 없었거나 삭제된 상태면 Course는 nil입니다.
+type CourseHistory struct {
+	At      time.Time
+	Entries []*CourseHistoryEntry
+	Course  *course.CourseAggregate
+}
+
+// CourseHistoryQueryService는 코스 이벤트로 변경 이력과 특정 시점의 코스를 조회합니다.
+type CourseHistoryQueryService struct {
+	repo     course.CourseHistoryRepository
+	userRepo user.UserRepository
+	now      func() time.Time
+}
+
+func NewCourseHistoryQueryService(repo course.CourseHistoryRepository, userRepo user.UserRepository) *CourseHistoryQueryService {
+	return &CourseHistoryQueryService{repo: repo, userRepo: userRepo, now: time.Now}
+}
+
+// GetHistory는 at 시점(nil이면 현재)까지의 이벤트를 오래된 순으로, 그 시점의 코스와 함께 반환합니다.
+// 이벤트가 하나도 없는 코스면 course.ErrCourseNotFound를 반환합니다.
+func (svc *CourseHistoryQueryService) GetHistory(courseID int, at *time.Time) (*CourseHistory, error) {
+	events, err := svc.repo.FindEvents(courseID)
+	if err != nil {
+		return nil, err
+	}
+	if len(events) == 0 {
+		return nil, course.ErrCourseNotFound
+	}
+	until := svc.now()
+	if at != nil {
+		until = *at
+	}
+	result := &CourseHistory{At: until, Entries: []*CourseHistoryEntry{}}
+	actors := make(map[int]*user.User)
+	var state *course.CourseAggregate
+	for _, e := range events {
+		if e.At.After(until) {
+			break
+		}
+		next, err := course.Apply(state, e)
+		if err != nil {
+			return nil, err
+		}
+		changes, err := audit.Diff(state, next)
+		if err != nil {
+			return nil, err
+		}
+		state = next
+		entry := &CourseHistoryEntry{Event: e, Changes: changes}
+		if e.ActorID != 0 {
+			actor, ok := actors[e.ActorID]
+			if !ok {
+				if actor, err = svc.userRepo.FindByID(e.ActorID); err != nil {
+					return nil, err
+				}
+				actors[e.ActorID] = actor
+			}
+			entry.Actor = actor
+		}
+		result.Entries = append(result.Entries, entry)
+	}
+	if result.Course, err = svc.repo.FindAt(courseID, until); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
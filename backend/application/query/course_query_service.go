@@ -1,21 +1,67 @@
 package query
 
-import "github.com/sunDar0/winding-road-finder/backend/domain/course"
+import (
+	"context"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+)
 
 // CourseQueryService는 코스 목록/상세 조회 비즈니스 로직을 담당합니다.
 type CourseQueryService struct {
-	repo course.CourseQueryRepository
+	repo       course.CourseQueryRepository
+	regionSvc  *RegionQueryService
+	styleSvc   *StyleQueryService
+	weatherSvc *WeatherQueryService
 }
 
-func NewCourseQueryService(repo course.CourseQueryRepository) *CourseQueryService {
-	return &CourseQueryService{repo: repo}
+func NewCourseQueryService(repo course.CourseQueryRepository, regionSvc *RegionQueryService, styleSvc *StyleQueryService, weatherSvc *WeatherQueryService) *CourseQueryService {
+	return &CourseQueryService{repo: repo, regionSvc: regionSvc, styleSvc: styleSvc, weatherSvc: weatherSvc}
 }
 
-func (svc *CourseQueryService) GetCourses(region, style, search string) ([]*course.CourseAggregate, error) {
-	return svc.repo.FindAll(region, style, search)
+// CourseConditions는 코스 목록의 계절/날씨 조건입니다. Date가 있으면 그 날짜에 통제되지 않는 코스를,
+// Month가 0이 아니면 그 달이 추천 시기인 코스를, DryRoads가 true면 보관된 예보상 노면이 마를 코스를 조회합니다.
+type CourseConditions struct {
+	Date     *time.Time
+	Month    time.Month
+	DryRoads bool
 }
 
-func (svc *CourseQueryService) GetCourseByID(id int) (*course.CourseAggregate, error) {
-	return svc.repo.FindByID(id)
-} 
+func (svc *CourseQueryService) GetCourses(ctx context.Context, region, style, search string, conditions CourseConditions) (_ []*course.CourseAggregate, err error) {
+	ctx, span := tracer.Start(ctx, "CourseQueryService.GetCourses")
+	defer func() { endSpan(span, err) }()
+	region, err = svc.regionSvc.NormalizeRegion(region)
+	if err != nil {
+		return nil, err
+	}
+	taxonomy, err := svc.styleSvc.Taxonomy()
+	if err != nil {
+		return nil, err
+	}
+	filter := course.CourseFilter{Region: region, Style: style, Search: search, Date: conditions.Date, Month: conditions.Month}
+	// 스타일 필터는 slug, 이름, 동의어를 모두 허용합니다.
+	if s := taxonomy.Resolve(style); s != nil {
+		filter.Style = s.Slug
+	}
+	// 검색어가 스타일 동의어와 일치하면 해당 스타일 코스도 함께 검색합니다.
+	if s := taxonomy.Resolve(search); s != nil {
+		filter.SearchStyles = []string{s.Slug}
+	}
+	courses, err := tracedFindCourses(ctx, svc.repo, filter)
+	if err != nil || !conditions.DryRoads {
+		return courses, err
+	}
+	var dry []*course.CourseAggregate
+	for _, c := range courses {
+		if svc.weatherSvc.IsDry(c) {
+			dry = append(dry, c)
+		}
+	}
+	return dry, nil
+}
 
+func (svc *CourseQueryService) GetCourseByID(ctx context.Context, id int) (_ *course.CourseAggregate, err error) {
+	ctx, span := tracer.Start(ctx, "CourseQueryService.GetCourseByID")
+	defer func() { endSpan(span, err) }()
+	return tracedFindCourse(ctx, svc.repo, id)
+} 
@@ -0,0 +1,133 @@
+package query
+
+import (
+	"sort"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/drive"
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+	"github.com/sunDar0/winding-road-finder/backend/domain/region"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+)
+
+// RegionProgress는 시·도별 코스 완주 현황입니다.
+type RegionProgress struct {
+	Region    *region.Region
+	Completed int
+	Total     int
+}
+
+// CategoryProgress는 추천 카테고리별 코스 완주 현황입니다.
+type CategoryProgress struct {
+	ID        int
+	Title     i18n.LocalizedText
+	Completed int
+	Total     int
+}
+
+// DriveStats는 사용자의 주행 통계와 완주 현황입니다.
+type DriveStats struct {
+	drive.Stats
+	Regions    []RegionProgress
+	Categories []CategoryProgress
+}
+
+// DriveQueryService는 주행 기록과 통계 조회를 담당합니다.
+type DriveQueryService struct {
+	repo       drive.DriveRepository
+	courseRepo course.CourseQueryRepository
+	recSvc     *RecommendationQueryService
+	regionSvc  *RegionQueryService
+}
+
+func NewDriveQueryService(repo drive.DriveRepository, courseRepo course.CourseQueryRepository, recSvc *RecommendationQueryService, regionSvc *RegionQueryService) *DriveQueryService {
+	return &DriveQueryService{repo: repo, courseRepo: courseRepo, recSvc: recSvc, regionSvc: regionSvc}
+}
+
+// GetMyDrives는 로그인한 사용자의 주행 기록을 최신순으로 반환합니다. courseID가 0보다 크면 해당 코스 기록만 반환합니다.
+func (svc *DriveQueryService) GetMyDrives(principal *user.Principal, courseID int) ([]*drive.Drive, error) {
+	drives, err := svc.repo.FindByUser(principal.UserID)
+	if err != nil || courseID <= 0 {
+		return drives, err
+	}
+	var result []*drive.Drive
+	for _, d := range drives {
+		if d.CourseID == courseID {
+			result = append(result, d)
+		}
+	}
+	return result, nil
+}
+
+// GetMyDrive는 로그인한 사용자의 주행 기록을 반환합니다. 없거나 다른 사용자의 기록이면 nil을 반환합니다.
+func (svc *DriveQueryService) GetMyDrive(principal *user.Principal, id int) (*drive.Drive, error) {
+	d, err := svc.repo.FindByID(id)
+	if err != nil || d == nil || !d.OwnedBy(principal.UserID) {
+		return nil, err
+	}
+	return d, nil
+}
+
+// GetMyStats는 주행 횟수, 완주 코스 수, 총 거리와 시·도별, 추천 카테고리별 완주 현황을 계산합니다.
+// 궤적이 없는 기록의 거리는 코스 내비게이션 포인트 간 직선거리 합으로 추정합니다.
+func (svc *DriveQueryService) GetMyStats(principal *user.Principal) (*DriveStats, error) {
+	drives, err := svc.repo.FindByUser(principal.UserID)
+	if err != nil {
+		return nil, err
+	}
+	courses, err := svc.courseRepo.FindAll(course.CourseFilter{})
+	if err != nil {
+		return nil, err
+	}
+	lengths := make(map[int]float64, len(courses))
+	for _, c := range courses {
+		lengths[c.ID] = c.ApproxLengthKm()
+	}
+	stats := &DriveStats{Stats: drive.Summarize(drives, func(id int) float64 { return lengths[id] })}
+
+	dir, err := svc.regionSvc.Directory()
+	if err != nil {
+		return nil, err
+	}
+	byRegion := map[string]*RegionProgress{}
+	for _, c := range courses {
+		r := dir.Find(c.RegionCode)
+		if r == nil {
+			continue
+		}
+		p, ok := byRegion[r.Code]
+		if !ok {
+			p = &RegionProgress{Region: r}
+			byRegion[r.Code] = p
+		}
+		p.Total++
+		if stats.CompletedCourses[c.ID] {
+			p.Completed++
+		}
+	}
+	for _, p := range byRegion {
+		stats.Regions = append(stats.Regions, *p)
+	}
+	sort.Slice(stats.Regions, func(i, j int) bool {
+		a, b := stats.Regions[i], stats.Regions[j]
+		if a.Completed != b.Completed {
+			return a.Completed > b.Completed
+		}
+		return a.Region.Code < b.Region.Code
+	})
+
+	recs, err := svc.recSvc.GetRecommendationsWithCourses()
+	if err != nil {
+		return nil, err
+	}
+	for _, rec := range recs {
+		p := CategoryProgress{ID: rec.ID, Title: rec.Title, Total: len(rec.Courses)}
+		for _, c := range rec.Courses {
+			if stats.CompletedCourses[c.ID] {
+				p.Completed++
+			}
+		}
+		stats.Categories = append(stats.Categories, p)
+	}
+	return stats, nil
+}
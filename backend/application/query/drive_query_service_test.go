@@ -0,0 +1,140 @@
+package query_test
+
+import (
+	"io"
+	"log/slog"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/sunDar0/winding-road-finder/backend/application/query"
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/drive"
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+	"github.com/sunDar0/winding-road-finder/backend/domain/region"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+)
+
+// stubDrives는 주어진 기록에서 사용자의 기록만 골라 주는 저장소입니다.
+type stubDrives []*drive.Drive
+
+func (s stubDrives) Save(*drive.Drive) error { return nil }
+
+func (s stubDrives) Update(int, func(*drive.Drive) error) (*drive.Drive, error) { return nil, nil }
+
+func (s stubDrives) Delete(int) error { return nil }
+
+func (s stubDrives) FindByID(int) (*drive.Drive, error) { return nil, nil }
+
+func (s stubDrives) FindByUser(userID int) ([]*drive.Drive, error) {
+	var result []*drive.Drive
+	for _, d := range s {
+		if d.UserID == userID {
+			result = append(result, d)
+		}
+	}
+	return result, nil
+}
+
+// regionCourses는 지역과 내비게이션 포인트가 있는 코스 저장소입니다.
+type regionCourses []*course.CourseAggregate
+
+func (s regionCourses) FindAll(course.CourseFilter) ([]*course.CourseAggregate, error) { return s, nil }
+
+func (s regionCourses) FindByID(id int) (*course.CourseAggregate, error) {
+	for _, c := range s {
+		if c.ID == id {
+			return c, nil
+		}
+	}
+	return nil, nil
+}
+
+type sidoRegions []*region.Region
+
+func (s sidoRegions) FindAll() ([]*region.Region, error) { return s, nil }
+
+func (s sidoRegions) FindByCode(string) (*region.Region, error) { return nil, nil }
+
+// northCourse는 북쪽으로 위도 km/111.2도 가는 코스입니다.
+func northCourse(id int, regionCode string, km float64) *course.CourseAggregate {
+	return &course.CourseAggregate{ID: id, RegionCode: regionCode, Nav: []course.CourseNav{
+		{Geolocation: course.CourseGeolocation{Latitude: 37, Longitude: 127}},
+		{Geolocation: course.CourseGeolocation{Latitude: 37 + km/111.195, Longitude: 127}},
+	}}
+}
+
+func TestGetMyStats(t *testing.T) {
+	const me = 7
+	courses := regionCourses{
+		northCourse(1, "41", 10), northCourse(2, "41", 20),
+		northCourse(3, "51", 30), northCourse(5, "51", 40),
+		// 지역 목록에 없는 코스는 지역별 현황에서 빠진다.
+		northCourse(6, "99", 50),
+	}
+	track := &drive.Track{Points: []course.CourseGeolocation{{Latitude: 37, Longitude: 127}, {Latitude: 37.1, Longitude: 127}}}
+	drives := stubDrives{
+		{ID: 1, UserID: me, CourseID: 1},
+		{ID: 2, UserID: me, CourseID: 1, Track: track},
+		{ID: 3, UserID: me, CourseID: 3},
+		{ID: 4, UserID: me, CourseID: 5},
+		{ID: 5, UserID: me, CourseID: 6},
+		// 다른 사용자의 기록은 세지 않는다.
+		{ID: 6, UserID: 8, CourseID: 2},
+	}
+	regions := sidoRegions{
+		{Code: "41", Name: i18n.Text("경기도"), Level: region.LevelSido},
+		{Code: "51", Name: i18n.Text("강원특별자치도"), Level: region.LevelSido},
+	}
+	recs := stubRecommendations{
+		{ID: 1, Title: i18n.Text("경기 코스"), CourseIds: []int{1, 2}},
+		{ID: 2, Title: i18n.Text("강원 코스"), CourseIds: []int{3, 5}},
+	}
+	regionSvc := query.NewRegionQueryService(regions)
+	recSvc := query.NewRecommendationQueryService(recs, courses, query.NewStyleQueryService(stubStyles{}), regionSvc, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	svc := query.NewDriveQueryService(drives, courses, recSvc, regionSvc)
+
+	stats, err := svc.GetMyStats(&user.Principal{UserID: me})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.TotalDrives != 5 {
+		t.Errorf("TotalDrives = %d, want 5", stats.TotalDrives)
+	}
+	var completed []int
+	for id := range stats.CompletedCourses {
+		completed = append(completed, id)
+	}
+	slices.Sort(completed)
+	if !slices.Equal(completed, []int{1, 3, 5, 6}) {
+		t.Errorf("CompletedCourses = %v, want [1 3 5 6]", completed)
+	}
+	// 궤적이 없는 기록은 코스 추정 거리(10, 30, 40, 50km), 궤적이 있는 기록은 궤적 거리를 쓴다.
+	if want := 130 + track.DistanceKm(); math.Abs(stats.TotalKm-want) > 0.01 {
+		t.Errorf("TotalKm = %.2f, want %.2f", stats.TotalKm, want)
+	}
+
+	// 완주가 많은 지역부터 보여준다.
+	wantRegions := []struct {
+		code             string
+		completed, total int
+	}{{"51", 2, 2}, {"41", 1, 2}}
+	if len(stats.Regions) != len(wantRegions) {
+		t.Fatalf("len(Regions) = %d, want %d", len(stats.Regions), len(wantRegions))
+	}
+	for i, w := range wantRegions {
+		if p := stats.Regions[i]; p.Region.Code != w.code || p.Completed != w.completed || p.Total != w.total {
+			t.Errorf("Regions[%d] = %s %d/%d, want %s %d/%d", i, p.Region.Code, p.Completed, p.Total, w.code, w.completed, w.total)
+		}
+	}
+
+	wantCategories := map[int][2]int{1: {1, 2}, 2: {2, 2}}
+	if len(stats.Categories) != len(wantCategories) {
+		t.Fatalf("len(Categories) = %d, want %d", len(stats.Categories), len(wantCategories))
+	}
+	for _, p := range stats.Categories {
+		if w := wantCategories[p.ID]; p.Completed != w[0] || p.Total != w[1] {
+			t.Errorf("category %d = %d/%d, want %d/%d", p.ID, p.Completed, p.Total, w[0], w[1])
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package query
+
+import (
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/hazard"
+)
+
+// HazardQueryService는 위험 신고 조회와 코스별 활성 신고 집계를 담당합니다.
+type HazardQueryService struct {
+	repo hazard.HazardRepository
+	now  func() time.Time
+}
+
+func NewHazardQueryService(repo hazard.HazardRepository) *HazardQueryService {
+	return &HazardQueryService{repo: repo, now: time.Now}
+}
+
+// GetHazards는 코스의 위험 신고를 최신순으로 반환합니다. includeInactive가 false면 활성 신고만 반환합니다.
+// 관리자가 숨긴 신고는 포함하지 않습니다.
+func (svc *HazardQueryService) GetHazards(courseID int, includeInactive bool) ([]*hazard.Hazard, error) {
+	hazards, err := svc.repo.FindByCourse(courseID)
+	if err != nil {
+		return nil, err
+	}
+	now := svc.now()
+	var result []*hazard.Hazard
+	for _, h := range hazards {
+		if h.IsActive(now) || (includeInactive && !h.Hidden) {
+			result = append(result, h)
+		}
+	}
+	return result, nil
+}
+
+// IsActive는 현재 시각 기준으로 신고가 활성인지 확인합니다.
+func (svc *HazardQueryService) IsActive(h *hazard.Hazard) bool {
+	return h.IsActive(svc.now())
+}
+
+// GetActiveSummaries는 코스별 활성 신고 요약을 반환합니다. 활성 신고가 없는 코스는 포함하지 않습니다.
+func (svc *HazardQueryService) GetActiveSummaries() (map[int]hazard.Summary, error) {
+	hazards, err := svc.repo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	return hazard.SummarizeActive(hazards, svc.now()), nil
+}
@@ -0,0 +1,18 @@
+package query
+
+// pageRange는 total개 항목 중 page(1부터), size 단위 페이지의 [start, end) 범위를 반환합니다.
+// 범위를 벗어난 페이지나 잘못된 값이면 ok가 false입니다. page나 size가 아무리 커도 곱셈이 넘치지 않도록 페이지 수로 먼저 확인합니다.
+func pageRange(total, page, size int) (start, end int, ok bool) {
+	if page < 1 || size < 1 {
+		return 0, 0, false
+	}
+	pages := total / size
+	if total%size != 0 {
+		pages++
+	}
+	if page > pages {
+		return 0, 0, false
+	}
+	start = (page - 1) * size
+	return start, min(start+size, total), true
+}
@@ -0,0 +1,80 @@
+package query
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/review"
+	commandRepo "github.com/sunDar0/winding-road-finder/backend/infrastructure/persistence/command"
+)
+
+func TestPageRange(t *testing.T) {
+	tests := []struct {
+		name              string
+		total, page, size int
+		start, end        int
+		ok                bool
+	}{
+		{"첫 페이지", 45, 1, 20, 0, 20, true},
+		{"가운데 페이지", 45, 2, 20, 20, 40, true},
+		{"마지막 부분 페이지", 45, 3, 20, 40, 45, true},
+		{"딱 맞는 마지막 페이지", 40, 2, 20, 20, 40, true},
+		{"마지막 다음 페이지", 40, 3, 20, 0, 0, false},
+		{"빈 목록", 0, 1, 20, 0, 0, false},
+		{"page 0", 45, 0, 20, 0, 0, false},
+		{"음수 page", 45, -1, 20, 0, 0, false},
+		{"size 0", 45, 1, 0, 0, 0, false},
+		{"곱셈이 넘치는 page", 45, math.MaxInt, 20, 0, 0, false},
+		{"곱셈이 넘치는 page (size 1)", 45, math.MaxInt, 1, 0, 0, false},
+		{"아주 큰 size", 45, 1, math.MaxInt, 0, 45, true},
+		{"아주 큰 size의 두 번째 페이지", 45, 2, math.MaxInt, 0, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, ok := pageRange(tt.total, tt.page, tt.size)
+			if start != tt.start || end != tt.end || ok != tt.ok {
+				t.Fatalf("pageRange(%d, %d, %d) = %d, %d, %v; want %d, %d, %v",
+					tt.total, tt.page, tt.size, start, end, ok, tt.start, tt.end, tt.ok)
+			}
+		})
+	}
+}
+
+func TestGetReviewsPaging(t *testing.T) {
+	dir := t.TempDir()
+	reviews := commandRepo.NewReviewCommandRepository(dir)
+	svc := NewReviewQueryService(reviews, commandRepo.NewUserCommandRepository(dir))
+	now := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	ratings := course.CourseRatings{Tech: 3, Speed: 3, Scenery: 3, Road: 3, Access: 3}
+	for userID := 1; userID <= 5; userID++ {
+		r, err := review.NewReview(1, userID, ratings, "", now.AddDate(0, 0, -1), now.Add(time.Duration(userID)*time.Minute))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := reviews.Save(r); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		page, size int
+		want       int
+	}{
+		{1, 2, 2},
+		{3, 2, 1},
+		{4, 2, 0},
+		{math.MaxInt, 20, 0},
+		{math.MaxInt / 2, 3, 0},
+	}
+	for _, tt := range tests {
+		page, err := svc.GetReviews(1, tt.page, tt.size)
+		if err != nil {
+			t.Fatalf("GetReviews(page=%d, size=%d): %v", tt.page, tt.size, err)
+		}
+		if len(page.Reviews) != tt.want || page.Total != 5 {
+			t.Fatalf("GetReviews(page=%d, size=%d) = %d reviews (total %d), want %d", tt.page, tt.size, len(page.Reviews), page.Total, tt.want)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package query
+
+import (
+	"sort"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/photo"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+)
+
+// PhotoView는 사진과 올린 사람, 변형 이미지별 주소입니다. 계정을 찾지 못하면 Uploader는 nil입니다.
+type PhotoView struct {
+	Photo    *photo.Photo
+	Uploader *user.User
+	URLs     map[photo.VariantName]string
+}
+
+// PhotoQueryService는 코스 사진 갤러리 조회를 담당합니다.
+type PhotoQueryService struct {
+	repo       photo.PhotoRepository
+	courseRepo course.CourseQueryRepository
+	userRepo   user.UserRepository
+	store      photo.MediaStore
+}
+
+func NewPhotoQueryService(repo photo.PhotoRepository, courseRepo course.CourseQueryRepository, userRepo user.UserRepository, store photo.MediaStore) *PhotoQueryService {
+	return &PhotoQueryService{repo: repo, courseRepo: courseRepo, userRepo: userRepo, store: store}
+}
+
+// GetCoursePhotos는 코스 사진을 경로 순서(출발지에서 가까운 순)로 반환합니다.
+// 경로 위에 배치하지 못한 사진은 그 뒤에 올린 순서대로 붙입니다. 코스가 없으면 course.ErrCourseNotFound를 반환합니다.
+func (svc *PhotoQueryService) GetCoursePhotos(courseID int) ([]*PhotoView, error) {
+	c, err := svc.courseRepo.FindByID(courseID)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil {
+		return nil, course.ErrCourseNotFound
+	}
+	photos, err := svc.repo.FindByCourse(courseID)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(photos, func(i, j int) bool {
+		a, b := photos[i].RouteKm, photos[j].RouteKm
+		switch {
+		case a != nil && b != nil:
+			return *a < *b
+		case a != nil || b != nil:
+			return a != nil
+		}
+		return photos[i].UploadedAt.Before(photos[j].UploadedAt)
+	})
+	users := map[int]*user.User{}
+	result := make([]*PhotoView, 0, len(photos))
+	for _, p := range photos {
+		uploader, ok := users[p.UploaderID]
+		if !ok {
+			if uploader, err = svc.userRepo.FindByID(p.UploaderID); err != nil {
+				return nil, err
+			}
+			users[p.UploaderID] = uploader
+		}
+		view := &PhotoView{Photo: p, Uploader: uploader, URLs: map[photo.VariantName]string{}}
+		for _, v := range p.Variants {
+			view.URLs[v.Name] = svc.store.URL(v.Key)
+		}
+		result = append(result, view)
+	}
+	return result, nil
+}
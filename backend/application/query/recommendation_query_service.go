@@ -1,15 +1,22 @@
 package query
 
 import (
+	"errors"
+	"log/slog"
+	"slices"
+	"sync"
+
 	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
 	"github.com/sunDar0/winding-road-finder/backend/domain/recommendation"
+	"github.com/sunDar0/winding-road-finder/backend/domain/style"
 )
 
 // RecommendationWithCourses는 추천 카테고리와 코스 상세정보 집합을 나타냅니다.
 type RecommendationWithCourses struct {
 	ID          int
-	Title       string
-	Description string
+	Title       i18n.LocalizedText
+	Description i18n.LocalizedText
 	Courses     []*course.CourseAggregate
 }
 
@@ -17,10 +24,45 @@ type RecommendationWithCourses struct {
 type RecommendationQueryService struct {
 	recRepo    recommendation.RecommendationRepository
 	courseRepo course.CourseQueryRepository
+	styleSvc   *StyleQueryService
+	regionSvc  *RegionQueryService
+	scorer     *recommendation.Scorer
+	logger     *slog.Logger
+	// rules는 규칙 문자열별 해석 결과입니다. 스타일 분류와 지역 목록은 실행 중 바뀌지 않으므로 규칙마다 한 번만 해석합니다.
+	rulesMu sync.Mutex
+	rules   map[string]compiledRule
+}
+
+// compiledRule은 규칙 하나의 해석 결과입니다. 해석할 수 없는 규칙도 err를 기억해 다시 해석하지 않습니다.
+type compiledRule struct {
+	rule *recommendation.Rule
+	err  error
+}
+
+func NewRecommendationQueryService(recRepo recommendation.RecommendationRepository, courseRepo course.CourseQueryRepository, styleSvc *StyleQueryService, regionSvc *RegionQueryService, logger *slog.Logger) *RecommendationQueryService {
+	return &RecommendationQueryService{recRepo: recRepo, courseRepo: courseRepo, styleSvc: styleSvc, regionSvc: regionSvc, scorer: recommendation.NewScorer(), logger: logger, rules: map[string]compiledRule{}}
 }
 
-func NewRecommendationQueryService(recRepo recommendation.RecommendationRepository, courseRepo course.CourseQueryRepository) *RecommendationQueryService {
-	return &RecommendationQueryService{recRepo: recRepo, courseRepo: courseRepo}
+// CheckRules는 데이터 파일의 모든 추천 규칙을 미리 해석하고, 해석할 수 없는 규칙의 개수를 반환합니다.
+// 그런 규칙은 경고 로그를 남기며 조회할 때 건너뜁니다. 데이터를 읽지 못하면 에러를 반환합니다.
+func (svc *RecommendationQueryService) CheckRules() (invalid int, err error) {
+	recs, err := svc.recRepo.FindAll()
+	if err != nil {
+		return 0, err
+	}
+	for _, rec := range recs {
+		if !rec.IsDynamic() {
+			continue
+		}
+		rule, err := svc.ruleFor(rec)
+		if err != nil {
+			return 0, err
+		}
+		if rule == nil {
+			invalid++
+		}
+	}
+	return invalid, nil
 }
 
 // GetRecommendationsWithCourses는 추천 카테고리별로 코스 상세정보를 포함해 반환합니다.
@@ -31,22 +73,11 @@ func (svc *RecommendationQueryService) GetRecommendationsWithCourses() ([]*Recom
 	}
 	var result []*RecommendationWithCourses
 	for _, rec := range recs {
-		var courses []*course.CourseAggregate
-		for _, cid := range rec.CourseIds {
-			c, err := svc.courseRepo.FindByID(cid)
-			if err != nil {
-				return nil, err
-			}
-			if c != nil {
-				courses = append(courses, c)
-			}
+		r, err := svc.Render(rec)
+		if err != nil {
+			return nil, err
 		}
-		result = append(result, &RecommendationWithCourses{
-			ID:          rec.ID,
-			Title:       rec.Title,
-			Description: rec.Description,
-			Courses:     courses,
-		})
+		result = append(result, r)
 	}
 	return result, nil
 }
@@ -61,8 +92,89 @@ func (svc *RecommendationQueryService) GetRecommendationById(id int) (*Recommend
 		return nil, nil
 	}
 	
+	return svc.Render(rec)
+}
+
+// Render는 추천 카테고리의 코스 목록을 구해 상세정보와 함께 반환합니다.
+// 데이터 파일의 추천뿐 아니라 공개 컬렉션처럼 추천 형태로 보여주는 코스 집합에도 사용합니다.
+func (svc *RecommendationQueryService) Render(rec *recommendation.Recommendation) (*RecommendationWithCourses, error) {
+	courses, err := svc.resolveCourses(rec)
+	if err != nil {
+		return nil, err
+	}
+	return &RecommendationWithCourses{
+		ID:          rec.ID,
+		Title:       rec.Title,
+		Description: rec.Description,
+		Courses:     courses,
+	}, nil
+}
+
+// resolveCourses는 추천 카테고리의 코스 목록을 구합니다.
+// 규칙이 있으면 고정 코스를 앞에 두고 규칙 결과에서 고정·제외 코스를 뺀 나머지를 이어 붙이며,
+// LIMIT은 고정 코스를 포함한 전체 개수에 적용합니다. 해석할 수 없는 규칙은 건너뛰고 고정 코스만 반환합니다.
+func (svc *RecommendationQueryService) resolveCourses(rec *recommendation.Recommendation) ([]*course.CourseAggregate, error) {
+	if !rec.IsDynamic() {
+		return svc.findCourses(rec.CourseIds)
+	}
+	rule, err := svc.ruleFor(rec)
+	if err != nil {
+		return nil, err
+	}
+	courses, err := svc.findCourses(rec.PinnedIds)
+	if err != nil || rule == nil {
+		return courses, err
+	}
+	all, err := svc.courseRepo.FindAll(course.CourseFilter{})
+	if err != nil {
+		return nil, err
+	}
+	var candidates []*course.CourseAggregate
+	for _, c := range all {
+		if !slices.Contains(rec.PinnedIds, c.ID) && !slices.Contains(rec.ExcludedIds, c.ID) {
+			candidates = append(candidates, c)
+		}
+	}
+	// 해석한 규칙은 여러 요청이 함께 쓰므로 복사본에서 LIMIT을 풉니다.
+	unlimited := *rule
+	unlimited.Limit = 0
+	courses = append(courses, unlimited.Apply(candidates)...)
+	if rule.Limit > 0 && len(courses) > rule.Limit {
+		courses = courses[:rule.Limit]
+	}
+	return courses, nil
+}
+
+// ruleFor는 현재 스타일 분류와 지역 목록으로 해석한 추천 규칙을 반환합니다.
+// 해석할 수 없는 규칙이면 처음 한 번 경고 로그를 남기고 nil을 반환하며, 분류나 지역 목록을 읽지 못한 경우만 에러입니다.
+func (svc *RecommendationQueryService) ruleFor(rec *recommendation.Recommendation) (*recommendation.Rule, error) {
+	svc.rulesMu.Lock()
+	defer svc.rulesMu.Unlock()
+	compiled, ok := svc.rules[rec.Rule]
+	if !ok {
+		taxonomy, err := svc.styleSvc.Taxonomy()
+		if err != nil {
+			return nil, err
+		}
+		dir, err := svc.regionSvc.Directory()
+		if err != nil {
+			return nil, err
+		}
+		compiled.rule, compiled.err = recommendation.CompileRule(rec.Rule, taxonomy, dir)
+		if compiled.err != nil && !errors.Is(compiled.err, recommendation.ErrInvalidRule) && !errors.Is(compiled.err, style.ErrUnknownStyle) {
+			return nil, compiled.err
+		}
+		svc.rules[rec.Rule] = compiled
+		if compiled.err != nil {
+			svc.logger.Warn("추천 규칙을 해석할 수 없어 고정 코스만 보여줍니다", "recommendation_id", rec.ID, "rule", rec.Rule, "error", compiled.err)
+		}
+	}
+	return compiled.rule, nil
+}
+
+func (svc *RecommendationQueryService) findCourses(ids []int) ([]*course.CourseAggregate, error) {
 	var courses []*course.CourseAggregate
-	for _, cid := range rec.CourseIds {
+	for _, cid := range ids {
 		c, err := svc.courseRepo.FindByID(cid)
 		if err != nil {
 			return nil, err
@@ -71,11 +183,44 @@ func (svc *RecommendationQueryService) GetRecommendationById(id int) (*Recommend
 			courses = append(courses, c)
 		}
 	}
-		
-	return &RecommendationWithCourses{
-		ID:          rec.ID,
-		Title:       rec.Title,
-		Description: rec.Description,
-		Courses:     courses,
-	}, nil
-} 
\ No newline at end of file
+	return courses, nil
+}
+
+// GetPersonalRecommendations는 사용자 취향에 맞춰 전체 코스를 점수화하고 상위 limit개를 반환합니다.
+// 선호 스타일은 slug, 이름, 동의어를 모두 허용하며, 알 수 없는 스타일이면 style.ErrUnknownStyle을 반환합니다.
+func (svc *RecommendationQueryService) GetPersonalRecommendations(pref recommendation.Preference, limit int) ([]*recommendation.ScoredCourse, error) {
+	taxonomy, err := svc.styleSvc.Taxonomy()
+	if err != nil {
+		return nil, err
+	}
+	if pref.Styles, err = taxonomy.Normalize(pref.Styles); err != nil {
+		return nil, err
+	}
+	courses, err := svc.courseRepo.FindAll(course.CourseFilter{})
+	if err != nil {
+		return nil, err
+	}
+	ranked := svc.scorer.RankByPreference(courses, pref)
+	if limit > 0 && len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+	return ranked, nil
+}
+
+// GetSimilarCourses는 기준 코스와 비슷한 코스를 점수화해 상위 limit개를 반환합니다.
+// 기준 코스가 없으면 nil을, 조건에 맞는 코스가 없으면 빈 목록을 반환합니다.
+func (svc *RecommendationQueryService) GetSimilarCourses(id int, opts recommendation.SimilarOptions, limit int) ([]*recommendation.ScoredCourse, error) {
+	base, err := svc.courseRepo.FindByID(id)
+	if err != nil || base == nil {
+		return nil, err
+	}
+	courses, err := svc.courseRepo.FindAll(course.CourseFilter{})
+	if err != nil {
+		return nil, err
+	}
+	ranked := append([]*recommendation.ScoredCourse{}, svc.scorer.RankSimilar(base, courses, opts)...)
+	if limit > 0 && len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+	return ranked, nil
+}
@@ -0,0 +1,149 @@
+package query_test
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"slices"
+	"testing"
+
+	"github.com/sunDar0/winding-road-finder/backend/application/query"
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+	"github.com/sunDar0/winding-road-finder/backend/domain/recommendation"
+	"github.com/sunDar0/winding-road-finder/backend/domain/region"
+	"github.com/sunDar0/winding-road-finder/backend/domain/style"
+)
+
+// stubCourses는 1~4번 코스가 있는 조회 저장소입니다. 1, 2번만 헤어핀 코스입니다.
+type stubCourses struct{}
+
+func (stubCourses) all() []*course.CourseAggregate {
+	return []*course.CourseAggregate{
+		{ID: 1, Styles: []string{"hairpin"}},
+		{ID: 2, Styles: []string{"hairpin"}},
+		{ID: 3, Styles: []string{"coastal"}},
+		{ID: 4, Styles: []string{"coastal"}},
+	}
+}
+
+func (s stubCourses) FindAll(course.CourseFilter) ([]*course.CourseAggregate, error) {
+	return s.all(), nil
+}
+
+func (s stubCourses) FindByID(id int) (*course.CourseAggregate, error) {
+	for _, c := range s.all() {
+		if c.ID == id {
+			return c, nil
+		}
+	}
+	return nil, nil
+}
+
+type stubStyles struct{}
+
+func (stubStyles) FindAll() ([]*style.Style, error) {
+	return []*style.Style{
+		{Slug: "hairpin", Name: i18n.LocalizedText{i18n.Korean: "헤어핀"}},
+		{Slug: "coastal", Name: i18n.LocalizedText{i18n.Korean: "해안"}},
+	}, nil
+}
+
+func (stubStyles) FindBySlug(string) (*style.Style, error) { return nil, nil }
+
+type stubRegions struct{}
+
+func (stubRegions) FindAll() ([]*region.Region, error) { return nil, nil }
+
+func (stubRegions) FindByCode(string) (*region.Region, error) { return nil, nil }
+
+// stubRecommendations는 주어진 추천 목록을 그대로 반환하는 저장소입니다.
+type stubRecommendations []*recommendation.Recommendation
+
+func (s stubRecommendations) FindAll() ([]*recommendation.Recommendation, error) { return s, nil }
+
+func (s stubRecommendations) FindById(id int) (*recommendation.Recommendation, error) {
+	for _, r := range s {
+		if r.ID == id {
+			return r, nil
+		}
+	}
+	return nil, nil
+}
+
+// failingRegions는 지역 목록을 읽지 못하는 저장소입니다.
+type failingRegions struct{ stubRegions }
+
+var errRegionsUnavailable = errors.New("지역 목록을 읽을 수 없음")
+
+func (failingRegions) FindAll() ([]*region.Region, error) { return nil, errRegionsUnavailable }
+
+func newRecommendationService(recs stubRecommendations, regions region.RegionRepository) *query.RecommendationQueryService {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return query.NewRecommendationQueryService(recs, stubCourses{}, query.NewStyleQueryService(stubStyles{}), query.NewRegionQueryService(regions), logger)
+}
+
+func courseIDs(courses []*course.CourseAggregate) []int {
+	var ids []int
+	for _, c := range courses {
+		ids = append(ids, c.ID)
+	}
+	return ids
+}
+
+// 해석할 수 없는 규칙이 있어도 전체 추천 조회는 실패하지 않고, 그 추천은 고정 코스만 보여준다.
+func TestRecommendationsSkipInvalidRules(t *testing.T) {
+	recs := stubRecommendations{
+		{ID: 1, Rule: "style contains 헤어핀 ORDER BY id DESC", PinnedIds: []int{4}},
+		{ID: 2, Rule: "style contains 없는스타일", PinnedIds: []int{3}},
+		{ID: 3, Rule: "tech >=", PinnedIds: []int{1}},
+		{ID: 4, Rule: "region = 없는지역"},
+		{ID: 5, CourseIds: []int{3, 1}},
+		{ID: 6, Rule: "style contains 헤어핀 LIMIT 2", PinnedIds: []int{3}},
+	}
+	svc := newRecommendationService(recs, stubRegions{})
+
+	invalid, err := svc.CheckRules()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if invalid != 3 {
+		t.Fatalf("CheckRules() = %d, want 3", invalid)
+	}
+
+	want := map[int][]int{
+		1: {4, 2, 1},
+		2: {3},
+		3: {1},
+		4: nil,
+		5: {3, 1},
+		6: {3, 1},
+	}
+	// 해석 결과를 재사용해도 같은 결과가 나온다.
+	for range 2 {
+		result, err := svc.GetRecommendationsWithCourses()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(result) != len(recs) {
+			t.Fatalf("len(result) = %d, want %d", len(result), len(recs))
+		}
+		for _, r := range result {
+			if got := courseIDs(r.Courses); !slices.Equal(got, want[r.ID]) {
+				t.Errorf("추천 %d 코스 = %v, want %v", r.ID, got, want[r.ID])
+			}
+		}
+	}
+}
+
+// 지역 목록을 읽지 못한 것은 규칙 오류가 아니므로 에러를 그대로 돌려주고 결과를 기억하지 않는다.
+func TestRecommendationRuleLoadError(t *testing.T) {
+	recs := stubRecommendations{{ID: 1, Rule: "style contains 헤어핀"}}
+	svc := newRecommendationService(recs, failingRegions{})
+	if _, err := svc.CheckRules(); !errors.Is(err, errRegionsUnavailable) {
+		t.Fatalf("CheckRules() error = %v, want %v", err, errRegionsUnavailable)
+	}
+	if _, err := svc.GetRecommendationById(1); !errors.Is(err, errRegionsUnavailable) {
+		t.Fatalf("GetRecommendationById() error = %v, want %v", err, errRegionsUnavailable)
+	}
+}
@@ -0,0 +1,88 @@
+package query
+
+import (
+	"sync"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/region"
+)
+
+// RegionWithChildren은 지역과 하위 지역 목록을 나타냅니다.
+type RegionWithChildren struct {
+	Region   *region.Region
+	Children []*region.Region
+}
+
+// RegionQueryService는 지역 계층 조회 비즈니스 로직을 담당합니다.
+type RegionQueryService struct {
+	repo region.RegionRepository
+	// dir은 built 목록으로 만든 계층 탐색기입니다. 코스 목록 요청마다 쓰이므로 저장소가 목록을 다시 읽었을 때만 새로 만듭니다.
+	mu    sync.Mutex
+	dir   *region.Directory
+	built []*region.Region
+}
+
+func NewRegionQueryService(repo region.RegionRepository) *RegionQueryService {
+	return &RegionQueryService{repo: repo}
+}
+
+// Directory는 저장소의 지역 목록으로 계층 탐색기를 구성합니다.
+// 저장소가 지난번과 같은 목록을 반환하면 만들어 둔 탐색기를 그대로 반환합니다.
+func (svc *RegionQueryService) Directory() (*region.Directory, error) {
+	regions, err := svc.repo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	if svc.dir == nil || !sameRegions(regions, svc.built) {
+		svc.dir, svc.built = region.NewDirectory(regions), regions
+	}
+	return svc.dir, nil
+}
+
+// sameRegions는 a와 b가 같은 배열을 가리키는 같은 길이의 목록인지 확인합니다. 저장소는 다시 읽을 때마다 새 목록을 만듭니다.
+func sameRegions(a, b []*region.Region) bool {
+	return len(a) == len(b) && (len(a) == 0 || &a[0] == &b[0])
+}
+
+// GetRegions는 시·도 목록을 하위 시·군·구와 함께 반환합니다.
+func (svc *RegionQueryService) GetRegions() ([]*RegionWithChildren, error) {
+	dir, err := svc.Directory()
+	if err != nil {
+		return nil, err
+	}
+	var result []*RegionWithChildren
+	for _, r := range dir.Roots() {
+		result = append(result, &RegionWithChildren{Region: r, Children: dir.Children(r.Code)})
+	}
+	return result, nil
+}
+
+// GetRegionByCode는 코드 또는 이름으로 지역을 하위 지역과 함께 조회합니다.
+func (svc *RegionQueryService) GetRegionByCode(code string) (*RegionWithChildren, error) {
+	dir, err := svc.Directory()
+	if err != nil {
+		return nil, err
+	}
+	r := dir.Resolve(code)
+	if r == nil {
+		return nil, nil
+	}
+	return &RegionWithChildren{Region: r, Children: dir.Children(r.Code)}, nil
+}
+
+// NormalizeRegion은 지역 필터 값(코드, 이름, 별칭)을 지역 코드로 변환합니다.
+// 알 수 없는 값은 그대로 반환합니다.
+func (svc *RegionQueryService) NormalizeRegion(name string) (string, error) {
+	if name == "" || name == "all" {
+		return name, nil
+	}
+	dir, err := svc.Directory()
+	if err != nil {
+		return "", err
+	}
+	if r := dir.Resolve(name); r != nil {
+		return r.Code, nil
+	}
+	return name, nil
+}
@@ -0,0 +1,53 @@
+package query_test
+
+import (
+	"testing"
+
+	"github.com/sunDar0/winding-road-finder/backend/application/query"
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+	"github.com/sunDar0/winding-road-finder/backend/domain/region"
+)
+
+// reloadingRegions는 reload를 부르기 전까지 같은 지역 목록을 반환하는 저장소입니다.
+type reloadingRegions struct {
+	regions []*region.Region
+}
+
+func (r *reloadingRegions) FindAll() ([]*region.Region, error) { return r.regions, nil }
+
+func (r *reloadingRegions) FindByCode(string) (*region.Region, error) { return nil, nil }
+
+// reload는 파일을 다시 읽은 것처럼 새 목록을 만듭니다.
+func (r *reloadingRegions) reload(regions ...*region.Region) { r.regions = regions }
+
+// 계층 탐색기는 저장소가 목록을 다시 읽었을 때만 새로 만든다.
+func TestRegionDirectoryRebuildsOnReload(t *testing.T) {
+	gyeonggi := &region.Region{Code: "41", Name: i18n.Text("경기도"), Level: region.LevelSido}
+	gangwon := &region.Region{Code: "51", Name: i18n.Text("강원특별자치도"), Level: region.LevelSido}
+	repo := &reloadingRegions{}
+	repo.reload(gyeonggi)
+	svc := query.NewRegionQueryService(repo)
+
+	first, err := svc.Directory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again, _ := svc.Directory(); again != first {
+		t.Fatal("Directory() rebuilt the directory for the same region list")
+	}
+	if code, _ := svc.NormalizeRegion("강원특별자치도"); code != "강원특별자치도" {
+		t.Fatalf("NormalizeRegion(강원특별자치도) = %q before reload, want it unchanged", code)
+	}
+
+	repo.reload(gyeonggi, gangwon)
+	reloaded, err := svc.Directory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reloaded == first {
+		t.Fatal("Directory() kept the old directory after reload")
+	}
+	if code, _ := svc.NormalizeRegion("강원특별자치도"); code != "51" {
+		t.Fatalf("NormalizeRegion(강원특별자치도) = %q after reload, want 51", code)
+	}
+}
@@ -0,0 +1,69 @@
+package query
+
+import (
+	"github.com/sunDar0/winding-road-finder/backend/domain/review"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+)
+
+// ReviewWithAuthor는 리뷰와 작성자 정보입니다. 탈퇴 등으로 작성자를 찾지 못하면 Author는 nil입니다.
+type ReviewWithAuthor struct {
+	*review.Review
+	Author *user.User
+}
+
+// ReviewPage는 페이지 단위 리뷰 목록입니다.
+type ReviewPage struct {
+	Reviews []*ReviewWithAuthor
+	Total   int
+}
+
+// ReviewQueryService는 코스 리뷰와 사용자 평점 집계 조회를 담당합니다.
+type ReviewQueryService struct {
+	repo     review.ReviewRepository
+	userRepo user.UserRepository
+}
+
+func NewReviewQueryService(repo review.ReviewRepository, userRepo user.UserRepository) *ReviewQueryService {
+	return &ReviewQueryService{repo: repo, userRepo: userRepo}
+}
+
+// GetReviews는 코스 리뷰를 최신순으로 page(1부터), size 단위로 반환합니다. 숨긴 리뷰는 제외합니다.
+func (svc *ReviewQueryService) GetReviews(courseID, page, size int) (*ReviewPage, error) {
+	reviews, err := svc.repo.FindByCourse(courseID)
+	if err != nil {
+		return nil, err
+	}
+	reviews = visibleReviews(reviews)
+	result := &ReviewPage{Reviews: []*ReviewWithAuthor{}, Total: len(reviews)}
+	start, end, ok := pageRange(len(reviews), page, size)
+	if !ok {
+		return result, nil
+	}
+	for _, r := range reviews[start:end] {
+		author, err := svc.userRepo.FindByID(r.UserID)
+		if err != nil {
+			return nil, err
+		}
+		result.Reviews = append(result.Reviews, &ReviewWithAuthor{Review: r, Author: author})
+	}
+	return result, nil
+}
+
+// GetCommunityRatings는 코스별 사용자 평점 집계를 반환합니다. 리뷰가 없는 코스는 포함하지 않으며, 숨긴 리뷰는 집계하지 않습니다.
+func (svc *ReviewQueryService) GetCommunityRatings() (map[int]review.CommunityRatings, error) {
+	reviews, err := svc.repo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	return review.SummarizeByCourse(visibleReviews(reviews)), nil
+}
+
+func visibleReviews(reviews []*review.Review) []*review.Review {
+	var result []*review.Review
+	for _, r := range reviews {
+		if !r.Hidden {
+			result = append(result, r)
+		}
+	}
+	return result
+}
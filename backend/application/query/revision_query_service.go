@@ -0,0 +1,150 @@
+package query
+
+import (
+	"github.com/sunDar0/winding-road-finder/backend/domain/audit"
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/revision"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+)
+
+// RevisionView는 수정안과 작성자·검토자, 그리고 기준 버전 이후 코스가 바뀌었는지 여부입니다.
+// 계정을 찾지 못하면 Author, Reviewer는 nil입니다.
+type RevisionView struct {
+	Revision *revision.Revision
+	Author   *user.User
+	Reviewer *user.User
+	// CurrentVersion은 게시된 코스의 현재 이벤트 버전입니다. 새 코스 제안이거나 삭제된 코스면 0입니다.
+	CurrentVersion int
+}
+
+// Outdated는 검토 중인 수정안의 기준 버전이 현재 코스 버전과 달라 그대로 승인할 수 없는지 확인합니다.
+func (v *RevisionView) Outdated() bool {
+	r := v.Revision
+	return r.CourseID != 0 && r.Status != revision.StatusApproved && r.BaseVersion != v.CurrentVersion
+}
+
+// RevisionPage는 페이지 단위 수정안 목록입니다.
+type RevisionPage struct {
+	Revisions []*RevisionView
+	Total     int
+}
+
+// RevisionDiff는 비교 대상(게시된 코스 또는 다른 수정안)에서 수정안으로 바뀌는 필드입니다.
+type RevisionDiff struct {
+	View    *RevisionView
+	Changes []audit.Change
+}
+
+// RevisionQueryService는 코스 수정안 조회와 비교를 담당합니다.
+// 수정안은 작성자와 editor 이상 권한 사용자만 볼 수 있으며, 그 밖에는 revision.ErrRevisionNotFound로 처리합니다.
+type RevisionQueryService struct {
+	repo     revision.RevisionRepository
+	history  course.CourseHistoryRepository
+	userRepo user.UserRepository
+}
+
+func NewRevisionQueryService(repo revision.RevisionRepository, history course.CourseHistoryRepository, userRepo user.UserRepository) *RevisionQueryService {
+	return &RevisionQueryService{repo: repo, history: history, userRepo: userRepo}
+}
+
+// GetRevisions는 조건에 맞는 수정안을 최근 수정 순으로 page(1부터), size 단위로 반환합니다.
+func (svc *RevisionQueryService) GetRevisions(filter revision.Filter, page, size int) (*RevisionPage, error) {
+	revisions, err := svc.repo.Find(filter)
+	if err != nil {
+		return nil, err
+	}
+	result := &RevisionPage{Revisions: []*RevisionView{}, Total: len(revisions)}
+	start, end, ok := pageRange(len(revisions), page, size)
+	if !ok {
+		return result, nil
+	}
+	for _, r := range revisions[start:end] {
+		view, err := svc.view(r)
+		if err != nil {
+			return nil, err
+		}
+		result.Revisions = append(result.Revisions, view)
+	}
+	return result, nil
+}
+
+// GetRevision은 수정안 하나를 반환합니다.
+func (svc *RevisionQueryService) GetRevision(principal *user.Principal, id int) (*RevisionView, error) {
+	r, err := svc.find(principal, id)
+	if err != nil {
+		return nil, err
+	}
+	return svc.view(r)
+}
+
+// GetDiff는 수정안을 againstID 수정안과 비교합니다. againstID가 0이면 현재 게시된 코스와 비교하며,
+// 새 코스 제안이거나 코스가 삭제되었으면 모든 필드가 추가된 것으로 나옵니다.
+func (svc *RevisionQueryService) GetDiff(principal *user.Principal, id, againstID int) (*RevisionDiff, error) {
+	r, err := svc.find(principal, id)
+	if err != nil {
+		return nil, err
+	}
+	view, err := svc.view(r)
+	if err != nil {
+		return nil, err
+	}
+	var base *course.CourseAggregate
+	if againstID != 0 {
+		other, err := svc.find(principal, againstID)
+		if err != nil {
+			return nil, err
+		}
+		base = other.Course
+	} else if r.CourseID != 0 {
+		events, err := svc.history.FindEvents(r.CourseID)
+		if err != nil {
+			return nil, err
+		}
+		if base, err = course.Replay(events); err != nil {
+			return nil, err
+		}
+	}
+	changes, err := audit.Diff(base, r.Course)
+	if err != nil {
+		return nil, err
+	}
+	return &RevisionDiff{View: view, Changes: changes}, nil
+}
+
+func (svc *RevisionQueryService) find(principal *user.Principal, id int) (*revision.Revision, error) {
+	r, err := svc.repo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if r == nil || !(r.WrittenBy(principal.UserID) || principal.Role.Allows(user.RoleEditor)) {
+		return nil, revision.ErrRevisionNotFound
+	}
+	return r, nil
+}
+
+func (svc *RevisionQueryService) view(r *revision.Revision) (*RevisionView, error) {
+	view := &RevisionView{Revision: r}
+	var err error
+	if view.Author, err = svc.userRepo.FindByID(r.AuthorID); err != nil {
+		return nil, err
+	}
+	if r.ReviewerID != 0 {
+		if view.Reviewer, err = svc.userRepo.FindByID(r.ReviewerID); err != nil {
+			return nil, err
+		}
+	}
+	if r.CourseID != 0 {
+		events, err := svc.history.FindEvents(r.CourseID)
+		if err != nil {
+			return nil, err
+		}
+		live, err := course.Replay(events)
+		if err != nil {
+			return nil, err
+		}
+		if live != nil {
+			view.CurrentVersion = course.LatestVersion(events)
+		}
+	}
+	return view, nil
+}
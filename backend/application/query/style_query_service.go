@@ -0,0 +1,35 @@
+package query
+
+import "github.com/sunDar0/winding-road-finder/backend/domain/style"
+
+// StyleQueryService는 스타일 분류 체계 조회 비즈니스 로직을 담당합니다.
+type StyleQueryService struct {
+	repo style.StyleRepository
+}
+
+func NewStyleQueryService(repo style.StyleRepository) *StyleQueryService {
+	return &StyleQueryService{repo: repo}
+}
+
+// Taxonomy는 저장소의 스타일 목록으로 분류 체계를 구성합니다.
+func (svc *StyleQueryService) Taxonomy() (*style.Taxonomy, error) {
+	styles, err := svc.repo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	return style.NewTaxonomy(styles), nil
+}
+
+// GetStyles는 전체 스타일 목록을 반환합니다.
+func (svc *StyleQueryService) GetStyles() ([]*style.Style, error) {
+	return svc.repo.FindAll()
+}
+
+// GetStyle은 slug, 이름 또는 동의어로 스타일을 조회합니다.
+func (svc *StyleQueryService) GetStyle(term string) (*style.Style, error) {
+	taxonomy, err := svc.Taxonomy()
+	if err != nil {
+		return nil, err
+	}
+	return taxonomy.Resolve(term), nil
+}
@@ -0,0 +1,93 @@
+package query
+
+import (
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/submission"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+)
+
+// SubmissionView는 제보와 제보자·검토자, 그리고 검토 대기 중인 제보의 중복 후보입니다.
+// 계정을 찾지 못하면 Submitter, Reviewer는 nil입니다.
+type SubmissionView struct {
+	Submission *submission.Submission
+	Submitter  *user.User
+	Reviewer   *user.User
+	// Duplicates는 현재 코스 목록과 비교한 중복 후보입니다. 이미 검토한 제보는 비어 있습니다.
+	Duplicates []submission.Duplicate
+}
+
+// SubmissionPage는 페이지 단위 제보 목록입니다.
+type SubmissionPage struct {
+	Submissions []*SubmissionView
+	Total       int
+}
+
+// SubmissionQueryService는 코스 제보 조회를 담당합니다.
+// 제보는 제보자와 editor 이상 권한 사용자만 볼 수 있으며, 그 밖에는 submission.ErrSubmissionNotFound로 처리합니다.
+type SubmissionQueryService struct {
+	repo       submission.SubmissionRepository
+	courseRepo course.CourseQueryRepository
+	userRepo   user.UserRepository
+}
+
+func NewSubmissionQueryService(repo submission.SubmissionRepository, courseRepo course.CourseQueryRepository, userRepo user.UserRepository) *SubmissionQueryService {
+	return &SubmissionQueryService{repo: repo, courseRepo: courseRepo, userRepo: userRepo}
+}
+
+// GetSubmissions는 조건에 맞는 제보를 최근 제보 순으로 page(1부터), size 단위로 반환합니다.
+func (svc *SubmissionQueryService) GetSubmissions(filter submission.Filter, page, size int) (*SubmissionPage, error) {
+	submissions, err := svc.repo.Find(filter)
+	if err != nil {
+		return nil, err
+	}
+	result := &SubmissionPage{Submissions: []*SubmissionView{}, Total: len(submissions)}
+	start, end, ok := pageRange(len(submissions), page, size)
+	if !ok {
+		return result, nil
+	}
+	courses, err := svc.courseRepo.FindAll(course.CourseFilter{})
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range submissions[start:end] {
+		view, err := svc.view(s, courses)
+		if err != nil {
+			return nil, err
+		}
+		result.Submissions = append(result.Submissions, view)
+	}
+	return result, nil
+}
+
+// GetSubmission은 제보 하나를 반환합니다.
+func (svc *SubmissionQueryService) GetSubmission(principal *user.Principal, id int) (*SubmissionView, error) {
+	s, err := svc.repo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if s == nil || !(s.SubmittedBy(principal.UserID) || principal.Role.Allows(user.RoleEditor)) {
+		return nil, submission.ErrSubmissionNotFound
+	}
+	courses, err := svc.courseRepo.FindAll(course.CourseFilter{})
+	if err != nil {
+		return nil, err
+	}
+	return svc.view(s, courses)
+}
+
+func (svc *SubmissionQueryService) view(s *submission.Submission, courses []*course.CourseAggregate) (*SubmissionView, error) {
+	view := &SubmissionView{Submission: s}
+	var err error
+	if view.Submitter, err = svc.userRepo.FindByID(s.SubmitterID); err != nil {
+		return nil, err
+	}
+	if s.ReviewerID != 0 {
+		if view.Reviewer, err = svc.userRepo.FindByID(s.ReviewerID); err != nil {
+			return nil, err
+		}
+	}
+	if s.Status == submission.StatusPending {
+		view.Duplicates = submission.FindDuplicates(s.Course, courses)
+	}
+	return view, nil
+}
@@ -0,0 +1,41 @@
+package query
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+)
+
+// tracer는 서비스와 저장소 호출 스팬을 만듭니다. 전역 TracerProvider가 설정되지 않았으면 아무것도 기록하지 않습니다.
+var tracer = otel.Tracer("github.com/sunDar0/winding-road-finder/backend/application/query")
+
+// endSpan은 err가 있으면 스팬에 에러로 기록한 뒤 스팬을 끝냅니다.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// tracedFindCourse는 코스 저장소의 FindByID 호출을 스팬으로 감쌉니다.
+func tracedFindCourse(ctx context.Context, repo course.CourseQueryRepository, id int) (*course.CourseAggregate, error) {
+	_, span := tracer.Start(ctx, "CourseQueryRepository.FindByID", trace.WithAttributes(attribute.Int("course.id", id)))
+	c, err := repo.FindByID(id)
+	endSpan(span, err)
+	return c, err
+}
+
+// tracedFindCourses는 코스 저장소의 FindAll 호출을 스팬으로 감쌉니다.
+func tracedFindCourses(ctx context.Context, repo course.CourseQueryRepository, filter course.CourseFilter) ([]*course.CourseAggregate, error) {
+	_, span := tracer.Start(ctx, "CourseQueryRepository.FindAll")
+	courses, err := repo.FindAll(filter)
+	span.SetAttributes(attribute.Int("courses", len(courses)))
+	endSpan(span, err)
+	return courses, err
+}
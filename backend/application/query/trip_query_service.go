@@ -0,0 +1,51 @@
+package query
+
+import (
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/trip"
+)
+
+// TripQueryService는 여러 코스를 잇는 여행 일정 계획을 담당합니다. 계획은 저장하지 않습니다.
+type TripQueryService struct {
+	courseRepo course.CourseQueryRepository
+	recService *RecommendationQueryService
+	estimator  trip.Estimator
+}
+
+func NewTripQueryService(courseRepo course.CourseQueryRepository, recService *RecommendationQueryService, estimator trip.Estimator) *TripQueryService {
+	return &TripQueryService{courseRepo: courseRepo, recService: recService, estimator: estimator}
+}
+
+// TripRequest는 여행 계획 요청입니다. CourseIDs와 RecommendationID 중 하나로 코스를 지정합니다.
+type TripRequest struct {
+	trip.Options
+	CourseIDs        []int
+	RecommendationID int
+}
+
+// PlanTrip은 요청한 코스(또는 추천 카테고리의 코스)로 일정을 만듭니다.
+// 없는 코스면 trip.ErrUnknownCourse, 없는 추천이면 trip.ErrUnknownRecommendation을 반환합니다.
+func (svc *TripQueryService) PlanTrip(req TripRequest) (*trip.Itinerary, error) {
+	var courses []*course.CourseAggregate
+	if req.RecommendationID != 0 {
+		rec, err := svc.recService.GetRecommendationById(req.RecommendationID)
+		if err != nil {
+			return nil, err
+		}
+		if rec == nil {
+			return nil, trip.ErrUnknownRecommendation
+		}
+		courses = rec.Courses
+	}
+	for _, id := range req.CourseIDs {
+		c, err := svc.courseRepo.FindByID(id)
+		if err != nil {
+			return nil, err
+		}
+		if c == nil {
+			return nil, trip.ErrUnknownCourse
+		}
+		courses = append(courses, c)
+	}
+	return trip.Plan(courses, req.Options, svc.estimator)
+}
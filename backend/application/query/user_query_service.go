@@ -0,0 +1,17 @@
+package query
+
+import "github.com/sunDar0/winding-road-finder/backend/domain/user"
+
+// UserQueryService는 사용자 계정 조회를 담당합니다.
+type UserQueryService struct {
+	repo user.UserRepository
+}
+
+func NewUserQueryService(repo user.UserRepository) *UserQueryService {
+	return &UserQueryService{repo: repo}
+}
+
+// GetUser는 ID로 사용자를 조회합니다. 없으면 nil을 반환합니다.
+func (svc *UserQueryService) GetUser(id int) (*user.User, error) {
+	return svc.repo.FindByID(id)
+}
@@ -0,0 +1,130 @@
+package query
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/weather"
+)
+
+// WeatherQueryService는 코스 출발지와 정상의 날씨 예보 조회를 담당합니다.
+type WeatherQueryService struct {
+	provider   weather.CachedProvider
+	courseRepo course.CourseQueryRepository
+	logger     *slog.Logger
+	now        func() time.Time
+}
+
+func NewWeatherQueryService(provider weather.CachedProvider, courseRepo course.CourseQueryRepository, logger *slog.Logger) *WeatherQueryService {
+	return &WeatherQueryService{provider: provider, courseRepo: courseRepo, logger: logger, now: time.Now}
+}
+
+// ForecastRange는 코스 날씨 조회에서 돌려주는 예보 구간입니다. 단기예보는 최대 3일까지 제공됩니다.
+const ForecastRange = 72 * time.Hour
+
+// PointWeather는 코스 지점 하나의 예보입니다. Hours는 현재 시간부터 ForecastRange 동안의 예보입니다.
+type PointWeather struct {
+	Nav      course.CourseNav
+	Cell     weather.GridCell
+	IssuedAt time.Time
+	Hours    []weather.Hourly
+}
+
+// CourseWeather는 코스의 지점별 예보입니다. Points는 출발지, (있으면) 정상 순입니다.
+type CourseWeather struct {
+	CourseID int
+	Points   []PointWeather
+	// DryRoads는 모든 지점에서 앞으로 weather.DryWindow 동안 노면이 젖을 예보가 없는지 여부입니다.
+	DryRoads bool
+}
+
+// weatherPoints는 예보를 조회할 코스 지점(출발지, 정상)을 반환합니다.
+func weatherPoints(c *course.CourseAggregate) []course.CourseNav {
+	if len(c.Nav) == 0 {
+		return nil
+	}
+	points := []course.CourseNav{c.Nav[0]}
+	if summit, ok := c.SummitPoint(); ok && summit.Geolocation != c.Nav[0].Geolocation {
+		points = append(points, summit)
+	}
+	return points
+}
+
+func gridOf(n course.CourseNav) weather.GridCell {
+	return weather.ToGrid(n.Geolocation.Latitude, n.Geolocation.Longitude)
+}
+
+// GetCourseWeather는 코스 지점별 예보를 조회합니다. 코스가 없으면 nil을 반환합니다.
+// 예보를 받지 못하면 weather.ErrUnavailable을 감싼 에러를 반환합니다.
+func (svc *WeatherQueryService) GetCourseWeather(ctx context.Context, courseID int) (_ *CourseWeather, err error) {
+	ctx, span := tracer.Start(ctx, "WeatherQueryService.GetCourseWeather")
+	defer func() { endSpan(span, err) }()
+	c, err := tracedFindCourse(ctx, svc.courseRepo, courseID)
+	if err != nil || c == nil {
+		return nil, err
+	}
+	now := svc.now()
+	result := &CourseWeather{CourseID: c.ID, DryRoads: true}
+	for _, n := range weatherPoints(c) {
+		f, err := svc.provider.Forecast(ctx, gridOf(n))
+		if err != nil {
+			return nil, err
+		}
+		result.Points = append(result.Points, PointWeather{Nav: n, Cell: f.Cell, IssuedAt: f.IssuedAt, Hours: f.Upcoming(now, ForecastRange)})
+		result.DryRoads = result.DryRoads && weather.DryRoads(f, now)
+	}
+	if len(result.Points) == 0 {
+		result.DryRoads = false
+	}
+	return result, nil
+}
+
+// IsDry는 보관된 예보만으로 코스 노면이 마를 것으로 보이는지 확인합니다.
+// 외부 호출을 하지 않으며, 예보가 아직 없는 지점이 있으면 false를 반환합니다.
+func (svc *WeatherQueryService) IsDry(c *course.CourseAggregate) bool {
+	points := weatherPoints(c)
+	if len(points) == 0 {
+		return false
+	}
+	now := svc.now()
+	for _, n := range points {
+		f, ok := svc.provider.Cached(gridOf(n))
+		if !ok || !weather.DryRoads(f, now) {
+			return false
+		}
+	}
+	return true
+}
+
+// Refresh는 모든 코스 지점의 예보를 받아 캐시를 채웁니다. 같은 격자는 한 번만 조회합니다.
+func (svc *WeatherQueryService) Refresh(ctx context.Context) (err error) {
+	ctx, span := tracer.Start(ctx, "WeatherQueryService.Refresh")
+	defer func() { endSpan(span, err) }()
+	courses, err := tracedFindCourses(ctx, svc.courseRepo, course.CourseFilter{})
+	if err != nil {
+		return err
+	}
+	seen := map[weather.GridCell]bool{}
+	failed := 0
+	for _, c := range courses {
+		for _, n := range weatherPoints(c) {
+			cell := gridOf(n)
+			if seen[cell] {
+				continue
+			}
+			seen[cell] = true
+			if _, err := svc.provider.Forecast(ctx, cell); err != nil {
+				failed++
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+			}
+		}
+	}
+	if failed > 0 {
+		svc.logger.WarnContext(ctx, "일부 격자의 날씨 예보를 갱신하지 못했습니다", "cells", len(seen), "failed", failed)
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package query_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/application/query"
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/weather"
+	weatherInfra "github.com/sunDar0/winding-road-finder/backend/infrastructure/external/weather"
+)
+
+// 격자가 서로 다른 세 지점입니다. 스텁 파일에서 wet 지점에만 비 예보를 넣습니다.
+var (
+	dryStart  = course.CourseGeolocation{Latitude: 37.5665, Longitude: 126.9780}
+	drySummit = course.CourseGeolocation{Latitude: 37.8813, Longitude: 127.7298}
+	wetPoint  = course.CourseGeolocation{Latitude: 35.1796, Longitude: 129.0756}
+)
+
+// weatherCourses는 날씨 조회에 쓰는 코스 저장소입니다.
+// 1번은 맑은 출발지와 정상, 2번은 비가 오는 출발지, 3번은 지점이 없는 코스입니다.
+type weatherCourses struct{}
+
+func (weatherCourses) all() []*course.CourseAggregate {
+	return []*course.CourseAggregate{
+		{ID: 1, Nav: []course.CourseNav{{Type: "출발지", Geolocation: dryStart}, {Type: "도착지", Geolocation: drySummit, Summit: true}}},
+		{ID: 2, Nav: []course.CourseNav{{Type: "출발지", Geolocation: wetPoint}, {Type: "도착지", Geolocation: dryStart}}},
+		{ID: 3},
+	}
+}
+
+func (s weatherCourses) FindAll(course.CourseFilter) ([]*course.CourseAggregate, error) {
+	return s.all(), nil
+}
+
+func (s weatherCourses) FindByID(id int) (*course.CourseAggregate, error) {
+	for _, c := range s.all() {
+		if c.ID == id {
+			return c, nil
+		}
+	}
+	return nil, nil
+}
+
+// countingWeather는 안쪽 제공자의 호출 횟수를 셉니다.
+type countingWeather struct {
+	inner weather.Provider
+	calls int
+}
+
+func (p *countingWeather) Forecast(ctx context.Context, cell weather.GridCell) (*weather.Forecast, error) {
+	p.calls++
+	return p.inner.Forecast(ctx, cell)
+}
+
+func newStubWeather(t *testing.T) *weatherInfra.StubProvider {
+	t.Helper()
+	wet := weather.ToGrid(wetPoint.Latitude, wetPoint.Longitude)
+	stub := `{
+  "default": [
+    {"temperatureC": 18, "precipitationChance": 10, "sky": "clear"},
+    {"temperatureC": 19, "precipitationChance": 10, "sky": "clear"},
+    {"temperatureC": 20, "precipitationChance": 20, "sky": "cloudy"}
+  ],
+  "cells": {
+    "` + wet.String() + `": [
+      {"temperatureC": 12, "precipitationChance": 30, "sky": "overcast"},
+      {"temperatureC": 11, "precipitationChance": 70, "precipitation": "rain", "precipitationMm": 3, "sky": "overcast"}
+    ]
+  }
+}`
+	path := filepath.Join(t.TempDir(), "weather_stub.json")
+	if err := os.WriteFile(path, []byte(stub), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	provider, err := weatherInfra.NewStubProvider(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return provider
+}
+
+func newWeatherService(provider weather.Provider) *query.WeatherQueryService {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return query.NewWeatherQueryService(weatherInfra.NewCachedProvider(provider, time.Hour, nil), weatherCourses{}, logger)
+}
+
+func TestGetCourseWeather(t *testing.T) {
+	svc := newWeatherService(newStubWeather(t))
+	tests := []struct {
+		name       string
+		courseID   int
+		wantPoints int
+		wantDry    bool
+	}{
+		{"출발지와 정상이 모두 맑다", 1, 2, true},
+		{"출발지에 비 예보가 있다", 2, 1, false},
+		{"지점이 없는 코스는 마른 노면으로 보지 않는다", 3, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, err := svc.GetCourseWeather(context.Background(), tt.courseID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(w.Points) != tt.wantPoints || w.DryRoads != tt.wantDry {
+				t.Fatalf("points = %d, dryRoads = %v; want %d, %v", len(w.Points), w.DryRoads, tt.wantPoints, tt.wantDry)
+			}
+			for _, p := range w.Points {
+				if len(p.Hours) == 0 {
+					t.Fatalf("%s: no upcoming hours", p.Nav.Type)
+				}
+			}
+		})
+	}
+
+	if w, err := svc.GetCourseWeather(context.Background(), 99); w != nil || err != nil {
+		t.Fatalf("GetCourseWeather(99) = %v, %v; want nil, nil", w, err)
+	}
+}
+
+// 미리 받아 둔 예보가 있어야 IsDry가 참이 되고, 이미 받은 격자는 다시 조회하지 않는다.
+func TestWeatherRefreshFillsCache(t *testing.T) {
+	provider := &countingWeather{inner: newStubWeather(t)}
+	svc := newWeatherService(provider)
+	dry, _ := weatherCourses{}.FindByID(1)
+	wet, _ := weatherCourses{}.FindByID(2)
+
+	if svc.IsDry(dry) {
+		t.Fatal("IsDry before Refresh = true, want false")
+	}
+	if err := svc.Refresh(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	// 세 코스의 지점은 세 격자로 모인다.
+	if provider.calls != 3 {
+		t.Fatalf("calls after Refresh = %d, want 3", provider.calls)
+	}
+	if !svc.IsDry(dry) || svc.IsDry(wet) {
+		t.Fatalf("IsDry = %v, %v; want true, false", svc.IsDry(dry), svc.IsDry(wet))
+	}
+	if _, err := svc.GetCourseWeather(context.Background(), 1); err != nil {
+		t.Fatal(err)
+	}
+	if provider.calls != 3 {
+		t.Fatalf("calls after cached lookup = %d, want 3", provider.calls)
+	}
+}
+
+// 날씨 제공자를 설정하지 않으면 조회는 weather.ErrUnavailable로 실패한다.
+func TestGetCourseWeatherDisabled(t *testing.T) {
+	svc := newWeatherService(weatherInfra.DisabledProvider{})
+	if _, err := svc.GetCourseWeather(context.Background(), 1); !errors.Is(err, weather.ErrUnavailable) {
+		t.Fatalf("GetCourseWeather() error = %v, want %v", err, weather.ErrUnavailable)
+	}
+	dry, _ := weatherCourses{}.FindByID(1)
+	if err := svc.Refresh(context.Background()); err != nil || svc.IsDry(dry) {
+		t.Fatalf("Refresh() = %v, IsDry = %v; want nil, false", err, svc.IsDry(dry))
+	}
+}
@@ -0,0 +1,26 @@
+// mockoidc는 로컬 개발용 모의 OIDC 서버를 실행합니다.
+//
+// 백엔드를 OIDC_PROVIDERS=mock, OIDC_MOCK_ISSUER=http://localhost:9000 으로 실행하면
+// 외부 공급자 없이 인가 코드 흐름을 확인할 수 있습니다.
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+
+	"github.com/sunDar0/winding-road-finder/backend/infrastructure/external/oidc"
+)
+
+func main() {
+	addr := flag.String("addr", ":9000", "listen address")
+	issuer := flag.String("issuer", "http://localhost:9000", "issuer URL")
+	flag.Parse()
+
+	server, err := oidc.NewMockServer(*issuer, nil)
+	if err != nil {
+		log.Fatalf("모의 OIDC 서버 생성 실패: %v", err)
+	}
+	log.Printf("모의 OIDC 서버 시작: %s (issuer %s)", *addr, *issuer)
+	log.Fatal(http.ListenAndServe(*addr, server))
+}
@@ -0,0 +1,164 @@
+// navlinks는 코스의 네이버 지도 단축 링크(naverMapUrl)를 풀어 내비게이션 포인트와 비교합니다.
+//
+// backend 디렉터리에서 실행합니다.
+//
+//	go run ./cmd/navlinks                       # 전체 코스 비교
+//	go run ./cmd/navlinks -ids 1,2 -backfill    # 포인트가 없거나 경유지가 빠진 코스의 nav를 링크로 채움
+//	go run ./cmd/navlinks -record fixtures.json # 실제 응답을 기록
+//	go run ./cmd/navlinks -replay infrastructure/external/naver/testdata/links.json -link https://naver.me/5XpL1bbm
+//
+// 지점 수가 다르거나 허용 거리(150m)보다 떨어진 지점이 있는 코스를 출력하며, 그런 코스가 있으면 종료 코드 1로 끝납니다.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"log/slog"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/navlink"
+	"github.com/sunDar0/winding-road-finder/backend/infrastructure/external/httpfixture"
+	"github.com/sunDar0/winding-road-finder/backend/infrastructure/external/naver"
+	queryRepo "github.com/sunDar0/winding-road-finder/backend/infrastructure/persistence/query"
+)
+
+func main() {
+	ids := flag.String("ids", "", "비교할 코스 ID (쉼표 구분). 생략하면 전체 코스")
+	link := flag.String("link", "", "코스 대신 링크 하나만 풀어 지점을 출력")
+	backfill := flag.Bool("backfill", false, "포인트가 없거나 경유지가 빠진 코스의 nav를 링크 경로로 채워 courses.json에 저장")
+	replay := flag.String("replay", "", "기록된 응답 파일로 링크를 풀기 (네트워크 사용 안 함)")
+	record := flag.String("record", "", "실제 응답을 이 파일에 기록")
+	timeout := flag.Duration("timeout", 5*time.Minute, "전체 작업 제한 시간")
+	flag.Parse()
+
+	var transport http.RoundTripper
+	var recorder *httpfixture.Recorder
+	switch {
+	case *replay != "" && *record != "":
+		log.Fatal("-replay와 -record는 함께 쓸 수 없습니다")
+	case *replay != "":
+		fixtures, err := httpfixture.Load(*replay)
+		if err != nil {
+			log.Fatalf("기록 파일 로드 실패: %v", err)
+		}
+		transport = httpfixture.NewReplay(fixtures)
+	case *record != "":
+		recorder = httpfixture.NewRecorder(nil, "Location")
+		transport = recorder
+	}
+	resolver := naver.NewLinkResolver(transport)
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	if *link != "" {
+		d, err := resolver.Resolve(ctx, *link)
+		if err != nil {
+			log.Fatalf("%s: %v", *link, err)
+		}
+		fmt.Println(d.URL)
+		for i, p := range d.Points {
+			fmt.Printf("  %d. %s (%.6f, %.6f)\n", i, p.Name, p.Geolocation.Latitude, p.Geolocation.Longitude)
+		}
+		saveRecording(recorder, *record)
+		return
+	}
+
+	selected, err := parseIDs(*ids)
+	if err != nil {
+		log.Fatalf("-ids: %v", err)
+	}
+	courseRepo := queryRepo.NewCourseQueryRepository(queryRepo.NewRegionQueryRepository(), queryRepo.NewStyleQueryRepository(), slog.Default(), nil)
+	courses, err := courseRepo.FindAll(course.CourseFilter{})
+	if err != nil {
+		log.Fatalf("코스 데이터 로드 실패: %v", err)
+	}
+
+	inconsistent := 0
+	filled := map[int][]course.CourseNav{}
+	for _, c := range courses {
+		if selected != nil && !selected[c.ID] {
+			continue
+		}
+		if c.NaverMapUrl == "" {
+			fmt.Printf("코스 %d: 지도 링크 없음\n", c.ID)
+			continue
+		}
+		d, err := resolver.Resolve(ctx, c.NaverMapUrl)
+		if errors.Is(err, navlink.ErrUnsupportedLink) {
+			fmt.Printf("코스 %d: %v\n", c.ID, err)
+			inconsistent++
+			continue
+		}
+		if err != nil {
+			log.Fatalf("코스 %d: %v", c.ID, err)
+		}
+		report := navlink.Compare(c, d)
+		if !report.Consistent() {
+			inconsistent++
+			printReport(c, d, report)
+		} else {
+			fmt.Printf("코스 %d: 일치 (지점 %d개)\n", c.ID, report.NavPoints)
+		}
+		if *backfill {
+			if nav, ok := navlink.Backfill(c, d); ok {
+				filled[c.ID] = nav
+			}
+		}
+	}
+	saveRecording(recorder, *record)
+
+	if len(filled) > 0 {
+		if err := queryRepo.UpdateCourseNav(queryRepo.CoursesPath, filled); err != nil {
+			log.Fatalf("courses.json 저장 실패: %v", err)
+		}
+		fmt.Printf("코스 %d개의 nav를 채웠습니다\n", len(filled))
+	}
+	if inconsistent > 0 {
+		fmt.Fprintf(os.Stderr, "링크와 다른 코스 %d개\n", inconsistent)
+		os.Exit(1)
+	}
+}
+
+func printReport(c *course.CourseAggregate, d *navlink.Directions, r navlink.Report) {
+	if r.CountMismatch() {
+		fmt.Printf("코스 %d: 지점 수 불일치 (nav %d개, 링크 %d개)\n", c.ID, r.NavPoints, r.LinkPoints)
+	} else {
+		fmt.Printf("코스 %d: 지점 위치 불일치\n", c.ID)
+	}
+	for _, drift := range r.Drifted() {
+		fmt.Printf("  nav %d (%s) ↔ 링크 %d (%s): %.0fm\n", drift.Nav, c.Nav[drift.Nav].Name, drift.Link, d.Points[drift.Link].Name, drift.DistanceM)
+	}
+}
+
+func saveRecording(recorder *httpfixture.Recorder, path string) {
+	if recorder == nil {
+		return
+	}
+	if err := recorder.Fixtures().Save(path); err != nil {
+		log.Fatalf("기록 저장 실패: %v", err)
+	}
+}
+
+// parseIDs는 쉼표로 구분한 코스 ID를 읽습니다. 비어 있으면 nil(전체)을 반환합니다.
+func parseIDs(raw string) (map[int]bool, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	ids := map[int]bool{}
+	for _, part := range strings.Split(raw, ",") {
+		id, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return nil, err
+		}
+		ids[id] = true
+	}
+	return ids, nil
+}
@@ -0,0 +1,347 @@
+// regions는 통계청 SGIS(통계지리정보서비스)에서 받은 행정구역 경계로 data/regions.geojson을 다시 만듭니다.
+//
+// SGIS 자료신청(https://sgis.kostat.go.kr → 자료신청 → 자료제공 목록 → 행정구역 경계)에서 시도(bnd_sido)와
+// 시군구(bnd_sigungu) 경계 SHP 파일을 받아, 좌표계를 UTM-K(EPSG:5179)에서 WGS84로 바꾼 GeoJSON으로 변환한 뒤 backend 디렉터리에서 실행합니다.
+//
+//	ogr2ogr -f GeoJSON -t_srs EPSG:4326 -lco RFC7946=YES sido.geojson bnd_sido_00_2024_2Q.shp
+//	ogr2ogr -f GeoJSON -t_srs EPSG:4326 -lco RFC7946=YES sigungu.geojson bnd_sigungu_00_2024_2Q.shp
+//	go run ./cmd/regions -sido sido.geojson -sigungu sigungu.geojson -source "SGIS 2024년 2분기 행정구역 경계"
+//
+// 기존 파일에 같은 이름의 지역이 있으면 코드, 영문·일문 이름, 별칭을 그대로 유지합니다.
+// 새 시·군·구는 "<시·도 코드>-<SGIS 시군구 코드>" 코드와 한국어 이름만으로 추가하고, 입력에 없는 기존 지역은 목록으로 출력합니다.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+	"github.com/sunDar0/winding-road-finder/backend/domain/region"
+)
+
+func main() {
+	sidoPath := flag.String("sido", "", "시도 경계 GeoJSON (WGS84)")
+	sigunguPath := flag.String("sigungu", "", "시군구 경계 GeoJSON (WGS84)")
+	sidoCode := flag.String("sido-code", "SIDO_CD", "시도 코드 속성 이름")
+	sidoName := flag.String("sido-name", "SIDO_NM", "시도 이름 속성 이름")
+	sigunguCode := flag.String("sigungu-code", "SIGUNGU_CD", "시군구 코드 속성 이름")
+	sigunguName := flag.String("sigungu-name", "SIGUNGU_NM", "시군구 이름 속성 이름")
+	tolerance := flag.Float64("tolerance", 0.0005, "경계 단순화 허용 오차(도). 0이면 단순화하지 않음")
+	source := flag.String("source", "", "파일에 기록할 원본 자료 설명 (예: SGIS 2024년 2분기 행정구역 경계)")
+	out := flag.String("out", "data/regions.geojson", "저장할 파일 (기존 코드와 번역도 이 파일에서 읽음)")
+	flag.Parse()
+	if *sidoPath == "" || *sigunguPath == "" || *source == "" {
+		log.Fatal("-sido, -sigungu, -source를 모두 지정해야 합니다")
+	}
+
+	existing, err := readExisting(*out)
+	if err != nil {
+		log.Fatalf("%s 로드 실패: %v", *out, err)
+	}
+	sidos, err := readBoundaries(*sidoPath, *sidoCode, *sidoName, *tolerance)
+	if err != nil {
+		log.Fatalf("%s: %v", *sidoPath, err)
+	}
+	sigungus, err := readBoundaries(*sigunguPath, *sigunguCode, *sigunguName, *tolerance)
+	if err != nil {
+		log.Fatalf("%s: %v", *sigunguPath, err)
+	}
+	features, missing, err := merge(existing, sidos, sigungus)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := write(*out, *source, features); err != nil {
+		log.Fatalf("%s 저장 실패: %v", *out, err)
+	}
+	fmt.Printf("%s에 시·도 %d개, 시·군·구 %d개 저장\n", *out, len(sidos), len(sigungus))
+	if len(missing) > 0 {
+		fmt.Fprintf(os.Stderr, "입력에 없어 빠진 기존 지역 %d개:\n  %s\n", len(missing), strings.Join(missing, "\n  "))
+	}
+}
+
+// properties는 regions.geojson의 지역 속성입니다. 필드 순서가 파일의 키 순서입니다.
+type properties struct {
+	Code       string             `json:"code"`
+	Name       i18n.LocalizedText `json:"name"`
+	Level      region.Level       `json:"level"`
+	ParentCode string             `json:"parentCode,omitempty"`
+	Aliases    []string           `json:"aliases"`
+}
+
+type feature struct {
+	Properties properties `json:"properties"`
+	Geometry   geometry   `json:"geometry"`
+}
+
+type geometry struct {
+	Type        string `json:"type"`
+	Coordinates any    `json:"coordinates"`
+}
+
+// boundary는 원본 자료의 지역 하나입니다. polygons는 [경도, 위도] 좌표의 MultiPolygon입니다.
+type boundary struct {
+	code, name string
+	polygons   [][][][2]float64
+}
+
+func readExisting(path string) ([]properties, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var fc struct {
+		Features []struct {
+			Properties properties `json:"properties"`
+		} `json:"features"`
+	}
+	if err := json.Unmarshal(data, &fc); err != nil {
+		return nil, err
+	}
+	result := make([]properties, len(fc.Features))
+	for i, f := range fc.Features {
+		result[i] = f.Properties
+	}
+	return result, nil
+}
+
+// readBoundaries는 GeoJSON 경계를 읽어 단순화합니다. 좌표가 한반도 경위도 범위를 벗어나면 변환하지 않은 자료로 보고 에러를 반환합니다.
+func readBoundaries(path, codeKey, nameKey string, tolerance float64) ([]boundary, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var fc struct {
+		Features []struct {
+			Properties map[string]any `json:"properties"`
+			Geometry   struct {
+				Type        string          `json:"type"`
+				Coordinates json.RawMessage `json:"coordinates"`
+			} `json:"geometry"`
+		} `json:"features"`
+	}
+	if err := json.Unmarshal(data, &fc); err != nil {
+		return nil, err
+	}
+	var result []boundary
+	for _, f := range fc.Features {
+		if f.Properties[codeKey] == nil || f.Properties[nameKey] == nil {
+			return nil, fmt.Errorf("%s, %s 속성이 없는 지역이 있습니다", codeKey, nameKey)
+		}
+		code, name := fmt.Sprint(f.Properties[codeKey]), strings.TrimSpace(fmt.Sprint(f.Properties[nameKey]))
+		var polygons [][][][2]float64
+		switch f.Geometry.Type {
+		case "Polygon":
+			var poly [][][2]float64
+			if err := json.Unmarshal(f.Geometry.Coordinates, &poly); err != nil {
+				return nil, fmt.Errorf("%s: %w", code, err)
+			}
+			polygons = [][][][2]float64{poly}
+		case "MultiPolygon":
+			if err := json.Unmarshal(f.Geometry.Coordinates, &polygons); err != nil {
+				return nil, fmt.Errorf("%s: %w", code, err)
+			}
+		default:
+			return nil, fmt.Errorf("%s: 지원하지 않는 geometry 타입 %s", code, f.Geometry.Type)
+		}
+		simplified, err := simplifyPolygons(polygons, tolerance)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %w", code, name, err)
+		}
+		result = append(result, boundary{code: code, name: name, polygons: simplified})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].code < result[j].code })
+	return result, nil
+}
+
+// merge는 기존 지역의 코드와 번역을 원본 자료의 경계와 합칩니다. 시·군·구는 코드 앞 두 자리로 시·도를 찾습니다.
+func merge(existing []properties, sidos, sigungus []boundary) ([]feature, []string, error) {
+	used := map[string]bool{}
+	var features []feature
+	sidoByCode := map[string]string{}
+	for _, b := range sidos {
+		p := findExisting(existing, "", b.name)
+		if p == nil {
+			return nil, nil, fmt.Errorf("시·도 %s %s에 해당하는 기존 지역이 없습니다. 코드와 번역을 정해 기존 파일에 먼저 추가하세요", b.code, b.name)
+		}
+		used[p.Code] = true
+		sidoByCode[b.code] = p.Code
+		features = append(features, feature{Properties: *p, Geometry: toGeometry(b.polygons)})
+	}
+	for _, b := range sigungus {
+		parent, ok := sidoByCode[b.code[:min(2, len(b.code))]]
+		if !ok {
+			return nil, nil, fmt.Errorf("시·군·구 %s %s의 시·도를 찾을 수 없습니다", b.code, b.name)
+		}
+		p := findExisting(existing, parent, b.name)
+		if p == nil {
+			p = &properties{Code: parent + "-" + b.code, Name: i18n.Text(b.name), Level: region.LevelSigungu, ParentCode: parent}
+		}
+		used[p.Code] = true
+		features = append(features, feature{Properties: *p, Geometry: toGeometry(b.polygons)})
+	}
+	var missing []string
+	for _, p := range existing {
+		if !used[p.Code] {
+			missing = append(missing, fmt.Sprintf("%s (%s)", p.Code, p.Name.String()))
+		}
+	}
+	for i := range features {
+		if features[i].Properties.Aliases == nil {
+			features[i].Properties.Aliases = []string{}
+		}
+	}
+	return features, missing, nil
+}
+
+// findExisting은 parent 아래에서 한국어 이름이나 별칭이 name인 기존 지역을 찾습니다. parent가 비어 있으면 시·도에서 찾습니다.
+func findExisting(existing []properties, parent, name string) *properties {
+	for i, p := range existing {
+		if p.ParentCode != parent {
+			continue
+		}
+		if p.Name.String() == name || slices.Contains(p.Aliases, name) {
+			return &existing[i]
+		}
+	}
+	return nil
+}
+
+func toGeometry(polygons [][][][2]float64) geometry {
+	if len(polygons) == 1 {
+		return geometry{Type: "Polygon", Coordinates: polygons[0]}
+	}
+	return geometry{Type: "MultiPolygon", Coordinates: polygons}
+}
+
+// simplifyPolygons는 각 고리를 Douglas-Peucker로 단순화하고 좌표를 소수점 다섯째 자리(약 1m)로 반올림합니다.
+// 꼭짓점이 셋 미만으로 줄어든 고리는 버리며, 바깥 고리가 사라진 다각형(작은 섬 등)도 버립니다.
+func simplifyPolygons(polygons [][][][2]float64, tolerance float64) ([][][][2]float64, error) {
+	var result [][][][2]float64
+	for _, poly := range polygons {
+		var rings [][][2]float64
+		for i, ring := range poly {
+			for _, pt := range ring {
+				if pt[0] < 124 || pt[0] > 132 || pt[1] < 33 || pt[1] > 39 {
+					return nil, fmt.Errorf("좌표 %v가 한반도 경위도 범위를 벗어납니다. WGS84(EPSG:4326)로 변환했는지 확인하세요", pt)
+				}
+			}
+			simplified := roundRing(simplifyRing(ring, tolerance))
+			if len(simplified) < 4 {
+				if i == 0 {
+					break
+				}
+				continue
+			}
+			rings = append(rings, simplified)
+		}
+		if len(rings) > 0 {
+			result = append(result, rings)
+		}
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("단순화한 뒤 남은 경계가 없습니다. -tolerance를 줄이세요")
+	}
+	return result, nil
+}
+
+// simplifyRing은 닫힌 고리를 Douglas-Peucker로 단순화합니다. 첫 점과 끝 점은 유지합니다.
+func simplifyRing(ring [][2]float64, tolerance float64) [][2]float64 {
+	if tolerance <= 0 || len(ring) < 4 {
+		return ring
+	}
+	keep := make([]bool, len(ring))
+	keep[0], keep[len(ring)-1] = true, true
+	// 닫힌 고리는 첫 점과 끝 점이 같으므로, 첫 점에서 가장 먼 점을 기준으로 두 구간을 나눠 단순화합니다.
+	far, farDist := 0, -1.0
+	for i, pt := range ring {
+		if d := math.Hypot(pt[0]-ring[0][0], pt[1]-ring[0][1]); d > farDist {
+			far, farDist = i, d
+		}
+	}
+	keep[far] = true
+	douglasPeucker(ring, 0, far, tolerance, keep)
+	douglasPeucker(ring, far, len(ring)-1, tolerance, keep)
+	var result [][2]float64
+	for i, pt := range ring {
+		if keep[i] {
+			result = append(result, pt)
+		}
+	}
+	return result
+}
+
+func douglasPeucker(points [][2]float64, first, last int, tolerance float64, keep []bool) {
+	if last-first < 2 {
+		return
+	}
+	index, maxDist := -1, tolerance
+	for i := first + 1; i < last; i++ {
+		if d := segmentDistance(points[i], points[first], points[last]); d > maxDist {
+			index, maxDist = i, d
+		}
+	}
+	if index < 0 {
+		return
+	}
+	keep[index] = true
+	douglasPeucker(points, first, index, tolerance, keep)
+	douglasPeucker(points, index, last, tolerance, keep)
+}
+
+// segmentDistance는 p에서 선분 ab까지의 평면 거리(도)입니다.
+func segmentDistance(p, a, b [2]float64) float64 {
+	dx, dy := b[0]-a[0], b[1]-a[1]
+	if dx == 0 && dy == 0 {
+		return math.Hypot(p[0]-a[0], p[1]-a[1])
+	}
+	t := ((p[0]-a[0])*dx + (p[1]-a[1])*dy) / (dx*dx + dy*dy)
+	t = math.Max(0, math.Min(1, t))
+	return math.Hypot(p[0]-(a[0]+t*dx), p[1]-(a[1]+t*dy))
+}
+
+func roundRing(ring [][2]float64) [][2]float64 {
+	result := make([][2]float64, 0, len(ring))
+	for _, pt := range ring {
+		rounded := [2]float64{math.Round(pt[0]*1e5) / 1e5, math.Round(pt[1]*1e5) / 1e5}
+		if n := len(result); n > 0 && result[n-1] == rounded {
+			continue
+		}
+		result = append(result, rounded)
+	}
+	return result
+}
+
+// write는 속성은 기존 파일처럼 들여 쓰고, 좌표는 한 줄로 씁니다.
+func write(path, source string, features []feature) error {
+	var buf bytes.Buffer
+	meta, err := json.Marshal(source)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(&buf, "{\n  \"type\": \"FeatureCollection\",\n  \"source\": %s,\n  \"features\": [\n", meta)
+	for i, f := range features {
+		props, err := json.MarshalIndent(f.Properties, "      ", "  ")
+		if err != nil {
+			return err
+		}
+		geom, err := json.Marshal(f.Geometry)
+		if err != nil {
+			return err
+		}
+		sep := ","
+		if i == len(features)-1 {
+			sep = ""
+		}
+		fmt.Fprintf(&buf, "    {\n      \"type\": \"Feature\",\n      \"properties\": %s,\n      \"geometry\": %s\n    }%s\n", props, geom, sep)
+	}
+	buf.WriteString("  ]\n}\n")
+	return os.WriteFile(path, buf.Bytes(), 0o644)
+}
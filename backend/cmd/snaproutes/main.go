@@ -0,0 +1,102 @@
+// snaproutes는 코스 내비게이션 포인트 사이의 실제 도로 경로를 구해 data/course_routes.json에 저장합니다.
+//
+// 로컬 OSRM 서버(osrm/README.md 참고)를 띄운 뒤 backend 디렉터리에서 실행합니다.
+//
+//	go run ./cmd/snaproutes -osrm http://localhost:5000
+//	go run ./cmd/snaproutes -router fake -ids 1,2,3
+//
+// 갈 수 없거나 도로에서 먼 포인트, 순서가 뒤바뀐 것으로 보이는 포인트는 경로와 함께 기록하고 목록으로 출력합니다.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"log/slog"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/routing"
+	routingInfra "github.com/sunDar0/winding-road-finder/backend/infrastructure/external/routing"
+	queryRepo "github.com/sunDar0/winding-road-finder/backend/infrastructure/persistence/query"
+)
+
+func main() {
+	routerName := flag.String("router", "osrm", "경로 탐색기 (osrm, fake)")
+	osrmURL := flag.String("osrm", "http://localhost:5000", "OSRM 서버 주소")
+	ids := flag.String("ids", "", "경로를 구할 코스 ID (쉼표 구분). 생략하면 전체 코스")
+	out := flag.String("out", queryRepo.CourseRoutesPath, "저장할 파일")
+	timeout := flag.Duration("timeout", 10*time.Minute, "전체 작업 제한 시간")
+	flag.Parse()
+
+	var router routing.Router
+	switch *routerName {
+	case "osrm":
+		router = routingInfra.NewOSRMRouter(*osrmURL, "")
+	case "fake":
+		router = routingInfra.NewFakeRouter()
+	default:
+		log.Fatalf("알 수 없는 경로 탐색기입니다: %s (osrm, fake)", *routerName)
+	}
+	selected, err := parseIDs(*ids)
+	if err != nil {
+		log.Fatalf("-ids: %v", err)
+	}
+
+	courseRepo := queryRepo.NewCourseQueryRepository(queryRepo.NewRegionQueryRepository(), queryRepo.NewStyleQueryRepository(), slog.Default(), nil)
+	courses, err := courseRepo.FindAll(course.CourseFilter{})
+	if err != nil {
+		log.Fatalf("코스 데이터 로드 실패: %v", err)
+	}
+	routes, err := queryRepo.LoadCourseRoutes(*out)
+	if err != nil {
+		log.Fatalf("%s 로드 실패: %v", *out, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	var flagged []string
+	for _, c := range courses {
+		if selected != nil && !selected[c.ID] {
+			continue
+		}
+		route, err := routing.SnapCourse(ctx, router, c, time.Now())
+		if err != nil {
+			log.Fatalf("코스 %d 경로 탐색 실패: %v", c.ID, err)
+		}
+		routes[c.ID] = route
+		fmt.Printf("코스 %d: %.1fkm, %d분, 좌표 %d개\n", c.ID, route.DistanceKm, int(route.Duration.Minutes()), len(route.Geometry))
+		for _, issue := range route.Issues {
+			flagged = append(flagged, fmt.Sprintf("코스 %d 포인트 %d (%s): %s", c.ID, issue.Nav, c.Nav[issue.Nav].Name, issue.Kind))
+		}
+	}
+	if err := queryRepo.SaveCourseRoutes(*out, routes); err != nil {
+		log.Fatalf("%s 저장 실패: %v", *out, err)
+	}
+	fmt.Printf("%s에 코스 %d개 경로 저장\n", *out, len(routes))
+	if len(flagged) > 0 {
+		sort.Strings(flagged)
+		fmt.Fprintf(os.Stderr, "확인이 필요한 포인트 %d개:\n  %s\n", len(flagged), strings.Join(flagged, "\n  "))
+	}
+}
+
+// parseIDs는 쉼표로 구분한 코스 ID를 읽습니다. 비어 있으면 nil(전체)을 반환합니다.
+func parseIDs(raw string) (map[int]bool, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	ids := map[int]bool{}
+	for _, part := range strings.Split(raw, ",") {
+		id, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return nil, err
+		}
+		ids[id] = true
+	}
+	return ids, nil
+}
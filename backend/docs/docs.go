@@ -15,36 +15,50 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/courses": {
+        "/admin/audit": {
             "get": {
-                "description": "지역, 스타일, 검색어로 코스를 필터링하여 조회합니다.",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "코스·추천 수정, 리뷰·위험 신고 숨김 등 관리자 작업을 작업자와 변경 전후 값과 함께 최신순으로 조회합니다.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "courses"
+                    "admin"
                 ],
-                "summary": "코스 목록 조회",
+                "summary": "관리자 작업 기록 조회",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "지역 필터",
-                        "name": "region",
+                        "description": "대상 종류 (course, recommendation, hazard, review)",
+                        "name": "targetType",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "스타일 필터",
-                        "name": "style",
+                        "type": "integer",
+                        "description": "대상 ID",
+                        "name": "targetId",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "검색어",
-                        "name": "search",
+                        "type": "integer",
+                        "description": "작업자 사용자 ID",
+                        "name": "actorId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "페이지 번호 (1~100000), 기본 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "페이지 크기, 기본 20, 최대 100",
+                        "name": "size",
                         "in": "query"
                     }
                 ],
@@ -52,24 +66,44 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.CourseDto"
-                            }
+                            "$ref": "#/definitions/models.AuditPageDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/query.ErrorResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/courses/{id}": {
-            "get": {
-                "description": "ID로 코스 상세 정보를 조회합니다.",
+        "/admin/courses": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "코스를 데이터 파일에 추가합니다. 스타일은 slug로 정규화해 저장합니다.",
                 "consumes": [
                     "application/json"
                 ],
@@ -77,9 +111,69 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "courses"
+                    "admin"
                 ],
-                "summary": "코스 상세 조회",
+                "summary": "코스 추가 (관리자)",
+                "parameters": [
+                    {
+                        "description": "코스 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.AdminCourseRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.CreatedResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/courses/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "코스 전체를 요청 내용으로 바꿉니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "코스 수정 (관리자)",
                 "parameters": [
                     {
                         "type": "integer",
@@ -87,142 +181,6286 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "코스 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.AdminCourseRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/models.CourseDto"
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "추천이 가리키는 코스는 삭제할 수 없습니다. (409)",
+                "tags": [
+                    "admin"
+                ],
+                "summary": "코스 삭제 (관리자)",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "코스 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "삭제 사유 (코스 변경 이력에 기록)",
+                        "name": "reason",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/query.ErrorResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/query.ErrorResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/query.ErrorResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/recommendations": {
+        "/admin/courses/{id}/images": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "네이버 지도 API로 썸네일·상세 이미지를 다시 만듭니다. 네이버 API 설정이 없으면 503입니다.",
+                "tags": [
+                    "admin"
+                ],
+                "summary": "코스 지도 이미지 다시 생성 (관리자)",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "코스 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "502": {
+                        "description": "Bad Gateway",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/hazards": {
             "get": {
-                "description": "추천 카테고리별 코스 목록을 조회합니다.",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "만료·해소·숨긴 신고를 포함해 최신순으로 조회합니다.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "recommendations"
+                    "admin"
+                ],
+                "summary": "위험 신고 관리 목록 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "코스 ID. 생략하면 전체 코스",
+                        "name": "courseId",
+                        "in": "query"
+                    }
                 ],
-                "summary": "추천 코스 목록 조회",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/models.RecommendationDto"
+                                "$ref": "#/definitions/models.HazardDto"
                             }
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/query.ErrorResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/recommendations/{id}": {
-            "get": {
-                "description": "ID로 추천 코스 상세 정보를 조회합니다.",
-                "consumes": [
-                    "application/json"
+        "/admin/hazards/{id}": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
-                "produces": [
+                "description": "숨긴 신고는 코스 응답과 신고 목록에서 빠집니다.",
+                "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "recommendations"
+                    "admin"
                 ],
-                "summary": "추천 코스 상세 조회",
+                "summary": "위험 신고 숨김/복구 (관리자)",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "추천 ID",
+                        "description": "신고 ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "숨김 여부",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ModerationRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/models.RecommendationDto"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
-                    "400": {
-                        "description": "잘못된 ID 형식",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/query.ErrorResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "추천 정보를 찾을 수 없음",
+                        "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/query.ErrorResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "서버 오류",
+                        "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/query.ErrorResponse"
+                            "$ref": "#/definitions/models.ErrorResponse"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "models.CourseDto": {
-            "type": "object",
-            "properties": {
-                "characteristics": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "name": {
-                    "type": "string"
-                },
+        },
+        "/admin/log-level": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "현재 서버 로그 레벨을 조회합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "로그 레벨 조회",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.LogLevelDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "서버를 다시 시작하지 않고 로그 레벨을 바꿉니다. 다시 시작하면 LOG_LEVEL 값으로 돌아갑니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "로그 레벨 변경",
+                "parameters": [
+                    {
+                        "description": "로그 레벨 (debug, info, warn, error)",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.LogLevelDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/recommendations": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "rule이 있으면 규칙 추천, 없으면 courseIds 순서대로 보여주는 추천을 만듭니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "추천 추가 (관리자)",
+                "parameters": [
+                    {
+                        "description": "추천 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.AdminRecommendationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.CreatedResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/recommendations/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "추천 전체를 요청 내용으로 바꿉니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "추천 수정 (관리자)",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "추천 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "추천 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.AdminRecommendationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "추천 삭제 (관리자)",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "추천 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/recommendations/{id}/courses": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "드래그로 바꾼 순서를 저장합니다. 현재 코스 ID를 빠짐없이 원하는 순서로 보내야 하며, 규칙 추천은 고정 코스 순서를 바꿉니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "추천 코스 순서 변경 (관리자)",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "추천 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "코스 순서",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ReorderRecommendationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/reviews": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "숨긴 리뷰를 포함해 최신순으로 조회합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "리뷰 관리 목록 조회",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "코스 ID. 생략하면 전체 코스",
+                        "name": "courseId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "페이지 번호 (1~100000), 기본 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "페이지 크기, 기본 20, 최대 100",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ReviewPageDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/reviews/{id}": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "숨긴 리뷰는 리뷰 목록과 사용자 평점 집계에서 빠집니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "리뷰 숨김/복구 (관리자)",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "리뷰 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "숨김 여부",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ModerationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/revisions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "모든 사용자의 수정안을 최근 수정 순으로 조회합니다. 검토 대기 목록은 status=submitted로 조회합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "코스 수정안 검토 목록 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "상태 (draft, submitted, rejected, approved)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "코스 ID. 생략하면 전체 (새 코스 제안 포함)",
+                        "name": "courseId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "페이지 번호 (1~100000), 기본 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "페이지 크기, 기본 20, 최대 100",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.RevisionPageDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/revisions/{id}/approve": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "검토 대기 중인 수정안을 게시합니다. 코스 변경 이벤트와 관리자 작업 기록이 남습니다.\n자신이 작성한 수정안은 승인할 수 없고, 수정안을 만든 뒤 코스가 바뀌었으면 409(revision_outdated)로 응답합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "코스 수정안 승인",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "수정안 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "검토 의견",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.ReviewDecisionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/revisions/{id}/reject": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "검토 대기 중인 수정안을 반려 사유와 함께 돌려보냅니다. 작성자는 수정안을 편집해 다시 제출할 수 있습니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "코스 수정안 반려",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "수정안 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "반려 사유",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ReviewDecisionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/submissions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "모든 사용자의 제보를 최근 제보 순으로 조회합니다. 검토 대기 목록은 status=pending으로 조회하며, 대기 중인 제보에는 중복 후보가 함께 나옵니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "코스 제보 검토 목록 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "상태 (pending, accepted, rejected)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "페이지 번호 (1~100000), 기본 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "페이지 크기, 기본 20, 최대 100",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SubmissionPageDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/submissions/{id}/accept": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "검토 대기 중인 제보를 코스로 게시합니다. course를 주면 제보 내용 대신 그 코스를 게시합니다(번역, 소개 문구 보완).\n코스 변경 이벤트와 관리자 작업 기록이 남습니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "코스 제보 수락",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "제보 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "게시할 코스와 검토 의견",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/models.SubmissionAcceptRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/submissions/{id}/reject": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "검토 대기 중인 제보를 반려 사유와 함께 반려합니다. 이미 있는 코스와 같으면 duplicateOf에 그 코스 ID를 줍니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "코스 제보 반려",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "제보 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "반려 사유",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SubmissionRejectRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/login": {
+            "post": {
+                "description": "이메일과 비밀번호를 확인하고 액세스/리프레시 토큰을 발급합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "로그인",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "description": "로그인 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.TokenResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/oidc/session": {
+            "post": {
+                "description": "콜백이 남긴 일회용 ticket 쿠키(oidc_ticket)로 토큰을 발급합니다. ticket은 1분 동안 한 번만 쓸 수 있습니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "외부 로그인 토큰 발급",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.TokenResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/oidc/{provider}/authorize": {
+            "post": {
+                "description": "공급자 인가 요청 URL(PKCE 적용)을 발급하고, state를 HttpOnly 쿠키(oidc_state)로 이 브라우저에 묶습니다.\n클라이언트는 쿠키를 받을 수 있도록 credentials를 포함해 호출한 뒤 사용자를 이 URL로 이동시킵니다.\n로그인한 상태(Authorization 헤더)로 호출하면 콜백에서 현재 계정에 외부 계정을 연결합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "외부 로그인 시작",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "공급자 이름 (예: google, kakao, mock)",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.AuthorizeResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "502": {
+                        "description": "Bad Gateway",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/oidc/{provider}/callback": {
+            "get": {
+                "description": "공급자가 돌려준 인가 코드를 교환한 뒤 브라우저를 프런트엔드(OIDC_COMPLETE_URL)로 돌려보냅니다. 토큰은 응답 본문이나 URL에 담지 않습니다.\n로그인이면 일회용 ticket을 HttpOnly 쿠키로 남기며, 프런트엔드는 POST /auth/oidc/session으로 토큰을 받습니다.\n계정 연결이면 ?linked={provider}, 실패하면 ?error={code}를 붙입니다.\n같은 이메일의 계정이 있어도 자동으로 연결하지 않으며(error=link_required), 그 계정으로 로그인한 뒤 연결해야 합니다.",
+                "tags": [
+                    "auth"
+                ],
+                "summary": "외부 로그인 콜백",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "공급자 이름",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "인가 코드",
+                        "name": "code",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "인가 요청 시 발급한 state",
+                        "name": "state",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "302": {
+                        "description": "Found"
+                    }
+                }
+            }
+        },
+        "/auth/providers": {
+            "get": {
+                "description": "설정된 OIDC 공급자 이름 목록을 반환합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "외부 로그인 공급자 목록",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ProvidersResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/refresh": {
+            "post": {
+                "description": "리프레시 토큰으로 새 액세스/리프레시 토큰을 발급합니다. 변경된 권한이 새 토큰에 반영됩니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "토큰 재발급",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "description": "리프레시 토큰",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.RefreshRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.TokenResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/signup": {
+            "post": {
+                "description": "이메일과 비밀번호로 viewer 권한 계정을 만들고 토큰을 발급합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "회원가입",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "description": "가입 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SignupRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.TokenResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/collections/shared/{token}": {
+            "get": {
+                "description": "공유 링크로 공개 컬렉션을 추천 카테고리와 같은 형식으로 조회합니다. 로그인이 필요 없습니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "collections"
+                ],
+                "summary": "공개 컬렉션 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "공유 토큰",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.RecommendationDto"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/courses": {
+            "get": {
+                "description": "지역, 스타일, 검색어로 코스를 필터링하여 조회합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "courses"
+                ],
+                "summary": "코스 목록 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "지역 필터 (지역 코드 또는 이름)",
+                        "name": "region",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "스타일 필터 (slug, 이름 또는 동의어)",
+                        "name": "style",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "검색어 (스타일 동의어 포함)",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "주행 예정일 (YYYY-MM-DD, KST). 그날 계절 통제되는 코스 제외",
+                        "name": "date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "주행 예정 달 (1~12). 추천 시기가 아니거나 한 달 내내 통제되는 코스 제외",
+                        "name": "month",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "true면 앞으로 6시간 동안 출발지와 정상에 비 예보가 없는 코스만 조회 (보관된 예보 기준)",
+                        "name": "dry",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.CourseDto"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/courses/{id}": {
+            "get": {
+                "description": "ID로 코스 상세 정보를 조회합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "courses"
+                ],
+                "summary": "코스 상세 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "코스 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CourseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/courses/{id}/hazards": {
+            "get": {
+                "description": "코스에 신고된 통제, 자갈, 낙석, 단속 등 일시적인 위험 요소를 최신순으로 조회합니다. 기본값은 활성 신고만 반환합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "hazards"
+                ],
+                "summary": "코스 위험 신고 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "코스 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "만료·해소된 신고 포함 여부",
+                        "name": "all",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.HazardDto"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "코스 위의 위험 요소를 위치, 유형, 위험도와 함께 신고합니다. 유형별 유효 기간이 지나면 자동으로 만료됩니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "hazards"
+                ],
+                "summary": "위험 신고",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "코스 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "신고 내용",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HazardRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.CreatedResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/courses/{id}/history": {
+            "get": {
+                "description": "코스 변경 이벤트(생성, 평가 변경, 내비게이션 수정 등)를 작업자·사유·변경 전후 값과 함께 오래된 순으로 조회합니다.\nat을 주면 그 시점까지의 이벤트와 그 시점의 코스 상태를 재구성해 반환합니다. 삭제된 코스의 이력도 조회할 수 있습니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "courses"
+                ],
+                "summary": "코스 변경 이력 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "코스 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "조회 시점 (RFC 3339, 예: 2025-05-01T09:00:00+09:00). 생략 시 현재",
+                        "name": "at",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CourseHistoryDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/courses/{id}/photos": {
+            "get": {
+                "description": "코스에 올린 사진을 경로 순서(출발지에서 가까운 순)로 조회합니다. 경로 위에 배치하지 못한 사진은 뒤에 올린 순서대로 붙습니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "photos"
+                ],
+                "summary": "코스 사진 갤러리",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "코스 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.PhotoDto"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "JPEG 또는 PNG 사진(최대 20MB)을 코스에 올립니다. 썸네일(320px), 중간(1024px), 큰(2048px) 크기로 다시 인코딩해 저장하며,\n저장한 사진에는 EXIF 등 메타데이터가 남지 않습니다. EXIF에 GPS 위치가 있고 코스 경로에서 1km 안이면 경로 위에 배치합니다.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "photos"
+                ],
+                "summary": "코스 사진 업로드",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "코스 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "사진 파일 (JPEG, PNG)",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "사진 설명 (최대 200자)",
+                        "name": "caption",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.CreatedResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/courses/{id}/reviews": {
+            "get": {
+                "description": "코스 리뷰를 최신순으로 페이지 단위 조회합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reviews"
+                ],
+                "summary": "코스 리뷰 목록 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "코스 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "페이지 번호 (1~100000), 기본 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "페이지 크기, 기본 20, 최대 100",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ReviewPageDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "5가지 항목 점수, 본문, 방문일로 코스 리뷰를 작성합니다. 사용자당 코스 하나에 리뷰 하나만 작성할 수 있습니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reviews"
+                ],
+                "summary": "코스 리뷰 작성",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "코스 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "리뷰 내용",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ReviewRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.CreatedResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/courses/{id}/route": {
+            "get": {
+                "description": "경로 탐색 엔진으로 내비게이션 포인트 사이를 실제 도로에 맞춰 구한 주행 경로(GeoJSON LineString)와 포인트 문제를 조회합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "courses"
+                ],
+                "summary": "코스 도로 경로 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "코스 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CourseRouteDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/courses/{id}/similar": {
+            "get": {
+                "description": "기준 코스와 평가 점수, 스타일, 지역 근접도, 특징 설명이 비슷한 코스를 점수 순으로 반환합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "courses"
+                ],
+                "summary": "유사 코스 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "기준 코스 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "같은 시·도의 코스 제외 여부",
+                        "name": "excludeSameRegion",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "지역 근접도 점수가 0이 되는 출발지 간 거리(km), 기본 150",
+                        "name": "radius",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "평가 점수 가중치, 기본 0.4",
+                        "name": "ratingsWeight",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "스타일 가중치, 기본 0.25",
+                        "name": "styleWeight",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "지역 근접도 가중치, 기본 0.2",
+                        "name": "regionWeight",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "특징 설명 유사도 가중치, 기본 0.15",
+                        "name": "textWeight",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "최대 결과 수, 기본 10",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.ScoredCourseDto"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/courses/{id}/weather": {
+            "get": {
+                "description": "코스 출발지와 정상(있는 경우)의 기상청 단기예보를 시간별로 조회합니다. 예보는 격자별로 캐시됩니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "weather"
+                ],
+                "summary": "코스 날씨 예보 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "코스 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CourseWeatherDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/hazards/{id}/votes": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "confirm은 신고가 여전히 유효함을 확인해 만료 시각을 연장하고, dismiss는 해소되었음을 알립니다. 해소 투표가 3표 이상이고 확인보다 많으면 신고가 내려갑니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "hazards"
+                ],
+                "summary": "위험 신고 확인/해소 투표",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "신고 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "투표",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.HazardVoteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/me": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "액세스 토큰의 사용자 계정 정보를 조회합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "내 정보 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.UserDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/collections": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "로그인한 사용자의 컬렉션 목록을 생성 순으로 조회합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "collections"
+                ],
+                "summary": "내 컬렉션 목록 조회",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.CollectionDto"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "코스 목록(즐겨찾기 등)을 만듭니다. public이면 공유 링크가 발급됩니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "collections"
+                ],
+                "summary": "컬렉션 생성",
+                "parameters": [
+                    {
+                        "description": "컬렉션 정보",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.CreateCollectionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.CreatedResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/collections/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "로그인한 사용자의 컬렉션을 담긴 코스 정보와 함께 조회합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "collections"
+                ],
+                "summary": "내 컬렉션 상세 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "컬렉션 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CollectionDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "collections"
+                ],
+                "summary": "컬렉션 삭제",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "컬렉션 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "비공개로 바꾸면 기존 공유 링크가 무효화되고, 다시 공개하면 새 링크가 발급됩니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "collections"
+                ],
+                "summary": "컬렉션 이름/공개 여부 변경",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "컬렉션 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "변경할 항목",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.UpdateCollectionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/collections/{id}/courses": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "컬렉션에 담긴 코스 ID 전체를 원하는 순서로 보냅니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "collections"
+                ],
+                "summary": "컬렉션 코스 순서 변경",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "컬렉션 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "새 순서",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ReorderCollectionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "코스를 컬렉션 끝에 추가합니다. 이미 담긴 코스면 그대로 둡니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "collections"
+                ],
+                "summary": "컬렉션에 코스 추가",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "컬렉션 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "추가할 코스",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.AddCollectionCourseRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/collections/{id}/courses/{courseId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "collections"
+                ],
+                "summary": "컬렉션에서 코스 제거",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "컬렉션 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "코스 ID",
+                        "name": "courseId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/drives": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "로그인한 사용자의 주행 기록을 주행일 최신순으로 조회합니다. 궤적 점은 포함하지 않습니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "drives"
+                ],
+                "summary": "내 주행 기록 목록 조회",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "코스 ID로 필터",
+                        "name": "courseId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.DriveDto"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "코스를 달린 날짜, 방향, 차량, 날씨를 기록합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "drives"
+                ],
+                "summary": "주행 기록 작성",
+                "parameters": [
+                    {
+                        "description": "주행 기록",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.DriveRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.CreatedResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/drives/stats": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "주행 횟수, 완주 코스 수, 총 거리와 시·도별, 추천 카테고리별 완주 현황을 조회합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "drives"
+                ],
+                "summary": "내 주행 통계 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.DriveStatsDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/drives/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "주행 기록을 궤적 점과 함께 조회합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "drives"
+                ],
+                "summary": "내 주행 기록 상세 조회",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "주행 기록 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.DriveDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "주행 기록의 입력 항목을 모두 바꿉니다. 업로드한 궤적은 유지합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "drives"
+                ],
+                "summary": "주행 기록 수정",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "주행 기록 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "주행 기록",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.DriveRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "drives"
+                ],
+                "summary": "주행 기록 삭제",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "주행 기록 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/drives/{id}/track": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "GPX 파일을 multipart 폼의 file 필드로 올려 주행 기록에 궤적을 붙입니다. 기존 궤적은 교체됩니다. 최대 5MB.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "tags": [
+                    "drives"
+                ],
+                "summary": "주행 궤적 업로드",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "주행 기록 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "GPX 파일",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "drives"
+                ],
+                "summary": "주행 궤적 삭제",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "주행 기록 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/revisions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "로그인한 사용자가 작성한 수정안을 최근 수정 순으로 조회합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "revisions"
+                ],
+                "summary": "내 코스 수정안 목록 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "상태 (draft, submitted, rejected, approved)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "페이지 번호 (1~100000), 기본 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "페이지 크기, 기본 20, 최대 100",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.RevisionPageDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/submissions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "로그인한 사용자의 제보를 최근 제보 순으로 조회합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "submissions"
+                ],
+                "summary": "내 코스 제보 목록 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "상태 (pending, accepted, rejected)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "페이지 번호 (1~100000), 기본 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "페이지 크기, 기본 20, 최대 100",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SubmissionPageDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/photos/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "사진과 저장한 변형 이미지를 지웁니다. 올린 사람과 editor 이상 권한 사용자만 지울 수 있습니다.",
+                "tags": [
+                    "photos"
+                ],
+                "summary": "코스 사진 삭제",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "사진 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/recommendations": {
+            "get": {
+                "description": "추천 카테고리별 코스 목록을 조회합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recommendations"
+                ],
+                "summary": "추천 코스 목록 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.RecommendationDto"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/recommendations/personal": {
+            "get": {
+                "description": "평가 항목별 희망 점수, 위치, 선호 스타일로 전체 코스를 점수화해 순위와 추천 사유를 반환합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recommendations"
+                ],
+                "summary": "개인화 추천 코스 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "희망 기술 점수 (1~5)",
+                        "name": "tech",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "희망 속도 점수 (1~5)",
+                        "name": "speed",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "희망 경관 점수 (1~5)",
+                        "name": "scenery",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "희망 노면 점수 (1~5)",
+                        "name": "road",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "희망 접근성 점수 (1~5)",
+                        "name": "access",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "기술 점수 하한. 다른 항목도 min\u003cAxis\u003e/max\u003cAxis\u003e 형식으로 지정 가능",
+                        "name": "minTech",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "접근성 점수 상한",
+                        "name": "maxAccess",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "사용자 위치 (위도,경도)",
+                        "name": "near",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "거리 점수가 0이 되는 반경(km), 기본 200",
+                        "name": "radius",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "선호 스타일 (쉼표 구분, slug/이름/동의어)",
+                        "name": "styles",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "평가 점수 가중치, 기본 0.6",
+                        "name": "ratingsWeight",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "거리 가중치, 기본 0.25",
+                        "name": "distanceWeight",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "스타일 가중치, 기본 0.15",
+                        "name": "styleWeight",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "최대 결과 수, 기본 10",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.ScoredCourseDto"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/recommendations/{id}": {
+            "get": {
+                "description": "ID로 추천 코스 상세 정보를 조회합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recommendations"
+                ],
+                "summary": "추천 코스 상세 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "추천 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.RecommendationDto"
+                        }
+                    },
+                    "400": {
+                        "description": "잘못된 ID 형식",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "추천 정보를 찾을 수 없음",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "서버 오류",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/regions": {
+            "get": {
+                "description": "시·도 목록을 하위 시·군·구와 함께 조회합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "regions"
+                ],
+                "summary": "지역 목록 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "경계 다각형 포함 여부",
+                        "name": "boundary",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.RegionDto"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/regions/{code}": {
+            "get": {
+                "description": "지역 코드 또는 이름으로 지역 정보와 경계 다각형을 조회합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "regions"
+                ],
+                "summary": "지역 상세 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "지역 코드 또는 이름",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.RegionDto"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/revisions": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "게시된 코스를 고치는 수정안(courseId) 또는 새 코스 제안(courseId 생략)을 초안으로 만듭니다.\n코스 내용은 관리자 코스 수정과 같이 전체를 담고, reason에 변경 요약을 적습니다. 현재 코스 버전이 기준 버전이 됩니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "revisions"
+                ],
+                "summary": "코스 수정안 작성",
+                "parameters": [
+                    {
+                        "description": "수정안",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.RevisionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.CreatedResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/revisions/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "수정안과 제안한 코스 전체를 조회합니다. 작성자와 editor 이상 권한 사용자만 볼 수 있습니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "revisions"
+                ],
+                "summary": "코스 수정안 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "수정안 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.RevisionDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "초안이나 반려된 수정안의 내용 전체를 바꿉니다. 반려된 수정안은 다시 초안이 되며, 현재 코스 버전이 새 기준 버전이 됩니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "revisions"
+                ],
+                "summary": "코스 수정안 편집",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "수정안 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "수정안 (courseId는 무시)",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.RevisionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/revisions/{id}/diff": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "수정안이 바꾸는 필드의 전후 값을 조회합니다. against를 생략하면 현재 게시된 코스와 비교하고,\n다른 수정안 ID를 주면 그 수정안과 비교합니다. 새 코스 제안이거나 삭제된 코스면 모든 필드가 추가된 것으로 나옵니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "revisions"
+                ],
+                "summary": "코스 수정안 비교",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "수정안 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "비교할 수정안 ID. 생략하면 게시된 코스",
+                        "name": "against",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.RevisionDiffDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/revisions/{id}/submit": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "초안을 검토 대기 상태로 바꿉니다. 작성자만 할 수 있습니다.",
+                "tags": [
+                    "revisions"
+                ],
+                "summary": "코스 수정안 검토 요청",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "수정안 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/styles": {
+            "get": {
+                "description": "코스 주행 스타일 분류 체계를 조회합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "styles"
+                ],
+                "summary": "스타일 목록 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.StyleDto"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/styles/{slug}": {
+            "get": {
+                "description": "slug, 이름 또는 동의어로 스타일을 조회합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "styles"
+                ],
+                "summary": "스타일 상세 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "스타일 slug 또는 이름",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.StyleDto"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/submissions": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "아직 없는 코스를 제보합니다. 출발지부터 도착지까지 내비게이션 포인트가 2개 이상 필요합니다.\n출발지·도착지가 2km 안에 있거나(반대 방향 포함) 이름이 비슷한 기존 코스를 중복 후보로 함께 반환하며, 후보가 있어도 제보는 저장됩니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "submissions"
+                ],
+                "summary": "새 코스 제보",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "description": "제보할 코스",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.SubmissionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.SubmissionCreatedDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/submissions/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "제보 하나를 조회합니다. 제보자와 editor 이상 권한 사용자만 볼 수 있습니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "submissions"
+                ],
+                "summary": "코스 제보 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "제보 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SubmissionDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/trips/plan": {
+            "post": {
+                "description": "출발지와 코스 목록(또는 추천 ID)으로 코스 사이 이동 거리가 짧아지도록 순서를 정하고 구간별 예상 거리·시간을 반환합니다.\n이동 거리는 직선거리에 우회 계수를 곱해 추정합니다. 시간 예산을 넘으면 빼었을 때 시간이 가장 많이 줄어드는 코스부터 빼며, 코스가 하나도 들지 않으면 400 trip_budget_too_short입니다. format=gpx면 일정을 GPX 파일로 내려받습니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/gpx+xml"
+                ],
+                "tags": [
+                    "trips"
+                ],
+                "summary": "여행 일정 계획",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "응답 형식 (json, gpx), 기본 json",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "description": "여행 조건",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.TripPlanRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.TripDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "models.AddCollectionCourseRequest": {
+            "type": "object",
+            "required": [
+                "courseId"
+            ],
+            "properties": {
+                "courseId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.AdminAvailabilityDto": {
+            "type": "object",
+            "properties": {
+                "closures": {
+                    "description": "MM-DD",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AdminPeriodDto"
+                    }
+                },
+                "recommendedMonths": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "restrictions": {
+                    "description": "HH:MM",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AdminPeriodDto"
+                    }
+                }
+            }
+        },
+        "models.AdminCourseNavDto": {
+            "type": "object",
+            "properties": {
+                "geolocation": {
+                    "$ref": "#/definitions/models.CourseGeolocationDto"
+                },
+                "name": {
+                    "$ref": "#/definitions/models.LocalizedTextDto"
+                },
+                "summit": {
+                    "type": "boolean"
+                },
+                "type": {
+                    "description": "출발지, 경유지 N, 도착지",
+                    "type": "string"
+                }
+            }
+        },
+        "models.AdminCourseRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "ratings",
+                "region"
+            ],
+            "properties": {
+                "availability": {
+                    "$ref": "#/definitions/models.AdminAvailabilityDto"
+                },
+                "characteristics": {
+                    "$ref": "#/definitions/models.LocalizedTextDto"
+                },
+                "name": {
+                    "$ref": "#/definitions/models.LocalizedTextDto"
+                },
+                "nav": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AdminCourseNavDto"
+                    }
+                },
+                "naverMapUrl": {
+                    "type": "string"
+                },
+                "notes": {
+                    "$ref": "#/definitions/models.LocalizedTextDto"
+                },
+                "ratings": {
+                    "$ref": "#/definitions/models.CourseRatingsDto"
+                },
+                "reason": {
+                    "description": "변경 사유 (코스 변경 이력에 기록)",
+                    "type": "string"
+                },
+                "region": {
+                    "description": "시·도 이름",
+                    "type": "string"
+                },
+                "styles": {
+                    "description": "스타일 slug, 이름 또는 동의어",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "tagline": {
+                    "$ref": "#/definitions/models.LocalizedTextDto"
+                }
+            }
+        },
+        "models.AdminPeriodDto": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "type": "string"
+                },
+                "reason": {
+                    "$ref": "#/definitions/models.LocalizedTextDto"
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.AdminRecommendationRequest": {
+            "type": "object",
+            "required": [
+                "title"
+            ],
+            "properties": {
+                "courseIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "description": {
+                    "$ref": "#/definitions/models.LocalizedTextDto"
+                },
+                "excludedIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "pinnedIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "rule": {
+                    "type": "string"
+                },
+                "title": {
+                    "$ref": "#/definitions/models.LocalizedTextDto"
+                }
+            }
+        },
+        "models.AuditActorDto": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.AuditChangeDto": {
+            "type": "object",
+            "properties": {
+                "after": {
+                    "description": "지운 필드면 생략",
+                    "type": "object"
+                },
+                "before": {
+                    "description": "없던 필드면 생략",
+                    "type": "object"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.AuditEntryDto": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "create, update, delete, reorder, hide, unhide, render_images",
+                    "type": "string"
+                },
+                "actor": {
+                    "$ref": "#/definitions/models.AuditActorDto"
+                },
+                "at": {
+                    "type": "string"
+                },
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AuditChangeDto"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "targetId": {
+                    "type": "integer"
+                },
+                "targetType": {
+                    "description": "course, recommendation, hazard, review",
+                    "type": "string"
+                }
+            }
+        },
+        "models.AuditPageDto": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AuditEntryDto"
+                    }
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.AuthorizeResponse": {
+            "type": "object",
+            "properties": {
+                "authorizationUrl": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.AvailabilityDto": {
+            "type": "object",
+            "properties": {
+                "closedReason": {
+                    "type": "string"
+                },
+                "closures": {
+                    "description": "매년 반복되는 계절 통제 기간 (KST)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PeriodDto"
+                    }
+                },
+                "openNow": {
+                    "description": "서버 시각(KST) 기준 통행 가능 여부",
+                    "type": "boolean"
+                },
+                "recommendedMonths": {
+                    "description": "비어 있으면 연중 추천",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "restrictions": {
+                    "description": "매일 반복되는 통행 제한 시간대 (KST)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PeriodDto"
+                    }
+                }
+            }
+        },
+        "models.CategoryProgressDto": {
+            "type": "object",
+            "properties": {
+                "completed": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.CollectionDto": {
+            "type": "object",
+            "properties": {
+                "courseIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "courses": {
+                    "description": "상세 조회 시 코스 정보",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CourseDto"
+                    }
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "public": {
+                    "type": "boolean"
+                },
+                "shareUrl": {
+                    "description": "공개 컬렉션의 공유 링크 경로",
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.CommunityRatingsDto": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "distribution": {
+                    "description": "항목별 1~5점 리뷰 수",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        }
+                    }
+                },
+                "mean": {
+                    "description": "리뷰가 없으면 생략",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.CommunityRatingsMeanDto"
+                        }
+                    ]
+                }
+            }
+        },
+        "models.CommunityRatingsMeanDto": {
+            "type": "object",
+            "properties": {
+                "access": {
+                    "type": "number"
+                },
+                "road": {
+                    "type": "number"
+                },
+                "scenery": {
+                    "type": "number"
+                },
+                "speed": {
+                    "type": "number"
+                },
+                "tech": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.CourseDto": {
+            "type": "object",
+            "properties": {
+                "activeHazards": {
+                    "description": "활성 위험 신고 요약",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.HazardBadgeDto"
+                        }
+                    ]
+                },
+                "availability": {
+                    "description": "계절·시간대 이용 정보",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.AvailabilityDto"
+                        }
+                    ]
+                },
+                "characteristics": {
+                    "type": "string"
+                },
+                "communityRatings": {
+                    "description": "사용자 리뷰 집계",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.CommunityRatingsDto"
+                        }
+                    ]
+                },
+                "detailImage": {
+                    "description": "상세 이미지 URL",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "nav": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CourseNavDto"
+                    }
+                },
+                "naverMapUrl": {
+                    "type": "string"
+                },
+                "navigationLinks": {
+                    "description": "앱별 내비게이션 딥 링크. 경유지 제한을 넘으면 주행 순서대로 여러 개",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "ratings": {
+                    "description": "큐레이터 평가",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.CourseRatingsDto"
+                        }
+                    ]
+                },
+                "region": {
+                    "description": "요청 언어의 시·도 이름 (regionName과 같음, 이전 버전 호환용)",
+                    "type": "string"
+                },
+                "regionCode": {
+                    "type": "string"
+                },
+                "regionName": {
+                    "description": "요청 언어의 지역 이름",
+                    "type": "string"
+                },
+                "route": {
+                    "description": "도로 경로 요약. 경로를 구하지 않은 코스는 생략",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.CourseRouteSummaryDto"
+                        }
+                    ]
+                },
+                "styleSlugs": {
+                    "description": "스타일 식별자",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "styles": {
+                    "description": "스타일 표시 이름",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "subRegionCode": {
+                    "type": "string"
+                },
+                "subRegionName": {
+                    "type": "string"
+                },
+                "tagline": {
+                    "type": "string"
+                },
+                "thumbnailImage": {
+                    "description": "썸네일 이미지 URL",
+                    "type": "string"
+                }
+            }
+        },
+        "models.CourseEventActorDto": {
+            "type": "object",
+            "properties": {
+                "displayName": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.CourseEventDto": {
+            "type": "object",
+            "properties": {
+                "actor": {
+                    "description": "데이터 파일에서 가져온 변경이거나 작업자를 찾을 수 없으면 null",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.CourseEventActorDto"
+                        }
+                    ]
+                },
+                "at": {
+                    "type": "string"
+                },
+                "changes": {
+                    "description": "이벤트로 바뀐 필드의 전후 값",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AuditChangeDto"
+                    }
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "type": {
+                    "description": "course_created, details_changed, ratings_changed, nav_updated, styles_changed, availability_changed, course_deleted",
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.CourseGeolocationDto": {
+            "type": "object",
+            "properties": {
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.CourseHistoryDto": {
+            "type": "object",
+            "properties": {
+                "at": {
+                    "description": "조회 시점 (at 파라미터, 생략 시 현재)",
+                    "type": "string"
+                },
+                "course": {
+                    "description": "조회 시점의 코스, 그때 없었거나 삭제된 상태면 null",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.CourseDto"
+                        }
+                    ]
+                },
+                "courseId": {
+                    "type": "integer"
+                },
+                "events": {
+                    "description": "조회 시점까지의 이벤트 (오래된 순)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CourseEventDto"
+                    }
+                }
+            }
+        },
+        "models.CourseNavDto": {
+            "type": "object",
+            "properties": {
+                "geolocation": {
+                    "$ref": "#/definitions/models.CourseGeolocationDto"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "role": {
+                    "description": "start, waypoint, end. 알 수 없는 형식이면 생략",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "요청 언어의 역할 이름 (출발지, 경유지 1, 도착지 등)",
+                    "type": "string"
+                }
+            }
+        },
+        "models.CourseRatingsDto": {
+            "type": "object",
+            "properties": {
+                "access": {
+                    "type": "integer"
+                },
+                "road": {
+                    "type": "integer"
+                },
+                "scenery": {
+                    "type": "integer"
+                },
+                "speed": {
+                    "type": "integer"
+                },
+                "tech": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.CourseRouteDto": {
+            "type": "object",
+            "properties": {
+                "computedAt": {
+                    "type": "string"
+                },
+                "courseId": {
+                    "type": "integer"
+                },
+                "distanceKm": {
+                    "type": "number"
+                },
+                "durationMinutes": {
+                    "type": "integer"
+                },
+                "geometry": {
+                    "$ref": "#/definitions/models.LineStringDto"
+                },
+                "issues": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.RouteIssueDto"
+                    }
+                },
+                "reachable": {
+                    "description": "모든 구간을 도로로 이을 수 있는지 여부",
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.CourseRouteSummaryDto": {
+            "type": "object",
+            "properties": {
+                "distanceKm": {
+                    "type": "number"
+                },
+                "durationMinutes": {
+                    "type": "integer"
+                },
+                "issues": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.RouteIssueDto"
+                    }
+                },
+                "reachable": {
+                    "description": "모든 구간을 도로로 이을 수 있는지 여부",
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.CourseWeatherDto": {
+            "type": "object",
+            "properties": {
+                "courseId": {
+                    "type": "integer"
+                },
+                "dryRoads": {
+                    "description": "앞으로 6시간 동안 모든 지점에 비 예보가 없는지 여부",
+                    "type": "boolean"
+                },
+                "points": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PointWeatherDto"
+                    }
+                }
+            }
+        },
+        "models.CreateCollectionRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "courseIds": {
+                    "description": "처음 담을 코스 (순서 유지)",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "public": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.CreatedResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.DriveDto": {
+            "type": "object",
+            "properties": {
+                "car": {
+                    "type": "string"
+                },
+                "courseId": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "direction": {
+                    "type": "string"
+                },
+                "drivenOn": {
+                    "type": "string"
+                },
+                "hasTrack": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "track": {
+                    "description": "상세 조회 시 [위도, 경도] 배열",
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "number"
+                        }
+                    }
+                },
+                "trackDistanceKm": {
+                    "description": "TrackDistanceKm는 업로드한 궤적의 거리입니다.",
+                    "type": "number"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "weather": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.DriveRequest": {
+            "type": "object",
+            "required": [
+                "courseId",
+                "drivenOn"
+            ],
+            "properties": {
+                "car": {
+                    "type": "string"
+                },
+                "courseId": {
+                    "type": "integer"
+                },
+                "direction": {
+                    "description": "forward(기본) 또는 reverse",
+                    "type": "string"
+                },
+                "drivenOn": {
+                    "description": "주행일 (YYYY-MM-DD)",
+                    "type": "string"
+                },
+                "weather": {
+                    "description": "clear, cloudy, rain, snow, fog",
+                    "type": "string"
+                }
+            }
+        },
+        "models.DriveStatsDto": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CategoryProgressDto"
+                    }
+                },
+                "coursesCompleted": {
+                    "type": "integer"
+                },
+                "regions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.RegionProgressDto"
+                    }
+                },
+                "totalDrives": {
+                    "type": "integer"
+                },
+                "totalKm": {
+                    "description": "궤적이 없는 기록은 코스 추정 거리 사용",
+                    "type": "number"
+                }
+            }
+        },
+        "models.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "메시지 키 (예: course_not_found)",
+                    "type": "string"
+                },
+                "detail": {
+                    "description": "내부 에러 상세",
+                    "type": "string"
+                },
+                "error": {
+                    "description": "요청 언어로 번역된 에러 메시지",
+                    "type": "string"
+                }
+            }
+        },
+        "models.HazardBadgeDto": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "maxSeverity": {
+                    "description": "활성 신고 중 가장 높은 위험도",
+                    "type": "string"
+                }
+            }
+        },
+        "models.HazardDto": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "confirmations": {
+                    "type": "integer"
+                },
+                "courseId": {
+                    "type": "integer"
+                },
+                "dismissals": {
+                    "type": "integer"
+                },
+                "expiresAt": {
+                    "type": "string"
+                },
+                "hidden": {
+                    "description": "관리자 조회에서만 true",
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "reportedAt": {
+                    "type": "string"
+                },
+                "severity": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "typeName": {
+                    "description": "요청 언어의 유형 이름",
+                    "type": "string"
+                }
+            }
+        },
+        "models.HazardRequest": {
+            "type": "object",
+            "required": [
+                "latitude",
+                "longitude",
+                "severity",
+                "type"
+            ],
+            "properties": {
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "severity": {
+                    "description": "low, medium, high",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "closure, gravel, landslide, checkpoint, ice, accident, other",
+                    "type": "string"
+                }
+            }
+        },
+        "models.HazardVoteRequest": {
+            "type": "object",
+            "required": [
+                "vote"
+            ],
+            "properties": {
+                "vote": {
+                    "description": "confirm 또는 dismiss",
+                    "type": "string"
+                }
+            }
+        },
+        "models.HourlyWeatherDto": {
+            "type": "object",
+            "properties": {
+                "humidityPct": {
+                    "type": "integer"
+                },
+                "precipitation": {
+                    "description": "none, rain, sleet, snow, shower",
+                    "type": "string"
+                },
+                "precipitationChance": {
+                    "description": "강수확률(%)",
+                    "type": "integer"
+                },
+                "precipitationMm": {
+                    "type": "number"
+                },
+                "sky": {
+                    "description": "clear, cloudy, overcast",
+                    "type": "string"
+                },
+                "temperatureC": {
+                    "type": "number"
+                },
+                "time": {
+                    "type": "string"
+                },
+                "wet": {
+                    "description": "노면이 젖을 만한 예보인지 여부",
+                    "type": "boolean"
+                },
+                "windSpeedMs": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.IdentityDto": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "linkedAt": {
+                    "type": "string"
+                },
+                "provider": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.LineStringDto": {
+            "type": "object",
+            "properties": {
+                "coordinates": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "number"
+                        }
+                    }
+                },
+                "type": {
+                    "type": "string",
+                    "example": "LineString"
+                }
+            }
+        },
+        "models.LocalizedTextDto": {
+            "type": "object",
+            "additionalProperties": {
+                "type": "string"
+            }
+        },
+        "models.LogLevelDto": {
+            "type": "object",
+            "required": [
+                "level"
+            ],
+            "properties": {
+                "level": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.LoginRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ModerationRequest": {
+            "type": "object",
+            "required": [
+                "hidden"
+            ],
+            "properties": {
+                "hidden": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.PeriodDto": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "description": "계절 통제는 MM-DD, 시간대 제한은 HH:MM",
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.PhotoDto": {
+            "type": "object",
+            "properties": {
+                "caption": {
+                    "type": "string"
+                },
+                "courseId": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "routeKm": {
+                    "description": "출발지로부터 경로 거리(km), 경로 위에 배치한 사진만",
+                    "type": "number"
+                },
+                "routePoint": {
+                    "description": "촬영 위치를 경로에 투영한 지점, 경로 위에 배치한 사진만",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.CourseGeolocationDto"
+                        }
+                    ]
+                },
+                "uploadedAt": {
+                    "type": "string"
+                },
+                "uploader": {
+                    "description": "Uploader는 사진을 올린 사용자입니다. 계정을 찾을 수 없으면 null입니다.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.PhotoUserDto"
+                        }
+                    ]
+                },
+                "variants": {
+                    "description": "Variants는 크기별 사진입니다. 원본이 작으면 여러 크기가 같은 해상도일 수 있습니다.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PhotoVariantDto"
+                    }
+                }
+            }
+        },
+        "models.PhotoUserDto": {
+            "type": "object",
+            "properties": {
+                "displayName": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.PhotoVariantDto": {
+            "type": "object",
+            "properties": {
+                "height": {
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "thumb(320px), medium(1024px), large(2048px)",
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "width": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.PointWeatherDto": {
+            "type": "object",
+            "properties": {
+                "grid": {
+                    "description": "기상청 격자 좌표 \"nx,ny\"",
+                    "type": "string"
+                },
+                "hours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.HourlyWeatherDto"
+                    }
+                },
+                "issuedAt": {
+                    "type": "string"
+                },
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "summit": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.ProvidersResponse": {
+            "type": "object",
+            "properties": {
+                "providers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.RecommendationDto": {
+            "type": "object",
+            "properties": {
+                "courses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CourseDto"
+                    }
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.RefreshRequest": {
+            "type": "object",
+            "required": [
+                "refreshToken"
+            ],
+            "properties": {
+                "refreshToken": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.RegionBoundaryDto": {
+            "type": "object",
+            "properties": {
+                "coordinates": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "array",
+                            "items": {
+                                "type": "array",
+                                "items": {
+                                    "type": "number"
+                                }
+                            }
+                        }
+                    }
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.RegionDto": {
+            "type": "object",
+            "properties": {
+                "bbox": {
+                    "description": "[서, 남, 동, 북]",
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "boundary": {
+                    "$ref": "#/definitions/models.RegionBoundaryDto"
+                },
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.RegionDto"
+                    }
+                },
+                "code": {
+                    "type": "string"
+                },
+                "level": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parentCode": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.RegionProgressDto": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "completed": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.ReorderCollectionRequest": {
+            "type": "object",
+            "required": [
+                "courseIds"
+            ],
+            "properties": {
+                "courseIds": {
+                    "description": "현재 코스 ID 전체를 원하는 순서로",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "models.ReorderRecommendationRequest": {
+            "type": "object",
+            "required": [
+                "courseIds"
+            ],
+            "properties": {
+                "courseIds": {
+                    "description": "현재 코스 ID 전체를 원하는 순서로",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "models.ReviewAuthorDto": {
+            "type": "object",
+            "properties": {
+                "displayName": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.ReviewDecisionRequest": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "description": "검토 의견 (최대 500자), 반려 때는 필수",
+                    "type": "string"
+                }
+            }
+        },
+        "models.ReviewDto": {
+            "type": "object",
+            "properties": {
+                "author": {
+                    "description": "작성자를 찾을 수 없으면 null",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.ReviewAuthorDto"
+                        }
+                    ]
+                },
+                "courseId": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "hidden": {
+                    "description": "관리자 조회에서만 true",
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "ratings": {
+                    "$ref": "#/definitions/models.CourseRatingsDto"
+                },
+                "text": {
+                    "type": "string"
+                },
+                "visitedOn": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ReviewPageDto": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ReviewDto"
+                    }
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.ReviewRequest": {
+            "type": "object",
+            "required": [
+                "ratings",
+                "visitedOn"
+            ],
+            "properties": {
+                "ratings": {
+                    "$ref": "#/definitions/models.CourseRatingsDto"
+                },
+                "text": {
+                    "type": "string"
+                },
+                "visitedOn": {
+                    "description": "방문일 (YYYY-MM-DD)",
+                    "type": "string"
+                }
+            }
+        },
+        "models.RevisionDiffDto": {
+            "type": "object",
+            "properties": {
+                "againstRevisionId": {
+                    "description": "AgainstRevisionID는 비교한 수정안 ID입니다. 0이면 현재 게시된 코스와 비교한 결과입니다.",
+                    "type": "integer"
+                },
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AuditChangeDto"
+                    }
+                },
+                "revision": {
+                    "$ref": "#/definitions/models.RevisionDto"
+                }
+            }
+        },
+        "models.RevisionDto": {
+            "type": "object",
+            "properties": {
+                "author": {
+                    "description": "계정을 찾을 수 없으면 null",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.RevisionUserDto"
+                        }
+                    ]
+                },
+                "baseVersion": {
+                    "description": "BaseVersion은 수정안이 기준으로 삼은 코스 버전, CurrentVersion은 게시된 코스의 현재 버전입니다.",
+                    "type": "integer"
+                },
+                "course": {
+                    "description": "제안한 코스 (목록에서는 생략)",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.CourseDto"
+                        }
+                    ]
+                },
+                "courseId": {
+                    "description": "0이면 새 코스 제안, 승인 후에는 게시된 코스 ID",
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "currentVersion": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "outdated": {
+                    "description": "기준 버전 이후 코스가 바뀌어 다시 편집해야 승인할 수 있음",
+                    "type": "boolean"
+                },
+                "reviewComment": {
+                    "type": "string"
+                },
+                "reviewedAt": {
+                    "type": "string"
+                },
+                "reviewer": {
+                    "description": "승인·반려한 검토자",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.RevisionUserDto"
+                        }
+                    ]
+                },
+                "status": {
+                    "description": "draft, submitted, rejected, approved",
+                    "type": "string"
+                },
+                "submittedAt": {
+                    "type": "string"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.RevisionPageDto": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.RevisionDto"
+                    }
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.RevisionRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "ratings",
+                "region"
+            ],
+            "properties": {
+                "availability": {
+                    "$ref": "#/definitions/models.AdminAvailabilityDto"
+                },
+                "characteristics": {
+                    "$ref": "#/definitions/models.LocalizedTextDto"
+                },
+                "courseId": {
+                    "description": "고칠 코스 ID, 0이거나 생략하면 새 코스 제안 (편집 때는 무시)",
+                    "type": "integer"
+                },
+                "name": {
+                    "$ref": "#/definitions/models.LocalizedTextDto"
+                },
                 "nav": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.CourseNavDto"
+                        "$ref": "#/definitions/models.AdminCourseNavDto"
+                    }
+                },
+                "naverMapUrl": {
+                    "type": "string"
+                },
+                "notes": {
+                    "$ref": "#/definitions/models.LocalizedTextDto"
+                },
+                "ratings": {
+                    "$ref": "#/definitions/models.CourseRatingsDto"
+                },
+                "reason": {
+                    "description": "변경 사유 (코스 변경 이력에 기록)",
+                    "type": "string"
+                },
+                "region": {
+                    "description": "시·도 이름",
+                    "type": "string"
+                },
+                "styles": {
+                    "description": "스타일 slug, 이름 또는 동의어",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "tagline": {
+                    "$ref": "#/definitions/models.LocalizedTextDto"
+                }
+            }
+        },
+        "models.RevisionUserDto": {
+            "type": "object",
+            "properties": {
+                "displayName": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.RouteIssueDto": {
+            "type": "object",
+            "properties": {
+                "kind": {
+                    "description": "unreachable, off_road, out_of_order",
+                    "type": "string"
+                },
+                "message": {
+                    "description": "요청 언어의 설명",
+                    "type": "string"
+                },
+                "navIndex": {
+                    "description": "nav 배열의 인덱스",
+                    "type": "integer"
+                },
+                "navName": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ScoredCourseDto": {
+            "type": "object",
+            "properties": {
+                "breakdown": {
+                    "description": "항목별 0~1 점수",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number"
+                    }
+                },
+                "course": {
+                    "$ref": "#/definitions/models.CourseDto"
+                },
+                "distanceKm": {
+                    "description": "사용자 위치에서 출발지까지 거리",
+                    "type": "number"
+                },
+                "reasons": {
+                    "description": "요청 언어의 추천 사유",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
                     }
                 },
-                "naverMapUrl": {
+                "score": {
+                    "description": "0~1 종합 점수",
+                    "type": "number"
+                }
+            }
+        },
+        "models.SignupRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "displayName": {
                     "type": "string"
                 },
-                "notes": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.StyleDto": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "icon": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "synonyms": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.SubmissionAcceptRequest": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "course": {
+                    "description": "Course가 있으면 제보 내용 대신 이 코스를 게시합니다. 번역이나 소개 문구를 채워 넣을 때 사용합니다.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.AdminCourseRequest"
+                        }
+                    ]
+                }
+            }
+        },
+        "models.SubmissionCreatedDto": {
+            "type": "object",
+            "properties": {
+                "duplicates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SubmissionDuplicateDto"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.SubmissionDto": {
+            "type": "object",
+            "properties": {
+                "courseId": {
+                    "description": "수락해 게시한 코스 ID",
+                    "type": "integer"
+                },
+                "duplicateOf": {
+                    "description": "중복으로 반려한 경우 기존 코스 ID",
+                    "type": "integer"
+                },
+                "duplicates": {
+                    "description": "Duplicates는 현재 코스 목록과 비교한 중복 후보입니다. 검토 대기 중인 제보에만 있습니다.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SubmissionDuplicateDto"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "nav": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.CourseNavDto"
+                    }
+                },
+                "note": {
                     "type": "string"
                 },
                 "ratings": {
@@ -231,30 +6469,69 @@ const docTemplate = `{
                 "region": {
                     "type": "string"
                 },
+                "reviewComment": {
+                    "type": "string"
+                },
+                "reviewedAt": {
+                    "type": "string"
+                },
+                "reviewer": {
+                    "$ref": "#/definitions/models.SubmissionUserDto"
+                },
+                "status": {
+                    "description": "pending, accepted, rejected",
+                    "type": "string"
+                },
                 "styles": {
+                    "description": "스타일 slug",
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
-                "tagline": {
+                "submittedAt": {
                     "type": "string"
+                },
+                "submitter": {
+                    "description": "계정을 찾을 수 없으면 null",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.SubmissionUserDto"
+                        }
+                    ]
                 }
             }
         },
-        "models.CourseGeolocationDto": {
+        "models.SubmissionDuplicateDto": {
             "type": "object",
             "properties": {
-                "latitude": {
+                "courseId": {
+                    "type": "integer"
+                },
+                "endDistanceKm": {
                     "type": "number"
                 },
-                "longitude": {
+                "endpointsMatch": {
+                    "description": "출발지·도착지가 모두 2km 안 (반대 방향 포함)",
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "nameSimilarity": {
+                    "description": "이름 유사도 (0~1)",
+                    "type": "number"
+                },
+                "startDistanceKm": {
                     "type": "number"
                 }
             }
         },
-        "models.CourseNavDto": {
+        "models.SubmissionNavDto": {
             "type": "object",
+            "required": [
+                "name"
+            ],
             "properties": {
                 "geolocation": {
                     "$ref": "#/definitions/models.CourseGeolocationDto"
@@ -263,58 +6540,286 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "type": {
+                    "description": "출발지, 경유지 N, 도착지",
                     "type": "string"
                 }
             }
         },
-        "models.CourseRatingsDto": {
+        "models.SubmissionPageDto": {
             "type": "object",
             "properties": {
-                "access": {
-                    "type": "integer"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SubmissionDto"
+                    }
                 },
-                "road": {
+                "page": {
                     "type": "integer"
                 },
-                "scenery": {
+                "size": {
                     "type": "integer"
                 },
-                "speed": {
+                "total": {
                     "type": "integer"
+                }
+            }
+        },
+        "models.SubmissionRejectRequest": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "description": "반려 사유 (필수)",
+                    "type": "string"
                 },
-                "tech": {
+                "duplicateOf": {
+                    "description": "이미 있는 코스와 같으면 그 코스 ID",
                     "type": "integer"
                 }
             }
         },
-        "models.RecommendationDto": {
+        "models.SubmissionRequest": {
             "type": "object",
+            "required": [
+                "name",
+                "nav",
+                "ratings",
+                "region"
+            ],
             "properties": {
-                "courses": {
+                "name": {
+                    "type": "string"
+                },
+                "nav": {
+                    "description": "출발지부터 도착지까지 순서대로, 2개 이상",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.CourseDto"
+                        "$ref": "#/definitions/models.SubmissionNavDto"
                     }
                 },
-                "description": {
+                "note": {
+                    "description": "제보 메모 (최대 1000자)",
+                    "type": "string"
+                },
+                "ratings": {
+                    "$ref": "#/definitions/models.CourseRatingsDto"
+                },
+                "region": {
+                    "description": "시·도 이름",
+                    "type": "string"
+                },
+                "styles": {
+                    "description": "스타일 slug, 이름 또는 동의어",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.SubmissionUserDto": {
+            "type": "object",
+            "properties": {
+                "displayName": {
                     "type": "string"
                 },
                 "id": {
                     "type": "integer"
+                }
+            }
+        },
+        "models.TokenResponse": {
+            "type": "object",
+            "properties": {
+                "accessToken": {
+                    "type": "string"
+                },
+                "expiresIn": {
+                    "description": "액세스 토큰 유효 시간(초)",
+                    "type": "integer"
                 },
-                "title": {
+                "refreshToken": {
+                    "type": "string"
+                },
+                "tokenType": {
+                    "description": "항상 Bearer",
                     "type": "string"
                 }
             }
         },
-        "query.ErrorResponse": {
+        "models.TripDto": {
             "type": "object",
             "properties": {
-                "error": {
+                "arriveAt": {
+                    "type": "string"
+                },
+                "budgetMinutes": {
+                    "type": "integer"
+                },
+                "courseIds": {
+                    "description": "주행 순서",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "departAt": {
+                    "type": "string"
+                },
+                "distanceKm": {
+                    "type": "number"
+                },
+                "droppedCourseIds": {
+                    "description": "시간 예산을 넘어 뺀 코스",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "durationMinutes": {
+                    "type": "integer"
+                },
+                "legs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.TripLegDto"
+                    }
+                },
+                "returnToStart": {
+                    "type": "boolean"
+                },
+                "start": {
+                    "$ref": "#/definitions/models.CourseGeolocationDto"
+                },
+                "transitKm": {
+                    "description": "코스 사이 이동 거리",
+                    "type": "number"
+                }
+            }
+        },
+        "models.TripLegDto": {
+            "type": "object",
+            "properties": {
+                "arriveAt": {
+                    "type": "string"
+                },
+                "closedReason": {
+                    "description": "코스 진입 시각에 통제·제한 중이면 그 사유",
+                    "type": "string"
+                },
+                "courseId": {
+                    "description": "코스 구간의 코스, 이동 구간은 도착할 코스",
+                    "type": "integer"
+                },
+                "courseName": {
+                    "description": "요청 언어의 코스 이름",
+                    "type": "string"
+                },
+                "departAt": {
+                    "type": "string"
+                },
+                "distanceKm": {
+                    "type": "number"
+                },
+                "durationMinutes": {
+                    "type": "integer"
+                },
+                "from": {
+                    "$ref": "#/definitions/models.CourseGeolocationDto"
+                },
+                "kind": {
+                    "description": "transit(이동) 또는 course(코스 주행)",
+                    "type": "string"
+                },
+                "startMinute": {
+                    "description": "출발부터 이 구간 시작까지 걸린 시간(분)",
+                    "type": "integer"
+                },
+                "to": {
+                    "$ref": "#/definitions/models.CourseGeolocationDto"
+                }
+            }
+        },
+        "models.TripPlanRequest": {
+            "type": "object",
+            "required": [
+                "start"
+            ],
+            "properties": {
+                "budgetMinutes": {
+                    "description": "전체 여행 시간 한도(분). 0이면 제한 없음",
+                    "type": "integer"
+                },
+                "courseIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "departAt": {
+                    "description": "출발 시각. 있으면 구간별 시각과 통제 여부를 계산",
+                    "type": "string"
+                },
+                "recommendationId": {
+                    "type": "integer"
+                },
+                "returnToStart": {
+                    "type": "boolean"
+                },
+                "start": {
+                    "$ref": "#/definitions/models.CourseGeolocationDto"
+                }
+            }
+        },
+        "models.UpdateCollectionRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "public": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.UserDto": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "displayName": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "hasPassword": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "identities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.IdentityDto"
+                    }
+                },
+                "role": {
+                    "description": "viewer, editor, admin",
                     "type": "string"
                 }
             }
         }
+    },
+    "securityDefinitions": {
+        "BearerAuth": {
+            "description": "\"Bearer \u003caccessToken\u003e\" 형식",
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
+        }
     }
 }`
 
@@ -0,0 +1,157 @@
+package audit
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+)
+
+// Action은 관리자 작업 종류입니다.
+type Action string
+
+const (
+	ActionCreate       Action = "create"
+	ActionUpdate       Action = "update"
+	ActionDelete       Action = "delete"
+	ActionReorder      Action = "reorder"
+	ActionHide         Action = "hide"
+	ActionUnhide       Action = "unhide"
+	ActionRenderImages Action = "render_images"
+)
+
+// TargetType은 관리자 작업 대상의 종류입니다.
+type TargetType string
+
+const (
+	TargetCourse         TargetType = "course"
+	TargetRecommendation TargetType = "recommendation"
+	TargetHazard         TargetType = "hazard"
+	TargetReview         TargetType = "review"
+)
+
+// Change는 필드 하나의 변경 전후 값입니다. 값이 없던(생긴) 필드는 Before(After)가 nil입니다.
+type Change struct {
+	// Path는 변경된 필드 경로입니다. (예: Name.en, Nav[1].Geolocation.Latitude)
+	Path   string
+	Before json.RawMessage
+	After  json.RawMessage
+}
+
+// Entry는 관리자 작업 기록입니다.
+type Entry struct {
+	ID         int
+	ActorID    int
+	ActorEmail string
+	Action     Action
+	TargetType TargetType
+	TargetID   int
+	Changes    []Change
+	At         time.Time
+}
+
+// NewEntry는 작업 전후 상태를 비교해 작업 기록을 만듭니다. 생성은 before, 삭제는 after가 nil입니다. ID는 저장소가 배정합니다.
+func NewEntry(actor *user.Principal, action Action, targetType TargetType, targetID int, before, after any, now time.Time) (*Entry, error) {
+	changes, err := Diff(before, after)
+	if err != nil {
+		return nil, err
+	}
+	return &Entry{
+		ActorID:    actor.UserID,
+		ActorEmail: actor.Email,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Changes:    changes,
+		At:         now,
+	}, nil
+}
+
+// Diff는 두 값을 JSON 표현으로 비교해 달라진 필드를 경로순으로 반환합니다.
+// 객체는 필드별로, 같은 길이의 객체 배열은 항목별로 비교하며, 그 밖의 배열은 통째로 비교합니다.
+func Diff(before, after any) ([]Change, error) {
+	b, err := toJSONValue(before)
+	if err != nil {
+		return nil, err
+	}
+	a, err := toJSONValue(after)
+	if err != nil {
+		return nil, err
+	}
+	var changes []Change
+	diffValue("", b, a, &changes)
+	return changes, nil
+}
+
+func toJSONValue(v any) (any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var out any
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func diffValue(path string, before, after any, changes *[]Change) {
+	bm, bIsMap := before.(map[string]any)
+	am, aIsMap := after.(map[string]any)
+	if (bIsMap || before == nil) && (aIsMap || after == nil) && (bIsMap || aIsMap) {
+		keys := make([]string, 0, len(bm)+len(am))
+		for k := range bm {
+			keys = append(keys, k)
+		}
+		for k := range am {
+			if _, ok := bm[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			diffValue(joinPath(path, k), bm[k], am[k], changes)
+		}
+		return
+	}
+	if bs, ok := before.([]any); ok {
+		if as, ok := after.([]any); ok && len(bs) == len(as) && objectItems(bs) && objectItems(as) {
+			for i := range bs {
+				diffValue(path+"["+strconv.Itoa(i)+"]", bs[i], as[i], changes)
+			}
+			return
+		}
+	}
+	if reflect.DeepEqual(before, after) {
+		return
+	}
+	*changes = append(*changes, Change{Path: path, Before: rawJSON(before), After: rawJSON(after)})
+}
+
+func objectItems(items []any) bool {
+	for _, item := range items {
+		if _, ok := item.(map[string]any); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func rawJSON(v any) json.RawMessage {
+	if v == nil {
+		return nil
+	}
+	// JSON에서 디코딩한 값이라 다시 인코딩할 때 실패하지 않습니다.
+	data, _ := json.Marshal(v)
+	return data
+}
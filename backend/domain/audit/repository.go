@@ -0,0 +1,16 @@
+package audit
+
+// Filter는 작업 기록 조회 조건입니다. 0이나 빈 값인 조건은 적용하지 않습니다.
+type Filter struct {
+	TargetType TargetType
+	TargetID   int
+	ActorID    int
+}
+
+// AuditRepository는 관리자 작업 기록 저장/조회를 담당하는 인터페이스입니다.
+type AuditRepository interface {
+	// Append는 기록을 추가하고 새 ID를 배정합니다.
+	Append(e *Entry) error
+	// Find는 조건에 맞는 기록을 최신순으로 반환합니다.
+	Find(filter Filter) ([]*Entry, error)
+}
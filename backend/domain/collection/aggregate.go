@@ -0,0 +1,130 @@
+package collection
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+)
+
+// MaxNameLength는 컬렉션 이름의 최대 글자 수입니다.
+const MaxNameLength = 50
+
+// MaxCourses는 컬렉션 하나에 담을 수 있는 최대 코스 수입니다.
+const MaxCourses = 200
+
+var (
+	ErrInvalidName        = errors.New("컬렉션 이름은 1~50자여야 합니다")
+	ErrCollectionNotFound = errors.New("컬렉션을 찾을 수 없습니다")
+	ErrUnknownCourse      = errors.New("존재하지 않는 코스입니다")
+	ErrInvalidOrder       = errors.New("순서에는 컬렉션의 코스 ID가 빠짐없이 한 번씩 있어야 합니다")
+	ErrTooManyCourses     = errors.New("컬렉션에 담을 수 있는 코스 수를 넘었습니다")
+)
+
+// Collection은 사용자가 만든 코스 목록(즐겨찾기, "가을에 갈 곳" 등)입니다.
+// 공개 컬렉션은 ShareToken으로 로그인 없이 조회할 수 있습니다.
+type Collection struct {
+	ID      int
+	OwnerID int
+	Name    string
+	// CourseIDs는 사용자가 정한 순서의 코스 ID입니다.
+	CourseIDs []int
+	Public    bool
+	// ShareToken은 공개 링크 식별자입니다. 비공개 컬렉션은 빈 문자열입니다.
+	ShareToken string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// NewCollection은 이름을 검증해 빈 비공개 컬렉션을 만듭니다. ID는 저장소가 배정합니다.
+func NewCollection(ownerID int, name string, now time.Time) (*Collection, error) {
+	name, err := normalizeName(name)
+	if err != nil {
+		return nil, err
+	}
+	return &Collection{OwnerID: ownerID, Name: name, CourseIDs: []int{}, CreatedAt: now, UpdatedAt: now}, nil
+}
+
+// OwnedBy는 사용자가 컬렉션 소유자인지 확인합니다.
+func (c *Collection) OwnedBy(userID int) bool {
+	return c.OwnerID == userID
+}
+
+// Rename은 컬렉션 이름을 바꿉니다.
+func (c *Collection) Rename(name string, now time.Time) error {
+	name, err := normalizeName(name)
+	if err != nil {
+		return err
+	}
+	c.Name = name
+	c.UpdatedAt = now
+	return nil
+}
+
+// AddCourse는 코스를 목록 끝에 추가합니다. 이미 있는 코스면 아무것도 하지 않습니다.
+func (c *Collection) AddCourse(courseID int, now time.Time) error {
+	if slices.Contains(c.CourseIDs, courseID) {
+		return nil
+	}
+	if len(c.CourseIDs) >= MaxCourses {
+		return ErrTooManyCourses
+	}
+	c.CourseIDs = append(c.CourseIDs, courseID)
+	c.UpdatedAt = now
+	return nil
+}
+
+// RemoveCourse는 코스를 목록에서 뺍니다. 없는 코스면 아무것도 하지 않습니다.
+func (c *Collection) RemoveCourse(courseID int, now time.Time) {
+	i := slices.Index(c.CourseIDs, courseID)
+	if i < 0 {
+		return
+	}
+	c.CourseIDs = slices.Delete(c.CourseIDs, i, i+1)
+	c.UpdatedAt = now
+}
+
+// Reorder는 코스 순서를 바꿉니다. order는 현재 코스 ID를 빠짐없이 한 번씩 포함해야 합니다.
+func (c *Collection) Reorder(order []int, now time.Time) error {
+	if len(order) != len(c.CourseIDs) {
+		return ErrInvalidOrder
+	}
+	seen := make(map[int]bool, len(order))
+	for _, id := range order {
+		if seen[id] || !slices.Contains(c.CourseIDs, id) {
+			return ErrInvalidOrder
+		}
+		seen[id] = true
+	}
+	c.CourseIDs = slices.Clone(order)
+	c.UpdatedAt = now
+	return nil
+}
+
+// Publish는 컬렉션을 공개하고 새 공유 토큰을 붙입니다. 이미 공개 상태면 기존 링크를 유지합니다.
+func (c *Collection) Publish(token string, now time.Time) {
+	if c.Public {
+		return
+	}
+	c.Public = true
+	c.ShareToken = token
+	c.UpdatedAt = now
+}
+
+// Unpublish는 컬렉션을 비공개로 바꿉니다. 기존 공유 링크는 더 이상 동작하지 않습니다.
+func (c *Collection) Unpublish(now time.Time) {
+	if !c.Public {
+		return
+	}
+	c.Public = false
+	c.ShareToken = ""
+	c.UpdatedAt = now
+}
+
+func normalizeName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if n := len([]rune(name)); n == 0 || n > MaxNameLength {
+		return "", ErrInvalidName
+	}
+	return name, nil
+}
@@ -0,0 +1,15 @@
+package collection
+
+// CollectionRepository는 사용자 컬렉션 저장/조회를 담당하는 인터페이스입니다.
+type CollectionRepository interface {
+	// Save는 컬렉션을 저장합니다. ID가 0이면 새 ID를 배정합니다.
+	Save(c *Collection) error
+	// Update는 id 컬렉션을 저장소 잠금 안에서 읽어 change로 바꾼 뒤 저장합니다. 없으면 nil을 반환하며, change가 실패하면 저장하지 않습니다.
+	Update(id int, change func(*Collection) error) (*Collection, error)
+	Delete(id int) error
+	FindByID(id int) (*Collection, error)
+	// FindByOwner는 사용자의 컬렉션을 생성 순으로 반환합니다.
+	FindByOwner(ownerID int) ([]*Collection, error)
+	// FindByShareToken은 공유 토큰에 해당하는 공개 컬렉션을 반환합니다.
+	FindByShareToken(token string) (*Collection, error)
+}
@@ -1,5 +1,7 @@
 package course
 
+import "github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+
 // CourseGeolocation는 위도/경도 정보를 담는 도메인 구조체입니다.
 type CourseGeolocation struct {
 	Latitude  float64
@@ -9,8 +11,10 @@ type CourseGeolocation struct {
 // CourseNav는 내비게이션 포인트(출발지, 경유지, 도착지 등)를 나타냅니다.
 type CourseNav struct {
 	Type        string
-	Name        string
+	Name        i18n.LocalizedText
 	Geolocation CourseGeolocation
+	// Summit은 고개 정상 등 코스에서 가장 높은 지점인지 여부입니다. 날씨 조회에 사용합니다.
+	Summit bool
 }
 
 // CourseRatings는 코스의 5가지 특성 점수를 담습니다.
@@ -25,13 +29,55 @@ type CourseRatings struct {
 // CourseAggregate는 코스 도메인 모델입니다.
 type CourseAggregate struct {
 	ID              int
-	Name            string
+	Name            i18n.LocalizedText
 	Region          string
-	Tagline         string
-	Characteristics string
+	RegionCode      string // 시·도 지역 코드 (domain/region)
+	SubRegionCode   string // 시·군·구 지역 코드, 판별 불가 시 빈 값
+	Tagline         i18n.LocalizedText
+	Characteristics i18n.LocalizedText
 	NaverMapUrl     string
 	Nav             []CourseNav
-	Notes           string
+	Notes           i18n.LocalizedText
 	Styles          []string
 	Ratings         CourseRatings
-} 
\ No newline at end of file
+	// Availability는 계절 통제, 추천 시기, 시간대 제한입니다.
+	Availability Availability
+	// Route는 도로에 맞춘 주행 경로입니다. 경로를 아직 구하지 않았으면 nil입니다.
+	Route *CourseRoute `json:"-"`
+}
+
+// StartPoint는 코스 출발지 좌표를 반환합니다. 내비게이션 정보가 없으면 false를 반환합니다.
+func (c *CourseAggregate) StartPoint() (CourseGeolocation, bool) {
+	if len(c.Nav) == 0 {
+		return CourseGeolocation{}, false
+	}
+	return c.Nav[0].Geolocation, true
+}
+
+// EndPoint는 코스 도착지(마지막 내비게이션 포인트) 좌표를 반환합니다. 내비게이션 정보가 없으면 false를 반환합니다.
+func (c *CourseAggregate) EndPoint() (CourseGeolocation, bool) {
+	if len(c.Nav) == 0 {
+		return CourseGeolocation{}, false
+	}
+	return c.Nav[len(c.Nav)-1].Geolocation, true
+}
+
+// SummitPoint는 정상으로 표시된 내비게이션 포인트를 반환합니다. 없으면 false를 반환합니다.
+func (c *CourseAggregate) SummitPoint() (CourseNav, bool) {
+	for _, n := range c.Nav {
+		if n.Summit {
+			return n, true
+		}
+	}
+	return CourseNav{}, false
+}
+
+// ApproxLengthKm는 내비게이션 포인트를 순서대로 이은 직선거리 합(km)입니다.
+// 실제 도로 거리보다 짧으며, 주행 기록에 트랙이 없을 때 거리 추정에 사용합니다.
+func (c *CourseAggregate) ApproxLengthKm() float64 {
+	var km float64
+	for i := 1; i < len(c.Nav); i++ {
+		km += c.Nav[i-1].Geolocation.DistanceKm(c.Nav[i].Geolocation)
+	}
+	return km
+}
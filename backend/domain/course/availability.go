@@ -0,0 +1,201 @@
+package course
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+)
+
+// KST는 코스 통제 기간과 시간대 제한을 해석하는 기준 시간대입니다.
+var KST = time.FixedZone("KST", 9*60*60)
+
+// MonthDay는 연도와 관계없이 반복되는 날짜(월-일)입니다. JSON에서는 "MM-DD"로 표현합니다.
+type MonthDay struct {
+	Month time.Month
+	Day   int
+}
+
+// monthDayOf는 날짜의 월-일을 반환합니다.
+func monthDayOf(t time.Time) MonthDay {
+	return MonthDay{Month: t.Month(), Day: t.Day()}
+}
+
+func (d MonthDay) before(other MonthDay) bool {
+	if d.Month != other.Month {
+		return d.Month < other.Month
+	}
+	return d.Day < other.Day
+}
+
+func (d MonthDay) String() string {
+	return fmt.Sprintf("%02d-%02d", int(d.Month), d.Day)
+}
+
+func (d MonthDay) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+func (d *MonthDay) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	parsed, err := ParseMonthDay(s)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// ParseMonthDay는 "MM-DD" 형식의 날짜를 해석합니다.
+func ParseMonthDay(s string) (MonthDay, error) {
+	// 2024년은 윤년이라 02-29도 허용합니다.
+	t, err := time.Parse("2006-01-02", "2024-"+s)
+	if err != nil {
+		return MonthDay{}, fmt.Errorf("날짜 형식(MM-DD)이 올바르지 않습니다: %s", s)
+	}
+	return monthDayOf(t), nil
+}
+
+// ClockTime은 자정부터의 분(0~1439)으로 나타낸 하루 중 시각입니다. JSON에서는 "HH:MM"으로 표현합니다.
+type ClockTime int
+
+func clockOf(t time.Time) ClockTime {
+	return ClockTime(t.Hour()*60 + t.Minute())
+}
+
+func (c ClockTime) String() string {
+	return fmt.Sprintf("%02d:%02d", int(c)/60, int(c)%60)
+}
+
+func (c ClockTime) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
+
+func (c *ClockTime) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	parsed, err := ParseClockTime(s)
+	if err != nil {
+		return err
+	}
+	*c = parsed
+	return nil
+}
+
+// ParseClockTime은 "HH:MM" 형식의 시각을 해석합니다.
+func ParseClockTime(s string) (ClockTime, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("시각 형식(HH:MM)이 올바르지 않습니다: %s", s)
+	}
+	return clockOf(t), nil
+}
+
+// SeasonalClosure는 매년 반복되는 통제 기간입니다. From, To 모두 포함하며, To가 From보다 앞서면 해를 넘깁니다. (예: 12-01 ~ 03-31)
+type SeasonalClosure struct {
+	From   MonthDay
+	To     MonthDay
+	Reason i18n.LocalizedText
+}
+
+// Covers는 날짜가 통제 기간에 속하는지 확인합니다.
+func (s SeasonalClosure) Covers(d MonthDay) bool {
+	if s.To.before(s.From) {
+		return !d.before(s.From) || !s.To.before(d)
+	}
+	return !d.before(s.From) && !s.To.before(d)
+}
+
+// TimeWindow는 매일 반복되는 통행 제한 시간대입니다. From은 포함, To는 제외하며, To가 From보다 앞서면 자정을 넘깁니다. (예: 20:00 ~ 06:00)
+type TimeWindow struct {
+	From   ClockTime
+	To     ClockTime
+	Reason i18n.LocalizedText
+}
+
+// Covers는 시각이 제한 시간대에 속하는지 확인합니다.
+func (w TimeWindow) Covers(c ClockTime) bool {
+	if w.To < w.From {
+		return c >= w.From || c < w.To
+	}
+	return c >= w.From && c < w.To
+}
+
+// Availability는 코스의 계절·시간대 이용 정보입니다. 비어 있으면 연중 상시 통행으로 취급합니다.
+type Availability struct {
+	// Closures는 계절 통제 기간입니다.
+	Closures []SeasonalClosure
+	// RecommendedMonths는 주행을 추천하는 달(1~12)입니다. 비어 있으면 모든 달을 추천합니다.
+	RecommendedMonths []time.Month
+	// Restrictions는 매일 반복되는 통행 제한 시간대입니다.
+	Restrictions []TimeWindow
+}
+
+// ClosureOn은 날짜(KST 기준)에 해당하는 계절 통제를 반환합니다. 통제 중이 아니면 nil입니다.
+func (a Availability) ClosureOn(t time.Time) *SeasonalClosure {
+	d := monthDayOf(t.In(KST))
+	for i := range a.Closures {
+		if a.Closures[i].Covers(d) {
+			return &a.Closures[i]
+		}
+	}
+	return nil
+}
+
+// RestrictionAt은 시각(KST 기준)에 해당하는 시간대 제한을 반환합니다. 제한이 없으면 nil입니다.
+func (a Availability) RestrictionAt(t time.Time) *TimeWindow {
+	c := clockOf(t.In(KST))
+	for i := range a.Restrictions {
+		if a.Restrictions[i].Covers(c) {
+			return &a.Restrictions[i]
+		}
+	}
+	return nil
+}
+
+// OpenOn은 날짜(KST 기준)에 계절 통제가 없는지 확인합니다. 시간대 제한은 고려하지 않습니다.
+func (a Availability) OpenOn(t time.Time) bool {
+	return a.ClosureOn(t) == nil
+}
+
+// OpenAt은 시각(KST 기준)에 계절 통제와 시간대 제한이 모두 없는지 확인합니다.
+func (a Availability) OpenAt(t time.Time) bool {
+	return a.ClosureOn(t) == nil && a.RestrictionAt(t) == nil
+}
+
+// ClosedReasonAt은 시각(KST 기준)에 계절 통제나 시간대 제한이 있으면 그 사유를 반환합니다. 통행할 수 있으면 false를 반환합니다.
+func (a Availability) ClosedReasonAt(t time.Time) (i18n.LocalizedText, bool) {
+	if cl := a.ClosureOn(t); cl != nil {
+		return cl.Reason, true
+	}
+	if w := a.RestrictionAt(t); w != nil {
+		return w.Reason, true
+	}
+	return nil, false
+}
+
+// Recommends는 달이 추천 시기인지 확인합니다.
+func (a Availability) Recommends(m time.Month) bool {
+	return len(a.RecommendedMonths) == 0 || slices.Contains(a.RecommendedMonths, m)
+}
+
+// SuitableInMonth는 달이 추천 시기이고, 그 달 중 하루 이상 계절 통제가 없는지 확인합니다.
+func (a Availability) SuitableInMonth(m time.Month) bool {
+	if !a.Recommends(m) {
+		return false
+	}
+	// 윤년 기준으로 그 달의 모든 날을 확인합니다.
+	for d := time.Date(2024, m, 1, 0, 0, 0, 0, KST); d.Month() == m; d = d.AddDate(0, 0, 1) {
+		if a.OpenOn(d) {
+			return true
+		}
+	}
+	return false
+}
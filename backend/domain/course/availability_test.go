@@ -0,0 +1,109 @@
+package course
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+)
+
+// winterAndNight는 해를 넘기는 겨울 통제(12-01 ~ 03-31), 여름 통제(07-10 ~ 07-20)와
+// 자정을 넘기는 야간 제한(20:00 ~ 06:00)이 있는 코스입니다.
+var winterAndNight = Availability{
+	Closures: []SeasonalClosure{
+		{From: MonthDay{time.December, 1}, To: MonthDay{time.March, 31}, Reason: i18n.Text("동절기 통제")},
+		{From: MonthDay{time.July, 10}, To: MonthDay{time.July, 20}, Reason: i18n.Text("휴식년")},
+	},
+	Restrictions: []TimeWindow{{From: 20 * 60, To: 6 * 60, Reason: i18n.Text("야간 통행 금지")}},
+}
+
+func kst(year int, month time.Month, day, hour, min int) time.Time {
+	return time.Date(year, month, day, hour, min, 0, 0, KST)
+}
+
+func TestOpenOn(t *testing.T) {
+	tests := []struct {
+		name string
+		at   time.Time
+		want bool
+	}{
+		{"통제 전날", kst(2025, time.November, 30, 12, 0), true},
+		{"통제 첫날", kst(2025, time.December, 1, 0, 0), false},
+		{"해를 넘긴 1월", kst(2026, time.January, 15, 12, 0), false},
+		{"윤년 2월 29일", kst(2028, time.February, 29, 12, 0), false},
+		{"통제 마지막 날 밤", kst(2026, time.March, 31, 23, 59), false},
+		{"통제 다음 날", kst(2026, time.April, 1, 0, 0), true},
+		{"여름 통제 시작", kst(2025, time.July, 10, 0, 0), false},
+		{"여름 통제 끝", kst(2025, time.July, 20, 23, 59), false},
+		{"여름 통제 다음 날", kst(2025, time.July, 21, 0, 0), true},
+		// UTC로는 11월 30일이지만 KST로는 12월 1일이다.
+		{"UTC 11월 30일 15시", time.Date(2025, time.November, 30, 15, 0, 0, 0, time.UTC), false},
+		{"UTC 3월 31일 15시", time.Date(2026, time.March, 31, 15, 0, 0, 0, time.UTC), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := winterAndNight.OpenOn(tt.at); got != tt.want {
+				t.Fatalf("OpenOn(%v) = %v, want %v", tt.at, got, tt.want)
+			}
+		})
+	}
+	if !(Availability{}).OpenOn(kst(2026, time.January, 1, 0, 0)) {
+		t.Error("empty availability is closed")
+	}
+}
+
+func TestOpenAt(t *testing.T) {
+	tests := []struct {
+		name   string
+		at     time.Time
+		want   bool
+		reason string
+	}{
+		{"낮", kst(2025, time.May, 1, 12, 0), true, ""},
+		{"제한 직전", kst(2025, time.May, 1, 19, 59), true, ""},
+		{"제한 시작", kst(2025, time.May, 1, 20, 0), false, "야간 통행 금지"},
+		{"자정", kst(2025, time.May, 2, 0, 0), false, "야간 통행 금지"},
+		{"제한 끝 직전", kst(2025, time.May, 2, 5, 59), false, "야간 통행 금지"},
+		{"제한 끝", kst(2025, time.May, 2, 6, 0), true, ""},
+		// UTC 11시는 KST 20시다.
+		{"UTC 11시", time.Date(2025, time.May, 1, 11, 0, 0, 0, time.UTC), false, "야간 통행 금지"},
+		// 계절 통제가 시간대 제한보다 먼저다.
+		{"통제 기간의 낮", kst(2026, time.January, 1, 12, 0), false, "동절기 통제"},
+		{"통제 기간의 밤", kst(2026, time.January, 1, 22, 0), false, "동절기 통제"},
+		{"통제가 끝나는 새벽", kst(2026, time.April, 1, 5, 0), false, "야간 통행 금지"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := winterAndNight.OpenAt(tt.at); got != tt.want {
+				t.Fatalf("OpenAt(%v) = %v, want %v", tt.at, got, tt.want)
+			}
+			reason, closed := winterAndNight.ClosedReasonAt(tt.at)
+			if closed == tt.want || reason.String() != tt.reason {
+				t.Fatalf("ClosedReasonAt(%v) = %q, %v; want %q", tt.at, reason.String(), closed, tt.reason)
+			}
+		})
+	}
+}
+
+func TestTimeWindowCovers(t *testing.T) {
+	tests := []struct {
+		name   string
+		window TimeWindow
+		at     ClockTime
+		want   bool
+	}{
+		{"낮 시간대 시작 포함", TimeWindow{From: 9 * 60, To: 18 * 60}, 9 * 60, true},
+		{"낮 시간대 끝 제외", TimeWindow{From: 9 * 60, To: 18 * 60}, 18 * 60, false},
+		{"자정을 넘기는 시간대의 23:59", TimeWindow{From: 22 * 60, To: 2 * 60}, 23*60 + 59, true},
+		{"자정을 넘기는 시간대의 00:00", TimeWindow{From: 22 * 60, To: 2 * 60}, 0, true},
+		{"자정을 넘기는 시간대 밖", TimeWindow{From: 22 * 60, To: 2 * 60}, 12 * 60, false},
+		{"자정에 끝나는 시간대", TimeWindow{From: 22 * 60, To: 0}, 23 * 60, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.window.Covers(tt.at); got != tt.want {
+				t.Fatalf("Covers(%s) = %v, want %v", tt.at, got, tt.want)
+			}
+		})
+	}
+}
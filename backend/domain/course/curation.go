@@ -0,0 +1,47 @@
+package course
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+)
+
+var (
+	ErrInvalidCourse  = errors.New("코스 정보가 올바르지 않습니다")
+	ErrCourseNotFound = errors.New("코스를 찾을 수 없습니다")
+	// ErrCourseInUse는 추천에서 사용 중이라 삭제할 수 없는 코스입니다.
+	ErrCourseInUse = errors.New("추천에서 사용 중인 코스입니다")
+	// ErrImagesUnavailable은 지도 이미지 생성 설정(네이버 지도 API)이 없는 경우입니다.
+	ErrImagesUnavailable = errors.New("지도 이미지를 생성할 수 없습니다")
+	ErrImageGeneration   = errors.New("지도 이미지 생성에 실패했습니다")
+)
+
+// Validate는 관리자가 입력한 코스 정보를 검증합니다. 스타일과 지역 이름은 분류 체계로 따로 확인합니다.
+func (c *CourseAggregate) Validate() error {
+	if strings.TrimSpace(c.Name[i18n.DefaultLang]) == "" {
+		return fmt.Errorf("%w: 한국어 이름이 필요합니다", ErrInvalidCourse)
+	}
+	if strings.TrimSpace(c.Region) == "" {
+		return fmt.Errorf("%w: 지역이 필요합니다", ErrInvalidCourse)
+	}
+	if err := c.Ratings.Validate(); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidCourse, err)
+	}
+	for i, n := range c.Nav {
+		if strings.TrimSpace(n.Name[i18n.DefaultLang]) == "" {
+			return fmt.Errorf("%w: 내비게이션 포인트 %d의 이름이 없습니다", ErrInvalidCourse, i+1)
+		}
+		g := n.Geolocation
+		if g.Latitude < -90 || g.Latitude > 90 || g.Longitude < -180 || g.Longitude > 180 {
+			return fmt.Errorf("%w: 내비게이션 포인트 %d의 좌표가 범위를 벗어났습니다", ErrInvalidCourse, i+1)
+		}
+	}
+	for _, m := range c.Availability.RecommendedMonths {
+		if m < 1 || m > 12 {
+			return fmt.Errorf("%w: 추천 시기 %d", ErrInvalidCourse, m)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,267 @@
+package course
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+)
+
+// EventType은 코스 변경 이벤트 종류입니다.
+type EventType string
+
+const (
+	EventCreated             EventType = "course_created"
+	EventDetailsChanged      EventType = "details_changed"
+	EventRatingsChanged      EventType = "ratings_changed"
+	EventNavUpdated          EventType = "nav_updated"
+	EventStylesChanged       EventType = "styles_changed"
+	EventAvailabilityChanged EventType = "availability_changed"
+	EventDeleted             EventType = "course_deleted"
+)
+
+// ErrInvalidEvent는 현재 상태에 적용할 수 없는 이벤트입니다. (예: 생성 전 코스의 평가 변경)
+var ErrInvalidEvent = errors.New("코스 이벤트를 적용할 수 없습니다")
+
+// Event는 코스 변경 하나를 나타내는 도메인 이벤트입니다. 저장된 이벤트는 고치거나 지우지 않고,
+// 코스의 현재 상태와 과거 상태는 이벤트를 순서대로 적용해 재구성합니다.
+type Event struct {
+	Seq      int // 저장소 전체 순번, 저장소가 배정
+	CourseID int
+	Version  int // 코스별 순번(1부터), 저장소가 배정
+	Type     EventType
+	ActorID  int    // 변경한 사용자, 0이면 데이터 파일(courses.json)에서 가져온 변경
+	Reason   string // 변경 사유
+	At       time.Time
+	// Data는 이벤트 종류별 변경 후 값입니다. 생성은 코스 전체, 평가 변경은 CourseRatings처럼 바뀐 묶음만 담고 삭제는 비어 있습니다.
+	Data json.RawMessage
+}
+
+// CourseDetails는 details_changed 이벤트의 값으로, 코스의 이름과 소개 문구입니다.
+type CourseDetails struct {
+	Name            i18n.LocalizedText
+	Region          string
+	Tagline         i18n.LocalizedText
+	Characteristics i18n.LocalizedText
+	NaverMapUrl     string
+	Notes           i18n.LocalizedText
+}
+
+func (c *CourseAggregate) details() CourseDetails {
+	return CourseDetails{
+		Name:            c.Name,
+		Region:          c.Region,
+		Tagline:         c.Tagline,
+		Characteristics: c.Characteristics,
+		NaverMapUrl:     c.NaverMapUrl,
+		Notes:           c.Notes,
+	}
+}
+
+// CourseSnapshot은 course_created 이벤트의 값으로, 지역 코드나 도로 경로 같은 파생 정보를 뺀 코스 전체입니다.
+type CourseSnapshot struct {
+	CourseDetails
+	Ratings      CourseRatings
+	Nav          []CourseNav
+	Styles       []string
+	Availability Availability
+}
+
+func (c *CourseAggregate) snapshot() CourseSnapshot {
+	return CourseSnapshot{
+		CourseDetails: c.details(),
+		Ratings:       c.Ratings,
+		Nav:           c.Nav,
+		Styles:        c.Styles,
+		Availability:  c.Availability,
+	}
+}
+
+func (c *CourseAggregate) setDetails(d CourseDetails) {
+	c.Name, c.Region, c.Tagline = d.Name, d.Region, d.Tagline
+	c.Characteristics, c.NaverMapUrl, c.Notes = d.Characteristics, d.NaverMapUrl, d.Notes
+}
+
+// NewEvents는 before에서 after로 바뀐 내용을 이벤트로 만듭니다. before가 nil이면 생성, after가 nil이면 삭제 이벤트이고,
+// 그 밖에는 바뀐 항목 묶음마다 이벤트 하나를 만듭니다. 바뀐 것이 없으면 빈 슬라이스를 반환합니다.
+// 비어 있는 값과 생략된 값(null, "", {}, [])은 같은 것으로 봅니다.
+func NewEvents(before, after *CourseAggregate, actorID int, reason string, at time.Time) ([]*Event, error) {
+	var events []*Event
+	add := func(courseID int, t EventType, data any) error {
+		e := &Event{CourseID: courseID, Type: t, ActorID: actorID, Reason: reason, At: at}
+		if data != nil {
+			raw, err := json.Marshal(data)
+			if err != nil {
+				return err
+			}
+			e.Data = raw
+		}
+		events = append(events, e)
+		return nil
+	}
+	switch {
+	case before == nil && after == nil:
+		return nil, nil
+	case before == nil:
+		err := add(after.ID, EventCreated, after.snapshot())
+		return events, err
+	case after == nil:
+		err := add(before.ID, EventDeleted, nil)
+		return events, err
+	}
+	groups := []struct {
+		t             EventType
+		before, after any
+	}{
+		{EventDetailsChanged, before.details(), after.details()},
+		{EventRatingsChanged, before.Ratings, after.Ratings},
+		{EventNavUpdated, before.Nav, after.Nav},
+		{EventStylesChanged, before.Styles, after.Styles},
+		{EventAvailabilityChanged, before.Availability, after.Availability},
+	}
+	for _, g := range groups {
+		same, err := equivalent(g.before, g.after)
+		if err != nil {
+			return nil, err
+		}
+		if same {
+			continue
+		}
+		if err := add(after.ID, g.t, g.after); err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
+}
+
+// Apply는 c에 이벤트를 적용한 새 코스를 반환합니다. c는 바꾸지 않으며, 삭제 이벤트면 nil을 반환합니다.
+// 아직 없는 코스에는 생성 이벤트만, 있는 코스에는 생성 외의 이벤트만 적용할 수 있습니다.
+func Apply(c *CourseAggregate, e *Event) (*CourseAggregate, error) {
+	if e.Type == EventCreated {
+		if c != nil {
+			return nil, fmt.Errorf("%w: 코스 %d가 이미 있습니다 (버전 %d)", ErrInvalidEvent, e.CourseID, e.Version)
+		}
+		var s CourseSnapshot
+		if err := json.Unmarshal(e.Data, &s); err != nil {
+			return nil, fmt.Errorf("코스 %d 버전 %d: %w", e.CourseID, e.Version, err)
+		}
+		next := &CourseAggregate{ID: e.CourseID, Ratings: s.Ratings, Nav: s.Nav, Styles: s.Styles, Availability: s.Availability}
+		next.setDetails(s.CourseDetails)
+		return next, nil
+	}
+	if c == nil {
+		return nil, fmt.Errorf("%w: 코스 %d가 없습니다 (버전 %d, %s)", ErrInvalidEvent, e.CourseID, e.Version, e.Type)
+	}
+	next := *c
+	var err error
+	switch e.Type {
+	case EventDetailsChanged:
+		var d CourseDetails
+		if err = json.Unmarshal(e.Data, &d); err == nil {
+			next.setDetails(d)
+		}
+	case EventRatingsChanged:
+		var r CourseRatings
+		if err = json.Unmarshal(e.Data, &r); err == nil {
+			next.Ratings = r
+		}
+	case EventNavUpdated:
+		var nav []CourseNav
+		if err = json.Unmarshal(e.Data, &nav); err == nil {
+			next.Nav = nav
+		}
+	case EventStylesChanged:
+		var styles []string
+		if err = json.Unmarshal(e.Data, &styles); err == nil {
+			next.Styles = styles
+		}
+	case EventAvailabilityChanged:
+		var a Availability
+		if err = json.Unmarshal(e.Data, &a); err == nil {
+			next.Availability = a
+		}
+	case EventDeleted:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("%w: 알 수 없는 이벤트 %s", ErrInvalidEvent, e.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("코스 %d 버전 %d: %w", e.CourseID, e.Version, err)
+	}
+	return &next, nil
+}
+
+// Replay는 한 코스의 이벤트를 순서대로 적용해 상태를 재구성합니다. 생성 전이거나 삭제된 상태면 nil을 반환합니다.
+func Replay(events []*Event) (*CourseAggregate, error) {
+	var c *CourseAggregate
+	for _, e := range events {
+		var err error
+		if c, err = Apply(c, e); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// LatestVersion은 한 코스의 이벤트 중 마지막 버전을 반환합니다. 이벤트가 없으면 0입니다.
+func LatestVersion(events []*Event) int {
+	if len(events) == 0 {
+		return 0
+	}
+	return events[len(events)-1].Version
+}
+
+// equivalent는 두 값의 JSON 표현을 비교합니다. 비어 있는 값은 생략된 것으로 봅니다.
+func equivalent(a, b any) (bool, error) {
+	va, err := canonicalJSON(a)
+	if err != nil {
+		return false, err
+	}
+	vb, err := canonicalJSON(b)
+	if err != nil {
+		return false, err
+	}
+	return reflect.DeepEqual(va, vb), nil
+}
+
+func canonicalJSON(v any) (any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var decoded any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, err
+	}
+	return prune(decoded), nil
+}
+
+// prune은 null, 빈 문자열, 빈 객체·배열을 nil로 바꾸고 객체에서 그런 필드를 지웁니다.
+func prune(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, item := range v {
+			if v[k] = prune(item); v[k] == nil {
+				delete(v, k)
+			}
+		}
+		if len(v) == 0 {
+			return nil
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = prune(item)
+		}
+		if len(v) == 0 {
+			return nil
+		}
+	case string:
+		if v == "" {
+			return nil
+		}
+	}
+	return v
+}
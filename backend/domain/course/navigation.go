@@ -0,0 +1,208 @@
+package course
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+)
+
+// NavApp은 딥 링크를 만드는 내비게이션 앱입니다.
+type NavApp string
+
+const (
+	NavAppKakaoNavi  NavApp = "kakaoNavi"
+	NavAppTmap       NavApp = "tmap"
+	NavAppNaver      NavApp = "naverMap"
+	NavAppGoogleMaps NavApp = "googleMaps"
+	NavAppAppleMaps  NavApp = "appleMaps"
+)
+
+// NavLinkOptions는 앱별 딥 링크에 필요한 식별 정보입니다.
+type NavLinkOptions struct {
+	// NaverAppName은 네이버 지도 URL Scheme의 appname(앱 패키지명 또는 웹 URL)입니다.
+	NaverAppName string
+	// KakaoAppKey는 카카오내비 호출에 필요한 JavaScript 키입니다. 비어 있으면 카카오내비 링크를 만들지 않습니다.
+	KakaoAppKey string
+}
+
+// navAppSpec은 앱의 경유지 제한과 링크 형식입니다.
+type navAppSpec struct {
+	// maxWaypoints는 링크 하나에 넣을 수 있는 경유지 수입니다.
+	maxWaypoints int
+	// fromCurrentLocation이 true면 출발지를 지정할 수 없고 현재 위치에서 출발합니다.
+	// 이 경우 코스 출발지도 경유지로 넣고, 다음 링크는 이전 링크의 도착지에서 이어집니다.
+	fromCurrentLocation bool
+	build               func(points []navPoint, opts NavLinkOptions) (string, bool)
+}
+
+type navPoint struct {
+	name string
+	lat  float64
+	lng  float64
+}
+
+func (p navPoint) latLng() string {
+	return coord(p.lat) + "," + coord(p.lng)
+}
+
+func coord(v float64) string {
+	return strconv.FormatFloat(v, 'f', 6, 64)
+}
+
+// round6은 좌표를 소수점 6자리(약 10cm)로 자릅니다.
+func round6(v float64) float64 {
+	return math.Round(v*1e6) / 1e6
+}
+
+var navAppSpecs = map[NavApp]navAppSpec{
+	NavAppKakaoNavi:  {maxWaypoints: 3, fromCurrentLocation: true, build: kakaoNaviLink},
+	NavAppTmap:       {maxWaypoints: 5, fromCurrentLocation: true, build: tmapLink},
+	NavAppNaver:      {maxWaypoints: 5, build: naverMapLink},
+	NavAppGoogleMaps: {maxWaypoints: 9, build: googleMapsLink},
+	NavAppAppleMaps:  {maxWaypoints: 0, build: appleMapsLink},
+}
+
+// NavigationLinks는 내비게이션 포인트를 모두 경유하는 앱별 딥 링크를 만듭니다.
+// 포인트가 앱의 경유지 제한을 넘으면 링크를 여러 개로 나누며, 순서대로 실행하면 코스 전체를 주행합니다.
+// 포인트가 2개 미만이거나 필요한 식별 정보가 없는 앱은 결과에서 빠집니다. 지점 이름은 lang 언어로 넣습니다.
+func (c *CourseAggregate) NavigationLinks(lang i18n.Lang, opts NavLinkOptions) map[NavApp][]string {
+	if len(c.Nav) < 2 {
+		return nil
+	}
+	points := make([]navPoint, len(c.Nav))
+	for i, n := range c.Nav {
+		points[i] = navPoint{name: n.Name.In(lang), lat: n.Geolocation.Latitude, lng: n.Geolocation.Longitude}
+	}
+	links := map[NavApp][]string{}
+	for app, spec := range navAppSpecs {
+		for _, leg := range splitLegs(points, spec) {
+			link, ok := spec.build(leg, opts)
+			if !ok {
+				delete(links, app)
+				break
+			}
+			links[app] = append(links[app], link)
+		}
+	}
+	return links
+}
+
+// splitLegs는 포인트를 링크 하나에 들어가는 구간으로 나눕니다.
+// 출발지를 지정하는 앱은 구간의 끝점이 다음 구간의 시작점이 되고,
+// 현재 위치에서 출발하는 앱은 구간이 겹치지 않습니다. (각 구간의 마지막 포인트가 목적지)
+func splitLegs(points []navPoint, spec navAppSpec) [][]navPoint {
+	var legs [][]navPoint
+	if spec.fromCurrentLocation {
+		size := spec.maxWaypoints + 1
+		for start := 0; start < len(points); start += size {
+			legs = append(legs, points[start:min(start+size, len(points))])
+		}
+		return legs
+	}
+	size := spec.maxWaypoints + 2
+	for start := 0; start < len(points)-1; start += size - 1 {
+		legs = append(legs, points[start:min(start+size, len(points))])
+	}
+	return legs
+}
+
+// kakaoNaviLink는 카카오내비 웹 호출 링크를 만듭니다. 앱이 설치되어 있으면 앱으로 연결됩니다.
+func kakaoNaviLink(points []navPoint, opts NavLinkOptions) (string, bool) {
+	if opts.KakaoAppKey == "" {
+		return "", false
+	}
+	type location struct {
+		Name string  `json:"name"`
+		X    float64 `json:"x"`
+		Y    float64 `json:"y"`
+	}
+	toLocation := func(p navPoint) location { return location{Name: p.name, X: round6(p.lng), Y: round6(p.lat)} }
+	dest := points[len(points)-1]
+	param := struct {
+		Destination location   `json:"destination"`
+		ViaList     []location `json:"via_list,omitempty"`
+		Option      struct {
+			CoordType string `json:"coord_type"`
+		} `json:"option"`
+	}{Destination: toLocation(dest)}
+	for _, p := range points[:len(points)-1] {
+		param.ViaList = append(param.ViaList, toLocation(p))
+	}
+	param.Option.CoordType = "wgs84"
+	data, _ := json.Marshal(param)
+	q := url.Values{}
+	q.Set("appkey", opts.KakaoAppKey)
+	q.Set("apiver", "1.0")
+	q.Set("param", string(data))
+	return "https://kakaonavi-wguide.kakao.com/openapi?" + q.Encode(), true
+}
+
+// tmapLink는 T map 길안내 URL Scheme을 만듭니다. 현재 위치에서 출발합니다.
+func tmapLink(points []navPoint, _ NavLinkOptions) (string, bool) {
+	dest := points[len(points)-1]
+	q := url.Values{}
+	q.Set("rGoName", dest.name)
+	q.Set("rGoX", coord(dest.lng))
+	q.Set("rGoY", coord(dest.lat))
+	for i, p := range points[:len(points)-1] {
+		prefix := fmt.Sprintf("rV%d", i+1)
+		q.Set(prefix+"Name", p.name)
+		q.Set(prefix+"X", coord(p.lng))
+		q.Set(prefix+"Y", coord(p.lat))
+	}
+	return "tmap://route?" + q.Encode(), true
+}
+
+// naverMapLink는 네이버 지도 자동차 길찾기 URL Scheme을 만듭니다.
+func naverMapLink(points []navPoint, opts NavLinkOptions) (string, bool) {
+	if opts.NaverAppName == "" {
+		return "", false
+	}
+	start, dest := points[0], points[len(points)-1]
+	q := url.Values{}
+	q.Set("slat", coord(start.lat))
+	q.Set("slng", coord(start.lng))
+	q.Set("sname", start.name)
+	q.Set("dlat", coord(dest.lat))
+	q.Set("dlng", coord(dest.lng))
+	q.Set("dname", dest.name)
+	for i, p := range points[1 : len(points)-1] {
+		prefix := fmt.Sprintf("v%d", i+1)
+		q.Set(prefix+"lat", coord(p.lat))
+		q.Set(prefix+"lng", coord(p.lng))
+		q.Set(prefix+"name", p.name)
+	}
+	q.Set("appname", opts.NaverAppName)
+	return "nmap://route/car?" + q.Encode(), true
+}
+
+// googleMapsLink는 Google Maps 길찾기 URL(api=1)을 만듭니다.
+func googleMapsLink(points []navPoint, _ NavLinkOptions) (string, bool) {
+	q := url.Values{}
+	q.Set("api", "1")
+	q.Set("origin", points[0].latLng())
+	q.Set("destination", points[len(points)-1].latLng())
+	if via := points[1 : len(points)-1]; len(via) > 0 {
+		coords := make([]string, len(via))
+		for i, p := range via {
+			coords[i] = p.latLng()
+		}
+		q.Set("waypoints", strings.Join(coords, "|"))
+	}
+	q.Set("travelmode", "driving")
+	return "https://www.google.com/maps/dir/?" + q.Encode(), true
+}
+
+// appleMapsLink는 Apple 지도 길찾기 링크를 만듭니다. 경유지를 지원하지 않아 구간마다 링크를 만듭니다.
+func appleMapsLink(points []navPoint, _ NavLinkOptions) (string, bool) {
+	q := url.Values{}
+	q.Set("saddr", points[0].latLng())
+	q.Set("daddr", points[len(points)-1].latLng())
+	q.Set("dirflg", "d")
+	return "https://maps.apple.com/?" + q.Encode(), true
+}
@@ -0,0 +1,216 @@
+package course
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+)
+
+// testPoints는 이름이 p0, p1, …이고 북쪽으로 0.01도씩 떨어진 포인트 n개입니다.
+func testPoints(n int) []navPoint {
+	points := make([]navPoint, n)
+	for i := range points {
+		points[i] = navPoint{name: fmt.Sprintf("p%d", i), lat: 37 + float64(i)*0.01, lng: 127}
+	}
+	return points
+}
+
+// legIndexes는 구간을 포인트 번호로 바꿉니다.
+func legIndexes(legs [][]navPoint) [][]int {
+	out := make([][]int, len(legs))
+	for i, leg := range legs {
+		for _, p := range leg {
+			var n int
+			fmt.Sscanf(p.name, "p%d", &n)
+			out[i] = append(out[i], n)
+		}
+	}
+	return out
+}
+
+func TestSplitLegs(t *testing.T) {
+	tests := []struct {
+		name   string
+		app    NavApp
+		points int
+		want   [][]int
+	}{
+		// 현재 위치에서 출발하는 앱은 코스 출발지도 경유지로 넣고, 다음 링크는 앞 링크의 도착지(현재 위치)에서 이어진다.
+		{"카카오내비 2개", NavAppKakaoNavi, 2, [][]int{{0, 1}}},
+		{"카카오내비 경유지 3개 꽉 참", NavAppKakaoNavi, 4, [][]int{{0, 1, 2, 3}}},
+		{"카카오내비 제한+1", NavAppKakaoNavi, 5, [][]int{{0, 1, 2, 3}, {4}}},
+		{"카카오내비 세 구간", NavAppKakaoNavi, 9, [][]int{{0, 1, 2, 3}, {4, 5, 6, 7}, {8}}},
+		{"T map 2개", NavAppTmap, 2, [][]int{{0, 1}}},
+		{"T map 경유지 5개 꽉 참", NavAppTmap, 6, [][]int{{0, 1, 2, 3, 4, 5}}},
+		{"T map 제한+1", NavAppTmap, 7, [][]int{{0, 1, 2, 3, 4, 5}, {6}}},
+		// 출발지를 지정하는 앱은 앞 구간의 도착지가 다음 구간의 출발지다.
+		{"네이버 지도 2개", NavAppNaver, 2, [][]int{{0, 1}}},
+		{"네이버 지도 경유지 5개 꽉 참", NavAppNaver, 7, [][]int{{0, 1, 2, 3, 4, 5, 6}}},
+		{"네이버 지도 제한+1", NavAppNaver, 8, [][]int{{0, 1, 2, 3, 4, 5, 6}, {6, 7}}},
+		{"Google 지도 2개", NavAppGoogleMaps, 2, [][]int{{0, 1}}},
+		{"Google 지도 경유지 9개 꽉 참", NavAppGoogleMaps, 11, [][]int{{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10}}},
+		{"Google 지도 제한+1", NavAppGoogleMaps, 12, [][]int{{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, {10, 11}}},
+		// Apple 지도는 경유지가 없어 포인트 사이마다 링크 하나다.
+		{"Apple 지도 2개", NavAppAppleMaps, 2, [][]int{{0, 1}}},
+		{"Apple 지도 3개", NavAppAppleMaps, 3, [][]int{{0, 1}, {1, 2}}},
+		{"Apple 지도 5개", NavAppAppleMaps, 5, [][]int{{0, 1}, {1, 2}, {2, 3}, {3, 4}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := navAppSpecs[tt.app]
+			legs := splitLegs(testPoints(tt.points), spec)
+			if got := legIndexes(legs); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("splitLegs(%d) = %v, want %v", tt.points, got, tt.want)
+			}
+			for i, leg := range legs {
+				via := len(leg) - 2 // 출발지와 도착지를 뺀 경유지
+				if spec.fromCurrentLocation {
+					via = len(leg) - 1 // 도착지만 뺀다
+				}
+				if via > spec.maxWaypoints {
+					t.Errorf("leg %d has %d waypoints, limit %d", i, via, spec.maxWaypoints)
+				}
+			}
+		})
+	}
+}
+
+func TestNavigationLinks(t *testing.T) {
+	c := &CourseAggregate{Nav: []CourseNav{
+		{Type: "출발지", Name: i18n.Text("출발"), Geolocation: CourseGeolocation{Latitude: 37.1, Longitude: 127.1}},
+		{Type: "경유지 1", Name: i18n.Text("경유"), Geolocation: CourseGeolocation{Latitude: 37.2, Longitude: 127.2}},
+		{Type: "도착지", Name: i18n.Text("도착"), Geolocation: CourseGeolocation{Latitude: 37.3, Longitude: 127.3}},
+	}}
+	opts := NavLinkOptions{NaverAppName: "winding-road-finder", KakaoAppKey: "kakao-key"}
+
+	tests := []struct {
+		app   NavApp
+		check func(t *testing.T, links []string)
+	}{
+		{NavAppKakaoNavi, func(t *testing.T, links []string) {
+			q := query(t, links[0], "https://kakaonavi-wguide.kakao.com/openapi")
+			var param struct {
+				Destination struct {
+					Name string  `json:"name"`
+					X    float64 `json:"x"`
+					Y    float64 `json:"y"`
+				} `json:"destination"`
+				ViaList []struct {
+					Name string `json:"name"`
+				} `json:"via_list"`
+				Option struct {
+					CoordType string `json:"coord_type"`
+				} `json:"option"`
+			}
+			if err := json.Unmarshal([]byte(q.Get("param")), &param); err != nil {
+				t.Fatal(err)
+			}
+			var via []string
+			for _, v := range param.ViaList {
+				via = append(via, v.Name)
+			}
+			// 현재 위치에서 출발하므로 코스 출발지도 경유지다.
+			if q.Get("appkey") != "kakao-key" || param.Destination.Name != "도착" || param.Destination.X != 127.3 || param.Destination.Y != 37.3 ||
+				!slices.Equal(via, []string{"출발", "경유"}) || param.Option.CoordType != "wgs84" {
+				t.Fatalf("query = %v, param = %+v", q, param)
+			}
+		}},
+		{NavAppTmap, func(t *testing.T, links []string) {
+			q := query(t, links[0], "tmap://route")
+			// 현재 위치에서 출발하므로 코스 출발지가 첫 경유지다.
+			assertQuery(t, q, map[string]string{
+				"rGoName": "도착", "rGoX": "127.300000", "rGoY": "37.300000",
+				"rV1Name": "출발", "rV1X": "127.100000", "rV1Y": "37.100000",
+				"rV2Name": "경유", "rV2X": "127.200000", "rV2Y": "37.200000",
+			})
+		}},
+		{NavAppNaver, func(t *testing.T, links []string) {
+			q := query(t, links[0], "nmap://route/car")
+			assertQuery(t, q, map[string]string{
+				"sname": "출발", "slat": "37.100000", "slng": "127.100000",
+				"v1name": "경유", "v1lat": "37.200000", "v1lng": "127.200000",
+				"dname": "도착", "dlat": "37.300000", "dlng": "127.300000",
+				"appname": "winding-road-finder",
+			})
+		}},
+		{NavAppGoogleMaps, func(t *testing.T, links []string) {
+			q := query(t, links[0], "https://www.google.com/maps/dir/")
+			assertQuery(t, q, map[string]string{
+				"api": "1", "origin": "37.100000,127.100000", "waypoints": "37.200000,127.200000",
+				"destination": "37.300000,127.300000", "travelmode": "driving",
+			})
+		}},
+		{NavAppAppleMaps, func(t *testing.T, links []string) {
+			// 경유지가 없어 구간마다 링크를 만들고, 앞 링크의 도착지에서 다음 링크가 출발한다.
+			if len(links) != 2 {
+				t.Fatalf("len(links) = %d, want 2", len(links))
+			}
+			assertQuery(t, query(t, links[0], "https://maps.apple.com/"), map[string]string{"saddr": "37.100000,127.100000", "daddr": "37.200000,127.200000", "dirflg": "d"})
+			assertQuery(t, query(t, links[1], "https://maps.apple.com/"), map[string]string{"saddr": "37.200000,127.200000", "daddr": "37.300000,127.300000", "dirflg": "d"})
+		}},
+	}
+	links := c.NavigationLinks(i18n.Korean, opts)
+	if len(links) != len(tests) {
+		t.Fatalf("apps = %d, want %d", len(links), len(tests))
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.app), func(t *testing.T) {
+			if len(links[tt.app]) == 0 {
+				t.Fatal("no links")
+			}
+			tt.check(t, links[tt.app])
+		})
+	}
+}
+
+// 식별 정보가 없는 앱과 포인트가 2개 미만인 코스는 링크를 만들지 않는다.
+func TestNavigationLinksOmitted(t *testing.T) {
+	nav := []CourseNav{
+		{Name: i18n.Text("출발"), Geolocation: CourseGeolocation{Latitude: 37.1, Longitude: 127.1}},
+		{Name: i18n.Text("도착"), Geolocation: CourseGeolocation{Latitude: 37.3, Longitude: 127.3}},
+	}
+	links := (&CourseAggregate{Nav: nav}).NavigationLinks(i18n.Korean, NavLinkOptions{})
+	for _, app := range []NavApp{NavAppKakaoNavi, NavAppNaver} {
+		if _, ok := links[app]; ok {
+			t.Errorf("%s link without its key", app)
+		}
+	}
+	for _, app := range []NavApp{NavAppTmap, NavAppGoogleMaps, NavAppAppleMaps} {
+		if len(links[app]) != 1 {
+			t.Errorf("%s links = %v, want 1", app, links[app])
+		}
+	}
+	if links := (&CourseAggregate{Nav: nav[:1]}).NavigationLinks(i18n.Korean, NavLinkOptions{NaverAppName: "a", KakaoAppKey: "k"}); links != nil {
+		t.Errorf("links for one point = %v, want nil", links)
+	}
+}
+
+// query는 링크가 prefix로 시작하는지 확인하고 쿼리를 반환합니다.
+func query(t *testing.T, link, prefix string) url.Values {
+	t.Helper()
+	u, err := url.Parse(link)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := u.Scheme + "://" + u.Host + u.Path; got != prefix {
+		t.Fatalf("link %s, want prefix %s", link, prefix)
+	}
+	return u.Query()
+}
+
+func assertQuery(t *testing.T, q url.Values, want map[string]string) {
+	t.Helper()
+	if len(q) != len(want) {
+		t.Errorf("query has %d keys, want %d: %v", len(q), len(want), q)
+	}
+	for k, v := range want {
+		if got := q.Get(k); got != v {
+			t.Errorf("%s = %q, want %q", k, got, v)
+		}
+	}
+}
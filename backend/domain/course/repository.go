@@ -1,7 +1,47 @@
 package course
 
+import (
+	"context"
+	"time"
+)
+
+// CourseFilter는 코스 목록 조회 조건입니다.
+type CourseFilter struct {
+	Region string
+	Style  string
+	Search string
+	// SearchStyles는 검색어와 일치하는 스타일 slug 목록으로, 텍스트 검색과 OR 조건으로 적용됩니다.
+	SearchStyles []string
+	// Date가 있으면 그 날짜(KST)에 계절 통제가 없는 코스만 조회합니다.
+	Date *time.Time
+	// Month가 0이 아니면 그 달이 추천 시기이고 통제되지 않는 날이 있는 코스만 조회합니다.
+	Month time.Month
+}
+
 // CourseQueryRepository는 코스 목록/상세 조회를 담당하는 인터페이스입니다.
 type CourseQueryRepository interface {
-	FindAll(region, style, search string) ([]*CourseAggregate, error)
+	FindAll(filter CourseFilter) ([]*CourseAggregate, error)
 	FindByID(id int) (*CourseAggregate, error)
-} 
\ No newline at end of file
+}
+
+// ImageGenerator는 코스의 썸네일·상세 지도 이미지를 만듭니다.
+type ImageGenerator interface {
+	GenerateImageForCourse(ctx context.Context, c *CourseAggregate) error
+}
+
+// EventStore는 코스 이벤트를 덧붙이기만 하는 저장소입니다. 저장된 이벤트는 고치거나 지우지 않습니다.
+type EventStore interface {
+	// Append는 이벤트에 순번과 코스별 버전을 배정해 주어진 순서대로 저장합니다.
+	Append(events []*Event) error
+	// FindAfter는 순번이 seq보다 큰 이벤트를 순번순으로 반환합니다.
+	FindAfter(seq int) ([]*Event, error)
+	// FindByCourse는 코스의 이벤트를 버전순으로 반환합니다.
+	FindByCourse(courseID int) ([]*Event, error)
+}
+
+// CourseHistoryRepository는 코스 변경 이력과 특정 시점의 코스 상태를 조회합니다.
+type CourseHistoryRepository interface {
+	FindEvents(courseID int) ([]*Event, error)
+	// FindAt은 at 시점까지의 이벤트로 재구성한 코스를 반환합니다. 그때 없었거나 삭제된 상태면 nil입니다.
+	FindAt(courseID int, at time.Time) (*CourseAggregate, error)
+}
@@ -0,0 +1,41 @@
+package course
+
+import "time"
+
+// RouteIssueKind는 경로 탐색 결과에서 발견한 내비게이션 포인트 문제의 종류입니다.
+type RouteIssueKind string
+
+const (
+	// RouteIssueUnreachable은 이전 포인트에서 이 포인트까지 도로로 갈 수 없는 경우입니다.
+	RouteIssueUnreachable RouteIssueKind = "unreachable"
+	// RouteIssueOffRoad는 포인트가 가장 가까운 도로에서 멀리 떨어져 있는 경우입니다.
+	RouteIssueOffRoad RouteIssueKind = "off_road"
+	// RouteIssueOutOfOrder는 다음 포인트와 순서를 바꾸면 경로가 크게 짧아지는 경우입니다.
+	RouteIssueOutOfOrder RouteIssueKind = "out_of_order"
+)
+
+// RouteIssue는 내비게이션 포인트 하나의 경로 문제입니다. Nav는 CourseAggregate.Nav의 인덱스입니다.
+type RouteIssue struct {
+	Kind RouteIssueKind
+	Nav  int
+}
+
+// CourseRoute는 경로 탐색 엔진으로 내비게이션 포인트 사이를 실제 도로에 맞춰 구한 주행 경로입니다.
+type CourseRoute struct {
+	DistanceKm float64
+	Duration   time.Duration
+	// Geometry는 도로를 따라가는 경로 좌표입니다. 갈 수 없는 구간이 있으면 비어 있습니다.
+	Geometry   []CourseGeolocation
+	Issues     []RouteIssue
+	ComputedAt time.Time
+}
+
+// Reachable은 모든 구간을 도로로 이을 수 있었는지 확인합니다.
+func (r *CourseRoute) Reachable() bool {
+	for _, issue := range r.Issues {
+		if issue.Kind == RouteIssueUnreachable {
+			return false
+		}
+	}
+	return len(r.Geometry) > 0
+}
@@ -0,0 +1,103 @@
+package course
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidRating은 범위를 벗어난 평가 점수입니다.
+var ErrInvalidRating = errors.New("평가 점수가 올바르지 않습니다")
+
+// RatingAxis는 코스 평가 항목(5가지 특성) 식별자입니다.
+type RatingAxis string
+
+const (
+	AxisTech    RatingAxis = "tech"
+	AxisSpeed   RatingAxis = "speed"
+	AxisScenery RatingAxis = "scenery"
+	AxisRoad    RatingAxis = "road"
+	AxisAccess  RatingAxis = "access"
+)
+
+// RatingAxes는 평가 항목 목록입니다.
+var RatingAxes = []RatingAxis{AxisTech, AxisSpeed, AxisScenery, AxisRoad, AxisAccess}
+
+// NavRole은 내비게이션 포인트의 역할입니다.
+type NavRole string
+
+const (
+	NavStart    NavRole = "start"
+	NavWaypoint NavRole = "waypoint"
+	NavEnd      NavRole = "end"
+)
+
+// Role은 데이터 파일의 Type("출발지", "경유지 1", "경유지-2", "도착지")에서 역할과 경유지 번호(없으면 0)를 읽습니다.
+// 알 수 없는 형식이면 ok가 false입니다.
+func (n CourseNav) Role() (role NavRole, ordinal int, ok bool) {
+	t := strings.TrimSpace(n.Type)
+	switch t {
+	case "출발지":
+		return NavStart, 0, true
+	case "도착지":
+		return NavEnd, 0, true
+	case "경유지":
+		return NavWaypoint, 0, true
+	}
+	rest, found := strings.CutPrefix(t, "경유지")
+	if !found {
+		return "", 0, false
+	}
+	ordinal, err := strconv.Atoi(strings.TrimLeft(rest, " -"))
+	if err != nil || ordinal < 1 {
+		return "", 0, false
+	}
+	return NavWaypoint, ordinal, true
+}
+
+const (
+	// MinRating과 MaxRating은 평가 점수의 범위입니다.
+	MinRating = 1
+	MaxRating = 5
+
+	earthRadiusKm = 6371.0
+)
+
+// Get은 평가 항목의 점수를 반환합니다.
+func (r CourseRatings) Get(axis RatingAxis) int {
+	switch axis {
+	case AxisTech:
+		return r.Tech
+	case AxisSpeed:
+		return r.Speed
+	case AxisScenery:
+		return r.Scenery
+	case AxisRoad:
+		return r.Road
+	case AxisAccess:
+		return r.Access
+	}
+	return 0
+}
+
+// Validate는 모든 평가 항목이 MinRating~MaxRating 범위인지 확인합니다.
+func (r CourseRatings) Validate() error {
+	for _, axis := range RatingAxes {
+		if v := r.Get(axis); v < MinRating || v > MaxRating {
+			return fmt.Errorf("%w: %s=%d", ErrInvalidRating, axis, v)
+		}
+	}
+	return nil
+}
+
+// DistanceKm는 두 좌표 사이의 대권 거리(haversine)를 km 단위로 계산합니다.
+func (g CourseGeolocation) DistanceKm(other CourseGeolocation) float64 {
+	lat1 := g.Latitude * math.Pi / 180
+	lat2 := other.Latitude * math.Pi / 180
+	dLat := lat2 - lat1
+	dLng := (other.Longitude - g.Longitude) * math.Pi / 180
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(a))
+}
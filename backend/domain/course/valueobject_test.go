@@ -0,0 +1,32 @@
+package course
+
+import "testing"
+
+func TestCourseNavRole(t *testing.T) {
+	tests := []struct {
+		typ     string
+		role    NavRole
+		ordinal int
+		ok      bool
+	}{
+		{"출발지", NavStart, 0, true},
+		{"도착지", NavEnd, 0, true},
+		{"경유지", NavWaypoint, 0, true},
+		{"경유지 1", NavWaypoint, 1, true},
+		{"경유지 12", NavWaypoint, 12, true},
+		{"경유지-3", NavWaypoint, 3, true},
+		{" 도착지 ", NavEnd, 0, true},
+		{"경유지 0", "", 0, false},
+		{"경유지 A", "", 0, false},
+		{"정상", "", 0, false},
+		{"", "", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.typ, func(t *testing.T) {
+			role, ordinal, ok := CourseNav{Type: tt.typ}.Role()
+			if role != tt.role || ordinal != tt.ordinal || ok != tt.ok {
+				t.Fatalf("Role(%q) = %q, %d, %v; want %q, %d, %v", tt.typ, role, ordinal, ok, tt.role, tt.ordinal, tt.ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package drive
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// MaxCarLength는 차량 이름의 최대 글자 수입니다.
+const MaxCarLength = 50
+
+var (
+	ErrInvalidDriveDate = errors.New("주행일이 올바르지 않습니다")
+	ErrCarTooLong       = errors.New("차량 이름은 50자 이하여야 합니다")
+	ErrDriveNotFound    = errors.New("주행 기록을 찾을 수 없습니다")
+	ErrUnknownCourse    = errors.New("존재하지 않는 코스입니다")
+)
+
+// Drive는 사용자가 코스를 달린 기록입니다.
+type Drive struct {
+	ID       int
+	UserID   int
+	CourseID int
+	// DrivenOn은 주행일(날짜만 사용, KST 기준)입니다.
+	DrivenOn  time.Time
+	Direction Direction
+	Car       string
+	Weather   Weather
+	// Track은 업로드한 GPX 궤적으로, 없으면 nil입니다.
+	Track     *Track
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Details는 주행 기록에서 사용자가 입력하는 항목입니다.
+type Details struct {
+	CourseID  int
+	DrivenOn  time.Time
+	Direction Direction
+	Car       string
+	Weather   Weather
+}
+
+// NewDrive는 입력을 검증해 주행 기록을 만듭니다. 주행일은 기록 시각 이후일 수 없습니다. ID는 저장소가 배정합니다.
+func NewDrive(userID int, details Details, now time.Time) (*Drive, error) {
+	d := &Drive{UserID: userID, CreatedAt: now}
+	if err := d.Update(details, now); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// OwnedBy는 사용자가 기록 작성자인지 확인합니다.
+func (d *Drive) OwnedBy(userID int) bool {
+	return d.UserID == userID
+}
+
+// Update는 입력 항목을 검증해 바꿉니다.
+func (d *Drive) Update(details Details, now time.Time) error {
+	if details.DrivenOn.IsZero() || details.DrivenOn.After(now) {
+		return ErrInvalidDriveDate
+	}
+	car := strings.TrimSpace(details.Car)
+	if len([]rune(car)) > MaxCarLength {
+		return ErrCarTooLong
+	}
+	d.CourseID = details.CourseID
+	d.DrivenOn = details.DrivenOn
+	d.Direction = details.Direction
+	d.Car = car
+	d.Weather = details.Weather
+	d.UpdatedAt = now
+	return nil
+}
+
+// AttachTrack은 GPX 궤적을 붙입니다. 기존 궤적은 교체됩니다.
+func (d *Drive) AttachTrack(t *Track, now time.Time) {
+	d.Track = t
+	d.UpdatedAt = now
+}
+
+// DetachTrack은 궤적을 지웁니다.
+func (d *Drive) DetachTrack(now time.Time) {
+	d.Track = nil
+	d.UpdatedAt = now
+}
+
+// DistanceKm는 주행 거리(km)입니다. 궤적이 있으면 궤적 거리를, 없으면 코스의 추정 거리를 사용합니다.
+func (d *Drive) DistanceKm(courseLengthKm float64) float64 {
+	if d.Track != nil {
+		return d.Track.DistanceKm()
+	}
+	return courseLengthKm
+}
@@ -0,0 +1,70 @@
+package drive
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+)
+
+// MaxTrackPoints는 트랙 하나에 저장하는 최대 점 수입니다.
+const MaxTrackPoints = 20000
+
+var ErrInvalidTrack = errors.New("GPX 트랙을 읽을 수 없습니다")
+
+type gpxDocument struct {
+	Tracks []struct {
+		Segments []struct {
+			Points []gpxPoint `xml:"trkpt"`
+		} `xml:"trkseg"`
+	} `xml:"trk"`
+	Routes []struct {
+		Points []gpxPoint `xml:"rtept"`
+	} `xml:"rte"`
+}
+
+// gpxPoint는 trkpt, rtept 점입니다. lat, lon은 GPX 스키마의 필수 속성이라 빠지면 nil로 남겨 거부합니다.
+type gpxPoint struct {
+	Lat *float64 `xml:"lat,attr"`
+	Lon *float64 `xml:"lon,attr"`
+}
+
+// ParseGPX는 GPX 1.1 문서에서 트랙 점(trkpt)을 순서대로 읽습니다. 트랙이 없으면 경로 점(rtept)을 사용합니다.
+// 점이 2개 미만이거나 MaxTrackPoints를 넘거나, lat·lon 속성이 없거나 범위를 벗어난 점이 있으면 ErrInvalidTrack을 반환합니다.
+func ParseGPX(r io.Reader) (*Track, error) {
+	var doc gpxDocument
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTrack, err)
+	}
+	var points []gpxPoint
+	for _, trk := range doc.Tracks {
+		for _, seg := range trk.Segments {
+			points = append(points, seg.Points...)
+		}
+	}
+	if len(points) == 0 {
+		for _, rte := range doc.Routes {
+			points = append(points, rte.Points...)
+		}
+	}
+	if len(points) < 2 {
+		return nil, fmt.Errorf("%w: 점이 2개 이상 필요합니다", ErrInvalidTrack)
+	}
+	if len(points) > MaxTrackPoints {
+		return nil, fmt.Errorf("%w: 점은 %d개까지 저장할 수 있습니다", ErrInvalidTrack, MaxTrackPoints)
+	}
+	track := &Track{Points: make([]course.CourseGeolocation, len(points))}
+	for i, p := range points {
+		if p.Lat == nil || p.Lon == nil {
+			return nil, fmt.Errorf("%w: %d번째 점에 lat, lon 속성이 없습니다", ErrInvalidTrack, i+1)
+		}
+		lat, lon := *p.Lat, *p.Lon
+		if lat < -90 || lat > 90 || lon < -180 || lon > 180 {
+			return nil, fmt.Errorf("%w: 좌표 범위를 벗어났습니다 (%g, %g)", ErrInvalidTrack, lat, lon)
+		}
+		track.Points[i] = course.CourseGeolocation{Latitude: lat, Longitude: lon}
+	}
+	return track, nil
+}
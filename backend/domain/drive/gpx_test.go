@@ -0,0 +1,74 @@
+package drive
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+)
+
+func gpx(body string) string {
+	return `<?xml version="1.0" encoding="UTF-8"?><gpx version="1.1" creator="test" xmlns="http://www.topografix.com/GPX/1/1">` + body + `</gpx>`
+}
+
+func TestParseGPX(t *testing.T) {
+	var many strings.Builder
+	for i := range MaxTrackPoints + 1 {
+		fmt.Fprintf(&many, `<trkpt lat="37.%05d" lon="127.0"/>`, i)
+	}
+	tests := []struct {
+		name string
+		doc  string
+		want []course.CourseGeolocation
+		err  bool
+	}{
+		{
+			name: "트랙 세그먼트를 이어 붙인다",
+			doc:  gpx(`<trk><trkseg><trkpt lat="37.1" lon="127.1"><ele>100</ele></trkpt><trkpt lat="37.2" lon="127.2"/></trkseg><trkseg><trkpt lat="37.3" lon="127.3"/></trkseg></trk>`),
+			want: []course.CourseGeolocation{{Latitude: 37.1, Longitude: 127.1}, {Latitude: 37.2, Longitude: 127.2}, {Latitude: 37.3, Longitude: 127.3}},
+		},
+		{
+			name: "트랙이 없으면 경로 점",
+			doc:  gpx(`<rte><rtept lat="37.1" lon="127.1"/><rtept lat="37.2" lon="127.2"/></rte>`),
+			want: []course.CourseGeolocation{{Latitude: 37.1, Longitude: 127.1}, {Latitude: 37.2, Longitude: 127.2}},
+		},
+		{
+			name: "트랙이 있으면 경로 점은 쓰지 않는다",
+			doc:  gpx(`<rte><rtept lat="36" lon="126"/><rtept lat="36.5" lon="126.5"/></rte><trk><trkseg><trkpt lat="37.1" lon="127.1"/><trkpt lat="37.2" lon="127.2"/></trkseg></trk>`),
+			want: []course.CourseGeolocation{{Latitude: 37.1, Longitude: 127.1}, {Latitude: 37.2, Longitude: 127.2}},
+		},
+		{name: "lat 없음", doc: gpx(`<trk><trkseg><trkpt lon="127.1"/><trkpt lat="37.2" lon="127.2"/></trkseg></trk>`), err: true},
+		{name: "lon 없음", doc: gpx(`<trk><trkseg><trkpt lat="37.1" lon="127.1"/><trkpt lat="37.2"/></trkseg></trk>`), err: true},
+		{name: "경로 점의 좌표 없음", doc: gpx(`<rte><rtept/><rtept lat="37.2" lon="127.2"/></rte>`), err: true},
+		{name: "숫자가 아닌 좌표", doc: gpx(`<trk><trkseg><trkpt lat="북위" lon="127.1"/><trkpt lat="37.2" lon="127.2"/></trkseg></trk>`), err: true},
+		{name: "범위를 벗어난 좌표", doc: gpx(`<trk><trkseg><trkpt lat="91" lon="127.1"/><trkpt lat="37.2" lon="127.2"/></trkseg></trk>`), err: true},
+		{name: "점 하나", doc: gpx(`<trk><trkseg><trkpt lat="37.1" lon="127.1"/></trkseg></trk>`), err: true},
+		{name: "점 없음", doc: gpx(``), err: true},
+		{name: "점이 너무 많음", doc: gpx(`<trk><trkseg>` + many.String() + `</trkseg></trk>`), err: true},
+		{name: "XML이 아님", doc: `{"type": "FeatureCollection"}`, err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			track, err := ParseGPX(strings.NewReader(tt.doc))
+			if tt.err {
+				if !errors.Is(err, ErrInvalidTrack) {
+					t.Fatalf("ParseGPX() error = %v, want %v", err, ErrInvalidTrack)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(track.Points) != len(tt.want) {
+				t.Fatalf("len(Points) = %d, want %d", len(track.Points), len(tt.want))
+			}
+			for i, p := range track.Points {
+				if p != tt.want[i] {
+					t.Errorf("Points[%d] = %v, want %v", i, p, tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package drive
+
+// DriveRepository는 주행 기록 저장/조회를 담당하는 인터페이스입니다.
+type DriveRepository interface {
+	// Save는 주행 기록을 저장합니다. ID가 0이면 새 ID를 배정합니다.
+	Save(d *Drive) error
+	// Update는 id 주행 기록을 저장소 잠금 안에서 읽어 change로 바꾼 뒤 저장합니다. 없으면 nil을 반환하며, change가 실패하면 저장하지 않습니다.
+	Update(id int, change func(*Drive) error) (*Drive, error)
+	Delete(id int) error
+	FindByID(id int) (*Drive, error)
+	// FindByUser는 사용자의 주행 기록을 주행일 최신순으로 반환합니다.
+	FindByUser(userID int) ([]*Drive, error)
+}
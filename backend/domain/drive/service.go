@@ -0,0 +1,19 @@
+package drive
+
+// Stats는 사용자의 주행 통계입니다.
+type Stats struct {
+	TotalDrives int
+	// CompletedCourses는 한 번 이상 달린 코스 ID 집합입니다.
+	CompletedCourses map[int]bool
+	TotalKm          float64
+}
+
+// Summarize는 주행 기록을 집계합니다. courseLengthKm는 코스별 추정 거리로, 궤적이 없는 기록의 거리에 사용합니다.
+func Summarize(drives []*Drive, courseLengthKm func(courseID int) float64) Stats {
+	stats := Stats{TotalDrives: len(drives), CompletedCourses: map[int]bool{}}
+	for _, d := range drives {
+		stats.CompletedCourses[d.CourseID] = true
+		stats.TotalKm += d.DistanceKm(courseLengthKm(d.CourseID))
+	}
+	return stats
+}
@@ -0,0 +1,51 @@
+package drive
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+)
+
+func TestSummarize(t *testing.T) {
+	// 위도 0.1도는 약 11.12km입니다.
+	track := &Track{Points: []course.CourseGeolocation{{Latitude: 37.0, Longitude: 127}, {Latitude: 37.1, Longitude: 127}}}
+	trackKm := track.DistanceKm()
+	lengths := map[int]float64{1: 20, 2: 35}
+	tests := []struct {
+		name      string
+		drives    []*Drive
+		completed []int
+		km        float64
+	}{
+		{"기록 없음", nil, nil, 0},
+		{"궤적이 없으면 코스 추정 거리", []*Drive{{CourseID: 1}}, []int{1}, 20},
+		{"궤적이 있으면 궤적 거리", []*Drive{{CourseID: 1, Track: track}}, []int{1}, trackKm},
+		{"같은 코스를 두 번", []*Drive{{CourseID: 2}, {CourseID: 2, Track: track}}, []int{2}, 35 + trackKm},
+		{"여러 코스", []*Drive{{CourseID: 1}, {CourseID: 2}}, []int{1, 2}, 55},
+		// 지금은 없는 코스의 기록도 주행 횟수와 완주 코스에 센다.
+		{"없는 코스", []*Drive{{CourseID: 9}}, []int{9}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stats := Summarize(tt.drives, func(id int) float64 { return lengths[id] })
+			if stats.TotalDrives != len(tt.drives) {
+				t.Errorf("TotalDrives = %d, want %d", stats.TotalDrives, len(tt.drives))
+			}
+			if len(stats.CompletedCourses) != len(tt.completed) {
+				t.Errorf("CompletedCourses = %v, want %v", stats.CompletedCourses, tt.completed)
+			}
+			for _, id := range tt.completed {
+				if !stats.CompletedCourses[id] {
+					t.Errorf("course %d not completed", id)
+				}
+			}
+			if math.Abs(stats.TotalKm-tt.km) > 1e-9 {
+				t.Errorf("TotalKm = %v, want %v", stats.TotalKm, tt.km)
+			}
+		})
+	}
+	if math.Abs(trackKm-11.12) > 0.01 {
+		t.Fatalf("track distance = %.3f, want about 11.12", trackKm)
+	}
+}
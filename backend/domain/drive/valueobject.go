@@ -0,0 +1,73 @@
+package drive
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+)
+
+var (
+	ErrInvalidDirection = errors.New("주행 방향은 forward 또는 reverse여야 합니다")
+	ErrInvalidWeather   = errors.New("알 수 없는 날씨입니다")
+)
+
+// Direction은 코스를 주행한 방향입니다. Nav 순서대로 달렸으면 forward, 반대로 달렸으면 reverse입니다.
+type Direction string
+
+const (
+	DirectionForward Direction = "forward"
+	DirectionReverse Direction = "reverse"
+)
+
+// ParseDirection은 문자열을 주행 방향으로 변환합니다. 빈 값은 forward로 취급합니다.
+func ParseDirection(s string) (Direction, error) {
+	switch Direction(s) {
+	case "", DirectionForward:
+		return DirectionForward, nil
+	case DirectionReverse:
+		return DirectionReverse, nil
+	}
+	return "", fmt.Errorf("%w: %s", ErrInvalidDirection, s)
+}
+
+// Weather는 주행 당시 날씨입니다. 기록하지 않았으면 빈 값입니다.
+type Weather string
+
+const (
+	WeatherClear  Weather = "clear"
+	WeatherCloudy Weather = "cloudy"
+	WeatherRain   Weather = "rain"
+	WeatherSnow   Weather = "snow"
+	WeatherFog    Weather = "fog"
+)
+
+// Weathers는 기록할 수 있는 날씨 목록입니다.
+var Weathers = []Weather{WeatherClear, WeatherCloudy, WeatherRain, WeatherSnow, WeatherFog}
+
+// ParseWeather는 문자열을 날씨로 변환합니다. 빈 값은 기록하지 않은 것으로 취급합니다.
+func ParseWeather(s string) (Weather, error) {
+	if s == "" {
+		return "", nil
+	}
+	for _, w := range Weathers {
+		if string(w) == s {
+			return w, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %s", ErrInvalidWeather, s)
+}
+
+// Track은 사용자가 올린 GPS 주행 궤적입니다.
+type Track struct {
+	Points []course.CourseGeolocation
+}
+
+// DistanceKm는 궤적 점을 순서대로 이은 거리(km)입니다.
+func (t *Track) DistanceKm() float64 {
+	var km float64
+	for i := 1; i < len(t.Points); i++ {
+		km += t.Points[i-1].DistanceKm(t.Points[i])
+	}
+	return km
+}
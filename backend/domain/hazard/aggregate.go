@@ -0,0 +1,129 @@
+package hazard
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+)
+
+// MaxNoteLength는 신고 메모의 최대 글자 수입니다.
+const MaxNoteLength = 500
+
+// MaxDistanceFromCourseKm는 신고 위치와 코스 내비게이션 포인트 사이의 최대 거리입니다.
+const MaxDistanceFromCourseKm = 5.0
+
+// maxLifetimeFactor는 확인 투표로 늘릴 수 있는 유효 기간의 상한입니다. 신고 시각부터 유형별 유효 기간의 이 배수까지만 연장합니다.
+const maxLifetimeFactor = 4
+
+// dismissThreshold는 신고를 해소 처리하는 최소 해소 투표 수입니다. 해소 투표가 확인 투표보다 많아야 합니다.
+const dismissThreshold = 3
+
+var (
+	ErrNoteTooLong      = errors.New("메모는 500자 이하여야 합니다")
+	ErrTooFarFromCourse = errors.New("신고 위치가 코스에서 너무 멉니다")
+	ErrHazardNotFound   = errors.New("위험 신고를 찾을 수 없습니다")
+	ErrUnknownCourse    = errors.New("존재하지 않는 코스입니다")
+	ErrOwnReport        = errors.New("자신의 신고에는 투표할 수 없습니다")
+)
+
+// Hazard는 사용자가 코스 위에 신고한 일시적인 위험 요소(통제, 자갈, 낙석, 단속 등)입니다.
+// 유형별 유효 기간이 지나거나 해소 투표가 쌓이면 더 이상 활성 상태가 아닙니다.
+type Hazard struct {
+	ID         int
+	CourseID   int
+	ReporterID int
+	Type       Type
+	Severity   Severity
+	Location   course.CourseGeolocation
+	Note       string
+	ReportedAt time.Time
+	ExpiresAt  time.Time
+	// Votes는 사용자 ID별 투표입니다. 신고자는 투표하지 않습니다.
+	Votes map[int]Vote
+	// Hidden은 관리자가 내린 신고인지 여부입니다. 숨긴 신고는 만료 여부와 관계없이 조회되지 않습니다.
+	Hidden bool
+}
+
+// Report는 위험 신고를 검증해 만듭니다. 만료 시각은 유형별 유효 기간으로 정합니다. ID는 저장소가 배정합니다.
+func Report(c *course.CourseAggregate, reporterID int, t Type, severity Severity, location course.CourseGeolocation, note string, now time.Time) (*Hazard, error) {
+	note = strings.TrimSpace(note)
+	if len([]rune(note)) > MaxNoteLength {
+		return nil, ErrNoteTooLong
+	}
+	if !nearCourse(c, location) {
+		return nil, ErrTooFarFromCourse
+	}
+	return &Hazard{
+		CourseID:   c.ID,
+		ReporterID: reporterID,
+		Type:       t,
+		Severity:   severity,
+		Location:   location,
+		Note:       note,
+		ReportedAt: now,
+		ExpiresAt:  now.Add(t.Lifetime()),
+		Votes:      map[int]Vote{},
+	}, nil
+}
+
+// CastVote는 투표를 기록합니다. 같은 사용자가 다시 투표하면 이전 투표를 바꿉니다.
+// 사용자의 첫 투표가 확인 투표일 때만 만료 시각을 투표 시각부터 다시 계산해 연장하며, 신고 시각부터 유효 기간의
+// maxLifetimeFactor배를 넘기지 않습니다. 투표를 바꿔 가며 만료를 계속 미룰 수 없습니다.
+// 관리자가 숨긴 신고는 없는 신고로 보고 ErrHazardNotFound를 반환합니다.
+func (h *Hazard) CastVote(userID int, v Vote, now time.Time) error {
+	if h.Hidden {
+		return ErrHazardNotFound
+	}
+	if userID == h.ReporterID {
+		return ErrOwnReport
+	}
+	if h.Votes == nil {
+		h.Votes = map[int]Vote{}
+	}
+	_, voted := h.Votes[userID]
+	h.Votes[userID] = v
+	if v == VoteConfirm && !voted {
+		limit := h.ReportedAt.Add(maxLifetimeFactor * h.Type.Lifetime())
+		if extended := now.Add(h.Type.Lifetime()); extended.After(h.ExpiresAt) {
+			h.ExpiresAt = extended
+		}
+		if h.ExpiresAt.After(limit) {
+			h.ExpiresAt = limit
+		}
+	}
+	return nil
+}
+
+// Tally는 확인, 해소 투표 수를 반환합니다.
+func (h *Hazard) Tally() (confirms, dismisses int) {
+	for _, v := range h.Votes {
+		if v == VoteConfirm {
+			confirms++
+		} else {
+			dismisses++
+		}
+	}
+	return confirms, dismisses
+}
+
+// Dismissed는 해소 투표로 신고가 내려졌는지 여부입니다.
+func (h *Hazard) Dismissed() bool {
+	confirms, dismisses := h.Tally()
+	return dismisses >= dismissThreshold && dismisses > confirms
+}
+
+// IsActive는 now 시점에 신고가 유효한지 여부입니다.
+func (h *Hazard) IsActive(now time.Time) bool {
+	return !h.Hidden && now.Before(h.ExpiresAt) && !h.Dismissed()
+}
+
+func nearCourse(c *course.CourseAggregate, p course.CourseGeolocation) bool {
+	for _, n := range c.Nav {
+		if n.Geolocation.DistanceKm(p) <= MaxDistanceFromCourseKm {
+			return true
+		}
+	}
+	return false
+}
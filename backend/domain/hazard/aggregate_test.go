@@ -0,0 +1,167 @@
+package hazard
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+)
+
+const reporter = 1
+
+var reportedAt = time.Date(2025, 5, 1, 9, 0, 0, 0, time.UTC)
+
+var hazardCourse = &course.CourseAggregate{
+	ID:  7,
+	Nav: []course.CourseNav{{Type: "start", Geolocation: course.CourseGeolocation{Latitude: 37.5, Longitude: 127.0}}},
+}
+
+// newHazard는 reportedAt에 신고한 사고(유효 기간 6시간)입니다.
+func newHazard(t *testing.T) *Hazard {
+	t.Helper()
+	h, err := Report(hazardCourse, reporter, TypeAccident, SeverityHigh, course.CourseGeolocation{Latitude: 37.5, Longitude: 127.0}, "", reportedAt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return h
+}
+
+func TestReport(t *testing.T) {
+	tests := []struct {
+		name     string
+		location course.CourseGeolocation
+		note     string
+		want     error
+	}{
+		{"코스 위", course.CourseGeolocation{Latitude: 37.5, Longitude: 127.0}, " 2차로 사고 ", nil},
+		// 위도 0.05도는 약 5.6km입니다.
+		{"코스에서 5km 넘게 떨어짐", course.CourseGeolocation{Latitude: 37.55, Longitude: 127.0}, "", ErrTooFarFromCourse},
+		{"너무 긴 메모", course.CourseGeolocation{Latitude: 37.5, Longitude: 127.0}, strings.Repeat("가", MaxNoteLength+1), ErrNoteTooLong},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, err := Report(hazardCourse, reporter, TypeAccident, SeverityHigh, tt.location, tt.note, reportedAt)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("Report() error = %v, want %v", err, tt.want)
+			}
+			if err != nil {
+				return
+			}
+			if h.Note != "2차로 사고" || !h.ExpiresAt.Equal(reportedAt.Add(6*time.Hour)) {
+				t.Fatalf("note = %q, expiresAt = %v", h.Note, h.ExpiresAt)
+			}
+		})
+	}
+}
+
+// 확인 투표는 사용자의 첫 투표일 때만 만료를 늘리고, 신고 시각부터 유효 기간의 4배를 넘기지 않는다.
+func TestCastVoteExpiry(t *testing.T) {
+	type vote struct {
+		user  int
+		vote  Vote
+		after time.Duration // 신고 시각부터 지난 시간
+	}
+	tests := []struct {
+		name  string
+		votes []vote
+		want  time.Duration // 신고 시각부터 만료까지
+	}{
+		{"투표 없음", nil, 6 * time.Hour},
+		{"첫 확인 투표는 투표 시각부터 다시 계산", []vote{{2, VoteConfirm, 2 * time.Hour}}, 8 * time.Hour},
+		{"해소 투표는 늘리지 않는다", []vote{{2, VoteDismiss, 2 * time.Hour}}, 6 * time.Hour},
+		{"같은 사용자의 두 번째 확인 투표", []vote{{2, VoteConfirm, time.Hour}, {2, VoteConfirm, 5 * time.Hour}}, 7 * time.Hour},
+		{"해소에서 확인으로 바꿔도 늘리지 않는다", []vote{{2, VoteDismiss, time.Hour}, {2, VoteConfirm, 5 * time.Hour}}, 6 * time.Hour},
+		{"다른 사용자의 확인 투표는 각각 늘린다", []vote{{2, VoteConfirm, 2 * time.Hour}, {3, VoteConfirm, 7 * time.Hour}}, 13 * time.Hour},
+		{"만료 뒤 확인 투표로 다시 활성화", []vote{{2, VoteConfirm, 10 * time.Hour}}, 16 * time.Hour},
+		{"연장은 유효 기간의 4배까지", []vote{{2, VoteConfirm, 5 * time.Hour}, {3, VoteConfirm, 10 * time.Hour}, {4, VoteConfirm, 15 * time.Hour}, {5, VoteConfirm, 20 * time.Hour}}, 24 * time.Hour},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newHazard(t)
+			for _, v := range tt.votes {
+				if err := h.CastVote(v.user, v.vote, reportedAt.Add(v.after)); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if got := h.ExpiresAt.Sub(reportedAt); got != tt.want {
+				t.Fatalf("expires after %v, want %v", got, tt.want)
+			}
+			if !h.IsActive(reportedAt.Add(tt.want-time.Minute)) || h.IsActive(reportedAt.Add(tt.want)) {
+				t.Fatalf("IsActive() around expiry %v is wrong", tt.want)
+			}
+		})
+	}
+}
+
+func TestCastVoteRejects(t *testing.T) {
+	tests := []struct {
+		name   string
+		hidden bool
+		user   int
+		want   error
+	}{
+		{"신고자 자신의 투표", false, reporter, ErrOwnReport},
+		{"숨긴 신고", true, 2, ErrHazardNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newHazard(t)
+			h.Hidden = tt.hidden
+			if err := h.CastVote(tt.user, VoteConfirm, reportedAt.Add(time.Hour)); !errors.Is(err, tt.want) {
+				t.Fatalf("CastVote() error = %v, want %v", err, tt.want)
+			}
+			if len(h.Votes) != 0 || !h.ExpiresAt.Equal(reportedAt.Add(6*time.Hour)) {
+				t.Fatalf("votes = %v, expiresAt = %v; want unchanged", h.Votes, h.ExpiresAt)
+			}
+		})
+	}
+}
+
+// 해소 투표가 3표 이상이고 확인 투표보다 많아야 신고가 내려간다.
+func TestDismissed(t *testing.T) {
+	tests := []struct {
+		name      string
+		confirms  int
+		dismisses int
+		want      bool
+	}{
+		{"투표 없음", 0, 0, false},
+		{"해소 2표", 0, 2, false},
+		{"해소 3표", 0, 3, true},
+		{"해소 3표, 확인 3표", 3, 3, false},
+		{"해소 4표, 확인 3표", 3, 4, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newHazard(t)
+			user := reporter
+			for i := 0; i < tt.confirms+tt.dismisses; i++ {
+				user++
+				v := VoteDismiss
+				if i < tt.confirms {
+					v = VoteConfirm
+				}
+				if err := h.CastVote(user, v, reportedAt); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if c, d := h.Tally(); c != tt.confirms || d != tt.dismisses {
+				t.Fatalf("Tally() = %d, %d; want %d, %d", c, d, tt.confirms, tt.dismisses)
+			}
+			if h.Dismissed() != tt.want || h.IsActive(reportedAt.Add(time.Hour)) == tt.want {
+				t.Fatalf("Dismissed() = %v, IsActive() = %v; want dismissed %v", h.Dismissed(), h.IsActive(reportedAt.Add(time.Hour)), tt.want)
+			}
+		})
+	}
+}
+
+// 숨긴 신고는 만료 전이어도 활성 상태가 아니다.
+func TestHiddenIsInactive(t *testing.T) {
+	h := newHazard(t)
+	h.Hidden = true
+	if h.IsActive(reportedAt) {
+		t.Fatal("hidden hazard is active")
+	}
+}
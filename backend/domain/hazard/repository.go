@@ -0,0 +1,13 @@
+package hazard
+
+// HazardRepository는 위험 신고 저장/조회를 담당하는 인터페이스입니다.
+type HazardRepository interface {
+	// Save는 신고를 저장합니다. ID가 0이면 새 ID를 배정합니다.
+	Save(h *Hazard) error
+	// Update는 id 신고를 저장소 잠금 안에서 읽어 change로 바꾼 뒤 저장합니다. 없으면 nil을 반환하며, change가 실패하면 저장하지 않습니다.
+	Update(id int, change func(*Hazard) error) (*Hazard, error)
+	FindByID(id int) (*Hazard, error)
+	// FindByCourse는 코스의 신고를 최신순으로 반환합니다.
+	FindByCourse(courseID int) ([]*Hazard, error)
+	FindAll() ([]*Hazard, error)
+}
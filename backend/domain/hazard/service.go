@@ -0,0 +1,18 @@
+package hazard
+
+import "time"
+
+// SummarizeActive는 now 시점에 활성인 신고를 코스별로 집계합니다. 활성 신고가 없는 코스는 포함하지 않습니다.
+func SummarizeActive(hazards []*Hazard, now time.Time) map[int]Summary {
+	result := map[int]Summary{}
+	for _, h := range hazards {
+		if !h.IsActive(now) {
+			continue
+		}
+		s := result[h.CourseID]
+		s.Count++
+		s.MaxSeverity = max(s.MaxSeverity, h.Severity)
+		result[h.CourseID] = s
+	}
+	return result
+}
@@ -0,0 +1,105 @@
+package hazard
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrInvalidType     = errors.New("알 수 없는 위험 유형입니다")
+	ErrInvalidSeverity = errors.New("알 수 없는 위험도입니다")
+	ErrInvalidVote     = errors.New("투표는 confirm 또는 dismiss여야 합니다")
+)
+
+// Type은 위험 요소 유형입니다.
+type Type string
+
+const (
+	TypeClosure    Type = "closure"    // 통제·통행 금지
+	TypeGravel     Type = "gravel"     // 모래·자갈
+	TypeLandslide  Type = "landslide"  // 낙석·산사태
+	TypeCheckpoint Type = "checkpoint" // 음주·과속 단속
+	TypeIce        Type = "ice"        // 결빙·블랙아이스
+	TypeAccident   Type = "accident"   // 사고
+	TypeOther      Type = "other"
+)
+
+// Types는 신고할 수 있는 위험 유형 목록입니다.
+var Types = []Type{TypeClosure, TypeGravel, TypeLandslide, TypeCheckpoint, TypeIce, TypeAccident, TypeOther}
+
+// lifetimes는 유형별 기본 유효 기간입니다. 새 사용자의 확인 투표를 받으면 투표 시각부터 다시 계산합니다.
+var lifetimes = map[Type]time.Duration{
+	TypeClosure:    72 * time.Hour,
+	TypeGravel:     7 * 24 * time.Hour,
+	TypeLandslide:  7 * 24 * time.Hour,
+	TypeCheckpoint: 3 * time.Hour,
+	TypeIce:        24 * time.Hour,
+	TypeAccident:   6 * time.Hour,
+	TypeOther:      24 * time.Hour,
+}
+
+// Lifetime은 유형의 기본 유효 기간입니다.
+func (t Type) Lifetime() time.Duration {
+	return lifetimes[t]
+}
+
+// ParseType은 문자열을 위험 유형으로 변환합니다.
+func ParseType(s string) (Type, error) {
+	if _, ok := lifetimes[Type(s)]; ok {
+		return Type(s), nil
+	}
+	return "", fmt.Errorf("%w: %s", ErrInvalidType, s)
+}
+
+// Severity는 위험도입니다. 값이 클수록 위험합니다.
+type Severity int
+
+const (
+	SeverityLow Severity = iota + 1
+	SeverityMedium
+	SeverityHigh
+)
+
+var severityNames = map[Severity]string{
+	SeverityLow:    "low",
+	SeverityMedium: "medium",
+	SeverityHigh:   "high",
+}
+
+func (s Severity) String() string {
+	return severityNames[s]
+}
+
+// ParseSeverity는 low, medium, high를 위험도로 변환합니다.
+func ParseSeverity(s string) (Severity, error) {
+	for sev, name := range severityNames {
+		if name == s {
+			return sev, nil
+		}
+	}
+	return 0, fmt.Errorf("%w: %s", ErrInvalidSeverity, s)
+}
+
+// Vote는 다른 사용자의 신고 확인(confirm) 또는 해소(dismiss) 투표입니다.
+type Vote string
+
+const (
+	VoteConfirm Vote = "confirm"
+	VoteDismiss Vote = "dismiss"
+)
+
+// ParseVote는 문자열을 투표로 변환합니다.
+func ParseVote(s string) (Vote, error) {
+	switch Vote(s) {
+	case VoteConfirm, VoteDismiss:
+		return Vote(s), nil
+	}
+	return "", fmt.Errorf("%w: %s", ErrInvalidVote, s)
+}
+
+// Summary는 코스의 활성 위험 요약입니다.
+type Summary struct {
+	Count       int
+	MaxSeverity Severity
+}
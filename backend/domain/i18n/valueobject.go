@@ -0,0 +1,90 @@
+package i18n
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Lang은 지원 언어 코드(ISO 639-1)입니다.
+type Lang string
+
+const (
+	Korean   Lang = "ko"
+	English  Lang = "en"
+	Japanese Lang = "ja"
+
+	// DefaultLang은 번역이 없을 때 사용하는 기본 언어입니다.
+	DefaultLang = Korean
+)
+
+// SupportedLangs는 API가 지원하는 언어 목록입니다. 첫 번째 항목이 기본 언어입니다.
+var SupportedLangs = []Lang{Korean, English, Japanese}
+
+// LocalizedText는 언어별 번역 문자열을 담는 값 객체입니다.
+// JSON에서는 {"ko": "...", "en": "..."} 객체 또는 한국어 단일 문자열로 표현할 수 있습니다.
+type LocalizedText map[Lang]string
+
+// Text는 한국어 문자열로 LocalizedText를 생성합니다.
+func Text(ko string) LocalizedText {
+	return LocalizedText{DefaultLang: ko}
+}
+
+// In은 지정한 언어의 문자열을 반환하며, 번역이 없으면 한국어로 대체합니다.
+func (t LocalizedText) In(lang Lang) string {
+	if s, ok := t[lang]; ok && s != "" {
+		return s
+	}
+	return t[DefaultLang]
+}
+
+// String은 기본 언어(한국어) 문자열을 반환합니다.
+func (t LocalizedText) String() string {
+	return t[DefaultLang]
+}
+
+// Contains는 어느 언어로든 부분 문자열을 포함하는지 대소문자 구분 없이 확인합니다.
+func (t LocalizedText) Contains(substr string) bool {
+	substr = strings.ToLower(substr)
+	for _, s := range t {
+		if strings.Contains(strings.ToLower(s), substr) {
+			return true
+		}
+	}
+	return false
+}
+
+// Equals는 어느 언어로든 문자열이 일치하는지 대소문자 구분 없이 확인합니다.
+func (t LocalizedText) Equals(s string) bool {
+	for _, v := range t {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// UnmarshalJSON은 번역 객체와 한국어 단일 문자열 표기를 모두 허용합니다.
+func (t *LocalizedText) UnmarshalJSON(data []byte) error {
+	var ko string
+	if err := json.Unmarshal(data, &ko); err == nil {
+		*t = Text(ko)
+		return nil
+	}
+	var m map[Lang]string
+	if err := json.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	*t = m
+	return nil
+}
+
+// ParseLang은 지원 언어 코드로 변환합니다. 지원하지 않는 값이면 false를 반환합니다.
+func ParseLang(s string) (Lang, bool) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	for _, l := range SupportedLangs {
+		if string(l) == s {
+			return l, true
+		}
+	}
+	return "", false
+}
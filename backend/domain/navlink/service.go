@@ -0,0 +1,137 @@
+package navlink
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+)
+
+var (
+	// ErrUnsupportedLink는 링크가 길찾기 경로가 아닐 때 반환합니다. (장소 페이지, 알 수 없는 형식 등)
+	ErrUnsupportedLink = errors.New("길찾기 경로 링크가 아닙니다")
+	// ErrUnavailable은 링크를 따라가지 못했을 때 반환합니다.
+	ErrUnavailable = errors.New("지도 링크를 확인할 수 없습니다")
+)
+
+// DriftToleranceM은 링크 지점과 내비게이션 포인트가 이 거리(m) 안이면 같은 지점으로 봅니다.
+const DriftToleranceM = 150
+
+// Point는 지도 길찾기 링크의 지점입니다.
+type Point struct {
+	Name        string
+	Geolocation course.CourseGeolocation
+}
+
+// Directions는 지도 길찾기 링크를 풀어 얻은 경로입니다. Points는 출발지, 경유지, 도착지 순입니다.
+type Directions struct {
+	URL    string
+	Points []Point
+}
+
+// Resolver는 단축 링크를 따라가 길찾기 경로를 구합니다.
+type Resolver interface {
+	Resolve(ctx context.Context, link string) (*Directions, error)
+}
+
+// PointDrift는 내비게이션 포인트와 대응하는 링크 지점 사이의 거리입니다.
+type PointDrift struct {
+	Nav       int
+	Link      int
+	DistanceM float64
+}
+
+// Report는 코스 내비게이션 포인트와 지도 링크 경로의 비교 결과입니다.
+type Report struct {
+	CourseID   int
+	NavPoints  int
+	LinkPoints int
+	// Drifts는 대응하는 지점 쌍의 거리입니다. 지점 수가 같으면 순서대로, 다르면 출발지와 도착지만 비교합니다.
+	Drifts []PointDrift
+}
+
+// CountMismatch는 내비게이션 포인트와 링크 지점 수가 다른지 확인합니다.
+func (r Report) CountMismatch() bool {
+	return r.NavPoints != r.LinkPoints
+}
+
+// Drifted는 DriftToleranceM보다 멀리 떨어진 지점 쌍을 반환합니다.
+func (r Report) Drifted() []PointDrift {
+	var result []PointDrift
+	for _, d := range r.Drifts {
+		if d.DistanceM > DriftToleranceM {
+			result = append(result, d)
+		}
+	}
+	return result
+}
+
+// Consistent는 지점 수가 같고 모든 지점이 허용 거리 안에 있는지 확인합니다.
+func (r Report) Consistent() bool {
+	return !r.CountMismatch() && len(r.Drifted()) == 0
+}
+
+func distanceM(a, b course.CourseGeolocation) float64 {
+	return a.DistanceKm(b) * 1000
+}
+
+// Compare는 코스 내비게이션 포인트를 링크 경로와 비교합니다.
+func Compare(c *course.CourseAggregate, d *Directions) Report {
+	r := Report{CourseID: c.ID, NavPoints: len(c.Nav), LinkPoints: len(d.Points)}
+	if len(c.Nav) == 0 || len(d.Points) == 0 {
+		return r
+	}
+	pair := func(nav, link int) {
+		r.Drifts = append(r.Drifts, PointDrift{Nav: nav, Link: link, DistanceM: distanceM(c.Nav[nav].Geolocation, d.Points[link].Geolocation)})
+	}
+	if !r.CountMismatch() {
+		for i := range c.Nav {
+			pair(i, i)
+		}
+		return r
+	}
+	pair(0, 0)
+	pair(len(c.Nav)-1, len(d.Points)-1)
+	return r
+}
+
+// Backfill은 링크 경로로 코스 내비게이션 포인트를 채웁니다.
+// 포인트가 없거나, 링크보다 적으면서 출발지·도착지가 링크와 일치할 때(경유지 누락)만 채우며, 그 외에는 false를 반환합니다.
+// 링크 지점과 허용 거리 안에 있는 기존 포인트는 그대로 두고, 종류만 출발지/경유지 N/도착지로 다시 매깁니다.
+func Backfill(c *course.CourseAggregate, d *Directions) ([]course.CourseNav, bool) {
+	if len(d.Points) < 2 {
+		return nil, false
+	}
+	if len(c.Nav) > 0 {
+		r := Compare(c, d)
+		if len(c.Nav) >= len(d.Points) || len(r.Drifted()) > 0 {
+			return nil, false
+		}
+	}
+	nav := make([]course.CourseNav, len(d.Points))
+	for i, p := range d.Points {
+		nav[i] = course.CourseNav{Name: i18n.Text(p.Name), Geolocation: p.Geolocation}
+		for _, existing := range c.Nav {
+			if distanceM(existing.Geolocation, p.Geolocation) <= DriftToleranceM {
+				nav[i] = existing
+				break
+			}
+		}
+		nav[i].Type = navType(i, len(d.Points))
+	}
+	return nav, true
+}
+
+// navType은 courses.json의 내비게이션 포인트 종류 표기입니다.
+func navType(i, n int) string {
+	switch i {
+	case 0:
+		return "출발지"
+	case n - 1:
+		return "도착지"
+	default:
+		return fmt.Sprintf("경유지 %d", i)
+	}
+}
@@ -0,0 +1,86 @@
+package photo
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+)
+
+const (
+	// MaxUploadBytes는 업로드할 수 있는 사진 파일의 최대 크기입니다.
+	MaxUploadBytes = 20 << 20
+	// MaxCaptionLength는 사진 설명의 최대 글자 수입니다.
+	MaxCaptionLength = 200
+)
+
+var (
+	ErrPhotoNotFound = errors.New("사진을 찾을 수 없습니다")
+	ErrUnknownCourse = errors.New("존재하지 않는 코스입니다")
+	// ErrUnsupportedImage는 JPEG, PNG가 아니거나 읽을 수 없는 이미지입니다.
+	ErrUnsupportedImage = errors.New("지원하지 않는 이미지 형식입니다")
+	ErrImageTooLarge    = errors.New("사진 파일이 너무 큽니다")
+	ErrCaptionTooLong   = errors.New("사진 설명은 200자 이하여야 합니다")
+	// ErrStoreUnavailable은 사진 저장소에 쓰거나 지우지 못한 경우입니다.
+	ErrStoreUnavailable = errors.New("사진 저장소를 사용할 수 없습니다")
+)
+
+// Photo는 코스에 올린 사진입니다. 원본은 보관하지 않고, 메타데이터를 지운 크기별 변형 이미지만 저장합니다.
+// EXIF의 촬영 위치와 시각은 올린 사람의 동선을 드러내므로 보관하지 않고, 경로 위에 배치한 결과만 남깁니다.
+type Photo struct {
+	ID         int
+	CourseID   int
+	UploaderID int
+	Caption    string
+	// RouteKm은 촬영 위치를 코스 경로에 투영한 출발지로부터의 거리(km)입니다. 경로에서 멀거나 위치가 없으면 nil입니다.
+	RouteKm *float64
+	// RoutePoint는 촬영 위치를 코스 경로에 투영한 지점입니다. RouteKm과 함께 정해집니다.
+	RoutePoint *course.CourseGeolocation
+	Variants   []Variant
+	UploadedAt time.Time
+}
+
+// Variant는 크기별로 줄인 사진 한 장입니다. Key는 MediaStore 안의 위치입니다.
+type Variant struct {
+	Name   VariantName
+	Width  int
+	Height int
+	Key    string
+}
+
+// New는 처리한 이미지로 사진을 만들고 코스 경로 위에 배치합니다. ID는 저장소가 배정합니다.
+func New(c *course.CourseAggregate, uploaderID int, caption string, img *Processed, variants []Variant, now time.Time) (*Photo, error) {
+	caption, err := NormalizeCaption(caption)
+	if err != nil {
+		return nil, err
+	}
+	p := &Photo{
+		CourseID:   c.ID,
+		UploaderID: uploaderID,
+		Caption:    caption,
+		Variants:   variants,
+		UploadedAt: now,
+	}
+	if img.Location != nil {
+		if km, point, ok := PlaceOnRoute(c, *img.Location); ok {
+			p.RouteKm, p.RoutePoint = &km, &point
+		}
+	}
+	return p, nil
+}
+
+// NormalizeCaption은 앞뒤 공백을 지우고 길이를 확인합니다.
+func NormalizeCaption(caption string) (string, error) {
+	caption = strings.TrimSpace(caption)
+	if utf8.RuneCountInString(caption) > MaxCaptionLength {
+		return "", ErrCaptionTooLong
+	}
+	return caption, nil
+}
+
+// UploadedBy는 사용자가 사진을 올린 사람인지 확인합니다.
+func (p *Photo) UploadedBy(userID int) bool {
+	return p.UploaderID == userID
+}
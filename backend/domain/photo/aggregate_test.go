@@ -0,0 +1,60 @@
+package photo
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+)
+
+// straightCourse는 북위 37.5도를 따라 동쪽으로 0.1도(약 8.8km) 가는 코스입니다.
+var straightCourse = &course.CourseAggregate{
+	ID: 7,
+	Nav: []course.CourseNav{
+		{Type: "start", Geolocation: course.CourseGeolocation{Latitude: 37.5, Longitude: 127.0}},
+		{Type: "end", Geolocation: course.CourseGeolocation{Latitude: 37.5, Longitude: 127.1}},
+	},
+}
+
+// 경로 가까이에서 찍은 사진만 경로 위 지점으로 배치하고, 촬영 위치와 시각은 남기지 않는다.
+func TestNewPlacesOnRoute(t *testing.T) {
+	takenAt := time.Date(2025, 5, 1, 7, 30, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		location  *course.CourseGeolocation
+		wantPoint *course.CourseGeolocation
+	}{
+		{"경로 옆 500m", &course.CourseGeolocation{Latitude: 37.5045, Longitude: 127.05}, &course.CourseGeolocation{Latitude: 37.5, Longitude: 127.05}},
+		{"경로에서 2km", &course.CourseGeolocation{Latitude: 37.518, Longitude: 127.05}, nil},
+		{"위치 없음", nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			img := &Processed{Location: tt.location, TakenAt: &takenAt}
+			p, err := New(straightCourse, 3, " 정상 ", img, nil, takenAt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if p.CourseID != 7 || p.UploaderID != 3 || p.Caption != "정상" {
+				t.Fatalf("photo = %+v", p)
+			}
+			if tt.wantPoint == nil {
+				if p.RouteKm != nil || p.RoutePoint != nil {
+					t.Fatalf("routeKm = %v, routePoint = %v; want unplaced", p.RouteKm, p.RoutePoint)
+				}
+				return
+			}
+			if p.RoutePoint == nil || p.RouteKm == nil {
+				t.Fatalf("routeKm = %v, routePoint = %v; want placed", p.RouteKm, p.RoutePoint)
+			}
+			if d := p.RoutePoint.DistanceKm(*tt.wantPoint); d > 0.01 {
+				t.Errorf("routePoint = %+v, want %+v", *p.RoutePoint, *tt.wantPoint)
+			}
+			want := straightCourse.Nav[0].Geolocation.DistanceKm(*tt.wantPoint)
+			if math.Abs(*p.RouteKm-want) > 0.01 {
+				t.Errorf("routeKm = %.3f, want %.3f", *p.RouteKm, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package photo
+
+// PhotoRepository는 사진 정보 저장/조회를 담당하는 인터페이스입니다. 파일 자체는 MediaStore에 저장합니다.
+type PhotoRepository interface {
+	// Save는 사진을 저장합니다. ID가 0이면 새 ID를 배정합니다.
+	Save(p *Photo) error
+	FindByID(id int) (*Photo, error)
+	// FindByCourse는 코스의 사진을 올린 순서대로 반환합니다.
+	FindByCourse(courseID int) ([]*Photo, error)
+	Delete(id int) error
+}
@@ -0,0 +1,112 @@
+package photo
+
+import (
+	"context"
+	"io"
+	"math"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+)
+
+// VariantName은 변형 이미지의 크기 이름입니다.
+type VariantName string
+
+const (
+	VariantThumb  VariantName = "thumb"
+	VariantMedium VariantName = "medium"
+	VariantLarge  VariantName = "large"
+)
+
+// VariantSpec은 변형 이미지의 긴 변 최대 길이입니다. 원본이 더 작으면 늘리지 않습니다.
+type VariantSpec struct {
+	Name    VariantName
+	MaxEdge int
+}
+
+// DefaultVariants는 업로드한 사진마다 만드는 변형 이미지입니다.
+var DefaultVariants = []VariantSpec{
+	{VariantThumb, 320},
+	{VariantMedium, 1024},
+	{VariantLarge, 2048},
+}
+
+// MaxRouteOffsetKm는 촬영 위치가 코스 경로에서 이 거리 안에 있어야 경로 위에 배치합니다.
+const MaxRouteOffsetKm = 1.0
+
+// ProcessedVariant는 인코딩을 마친 변형 이미지입니다.
+type ProcessedVariant struct {
+	Name        VariantName
+	Width       int
+	Height      int
+	ContentType string
+	Data        []byte
+}
+
+// Processed는 업로드한 이미지를 처리한 결과입니다. 변형 이미지에는 EXIF 등 메타데이터가 남지 않습니다.
+type Processed struct {
+	Variants []ProcessedVariant
+	Location *course.CourseGeolocation
+	TakenAt  *time.Time
+}
+
+// ImageProcessor는 업로드한 이미지에서 촬영 위치와 시각을 읽고, 방향을 바로잡아 크기별로 다시 인코딩합니다.
+// 읽을 수 없는 이미지면 ErrUnsupportedImage를 감싼 에러를 반환합니다.
+type ImageProcessor interface {
+	Process(data []byte, specs []VariantSpec) (*Processed, error)
+}
+
+// MediaStore는 사진 파일을 저장하는 곳입니다. (로컬 디스크, S3 호환 스토리지 등)
+// 쓰거나 지우지 못하면 ErrStoreUnavailable을 감싼 에러를 반환합니다.
+type MediaStore interface {
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+	Delete(ctx context.Context, key string) error
+	// URL은 key에 저장한 파일을 내려받을 수 있는 주소입니다.
+	URL(key string) string
+}
+
+// PlaceOnRoute는 위치를 코스 경로에 투영해 출발지로부터의 거리(km)와 경로 위의 투영점을 구합니다.
+// 도로 경로가 있으면 경로를, 없으면 내비게이션 포인트를 이은 직선을 사용하며, 경로에서 MaxRouteOffsetKm보다 멀면 false를 반환합니다.
+func PlaceOnRoute(c *course.CourseAggregate, p course.CourseGeolocation) (float64, course.CourseGeolocation, bool) {
+	var line []course.CourseGeolocation
+	if c.Route != nil && c.Route.Reachable() {
+		line = c.Route.Geometry
+	} else {
+		for _, n := range c.Nav {
+			line = append(line, n.Geolocation)
+		}
+	}
+	if len(line) == 0 {
+		return 0, course.CourseGeolocation{}, false
+	}
+	best, bestKm, bestPoint := math.Inf(1), 0.0, line[0]
+	if len(line) == 1 {
+		best = line[0].DistanceKm(p)
+	}
+	var travelled float64
+	for i := 1; i < len(line); i++ {
+		a, b := line[i-1], line[i]
+		segment := a.DistanceKm(b)
+		t := projectOnSegment(a, b, p)
+		foot := course.CourseGeolocation{
+			Latitude:  a.Latitude + t*(b.Latitude-a.Latitude),
+			Longitude: a.Longitude + t*(b.Longitude-a.Longitude),
+		}
+		if d := foot.DistanceKm(p); d < best {
+			best, bestKm, bestPoint = d, travelled+t*segment, foot
+		}
+		travelled += segment
+	}
+	return bestKm, bestPoint, best <= MaxRouteOffsetKm
+}
+
+// projectOnSegment는 p를 선분 ab에 수직 투영한 위치(0~1)를 구합니다. 짧은 구간이라 위경도를 평면 좌표로 근사합니다.
+func projectOnSegment(a, b, p course.CourseGeolocation) float64 {
+	scale := math.Cos(a.Latitude * math.Pi / 180)
+	dx, dy := (b.Longitude-a.Longitude)*scale, b.Latitude-a.Latitude
+	if dx == 0 && dy == 0 {
+		return 0
+	}
+	t := ((p.Longitude-a.Longitude)*scale*dx + (p.Latitude-a.Latitude)*dy) / (dx*dx + dy*dy)
+	return math.Max(0, math.Min(1, t))
+}
@@ -1,9 +1,80 @@
 package recommendation
 
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+)
+
+var (
+	ErrInvalidRecommendation  = errors.New("추천 정보가 올바르지 않습니다")
+	ErrRecommendationNotFound = errors.New("추천을 찾을 수 없습니다")
+	ErrUnknownCourse          = errors.New("존재하지 않는 코스입니다")
+	ErrInvalidOrder           = errors.New("순서에는 추천의 코스 ID가 빠짐없이 한 번씩 있어야 합니다")
+)
+
 // Recommendation은 추천 카테고리(코스 집합)를 나타냅니다.
+// Rule이 있으면 조회 시점에 규칙으로 코스를 고르고, 없으면 CourseIds를 그대로 사용합니다.
 type Recommendation struct {
 	ID          int
-	Title       string
-	Description string
+	Title       i18n.LocalizedText
+	Description i18n.LocalizedText
 	CourseIds   []int
-} 
\ No newline at end of file
+	// Rule은 코스 집합을 정의하는 규칙 문자열입니다. (예: style contains 헤어핀 AND tech >= 5 ORDER BY tech DESC LIMIT 5)
+	Rule string
+	// PinnedIds는 규칙 결과와 관계없이 맨 앞에 고정할 코스 ID입니다.
+	PinnedIds []int
+	// ExcludedIds는 규칙 결과에서 제외할 코스 ID입니다.
+	ExcludedIds []int
+}
+
+// IsDynamic은 규칙으로 코스를 고르는 추천인지 여부를 반환합니다.
+func (r *Recommendation) IsDynamic() bool {
+	return r.Rule != ""
+}
+
+// Validate는 제목과 코스 ID 목록을 검증합니다. 규칙 문법은 CompileRule로 따로 확인합니다.
+func (r *Recommendation) Validate() error {
+	if strings.TrimSpace(r.Title[i18n.DefaultLang]) == "" {
+		return fmt.Errorf("%w: 한국어 제목이 필요합니다", ErrInvalidRecommendation)
+	}
+	for _, ids := range [][]int{r.CourseIds, r.PinnedIds, r.ExcludedIds} {
+		seen := make(map[int]bool, len(ids))
+		for _, id := range ids {
+			if seen[id] {
+				return fmt.Errorf("%w: 코스 %d가 중복되었습니다", ErrInvalidRecommendation, id)
+			}
+			seen[id] = true
+		}
+	}
+	return nil
+}
+
+// CourseRefs는 추천이 직접 가리키는 코스 ID(CourseIds, PinnedIds, ExcludedIds)입니다.
+func (r *Recommendation) CourseRefs() []int {
+	return slices.Concat(r.CourseIds, r.PinnedIds, r.ExcludedIds)
+}
+
+// Reorder는 CourseIds 순서를 바꿉니다. order는 현재 코스 ID를 빠짐없이 한 번씩 포함해야 합니다.
+// 규칙 추천은 고정 코스(PinnedIds) 순서를 바꿉니다.
+func (r *Recommendation) Reorder(order []int) error {
+	ids := &r.CourseIds
+	if r.IsDynamic() {
+		ids = &r.PinnedIds
+	}
+	if len(order) != len(*ids) {
+		return ErrInvalidOrder
+	}
+	seen := make(map[int]bool, len(order))
+	for _, id := range order {
+		if seen[id] || !slices.Contains(*ids, id) {
+			return ErrInvalidOrder
+		}
+		seen[id] = true
+	}
+	*ids = slices.Clone(order)
+	return nil
+}
@@ -4,4 +4,14 @@ package recommendation
 type RecommendationRepository interface {
 	FindAll() ([]*Recommendation, error)
 	FindById(id int) (*Recommendation, error)
-} 
\ No newline at end of file
+}
+
+// RecommendationCommandRepository는 관리자의 추천 데이터 수정을 담당하는 인터페이스입니다.
+type RecommendationCommandRepository interface {
+	RecommendationRepository
+	// NextID는 ID가 0인 추천을 저장할 때 배정될 ID를 반환합니다.
+	NextID() (int, error)
+	// Save는 추천을 저장합니다. ID가 0이면 새 ID를 배정합니다.
+	Save(r *Recommendation) error
+	Delete(id int) error
+}
@@ -0,0 +1,468 @@
+package recommendation
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/region"
+	"github.com/sunDar0/winding-road-finder/backend/domain/style"
+)
+
+// ErrInvalidRule은 추천 규칙 문법 오류입니다.
+var ErrInvalidRule = errors.New("잘못된 추천 규칙")
+
+// 규칙에서 사용할 수 있는 필드입니다. 평가 항목(tech, speed, scenery, road, access)도 필드로 사용합니다.
+const (
+	FieldID              = "id"
+	FieldStyle           = "style"
+	FieldRegion          = "region"
+	FieldName            = "name"
+	FieldCharacteristics = "characteristics"
+)
+
+// Rule은 코스 집합을 정의하는 추천 규칙입니다.
+//
+// 문법:
+//
+//	[조건] [ORDER BY 필드 [ASC|DESC] {, 필드 [ASC|DESC]}] [LIMIT n]
+//	조건   := 항 {OR 항}
+//	항     := 인자 {AND 인자}
+//	인자   := NOT 인자 | ( 조건 ) | 필드 연산자 값
+//	연산자 := = != > >= < <= contains
+//
+// 예: style contains 헤어핀 AND tech >= 5 ORDER BY tech DESC LIMIT 5
+type Rule struct {
+	Where   Condition
+	OrderBy []OrderKey
+	// Limit은 결과 개수 제한입니다. 0이면 제한하지 않습니다.
+	Limit int
+}
+
+// OrderKey는 정렬 기준 하나입니다.
+type OrderKey struct {
+	Field string
+	Desc  bool
+}
+
+// Condition은 코스가 조건을 만족하는지 판별하는 규칙 트리의 노드입니다.
+type Condition interface {
+	Match(c *course.CourseAggregate) bool
+}
+
+type andCondition struct{ left, right Condition }
+type orCondition struct{ left, right Condition }
+type notCondition struct{ inner Condition }
+
+// Comparison은 "필드 연산자 값" 형태의 단일 비교입니다.
+type Comparison struct {
+	Field string
+	Op    string
+	Value string
+}
+
+func (a andCondition) Match(c *course.CourseAggregate) bool {
+	return a.left.Match(c) && a.right.Match(c)
+}
+func (o orCondition) Match(c *course.CourseAggregate) bool {
+	return o.left.Match(c) || o.right.Match(c)
+}
+func (n notCondition) Match(c *course.CourseAggregate) bool { return !n.inner.Match(c) }
+
+// Match는 코스가 비교 조건을 만족하는지 확인합니다.
+// style, region 값은 Normalize로 slug/지역 코드로 바꾼 뒤 평가해야 합니다.
+func (cmp *Comparison) Match(c *course.CourseAggregate) bool {
+	switch cmp.Field {
+	case FieldStyle:
+		has := slices.Contains(c.Styles, cmp.Value)
+		if cmp.Op == "!=" {
+			return !has
+		}
+		return has
+	case FieldRegion:
+		in := c.RegionCode == cmp.Value || c.SubRegionCode == cmp.Value
+		if cmp.Op == "!=" {
+			return !in
+		}
+		return in
+	case FieldName:
+		return cmp.matchText(c.Name.Contains(cmp.Value), c.Name.Equals(cmp.Value))
+	case FieldCharacteristics:
+		return cmp.matchText(c.Characteristics.Contains(cmp.Value), c.Characteristics.Equals(cmp.Value))
+	}
+	v, ok := numericField(c, cmp.Field)
+	if !ok {
+		return false
+	}
+	n, _ := strconv.Atoi(cmp.Value)
+	switch cmp.Op {
+	case "=":
+		return v == n
+	case "!=":
+		return v != n
+	case ">":
+		return v > n
+	case ">=":
+		return v >= n
+	case "<":
+		return v < n
+	case "<=":
+		return v <= n
+	}
+	return false
+}
+
+func (cmp *Comparison) matchText(contains, equals bool) bool {
+	switch cmp.Op {
+	case "contains":
+		return contains
+	case "!=":
+		return !equals
+	}
+	return equals
+}
+
+// numericField는 숫자형 필드 값을 반환합니다.
+func numericField(c *course.CourseAggregate, field string) (int, bool) {
+	if field == FieldID {
+		return c.ID, true
+	}
+	axis := course.RatingAxis(field)
+	if !slices.Contains(course.RatingAxes, axis) {
+		return 0, false
+	}
+	return c.Ratings.Get(axis), true
+}
+
+func isNumericField(field string) bool {
+	return field == FieldID || slices.Contains(course.RatingAxes, course.RatingAxis(field))
+}
+
+// Comparisons는 규칙에 포함된 비교 조건을 모두 반환합니다.
+func (r *Rule) Comparisons() []*Comparison {
+	var result []*Comparison
+	var walk func(Condition)
+	walk = func(cond Condition) {
+		switch n := cond.(type) {
+		case andCondition:
+			walk(n.left)
+			walk(n.right)
+		case orCondition:
+			walk(n.left)
+			walk(n.right)
+		case notCondition:
+			walk(n.inner)
+		case *Comparison:
+			result = append(result, n)
+		}
+	}
+	if r.Where != nil {
+		walk(r.Where)
+	}
+	return result
+}
+
+// Normalize는 field 비교 값을 resolve 결과로 바꿉니다. 스타일 이름을 slug로, 지역 이름을 코드로 바꿀 때 사용합니다.
+func (r *Rule) Normalize(field string, resolve func(string) (string, error)) error {
+	for _, cmp := range r.Comparisons() {
+		if cmp.Field != field {
+			continue
+		}
+		v, err := resolve(cmp.Value)
+		if err != nil {
+			return err
+		}
+		cmp.Value = v
+	}
+	return nil
+}
+
+// Apply는 조건에 맞는 코스를 정렬·제한해 반환합니다. 정렬 기준이 같으면 코스 ID 오름차순입니다.
+func (r *Rule) Apply(courses []*course.CourseAggregate) []*course.CourseAggregate {
+	var result []*course.CourseAggregate
+	for _, c := range courses {
+		if r.Where == nil || r.Where.Match(c) {
+			result = append(result, c)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		for _, key := range r.OrderBy {
+			a, _ := numericField(result[i], key.Field)
+			b, _ := numericField(result[j], key.Field)
+			if a == b {
+				continue
+			}
+			if key.Desc {
+				return a > b
+			}
+			return a < b
+		}
+		return result[i].ID < result[j].ID
+	})
+	if r.Limit > 0 && len(result) > r.Limit {
+		result = result[:r.Limit]
+	}
+	return result
+}
+
+// CompileRule은 규칙 문자열을 해석하고 스타일 이름은 slug로, 지역 이름은 코드로 바꿉니다.
+// 문법 오류나 알 수 없는 지역은 ErrInvalidRule, 알 수 없는 스타일은 style.ErrUnknownStyle로 감싸 반환합니다.
+func CompileRule(src string, taxonomy *style.Taxonomy, dir *region.Directory) (*Rule, error) {
+	rule, err := ParseRule(src)
+	if err != nil {
+		return nil, err
+	}
+	err = rule.Normalize(FieldStyle, func(term string) (string, error) {
+		slugs, err := taxonomy.Normalize([]string{term})
+		if err != nil {
+			return "", err
+		}
+		return slugs[0], nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = rule.Normalize(FieldRegion, func(name string) (string, error) {
+		r := dir.Resolve(name)
+		if r == nil {
+			return "", fmt.Errorf("%w: 알 수 없는 지역 %s", ErrInvalidRule, name)
+		}
+		return r.Code, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// ParseRule은 규칙 문자열을 해석합니다. 문법 오류는 ErrInvalidRule로 감싸 반환합니다.
+func ParseRule(src string) (*Rule, error) {
+	tokens, err := tokenize(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &ruleParser{tokens: tokens}
+	rule := &Rule{}
+	if !p.atKeyword("order") && !p.atKeyword("limit") && !p.done() {
+		if rule.Where, err = p.parseOr(); err != nil {
+			return nil, err
+		}
+	}
+	if p.atKeyword("order") {
+		p.next()
+		if !p.atKeyword("by") {
+			return nil, p.errorf("ORDER 뒤에 BY가 필요합니다")
+		}
+		p.next()
+		for {
+			field := strings.ToLower(p.next().text)
+			if !isNumericField(field) {
+				return nil, p.errorf("정렬할 수 없는 필드: %s", field)
+			}
+			key := OrderKey{Field: field}
+			if p.atKeyword("desc") {
+				key.Desc = true
+				p.next()
+			} else if p.atKeyword("asc") {
+				p.next()
+			}
+			rule.OrderBy = append(rule.OrderBy, key)
+			if p.peek().text != "," {
+				break
+			}
+			p.next()
+		}
+	}
+	if p.atKeyword("limit") {
+		p.next()
+		n, err := strconv.Atoi(p.next().text)
+		if err != nil || n < 1 {
+			return nil, p.errorf("LIMIT 값은 1 이상의 정수여야 합니다")
+		}
+		rule.Limit = n
+	}
+	if !p.done() {
+		return nil, p.errorf("예상하지 못한 토큰: %s", p.peek().text)
+	}
+	return rule, nil
+}
+
+type ruleToken struct {
+	text   string
+	quoted bool
+	pos    int
+}
+
+type ruleParser struct {
+	tokens []ruleToken
+	pos    int
+}
+
+func (p *ruleParser) done() bool { return p.pos >= len(p.tokens) }
+
+func (p *ruleParser) peek() ruleToken {
+	if p.done() {
+		return ruleToken{pos: -1}
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *ruleParser) next() ruleToken {
+	t := p.peek()
+	if !p.done() {
+		p.pos++
+	}
+	return t
+}
+
+func (p *ruleParser) atKeyword(kw string) bool {
+	t := p.peek()
+	return !t.quoted && strings.EqualFold(t.text, kw)
+}
+
+func (p *ruleParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w: %s (토큰 %d)", ErrInvalidRule, fmt.Sprintf(format, args...), p.pos)
+}
+
+func (p *ruleParser) parseOr() (Condition, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.atKeyword("or") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orCondition{left, right}
+	}
+	return left, nil
+}
+
+func (p *ruleParser) parseAnd() (Condition, error) {
+	left, err := p.parseFactor()
+	if err != nil {
+		return nil, err
+	}
+	for p.atKeyword("and") {
+		p.next()
+		right, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		left = andCondition{left, right}
+	}
+	return left, nil
+}
+
+func (p *ruleParser) parseFactor() (Condition, error) {
+	if p.atKeyword("not") {
+		p.next()
+		inner, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		return notCondition{inner}, nil
+	}
+	if t := p.peek(); !t.quoted && t.text == "(" {
+		p.next()
+		cond, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if t := p.next(); t.quoted || t.text != ")" {
+			return nil, p.errorf("닫는 괄호가 필요합니다")
+		}
+		return cond, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *ruleParser) parseComparison() (Condition, error) {
+	field := strings.ToLower(p.next().text)
+	op := strings.ToLower(p.next().text)
+	value := p.next()
+	if value.pos < 0 {
+		return nil, p.errorf("비교 값이 필요합니다")
+	}
+	cmp := &Comparison{Field: field, Op: op, Value: value.text}
+
+	switch {
+	case isNumericField(field):
+		if !slices.Contains([]string{"=", "!=", ">", ">=", "<", "<="}, op) {
+			return nil, p.errorf("%s 필드에 사용할 수 없는 연산자: %s", field, op)
+		}
+		if _, err := strconv.Atoi(value.text); err != nil {
+			return nil, p.errorf("%s 필드의 값은 정수여야 합니다: %s", field, value.text)
+		}
+	case field == FieldStyle:
+		if op == "=" {
+			cmp.Op = "contains"
+		} else if op != "contains" && op != "!=" {
+			return nil, p.errorf("style 필드에 사용할 수 없는 연산자: %s", op)
+		}
+	case field == FieldRegion:
+		if op != "=" && op != "!=" {
+			return nil, p.errorf("region 필드에 사용할 수 없는 연산자: %s", op)
+		}
+	case field == FieldName || field == FieldCharacteristics:
+		if op != "=" && op != "!=" && op != "contains" {
+			return nil, p.errorf("%s 필드에 사용할 수 없는 연산자: %s", field, op)
+		}
+	default:
+		return nil, p.errorf("알 수 없는 필드: %s", field)
+	}
+	return cmp, nil
+}
+
+// tokenize는 규칙 문자열을 토큰으로 나눕니다. 값에 공백이 있으면 작은따옴표나 큰따옴표로 감쌉니다.
+func tokenize(src string) ([]ruleToken, error) {
+	var tokens []ruleToken
+	runes := []rune(src)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '\'' || r == '"':
+			end := i + 1
+			for end < len(runes) && runes[end] != r {
+				end++
+			}
+			if end >= len(runes) {
+				return nil, fmt.Errorf("%w: 따옴표가 닫히지 않았습니다", ErrInvalidRule)
+			}
+			tokens = append(tokens, ruleToken{text: string(runes[i+1 : end]), quoted: true, pos: len(tokens)})
+			i = end + 1
+		case r == '(' || r == ')' || r == ',':
+			tokens = append(tokens, ruleToken{text: string(r), pos: len(tokens)})
+			i++
+		case r == '>' || r == '<' || r == '!' || r == '=':
+			op := string(r)
+			if i+1 < len(runes) && runes[i+1] == '=' {
+				op += "="
+			}
+			if op == "!" {
+				return nil, fmt.Errorf("%w: 알 수 없는 연산자 !", ErrInvalidRule)
+			}
+			tokens = append(tokens, ruleToken{text: op, pos: len(tokens)})
+			i += len(op)
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '_':
+			end := i
+			for end < len(runes) && (unicode.IsLetter(runes[end]) || unicode.IsDigit(runes[end]) || runes[end] == '-' || runes[end] == '_') {
+				end++
+			}
+			tokens = append(tokens, ruleToken{text: string(runes[i:end]), pos: len(tokens)})
+			i = end
+		default:
+			return nil, fmt.Errorf("%w: 알 수 없는 문자 %q", ErrInvalidRule, r)
+		}
+	}
+	return tokens, nil
+}
@@ -0,0 +1,220 @@
+package recommendation
+
+import (
+	"math"
+	"slices"
+	"sort"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+)
+
+// Component는 추천 점수를 구성하는 항목입니다.
+type Component string
+
+const (
+	ComponentRatings  Component = "ratings"
+	ComponentDistance Component = "distance"
+	ComponentStyle    Component = "style"
+)
+
+// Weights는 항목별 가중치입니다. 가중치가 0이거나 없는 항목은 점수에 반영하지 않습니다.
+type Weights map[Component]float64
+
+// DefaultPreferenceWeights는 개인화 추천의 기본 가중치입니다.
+var DefaultPreferenceWeights = Weights{
+	ComponentRatings:  0.6,
+	ComponentDistance: 0.25,
+	ComponentStyle:    0.15,
+}
+
+// DefaultRadiusKm는 거리 점수가 0이 되는 기본 반경입니다.
+const DefaultRadiusKm = 200.0
+
+// ReasonKind는 추천 사유의 종류입니다.
+type ReasonKind string
+
+const (
+	ReasonRatingMatch ReasonKind = "rating_match"
+	ReasonNearby      ReasonKind = "nearby"
+	ReasonStyleMatch  ReasonKind = "style_match"
+)
+
+// Reason은 코스가 추천된 사유 하나를 나타냅니다. 표시 문구는 인터페이스 계층에서 언어별로 만듭니다.
+type Reason struct {
+	Kind       ReasonKind
+	Axis       course.RatingAxis
+	Axes       []course.RatingAxis
+	Value      int
+	Target     int
+	Styles     []string
+	Region     string
+	DistanceKm float64
+}
+
+// ScoredCourse는 점수와 사유가 계산된 추천 코스입니다.
+type ScoredCourse struct {
+	Course *course.CourseAggregate
+	// Score는 0~1 범위의 종합 점수입니다.
+	Score float64
+	// Breakdown은 항목별 0~1 점수입니다. 적용되지 않은 항목은 포함하지 않습니다.
+	Breakdown  map[Component]float64
+	Reasons    []Reason
+	DistanceKm *float64
+}
+
+// Preference는 사용자의 주행 취향입니다.
+type Preference struct {
+	// Targets는 평가 항목별 희망 점수(1~5)입니다. 지정하지 않은 항목은 비교하지 않습니다.
+	Targets map[course.RatingAxis]int
+	// Min/Max는 평가 항목별 허용 범위로, 벗어난 코스는 제외합니다.
+	Min map[course.RatingAxis]int
+	Max map[course.RatingAxis]int
+	// Styles는 선호 스타일 slug 목록입니다.
+	Styles []string
+	// Near는 사용자 위치입니다. nil이면 거리 점수를 계산하지 않습니다.
+	Near     *course.CourseGeolocation
+	RadiusKm float64
+	Weights  Weights
+}
+
+// Scorer는 코스 추천 점수를 계산하는 도메인 서비스입니다.
+// 개인화 추천과 유사 코스 추천이 같은 점수 계산 규칙을 공유합니다.
+type Scorer struct{}
+
+func NewScorer() *Scorer {
+	return &Scorer{}
+}
+
+// RankByPreference는 취향에 맞는 순서로 코스를 정렬해 반환합니다.
+func (s *Scorer) RankByPreference(courses []*course.CourseAggregate, pref Preference) []*ScoredCourse {
+	weights := pref.Weights
+	if weights == nil {
+		weights = DefaultPreferenceWeights
+	}
+	radius := pref.RadiusKm
+	if radius <= 0 {
+		radius = DefaultRadiusKm
+	}
+
+	var result []*ScoredCourse
+	for _, c := range courses {
+		if !withinBounds(c.Ratings, pref.Min, pref.Max) {
+			continue
+		}
+		scored := &ScoredCourse{Course: c, Breakdown: map[Component]float64{}}
+
+		if len(pref.Targets) > 0 {
+			score, matched := RatingSimilarity(c.Ratings, pref.Targets)
+			scored.Breakdown[ComponentRatings] = score
+			for _, axis := range matched {
+				scored.Reasons = append(scored.Reasons, Reason{Kind: ReasonRatingMatch, Axis: axis, Value: c.Ratings.Get(axis), Target: pref.Targets[axis]})
+			}
+		}
+		if pref.Near != nil {
+			if start, ok := c.StartPoint(); ok {
+				km := pref.Near.DistanceKm(start)
+				scored.DistanceKm = &km
+				scored.Breakdown[ComponentDistance] = DistanceScore(km, radius)
+				if km <= radius/2 {
+					scored.Reasons = append(scored.Reasons, Reason{Kind: ReasonNearby, DistanceKm: km})
+				}
+			}
+		}
+		if len(pref.Styles) > 0 {
+			shared := sharedStyles(c.Styles, pref.Styles)
+			scored.Breakdown[ComponentStyle] = float64(len(shared)) / float64(len(pref.Styles))
+			if len(shared) > 0 {
+				scored.Reasons = append(scored.Reasons, Reason{Kind: ReasonStyleMatch, Styles: shared})
+			}
+		}
+
+		scored.Score = Blend(scored.Breakdown, weights)
+		result = append(result, scored)
+	}
+	SortByScore(result)
+	return result
+}
+
+// RatingSimilarity는 평가 점수와 희망 점수의 유사도(0~1)를 계산합니다.
+// 항목별로 1 - |차이|/4 를 구해 평균하며, 차이가 1 이하인 항목을 일치 항목으로 반환합니다.
+func RatingSimilarity(ratings course.CourseRatings, targets map[course.RatingAxis]int) (float64, []course.RatingAxis) {
+	var sum float64
+	var n int
+	var matched []course.RatingAxis
+	span := float64(course.MaxRating - course.MinRating)
+	for _, axis := range course.RatingAxes {
+		target, ok := targets[axis]
+		if !ok {
+			continue
+		}
+		diff := math.Abs(float64(ratings.Get(axis) - target))
+		sum += 1 - diff/span
+		n++
+		if diff <= 1 {
+			matched = append(matched, axis)
+		}
+	}
+	if n == 0 {
+		return 0, nil
+	}
+	return sum / float64(n), matched
+}
+
+// DistanceScore는 거리가 가까울수록 1에 가깝고 반경에서 0이 되는 점수를 계산합니다.
+func DistanceScore(km, radiusKm float64) float64 {
+	if radiusKm <= 0 {
+		return 0
+	}
+	return math.Max(0, 1-km/radiusKm)
+}
+
+// Blend는 항목별 점수를 가중 평균합니다. 점수가 없는 항목의 가중치는 제외합니다.
+func Blend(breakdown map[Component]float64, weights Weights) float64 {
+	var sum, total float64
+	for comp, score := range breakdown {
+		w := weights[comp]
+		if w <= 0 {
+			continue
+		}
+		sum += w * score
+		total += w
+	}
+	if total == 0 {
+		return 0
+	}
+	return sum / total
+}
+
+// SortByScore는 점수 내림차순(동점이면 코스 ID 오름차순)으로 정렬합니다.
+func SortByScore(scored []*ScoredCourse) {
+	sort.SliceStable(scored, func(i, j int) bool {
+		if scored[i].Score != scored[j].Score {
+			return scored[i].Score > scored[j].Score
+		}
+		return scored[i].Course.ID < scored[j].Course.ID
+	})
+}
+
+func withinBounds(ratings course.CourseRatings, min, max map[course.RatingAxis]int) bool {
+	for axis, v := range min {
+		if ratings.Get(axis) < v {
+			return false
+		}
+	}
+	for axis, v := range max {
+		if ratings.Get(axis) > v {
+			return false
+		}
+	}
+	return true
+}
+
+func sharedStyles(a, b []string) []string {
+	var shared []string
+	for _, s := range a {
+		if slices.Contains(b, s) {
+			shared = append(shared, s)
+		}
+	}
+	return shared
+}
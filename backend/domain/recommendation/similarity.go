@@ -0,0 +1,194 @@
+package recommendation
+
+import (
+	"math"
+	"unicode"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+)
+
+const (
+	ComponentRegion Component = "region"
+	ComponentText   Component = "text"
+)
+
+const (
+	ReasonSimilarRatings ReasonKind = "similar_ratings"
+	ReasonSharedStyle    ReasonKind = "shared_style"
+	ReasonSameRegion     ReasonKind = "same_region"
+)
+
+// DefaultSimilarityWeights는 유사 코스 추천의 기본 가중치입니다.
+var DefaultSimilarityWeights = Weights{
+	ComponentRatings: 0.4,
+	ComponentStyle:   0.25,
+	ComponentRegion:  0.2,
+	ComponentText:    0.15,
+}
+
+// DefaultSimilarRadiusKm는 지역 근접도 점수가 0이 되는 기본 반경입니다.
+const DefaultSimilarRadiusKm = 150.0
+
+// sameRegionScore는 같은 시·도에 속한 코스의 최소 지역 근접도 점수입니다.
+// 출발지 좌표가 부정확한 코스도 같은 시·도라면 가깝게 취급하기 위함입니다.
+const sameRegionScore = 0.5
+
+// SimilarOptions는 유사 코스 계산 옵션입니다.
+type SimilarOptions struct {
+	Weights Weights
+	// RadiusKm는 출발지 간 거리로 지역 근접도를 계산할 때의 반경입니다.
+	RadiusKm float64
+	// ExcludeSameRegion이 true면 기준 코스와 같은 시·도의 코스를 제외합니다.
+	ExcludeSameRegion bool
+}
+
+// RankSimilar는 기준 코스와 비슷한 순서로 후보 코스를 정렬해 반환합니다. 기준 코스 자신은 제외합니다.
+// 평가 점수, 스타일, 지역 근접도, 특징 설명(Characteristics) 텍스트를 같은 가중 평균 규칙으로 합산합니다.
+func (s *Scorer) RankSimilar(base *course.CourseAggregate, candidates []*course.CourseAggregate, opts SimilarOptions) []*ScoredCourse {
+	weights := opts.Weights
+	if weights == nil {
+		weights = DefaultSimilarityWeights
+	}
+	radius := opts.RadiusKm
+	if radius <= 0 {
+		radius = DefaultSimilarRadiusKm
+	}
+
+	targets := make(map[course.RatingAxis]int, len(course.RatingAxes))
+	for _, axis := range course.RatingAxes {
+		targets[axis] = base.Ratings.Get(axis)
+	}
+	texts := newTextIndex(append([]*course.CourseAggregate{base}, candidates...))
+	baseStart, baseHasStart := base.StartPoint()
+
+	var result []*ScoredCourse
+	for _, c := range candidates {
+		if c.ID == base.ID {
+			continue
+		}
+		sameRegion := base.RegionCode != "" && c.RegionCode == base.RegionCode
+		if opts.ExcludeSameRegion && sameRegion {
+			continue
+		}
+		scored := &ScoredCourse{Course: c, Breakdown: map[Component]float64{}}
+
+		score, matched := RatingSimilarity(c.Ratings, targets)
+		scored.Breakdown[ComponentRatings] = score
+		if len(matched) >= 3 {
+			scored.Reasons = append(scored.Reasons, Reason{Kind: ReasonSimilarRatings, Axes: matched})
+		}
+
+		shared := sharedStyles(c.Styles, base.Styles)
+		scored.Breakdown[ComponentStyle] = jaccard(len(shared), len(c.Styles), len(base.Styles))
+		if len(shared) > 0 {
+			scored.Reasons = append(scored.Reasons, Reason{Kind: ReasonSharedStyle, Styles: shared})
+		}
+
+		var proximity float64
+		if start, ok := c.StartPoint(); ok && baseHasStart {
+			km := baseStart.DistanceKm(start)
+			scored.DistanceKm = &km
+			proximity = DistanceScore(km, radius)
+		}
+		if sameRegion {
+			proximity = math.Max(proximity, sameRegionScore)
+			if base.SubRegionCode != "" && c.SubRegionCode == base.SubRegionCode {
+				proximity = 1
+			}
+			scored.Reasons = append(scored.Reasons, Reason{Kind: ReasonSameRegion, Region: c.RegionCode})
+		}
+		scored.Breakdown[ComponentRegion] = proximity
+
+		scored.Breakdown[ComponentText] = texts.similarity(base.ID, c.ID)
+
+		scored.Score = Blend(scored.Breakdown, weights)
+		result = append(result, scored)
+	}
+	SortByScore(result)
+	return result
+}
+
+// jaccard는 교집합 크기와 두 집합의 크기로 자카드 유사도를 계산합니다.
+func jaccard(shared, a, b int) float64 {
+	union := a + b - shared
+	if union == 0 {
+		return 0
+	}
+	return float64(shared) / float64(union)
+}
+
+// textIndex는 코스 특징 설명의 TF-IDF 벡터입니다.
+// 한국어는 조사와 어미가 붙어 단어 단위 비교가 어려워 글자 2-gram을 특징으로 사용합니다.
+type textIndex struct {
+	vectors map[int]map[string]float64
+}
+
+func newTextIndex(courses []*course.CourseAggregate) *textIndex {
+	counts := make(map[int]map[string]int, len(courses))
+	df := map[string]int{}
+	for _, c := range courses {
+		if _, ok := counts[c.ID]; ok {
+			continue
+		}
+		tf := bigrams(c.Characteristics.String())
+		counts[c.ID] = tf
+		for gram := range tf {
+			df[gram]++
+		}
+	}
+
+	n := float64(len(counts))
+	idx := &textIndex{vectors: make(map[int]map[string]float64, len(counts))}
+	for id, tf := range counts {
+		vec := make(map[string]float64, len(tf))
+		var norm float64
+		for gram, cnt := range tf {
+			w := float64(cnt) * math.Log(1+n/float64(df[gram]))
+			vec[gram] = w
+			norm += w * w
+		}
+		if norm == 0 {
+			continue
+		}
+		norm = math.Sqrt(norm)
+		for gram := range vec {
+			vec[gram] /= norm
+		}
+		idx.vectors[id] = vec
+	}
+	return idx
+}
+
+// similarity는 두 코스 특징 설명의 코사인 유사도(0~1)를 반환합니다.
+func (idx *textIndex) similarity(a, b int) float64 {
+	va, vb := idx.vectors[a], idx.vectors[b]
+	if len(vb) < len(va) {
+		va, vb = vb, va
+	}
+	var dot float64
+	for gram, w := range va {
+		dot += w * vb[gram]
+	}
+	return math.Min(dot, 1)
+}
+
+// bigrams는 문자·숫자로 이루어진 단어마다 연속한 두 글자의 빈도를 셉니다.
+func bigrams(text string) map[string]int {
+	counts := map[string]int{}
+	var word []rune
+	flush := func() {
+		for i := 0; i+1 < len(word); i++ {
+			counts[string(word[i:i+2])]++
+		}
+		word = word[:0]
+	}
+	for _, r := range text {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			word = append(word, unicode.ToLower(r))
+			continue
+		}
+		flush()
+	}
+	flush()
+	return counts
+}
@@ -0,0 +1,158 @@
+package recommendation
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+)
+
+// similarCourse는 출발지가 (lat, lng)인 코스입니다. lat, lng가 모두 0이면 내비게이션 정보가 없습니다.
+func similarCourse(id int, region, subRegion string, lat, lng float64, ratings course.CourseRatings, styles []string, text string) *course.CourseAggregate {
+	c := &course.CourseAggregate{
+		ID:              id,
+		RegionCode:      region,
+		SubRegionCode:   subRegion,
+		Ratings:         ratings,
+		Styles:          styles,
+		Characteristics: i18n.Text(text),
+	}
+	if lat != 0 || lng != 0 {
+		c.Nav = []course.CourseNav{{Type: "start", Geolocation: course.CourseGeolocation{Latitude: lat, Longitude: lng}}}
+	}
+	return c
+}
+
+var (
+	baseRatings = course.CourseRatings{Tech: 4, Speed: 3, Scenery: 5, Road: 4, Access: 3}
+	// similarBase는 경기 가평군의 산악 코스입니다.
+	similarBase = similarCourse(1, "41", "41820", 37.5, 127.5, baseRatings, []string{"mountain", "twisty"}, "급커브가 이어지는 산악 와인딩")
+	// twin은 같은 시·군·구에서 출발하는 거의 같은 코스입니다.
+	twin = similarCourse(2, "41", "41820", 37.51, 127.5, baseRatings, []string{"mountain", "twisty"}, "급커브가 이어지는 산악 와인딩")
+	// sameSido는 같은 시·도지만 약 78km 떨어진 코스입니다.
+	sameSido = similarCourse(3, "41", "41650", 38.2, 127.5, course.CourseRatings{Tech: 3, Speed: 3, Scenery: 4, Road: 4, Access: 2}, []string{"mountain"}, "산악 와인딩과 호수 경치")
+	// farAway는 부산의 해안 코스입니다.
+	farAway = similarCourse(4, "26", "26440", 35.1, 129.0, course.CourseRatings{Tech: 1, Speed: 5, Scenery: 2, Road: 1, Access: 5}, []string{"coastal"}, "바다를 따라 달리는 해안도로")
+	// unplaced는 지역과 출발지를 알 수 없는 코스입니다.
+	unplaced = similarCourse(5, "", "", 0, 0, baseRatings, nil, "")
+)
+
+func scoredIDs(scored []*ScoredCourse) []int {
+	ids := make([]int, len(scored))
+	for i, s := range scored {
+		ids[i] = s.Course.ID
+	}
+	return ids
+}
+
+func hasReason(s *ScoredCourse, kind ReasonKind) bool {
+	return slices.ContainsFunc(s.Reasons, func(r Reason) bool { return r.Kind == kind })
+}
+
+// 기준 코스 자신은 빼고, 비슷한 순서로 정렬한다.
+func TestRankSimilarOrder(t *testing.T) {
+	candidates := []*course.CourseAggregate{farAway, unplaced, similarBase, sameSido, twin}
+	got := NewScorer().RankSimilar(similarBase, candidates, SimilarOptions{})
+	if ids := scoredIDs(got); !slices.Equal(ids, []int{2, 3, 5, 4}) {
+		t.Fatalf("RankSimilar() = %v, want [2 3 5 4]", ids)
+	}
+	first := got[0]
+	for comp, want := range map[Component]float64{ComponentRatings: 1, ComponentStyle: 1, ComponentRegion: 1, ComponentText: 1} {
+		if math.Abs(first.Breakdown[comp]-want) > 1e-9 {
+			t.Errorf("twin %s = %.3f, want %.3f", comp, first.Breakdown[comp], want)
+		}
+	}
+	if math.Abs(first.Score-1) > 1e-9 {
+		t.Errorf("twin score = %.3f, want 1", first.Score)
+	}
+	for _, kind := range []ReasonKind{ReasonSimilarRatings, ReasonSharedStyle, ReasonSameRegion} {
+		if !hasReason(first, kind) {
+			t.Errorf("twin reasons = %+v, missing %s", first.Reasons, kind)
+		}
+	}
+	for i := 1; i < len(got); i++ {
+		if got[i-1].Score < got[i].Score {
+			t.Fatalf("scores not descending: %.3f before %.3f", got[i-1].Score, got[i].Score)
+		}
+	}
+}
+
+// 지역 근접도는 출발지 거리로 계산하고, 같은 시·도는 0.5, 같은 시·군·구는 1로 올린다.
+func TestRankSimilarRegion(t *testing.T) {
+	tests := []struct {
+		name       string
+		base       *course.CourseAggregate
+		candidate  *course.CourseAggregate
+		want       float64
+		sameRegion bool
+		hasKm      bool
+	}{
+		{"같은 시·군·구", similarBase, twin, 1, true, true},
+		{"같은 시·도지만 멀다", similarBase, sameSido, sameRegionScore, true, true},
+		{"다른 시·도의 먼 코스", similarBase, farAway, 0, false, true},
+		{"출발지를 모른다", similarBase, unplaced, 0, false, false},
+		{"지역 코드가 없으면 같은 지역이 아니다", unplaced, similarCourse(6, "", "", 0, 0, baseRatings, nil, ""), 0, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewScorer().RankSimilar(tt.base, []*course.CourseAggregate{tt.candidate}, SimilarOptions{})
+			if len(got) != 1 {
+				t.Fatalf("RankSimilar() = %v, want one course", scoredIDs(got))
+			}
+			s := got[0]
+			if math.Abs(s.Breakdown[ComponentRegion]-tt.want) > 1e-9 {
+				t.Errorf("region score = %.3f, want %.3f", s.Breakdown[ComponentRegion], tt.want)
+			}
+			if hasReason(s, ReasonSameRegion) != tt.sameRegion {
+				t.Errorf("reasons = %+v, same region = %v", s.Reasons, tt.sameRegion)
+			}
+			if (s.DistanceKm != nil) != tt.hasKm {
+				t.Errorf("distanceKm = %v, want set = %v", s.DistanceKm, tt.hasKm)
+			}
+		})
+	}
+}
+
+func TestRankSimilarExcludeSameRegion(t *testing.T) {
+	candidates := []*course.CourseAggregate{similarBase, twin, sameSido, farAway, unplaced}
+	tests := []struct {
+		name string
+		base *course.CourseAggregate
+		opts SimilarOptions
+		want []int
+	}{
+		{"같은 시·도도 포함", similarBase, SimilarOptions{}, []int{2, 3, 5, 4}},
+		{"같은 시·도 제외", similarBase, SimilarOptions{ExcludeSameRegion: true}, []int{5, 4}},
+		// 지역 코드가 없는 기준 코스는 어느 코스와도 같은 지역이 아니다.
+		{"기준 코스의 지역을 모르면 제외하지 않는다", unplaced, SimilarOptions{ExcludeSameRegion: true}, []int{1, 2, 3, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := scoredIDs(NewScorer().RankSimilar(tt.base, candidates, tt.opts))
+			slices.Sort(got)
+			want := slices.Clone(tt.want)
+			slices.Sort(want)
+			if !slices.Equal(got, want) {
+				t.Fatalf("RankSimilar() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTextSimilarity(t *testing.T) {
+	idx := newTextIndex([]*course.CourseAggregate{similarBase, twin, sameSido, farAway, unplaced})
+	if got := idx.similarity(1, 2); math.Abs(got-1) > 1e-9 {
+		t.Errorf("same text = %.3f, want 1", got)
+	}
+	if got := idx.similarity(1, 4); got != 0 {
+		t.Errorf("disjoint text = %.3f, want 0", got)
+	}
+	if got := idx.similarity(1, 5); got != 0 {
+		t.Errorf("empty text = %.3f, want 0", got)
+	}
+	if got := idx.similarity(1, 3); got <= 0 || got >= 1 {
+		t.Errorf("partly shared text = %.3f, want between 0 and 1", got)
+	}
+}
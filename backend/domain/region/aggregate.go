@@ -0,0 +1,28 @@
+package region
+
+import "github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+
+// Level은 행정구역 단계를 나타냅니다.
+type Level string
+
+const (
+	// LevelSido는 특별시·광역시·도 단위입니다.
+	LevelSido Level = "sido"
+	// LevelSigungu는 시·군·구 단위입니다.
+	LevelSigungu Level = "sigungu"
+)
+
+// Region은 지역 도메인 모델입니다.
+type Region struct {
+	Code       string
+	Name       i18n.LocalizedText
+	Level      Level
+	ParentCode string
+	Aliases    []string
+	Boundary   Boundary
+}
+
+// IsRoot는 최상위(시·도) 지역인지 여부를 반환합니다.
+func (r *Region) IsRoot() bool {
+	return r.ParentCode == ""
+}
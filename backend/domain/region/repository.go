@@ -0,0 +1,7 @@
+package region
+
+// RegionRepository는 지역 목록/상세 조회를 담당하는 인터페이스입니다.
+type RegionRepository interface {
+	FindAll() ([]*Region, error)
+	FindByCode(code string) (*Region, error)
+}
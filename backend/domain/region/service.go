@@ -0,0 +1,137 @@
+package region
+
+import "strings"
+
+// Assignment는 좌표 기반 지역 배정 결과입니다.
+type Assignment struct {
+	SidoCode    string
+	SigunguCode string
+	// Mismatch는 좌표가 가리키는 시·도와 지정된 지역이 다를 때 true입니다.
+	Mismatch bool
+}
+
+// Directory는 지역 계층 탐색과 좌표 기반 지역 판별을 담당하는 도메인 서비스입니다.
+type Directory struct {
+	regions  []*Region
+	byCode   map[string]*Region
+	children map[string][]*Region
+}
+
+// NewDirectory는 지역 목록으로 계층 구조를 구성합니다.
+func NewDirectory(regions []*Region) *Directory {
+	d := &Directory{
+		regions:  regions,
+		byCode:   make(map[string]*Region, len(regions)),
+		children: make(map[string][]*Region),
+	}
+	for _, r := range regions {
+		d.byCode[r.Code] = r
+		if !r.IsRoot() {
+			d.children[r.ParentCode] = append(d.children[r.ParentCode], r)
+		}
+	}
+	return d
+}
+
+// Find는 코드로 지역을 찾습니다.
+func (d *Directory) Find(code string) *Region {
+	return d.byCode[code]
+}
+
+// Roots는 최상위(시·도) 지역 목록을 반환합니다.
+func (d *Directory) Roots() []*Region {
+	var roots []*Region
+	for _, r := range d.regions {
+		if r.IsRoot() {
+			roots = append(roots, r)
+		}
+	}
+	return roots
+}
+
+// Children은 하위 지역 목록을 반환합니다.
+func (d *Directory) Children(code string) []*Region {
+	return d.children[code]
+}
+
+// Resolve는 코드, 언어별 이름, 별칭 중 하나로 지역을 찾습니다.
+// 같은 이름이 여러 단계에 있으면 상위 지역을 우선합니다.
+func (d *Directory) Resolve(name string) *Region {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil
+	}
+	if r, ok := d.byCode[strings.ToLower(name)]; ok {
+		return r
+	}
+	var found *Region
+	for _, r := range d.regions {
+		if !r.matches(name) {
+			continue
+		}
+		if found == nil || (r.IsRoot() && !found.IsRoot()) {
+			found = r
+		}
+	}
+	return found
+}
+
+func (r *Region) matches(name string) bool {
+	if r.Name.Equals(name) {
+		return true
+	}
+	for _, alias := range r.Aliases {
+		if alias == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Locate는 좌표를 포함하는 가장 작은 시·도를 찾습니다.
+func (d *Directory) Locate(p Point) *Region {
+	return smallestContaining(d.Roots(), p)
+}
+
+// Assign은 지정된 지역명과 출발지 좌표로 코스의 시·도/시·군·구를 배정합니다.
+// 지정된 지역이 없으면 좌표만으로 판별하고, 있으면 그 시·도 안에서 시·군·구를 찾습니다.
+// 좌표가 다른 시·도를 가리키면 지정된 지역을 유지하고 Mismatch를 표시합니다.
+func (d *Directory) Assign(declared string, p Point) Assignment {
+	located := d.Locate(p)
+	sido := d.Resolve(declared)
+	for sido != nil && !sido.IsRoot() {
+		sido = d.byCode[sido.ParentCode]
+	}
+	if sido == nil {
+		sido = located
+	}
+	if sido == nil {
+		return Assignment{}
+	}
+
+	result := Assignment{SidoCode: sido.Code}
+	if located != nil && located.Code != sido.Code {
+		result.Mismatch = true
+	}
+	if sigungu := smallestContaining(d.children[sido.Code], p); sigungu != nil {
+		result.SigunguCode = sigungu.Code
+	}
+	return result
+}
+
+// smallestContaining은 좌표를 포함하는 지역 중 면적이 가장 작은 지역을 반환합니다.
+// 광역시처럼 다른 시·도 경계 안에 놓인 지역을 올바르게 고르기 위함입니다.
+func smallestContaining(candidates []*Region, p Point) *Region {
+	var best *Region
+	var bestArea float64
+	for _, r := range candidates {
+		if !r.Boundary.Contains(p) {
+			continue
+		}
+		area := r.Boundary.Area()
+		if best == nil || area < bestArea {
+			best, bestArea = r, area
+		}
+	}
+	return best
+}
@@ -0,0 +1,104 @@
+package region
+
+import "math"
+
+// Point는 위도/경도 좌표를 나타내는 값 객체입니다.
+type Point struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// Ring은 닫힌 좌표 고리(첫 점과 마지막 점이 같음)입니다.
+type Ring []Point
+
+// Polygon은 외곽 고리(0번)와 구멍 고리(1번 이후)로 이루어진 다각형입니다.
+type Polygon []Ring
+
+// Boundary는 지역 경계를 이루는 다각형 집합(MultiPolygon)입니다.
+type Boundary []Polygon
+
+// BBox는 경계 사각형을 [서, 남, 동, 북] 순서로 나타냅니다.
+type BBox [4]float64
+
+// Contains는 좌표가 고리 내부에 있는지 ray casting 방식으로 판별합니다.
+func (r Ring) Contains(p Point) bool {
+	inside := false
+	for i, j := 0, len(r)-1; i < len(r); j, i = i, i+1 {
+		a, b := r[i], r[j]
+		if (a.Latitude > p.Latitude) != (b.Latitude > p.Latitude) {
+			x := (b.Longitude-a.Longitude)*(p.Latitude-a.Latitude)/(b.Latitude-a.Latitude) + a.Longitude
+			if p.Longitude < x {
+				inside = !inside
+			}
+		}
+	}
+	return inside
+}
+
+// area는 고리의 면적을 경위도 단위(shoelace 공식)로 계산합니다.
+func (r Ring) area() float64 {
+	var sum float64
+	for i, j := 0, len(r)-1; i < len(r); j, i = i, i+1 {
+		sum += (r[j].Longitude * r[i].Latitude) - (r[i].Longitude * r[j].Latitude)
+	}
+	return math.Abs(sum) / 2
+}
+
+// Contains는 좌표가 외곽 고리 안에 있고 구멍 고리 밖에 있는지 판별합니다.
+func (p Polygon) Contains(pt Point) bool {
+	if len(p) == 0 || !p[0].Contains(pt) {
+		return false
+	}
+	for _, hole := range p[1:] {
+		if hole.Contains(pt) {
+			return false
+		}
+	}
+	return true
+}
+
+// Contains는 좌표가 경계 내 어느 다각형에든 포함되는지 판별합니다.
+func (b Boundary) Contains(pt Point) bool {
+	for _, poly := range b {
+		if poly.Contains(pt) {
+			return true
+		}
+	}
+	return false
+}
+
+// Area는 경계의 근사 면적(경위도 제곱 단위)을 반환합니다. 지역 간 크기 비교에만 사용합니다.
+func (b Boundary) Area() float64 {
+	var total float64
+	for _, poly := range b {
+		if len(poly) == 0 {
+			continue
+		}
+		total += poly[0].area()
+		for _, hole := range poly[1:] {
+			total -= hole.area()
+		}
+	}
+	return total
+}
+
+// BBox는 경계를 감싸는 최소 사각형을 반환합니다.
+func (b Boundary) BBox() BBox {
+	box := BBox{math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)}
+	empty := true
+	for _, poly := range b {
+		for _, ring := range poly {
+			for _, pt := range ring {
+				empty = false
+				box[0] = math.Min(box[0], pt.Longitude)
+				box[1] = math.Min(box[1], pt.Latitude)
+				box[2] = math.Max(box[2], pt.Longitude)
+				box[3] = math.Max(box[3], pt.Latitude)
+			}
+		}
+	}
+	if empty {
+		return BBox{}
+	}
+	return box
+}
@@ -0,0 +1,56 @@
+package review
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+)
+
+// MaxTextLength는 리뷰 본문의 최대 글자 수입니다.
+const MaxTextLength = 2000
+
+var (
+	ErrTextTooLong      = errors.New("리뷰 본문은 2000자 이하여야 합니다")
+	ErrInvalidVisitDate = errors.New("방문일이 올바르지 않습니다")
+	ErrAlreadyReviewed  = errors.New("이미 리뷰를 작성한 코스입니다")
+	ErrReviewNotFound   = errors.New("리뷰를 찾을 수 없습니다")
+)
+
+// Review는 사용자가 작성한 코스 리뷰입니다.
+type Review struct {
+	ID       int
+	CourseID int
+	UserID   int
+	// Ratings는 작성자가 매긴 5가지 항목 점수로, 코스의 큐레이터 점수와 같은 기준입니다.
+	Ratings course.CourseRatings
+	Text    string
+	// VisitedOn은 방문일(날짜만 사용, KST 기준)입니다.
+	VisitedOn time.Time
+	CreatedAt time.Time
+	// Hidden은 관리자가 숨긴 리뷰인지 여부입니다. 숨긴 리뷰는 목록과 평점 집계에서 빠집니다.
+	Hidden bool
+}
+
+// NewReview는 리뷰를 검증해 만듭니다. 방문일은 작성 시각 이후일 수 없습니다. ID는 저장소가 배정합니다.
+func NewReview(courseID, userID int, ratings course.CourseRatings, text string, visitedOn, now time.Time) (*Review, error) {
+	if err := ratings.Validate(); err != nil {
+		return nil, err
+	}
+	text = strings.TrimSpace(text)
+	if len([]rune(text)) > MaxTextLength {
+		return nil, ErrTextTooLong
+	}
+	if visitedOn.IsZero() || visitedOn.After(now) {
+		return nil, ErrInvalidVisitDate
+	}
+	return &Review{
+		CourseID:  courseID,
+		UserID:    userID,
+		Ratings:   ratings,
+		Text:      text,
+		VisitedOn: visitedOn,
+		CreatedAt: now,
+	}, nil
+}
@@ -0,0 +1,13 @@
+package review
+
+// ReviewRepository는 코스 리뷰 저장/조회를 담당하는 인터페이스입니다.
+type ReviewRepository interface {
+	// Save는 리뷰를 저장합니다. ID가 0이면 새 ID를 배정하며, 같은 사용자가 같은 코스에 이미 리뷰를 남겼으면 ErrAlreadyReviewed를 반환합니다.
+	Save(r *Review) error
+	// Update는 id 리뷰를 저장소 잠금 안에서 읽어 change로 바꾼 뒤 저장합니다. 없으면 nil을 반환하며, change가 실패하면 저장하지 않습니다.
+	Update(id int, change func(*Review) error) (*Review, error)
+	FindByID(id int) (*Review, error)
+	// FindByCourse는 코스의 리뷰를 최신순으로 반환합니다.
+	FindByCourse(courseID int) ([]*Review, error)
+	FindAll() ([]*Review, error)
+}
@@ -0,0 +1,40 @@
+package review
+
+import "github.com/sunDar0/winding-road-finder/backend/domain/course"
+
+// Summarize는 리뷰 목록의 항목별 평균과 점수 분포를 계산합니다.
+func Summarize(reviews []*Review) CommunityRatings {
+	summary := CommunityRatings{
+		Count:        len(reviews),
+		Mean:         map[course.RatingAxis]float64{},
+		Distribution: map[course.RatingAxis][course.MaxRating]int{},
+	}
+	if len(reviews) == 0 {
+		return summary
+	}
+	for _, axis := range course.RatingAxes {
+		var sum int
+		var dist [course.MaxRating]int
+		for _, r := range reviews {
+			v := r.Ratings.Get(axis)
+			sum += v
+			dist[v-course.MinRating]++
+		}
+		summary.Mean[axis] = float64(sum) / float64(len(reviews))
+		summary.Distribution[axis] = dist
+	}
+	return summary
+}
+
+// SummarizeByCourse는 리뷰를 코스별로 묶어 집계합니다.
+func SummarizeByCourse(reviews []*Review) map[int]CommunityRatings {
+	byCourse := map[int][]*Review{}
+	for _, r := range reviews {
+		byCourse[r.CourseID] = append(byCourse[r.CourseID], r)
+	}
+	result := make(map[int]CommunityRatings, len(byCourse))
+	for id, rs := range byCourse {
+		result[id] = Summarize(rs)
+	}
+	return result
+}
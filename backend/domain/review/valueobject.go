@@ -0,0 +1,12 @@
+package review
+
+import "github.com/sunDar0/winding-road-finder/backend/domain/course"
+
+// CommunityRatings는 한 코스에 대한 사용자 리뷰 점수 집계입니다.
+type CommunityRatings struct {
+	Count int
+	// Mean은 항목별 평균 점수입니다. 리뷰가 없으면 비어 있습니다.
+	Mean map[course.RatingAxis]float64
+	// Distribution은 항목별 1~5점 리뷰 수입니다. 인덱스 0이 1점입니다.
+	Distribution map[course.RatingAxis][course.MaxRating]int
+}
@@ -0,0 +1,199 @@
+package revision
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+)
+
+// MaxSummaryLength는 변경 요약과 검토 의견의 최대 글자 수입니다.
+const MaxSummaryLength = 500
+
+var (
+	ErrRevisionNotFound = errors.New("수정안을 찾을 수 없습니다")
+	// ErrInvalidTransition은 현재 상태에서 할 수 없는 작업입니다. (예: 승인된 수정안 편집)
+	ErrInvalidTransition = errors.New("현재 상태에서 할 수 없는 작업입니다")
+	ErrSelfReview        = errors.New("자신이 작성한 수정안은 검토할 수 없습니다")
+	// ErrOutdated는 수정안을 만든 뒤 코스가 바뀌어 그대로 게시할 수 없는 경우입니다. 수정안을 다시 편집하면 현재 코스 기준으로 바뀝니다.
+	ErrOutdated        = errors.New("수정안을 만든 뒤 코스가 바뀌었습니다")
+	ErrInvalidSummary  = errors.New("변경 요약은 1~500자여야 합니다")
+	ErrInvalidComment  = errors.New("검토 의견은 500자까지 입력할 수 있습니다")
+	ErrCommentRequired = errors.New("반려 사유가 필요합니다")
+	ErrCourseDeleted   = errors.New("삭제된 코스의 수정안입니다")
+)
+
+// Status는 수정안의 검토 상태입니다.
+//
+//	draft ─제출→ submitted ─승인→ approved(게시됨)
+//	  ↑                     └─반려→ rejected
+//	  └──────────편집───────────────┘
+type Status string
+
+const (
+	StatusDraft     Status = "draft"
+	StatusSubmitted Status = "submitted"
+	StatusRejected  Status = "rejected"
+	StatusApproved  Status = "approved"
+)
+
+// Valid는 알려진 상태인지 확인합니다.
+func (s Status) Valid() bool {
+	switch s {
+	case StatusDraft, StatusSubmitted, StatusRejected, StatusApproved:
+		return true
+	}
+	return false
+}
+
+// Revision은 코스 수정안입니다. 게시된 코스를 바로 고치지 않고 제안한 코스 전체를 담아 두었다가,
+// 검토자가 승인하면 한 번에 게시합니다. CourseID가 0이면 새 코스 제안입니다.
+type Revision struct {
+	ID       int
+	CourseID int
+	// BaseVersion은 수정안이 기준으로 삼은 코스 이벤트 버전입니다. 새 코스 제안은 0입니다.
+	BaseVersion int
+	AuthorID    int
+	Status      Status
+	Summary     string
+	Course      *course.CourseAggregate
+	ReviewerID  int
+	// ReviewComment는 승인·반려 때 검토자가 남긴 의견입니다.
+	ReviewComment string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	SubmittedAt   *time.Time
+	ReviewedAt    *time.Time
+}
+
+// New는 코스 수정안 초안을 만듭니다. 코스 내용 검증은 호출자가 합니다. ID는 저장소가 배정합니다.
+func New(authorID, courseID, baseVersion int, c *course.CourseAggregate, summary string, now time.Time) (*Revision, error) {
+	summary, err := normalizeSummary(summary)
+	if err != nil {
+		return nil, err
+	}
+	c.ID = courseID
+	return &Revision{
+		CourseID:    courseID,
+		BaseVersion: baseVersion,
+		AuthorID:    authorID,
+		Status:      StatusDraft,
+		Summary:     summary,
+		Course:      c,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+// WrittenBy는 사용자가 수정안 작성자인지 확인합니다.
+func (r *Revision) WrittenBy(userID int) bool {
+	return r.AuthorID == userID
+}
+
+// Edit는 제안 내용을 바꾸고 baseVersion을 새 기준으로 삼습니다. 반려된 수정안을 편집하면 다시 초안이 됩니다.
+func (r *Revision) Edit(c *course.CourseAggregate, summary string, baseVersion int, now time.Time) error {
+	if r.Status != StatusDraft && r.Status != StatusRejected {
+		return ErrInvalidTransition
+	}
+	summary, err := normalizeSummary(summary)
+	if err != nil {
+		return err
+	}
+	c.ID = r.CourseID
+	r.Course, r.Summary, r.BaseVersion = c, summary, baseVersion
+	r.Status = StatusDraft
+	r.ReviewerID, r.ReviewComment, r.ReviewedAt, r.SubmittedAt = 0, "", nil, nil
+	r.UpdatedAt = now
+	return nil
+}
+
+// Submit은 초안을 검토 요청합니다.
+func (r *Revision) Submit(now time.Time) error {
+	if r.Status != StatusDraft {
+		return ErrInvalidTransition
+	}
+	r.Status = StatusSubmitted
+	r.SubmittedAt = &now
+	r.UpdatedAt = now
+	return nil
+}
+
+// CheckReviewable은 reviewerID가 지금 수정안을 검토할 수 있는지 확인합니다.
+// currentVersion은 게시된 코스의 현재 이벤트 버전이며, 기준 버전과 다르면 ErrOutdated를 반환합니다.
+func (r *Revision) CheckReviewable(reviewerID, currentVersion int) error {
+	if r.Status != StatusSubmitted {
+		return ErrInvalidTransition
+	}
+	if r.WrittenBy(reviewerID) {
+		return ErrSelfReview
+	}
+	if r.CourseID != 0 && currentVersion != r.BaseVersion {
+		return ErrOutdated
+	}
+	return nil
+}
+
+// Approve는 수정안을 승인합니다. courseID에는 게시할 코스 ID(새 코스 제안이면 배정한 ID)를 넘기며,
+// 호출자는 승인한 수정안을 저장한 뒤 게시합니다.
+func (r *Revision) Approve(reviewerID, courseID int, comment string, now time.Time) error {
+	if r.Status != StatusSubmitted {
+		return ErrInvalidTransition
+	}
+	if r.WrittenBy(reviewerID) {
+		return ErrSelfReview
+	}
+	comment, err := trimToLimit(comment, ErrInvalidComment)
+	if err != nil {
+		return err
+	}
+	r.CourseID = courseID
+	r.Course.ID = courseID
+	r.review(StatusApproved, reviewerID, comment, now)
+	return nil
+}
+
+// Reject는 수정안을 반려합니다. 반려 사유가 필요합니다.
+func (r *Revision) Reject(reviewerID int, comment string, now time.Time) error {
+	if r.Status != StatusSubmitted {
+		return ErrInvalidTransition
+	}
+	if r.WrittenBy(reviewerID) {
+		return ErrSelfReview
+	}
+	comment, err := trimToLimit(comment, ErrInvalidComment)
+	if err != nil {
+		return err
+	}
+	if comment == "" {
+		return ErrCommentRequired
+	}
+	r.review(StatusRejected, reviewerID, comment, now)
+	return nil
+}
+
+func (r *Revision) review(status Status, reviewerID int, comment string, now time.Time) {
+	r.Status = status
+	r.ReviewerID = reviewerID
+	r.ReviewComment = comment
+	r.ReviewedAt = &now
+	r.UpdatedAt = now
+}
+
+func normalizeSummary(s string) (string, error) {
+	s, err := trimToLimit(s, ErrInvalidSummary)
+	if err == nil && s == "" {
+		err = ErrInvalidSummary
+	}
+	return s, err
+}
+
+// trimToLimit은 앞뒤 공백을 지우고, MaxSummaryLength자를 넘으면 errTooLong을 반환합니다.
+func trimToLimit(s string, errTooLong error) (string, error) {
+	s = strings.TrimSpace(s)
+	if utf8.RuneCountInString(s) > MaxSummaryLength {
+		return "", errTooLong
+	}
+	return s, nil
+}
@@ -0,0 +1,173 @@
+package revision
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+)
+
+const (
+	author   = 1
+	reviewer = 2
+)
+
+var now = time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+
+// revisionIn은 코스 7의 수정안을 status 상태로 만듭니다. 기준 버전은 3입니다.
+func revisionIn(t *testing.T, status Status) *Revision {
+	t.Helper()
+	r, err := New(author, 7, 3, &course.CourseAggregate{Name: i18n.Text("중미산")}, "이름 수정", now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	steps := map[Status][]func() error{
+		StatusDraft:     nil,
+		StatusSubmitted: {func() error { return r.Submit(now) }},
+		StatusRejected:  {func() error { return r.Submit(now) }, func() error { return r.Reject(reviewer, "근거 부족", now) }},
+		StatusApproved:  {func() error { return r.Submit(now) }, func() error { return r.Approve(reviewer, 7, "", now) }},
+	}
+	for _, step := range steps[status] {
+		if err := step(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return r
+}
+
+// 상태마다 할 수 있는 작업과 그 결과 상태입니다.
+func TestTransitions(t *testing.T) {
+	later := now.Add(time.Hour)
+	actions := map[string]func(r *Revision) error{
+		"편집": func(r *Revision) error { return r.Edit(&course.CourseAggregate{}, "다시 수정", 4, later) },
+		"제출": func(r *Revision) error { return r.Submit(later) },
+		"승인": func(r *Revision) error { return r.Approve(reviewer, 7, "좋습니다", later) },
+		"반려": func(r *Revision) error { return r.Reject(reviewer, "사진이 필요합니다", later) },
+	}
+	tests := []struct {
+		from   Status
+		action string
+		to     Status // 빈 값이면 ErrInvalidTransition
+	}{
+		{StatusDraft, "편집", StatusDraft},
+		{StatusDraft, "제출", StatusSubmitted},
+		{StatusDraft, "승인", ""},
+		{StatusDraft, "반려", ""},
+		{StatusSubmitted, "편집", ""},
+		{StatusSubmitted, "제출", ""},
+		{StatusSubmitted, "승인", StatusApproved},
+		{StatusSubmitted, "반려", StatusRejected},
+		{StatusRejected, "편집", StatusDraft},
+		{StatusRejected, "제출", ""},
+		{StatusRejected, "승인", ""},
+		{StatusRejected, "반려", ""},
+		{StatusApproved, "편집", ""},
+		{StatusApproved, "제출", ""},
+		{StatusApproved, "승인", ""},
+		{StatusApproved, "반려", ""},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.from)+" "+tt.action, func(t *testing.T) {
+			r := revisionIn(t, tt.from)
+			before := *r
+			err := actions[tt.action](r)
+			if tt.to == "" {
+				if !errors.Is(err, ErrInvalidTransition) {
+					t.Fatalf("error = %v, want %v", err, ErrInvalidTransition)
+				}
+				if r.Status != before.Status || !r.UpdatedAt.Equal(before.UpdatedAt) {
+					t.Fatalf("revision changed on a rejected transition: %s at %v", r.Status, r.UpdatedAt)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if r.Status != tt.to || !r.UpdatedAt.Equal(later) {
+				t.Fatalf("status = %s, updatedAt = %v; want %s, %v", r.Status, r.UpdatedAt, tt.to, later)
+			}
+		})
+	}
+}
+
+// 반려된 수정안을 편집하면 검토 기록을 지우고 현재 코스 버전을 새 기준으로 삼는다.
+func TestEditRejectedClearsReview(t *testing.T) {
+	r := revisionIn(t, StatusRejected)
+	c := &course.CourseAggregate{ID: 99, Name: i18n.Text("중미산 ~ 유명산")}
+	if err := r.Edit(c, "  사진 추가  ", 5, now); err != nil {
+		t.Fatal(err)
+	}
+	if r.ReviewerID != 0 || r.ReviewComment != "" || r.ReviewedAt != nil || r.SubmittedAt != nil {
+		t.Fatalf("review not cleared: %+v", r)
+	}
+	if r.BaseVersion != 5 || r.Summary != "사진 추가" || r.Course != c || c.ID != 7 {
+		t.Fatalf("base %d, summary %q, course ID %d; want 5, %q, 7", r.BaseVersion, r.Summary, c.ID, "사진 추가")
+	}
+}
+
+func TestCheckReviewable(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     Status
+		courseID   int
+		reviewerID int
+		current    int
+		want       error
+	}{
+		{"검토할 수 있다", StatusSubmitted, 7, reviewer, 3, nil},
+		{"초안은 검토할 수 없다", StatusDraft, 7, reviewer, 3, ErrInvalidTransition},
+		{"작성자는 검토할 수 없다", StatusSubmitted, 7, author, 3, ErrSelfReview},
+		{"코스가 바뀌었다", StatusSubmitted, 7, reviewer, 4, ErrOutdated},
+		{"새 코스 제안은 버전을 보지 않는다", StatusSubmitted, 0, reviewer, 9, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := revisionIn(t, tt.status)
+			r.CourseID = tt.courseID
+			if err := r.CheckReviewable(tt.reviewerID, tt.current); !errors.Is(err, tt.want) {
+				t.Fatalf("CheckReviewable() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestReviewValidation(t *testing.T) {
+	long := strings.Repeat("가", MaxSummaryLength+1)
+	tests := []struct {
+		name string
+		run  func(r *Revision) error
+		want error
+	}{
+		{"자신의 수정안 승인", func(r *Revision) error { return r.Approve(author, 7, "", now) }, ErrSelfReview},
+		{"자신의 수정안 반려", func(r *Revision) error { return r.Reject(author, "취소", now) }, ErrSelfReview},
+		{"사유 없는 반려", func(r *Revision) error { return r.Reject(reviewer, "   ", now) }, ErrCommentRequired},
+		{"너무 긴 반려 사유", func(r *Revision) error { return r.Reject(reviewer, long, now) }, ErrInvalidComment},
+		{"너무 긴 승인 의견", func(r *Revision) error { return r.Approve(reviewer, 7, long, now) }, ErrInvalidComment},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := revisionIn(t, StatusSubmitted)
+			if err := tt.run(r); !errors.Is(err, tt.want) {
+				t.Fatalf("error = %v, want %v", err, tt.want)
+			}
+			if r.Status != StatusSubmitted {
+				t.Fatalf("status = %s, want %s", r.Status, StatusSubmitted)
+			}
+		})
+	}
+}
+
+func TestNewValidatesSummary(t *testing.T) {
+	for _, summary := range []string{"", "   ", strings.Repeat("가", MaxSummaryLength+1)} {
+		if _, err := New(author, 0, 0, &course.CourseAggregate{}, summary, now); !errors.Is(err, ErrInvalidSummary) {
+			t.Errorf("New(summary %d자) error = %v, want %v", len([]rune(summary)), err, ErrInvalidSummary)
+		}
+	}
+	r, err := New(author, 0, 0, &course.CourseAggregate{ID: 5}, strings.Repeat("가", MaxSummaryLength), now)
+	if err != nil || r.Status != StatusDraft || r.Course.ID != 0 {
+		t.Fatalf("New() = %+v, %v; want a draft for a new course", r, err)
+	}
+}
@@ -0,0 +1,17 @@
+package revision
+
+// Filter는 수정안 목록 조회 조건입니다. 0이나 빈 값인 조건은 적용하지 않습니다.
+type Filter struct {
+	AuthorID int
+	CourseID int
+	Status   Status
+}
+
+// RevisionRepository는 코스 수정안 저장/조회를 담당하는 인터페이스입니다.
+type RevisionRepository interface {
+	// Save는 수정안을 저장합니다. ID가 0이면 새 ID를 배정합니다.
+	Save(r *Revision) error
+	FindByID(id int) (*Revision, error)
+	// Find는 조건에 맞는 수정안을 최근 수정 순으로 반환합니다.
+	Find(filter Filter) ([]*Revision, error)
+}
@@ -0,0 +1,142 @@
+package routing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+)
+
+var (
+	// ErrNoRoute는 지점 사이를 도로로 이을 수 없을 때 반환합니다.
+	ErrNoRoute = errors.New("도로로 이을 수 없는 지점입니다")
+	// ErrUnavailable은 경로 탐색 엔진에 연결하지 못했을 때 반환합니다.
+	ErrUnavailable = errors.New("경로 탐색 엔진을 사용할 수 없습니다")
+)
+
+const (
+	// MaxSnapOffsetM은 지점이 가장 가까운 도로에서 이보다 멀면(m) 도로 밖으로 봅니다.
+	MaxSnapOffsetM = 300
+	// OutOfOrderGain은 이웃한 두 지점의 순서를 바꿨을 때 경로가 이 비율 이상 짧아지면 순서가 뒤바뀐 것으로 봅니다.
+	OutOfOrderGain = 0.15
+)
+
+// Leg는 이웃한 두 지점 사이의 경로입니다.
+type Leg struct {
+	DistanceKm float64
+	Duration   time.Duration
+	Geometry   []course.CourseGeolocation
+}
+
+// Route는 지점들을 순서대로 잇는 경로입니다. Legs는 지점 수보다 하나 적고,
+// SnapOffsetsM은 지점마다 가장 가까운 도로까지의 거리(m)입니다.
+type Route struct {
+	Legs         []Leg
+	SnapOffsetsM []float64
+}
+
+// DistanceKm는 전체 경로 거리입니다.
+func (r *Route) DistanceKm() float64 {
+	var km float64
+	for _, l := range r.Legs {
+		km += l.DistanceKm
+	}
+	return km
+}
+
+// Duration은 전체 예상 주행 시간입니다.
+func (r *Route) Duration() time.Duration {
+	var d time.Duration
+	for _, l := range r.Legs {
+		d += l.Duration
+	}
+	return d
+}
+
+// Geometry는 구간 경로를 이어 붙인 좌표입니다. 구간 경계의 중복 좌표는 한 번만 넣습니다.
+func (r *Route) Geometry() []course.CourseGeolocation {
+	var points []course.CourseGeolocation
+	for _, l := range r.Legs {
+		g := l.Geometry
+		if len(points) > 0 && len(g) > 0 && points[len(points)-1] == g[0] {
+			g = g[1:]
+		}
+		points = append(points, g...)
+	}
+	return points
+}
+
+// Router는 지점들을 순서대로 지나는 도로 경로를 구합니다. (OSRM 등 경로 탐색 엔진)
+// 이을 수 없는 구간이 있으면 ErrNoRoute, 엔진에 연결하지 못하면 ErrUnavailable을 감싼 에러를 반환합니다.
+type Router interface {
+	Route(ctx context.Context, points []course.CourseGeolocation) (*Route, error)
+}
+
+// SnapCourse는 코스 내비게이션 포인트 사이의 실제 주행 경로를 구하고 포인트 문제를 찾습니다.
+//   - 갈 수 없는 구간이 있으면 그 구간의 도착 포인트를 unreachable로 표시하고 경로는 비워 둡니다.
+//   - 도로에서 MaxSnapOffsetM보다 먼 포인트는 off_road로 표시합니다.
+//   - 출발·도착을 제외한 이웃 포인트 쌍의 순서를 바꿨을 때 OutOfOrderGain 이상 짧아지면 두 포인트를 out_of_order로 표시합니다.
+//
+// 엔진에 연결하지 못하면 에러를 반환합니다.
+func SnapCourse(ctx context.Context, router Router, c *course.CourseAggregate, now time.Time) (*course.CourseRoute, error) {
+	points := make([]course.CourseGeolocation, len(c.Nav))
+	for i, n := range c.Nav {
+		points[i] = n.Geolocation
+	}
+	if len(points) < 2 {
+		return nil, fmt.Errorf("코스 %d: 내비게이션 포인트가 2개 이상 필요합니다", c.ID)
+	}
+	result := &course.CourseRoute{ComputedAt: now}
+
+	route, err := router.Route(ctx, points)
+	if errors.Is(err, ErrNoRoute) {
+		// 전체 경로가 없으면 구간별로 다시 구해 갈 수 없는 포인트를 찾습니다.
+		for i := 1; i < len(points); i++ {
+			_, err := router.Route(ctx, points[i-1:i+1])
+			if errors.Is(err, ErrNoRoute) {
+				result.Issues = append(result.Issues, course.RouteIssue{Kind: course.RouteIssueUnreachable, Nav: i})
+			} else if err != nil {
+				return nil, err
+			}
+		}
+		return result, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result.DistanceKm = route.DistanceKm()
+	result.Duration = route.Duration()
+	result.Geometry = route.Geometry()
+	for i, offset := range route.SnapOffsetsM {
+		if offset > MaxSnapOffsetM {
+			result.Issues = append(result.Issues, course.RouteIssue{Kind: course.RouteIssueOffRoad, Nav: i})
+		}
+	}
+	for i := 1; i+2 < len(points); i++ {
+		swapped := append([]course.CourseGeolocation{}, points[i-1:i+3]...)
+		swapped[1], swapped[2] = swapped[2], swapped[1]
+		alt, err := router.Route(ctx, swapped)
+		if errors.Is(err, ErrNoRoute) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		var current float64
+		for _, l := range route.Legs[i-1 : i+2] {
+			current += l.DistanceKm
+		}
+		if alt.DistanceKm() < current*(1-OutOfOrderGain) {
+			for _, nav := range []int{i, i + 1} {
+				if issue := (course.RouteIssue{Kind: course.RouteIssueOutOfOrder, Nav: nav}); !slices.Contains(result.Issues, issue) {
+					result.Issues = append(result.Issues, issue)
+				}
+			}
+		}
+	}
+	return result, nil
+}
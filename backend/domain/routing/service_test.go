@@ -0,0 +1,136 @@
+package routing_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/routing"
+	routingInfra "github.com/sunDar0/winding-road-finder/backend/infrastructure/external/routing"
+)
+
+// 같은 위도에서 경도만 0.01도(약 0.9km)씩 동쪽으로 떨어진 지점들입니다.
+var (
+	pA = course.CourseGeolocation{Latitude: 37.5, Longitude: 127.00}
+	pB = course.CourseGeolocation{Latitude: 37.5, Longitude: 127.01}
+	pC = course.CourseGeolocation{Latitude: 37.5, Longitude: 127.02}
+	pD = course.CourseGeolocation{Latitude: 37.5, Longitude: 127.03}
+)
+
+func courseOf(points ...course.CourseGeolocation) *course.CourseAggregate {
+	c := &course.CourseAggregate{ID: 1}
+	for _, p := range points {
+		c.Nav = append(c.Nav, course.CourseNav{Geolocation: p})
+	}
+	return c
+}
+
+func TestSnapCourse(t *testing.T) {
+	now := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		router func() *routingInfra.FakeRouter
+		points []course.CourseGeolocation
+		issues []course.RouteIssue
+		// reachable이면 거리는 직선거리×우회 계수이고 경로는 구간 경계를 한 번씩만 지납니다.
+		reachable bool
+	}{
+		{
+			name:      "모든 구간을 이을 수 있다",
+			router:    routingInfra.NewFakeRouter,
+			points:    []course.CourseGeolocation{pA, pB, pC, pD},
+			reachable: true,
+		},
+		{
+			name: "갈 수 없는 구간은 도착 포인트를 표시하고 경로를 비운다",
+			router: func() *routingInfra.FakeRouter {
+				r := routingInfra.NewFakeRouter()
+				r.Unreachable = []course.CourseGeolocation{pC}
+				return r
+			},
+			points: []course.CourseGeolocation{pA, pB, pC, pD},
+			// pC로 들어가는 구간과 pC에서 나오는 구간 모두 이을 수 없다.
+			issues: []course.RouteIssue{{Kind: course.RouteIssueUnreachable, Nav: 2}, {Kind: course.RouteIssueUnreachable, Nav: 3}},
+		},
+		{
+			name: "도로에서 먼 포인트",
+			router: func() *routingInfra.FakeRouter {
+				r := routingInfra.NewFakeRouter()
+				r.SnapOffsetsM = map[course.CourseGeolocation]float64{pB: routing.MaxSnapOffsetM + 1, pC: routing.MaxSnapOffsetM}
+				return r
+			},
+			points:    []course.CourseGeolocation{pA, pB, pC, pD},
+			issues:    []course.RouteIssue{{Kind: course.RouteIssueOffRoad, Nav: 1}},
+			reachable: true,
+		},
+		{
+			name:      "순서가 뒤바뀐 포인트",
+			router:    routingInfra.NewFakeRouter,
+			points:    []course.CourseGeolocation{pA, pC, pB, pD},
+			issues:    []course.RouteIssue{{Kind: course.RouteIssueOutOfOrder, Nav: 1}, {Kind: course.RouteIssueOutOfOrder, Nav: 2}},
+			reachable: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := tt.router()
+			c := courseOf(tt.points...)
+			route, err := routing.SnapCourse(context.Background(), router, c, now)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(route.Issues, tt.issues) {
+				t.Fatalf("Issues = %v, want %v", route.Issues, tt.issues)
+			}
+			if route.Reachable() != tt.reachable || !route.ComputedAt.Equal(now) {
+				t.Fatalf("Reachable() = %v, ComputedAt = %v; want %v, %v", route.Reachable(), route.ComputedAt, tt.reachable, now)
+			}
+			if !tt.reachable {
+				if route.Geometry != nil || route.DistanceKm != 0 {
+					t.Fatalf("unreachable route has geometry %v, distance %v", route.Geometry, route.DistanceKm)
+				}
+				return
+			}
+			wantKm := c.ApproxLengthKm() * router.Detour
+			if math.Abs(route.DistanceKm-wantKm) > 1e-9 {
+				t.Fatalf("DistanceKm = %v, want %v", route.DistanceKm, wantKm)
+			}
+			wantDuration := time.Duration(wantKm / router.SpeedKmh * float64(time.Hour))
+			if d := route.Duration - wantDuration; d < -time.Millisecond || d > time.Millisecond {
+				t.Fatalf("Duration = %v, want %v", route.Duration, wantDuration)
+			}
+			if !slices.Equal(route.Geometry, tt.points) {
+				t.Fatalf("Geometry = %v, want %v", route.Geometry, tt.points)
+			}
+		})
+	}
+}
+
+func TestSnapCourseErrors(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		course *course.CourseAggregate
+		want   error
+	}{
+		{"포인트가 하나뿐인 코스", context.Background(), courseOf(pA), nil},
+		{"취소된 요청", canceled, courseOf(pA, pB), context.Canceled},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			route, err := routing.SnapCourse(tt.ctx, routingInfra.NewFakeRouter(), tt.course, time.Now())
+			if err == nil || route != nil {
+				t.Fatalf("SnapCourse() = %v, %v; want an error", route, err)
+			}
+			if tt.want != nil && !errors.Is(err, tt.want) {
+				t.Fatalf("SnapCourse() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
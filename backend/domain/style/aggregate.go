@@ -0,0 +1,13 @@
+package style
+
+import "github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+
+// Style은 코스 주행 스타일(태그) 도메인 모델입니다.
+// Slug는 데이터와 API에서 스타일을 식별하는 변하지 않는 키입니다.
+type Style struct {
+	Slug        string
+	Name        i18n.LocalizedText
+	Description i18n.LocalizedText
+	Icon        string
+	Synonyms    []string
+}
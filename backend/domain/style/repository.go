@@ -0,0 +1,7 @@
+package style
+
+// StyleRepository는 스타일 분류 체계 조회를 담당하는 인터페이스입니다.
+type StyleRepository interface {
+	FindAll() ([]*Style, error)
+	FindBySlug(slug string) (*Style, error)
+}
@@ -0,0 +1,71 @@
+package style
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrUnknownStyle은 분류 체계에 없는 스타일을 가리킬 때 반환됩니다.
+var ErrUnknownStyle = errors.New("알 수 없는 스타일")
+
+// Taxonomy는 스타일 slug/이름/동의어 해석과 검증을 담당하는 도메인 서비스입니다.
+type Taxonomy struct {
+	styles []*Style
+	bySlug map[string]*Style
+}
+
+// NewTaxonomy는 스타일 목록으로 분류 체계를 구성합니다.
+func NewTaxonomy(styles []*Style) *Taxonomy {
+	t := &Taxonomy{styles: styles, bySlug: make(map[string]*Style, len(styles))}
+	for _, s := range styles {
+		t.bySlug[s.Slug] = s
+	}
+	return t
+}
+
+// Styles는 등록된 스타일 목록을 반환합니다.
+func (t *Taxonomy) Styles() []*Style {
+	return t.styles
+}
+
+// Find는 slug로 스타일을 찾습니다.
+func (t *Taxonomy) Find(slug string) *Style {
+	return t.bySlug[slug]
+}
+
+// Resolve는 slug, 언어별 이름, 동의어 중 하나로 스타일을 찾습니다.
+func (t *Taxonomy) Resolve(term string) *Style {
+	term = strings.TrimSpace(term)
+	if term == "" {
+		return nil
+	}
+	if s, ok := t.bySlug[strings.ToLower(term)]; ok {
+		return s
+	}
+	for _, s := range t.styles {
+		if s.Name.Equals(term) {
+			return s
+		}
+		for _, syn := range s.Synonyms {
+			if strings.EqualFold(syn, term) {
+				return s
+			}
+		}
+	}
+	return nil
+}
+
+// Normalize는 스타일 표기 목록을 slug 목록으로 변환합니다.
+// 분류 체계에 없는 스타일이 있으면 ErrUnknownStyle을 반환합니다.
+func (t *Taxonomy) Normalize(terms []string) ([]string, error) {
+	slugs := make([]string, 0, len(terms))
+	for _, term := range terms {
+		s := t.Resolve(term)
+		if s == nil {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownStyle, term)
+		}
+		slugs = append(slugs, s.Slug)
+	}
+	return slugs, nil
+}
@@ -0,0 +1,139 @@
+package submission
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+)
+
+const (
+	// MaxTextLength는 제보 메모와 검토 의견의 최대 글자 수입니다.
+	MaxTextLength = 1000
+)
+
+var (
+	ErrSubmissionNotFound = errors.New("코스 제보를 찾을 수 없습니다")
+	ErrInvalidSubmission  = errors.New("코스 제보 내용이 올바르지 않습니다")
+	// ErrAlreadyReviewed는 이미 수락하거나 반려한 제보를 다시 검토하는 경우입니다.
+	ErrAlreadyReviewed = errors.New("이미 검토한 제보입니다")
+	ErrCommentRequired = errors.New("반려 사유가 필요합니다")
+)
+
+// Status는 제보의 검토 상태입니다.
+type Status string
+
+const (
+	StatusPending  Status = "pending"
+	StatusAccepted Status = "accepted"
+	StatusRejected Status = "rejected"
+)
+
+// Valid는 알려진 상태인지 확인합니다.
+func (s Status) Valid() bool {
+	switch s {
+	case StatusPending, StatusAccepted, StatusRejected:
+		return true
+	}
+	return false
+}
+
+// Submission은 사용자가 제보한 새 코스입니다. 관리자가 수락하면 코스로 게시되고 CourseID에 배정된 ID가 남습니다.
+type Submission struct {
+	ID          int
+	SubmitterID int
+	// Course는 제보한 코스입니다. 이름과 내비게이션 포인트 이름은 한국어만 담고, 평가는 제보자가 제안한 값입니다.
+	Course *course.CourseAggregate
+	Note   string
+	Status Status
+	// CourseID는 수락해 게시한 코스 ID입니다.
+	CourseID int
+	// DuplicateOf는 이미 있는 코스와 같아 반려한 경우 그 코스 ID입니다.
+	DuplicateOf   int
+	ReviewerID    int
+	ReviewComment string
+	SubmittedAt   time.Time
+	ReviewedAt    *time.Time
+}
+
+// New는 코스 제보를 만듭니다. 출발지와 도착지를 알 수 있도록 내비게이션 포인트가 2개 이상 필요합니다.
+// 코스 내용 검증은 호출자가 합니다. ID는 저장소가 배정합니다.
+func New(submitterID int, c *course.CourseAggregate, note string, now time.Time) (*Submission, error) {
+	if len(c.Nav) < 2 {
+		return nil, fmt.Errorf("%w: 출발지와 도착지가 필요합니다", ErrInvalidSubmission)
+	}
+	note = strings.TrimSpace(note)
+	if utf8.RuneCountInString(note) > MaxTextLength {
+		return nil, fmt.Errorf("%w: 메모는 %d자까지 입력할 수 있습니다", ErrInvalidSubmission, MaxTextLength)
+	}
+	c.ID = 0
+	return &Submission{
+		SubmitterID: submitterID,
+		Course:      c,
+		Note:        note,
+		Status:      StatusPending,
+		SubmittedAt: now,
+	}, nil
+}
+
+// SubmittedBy는 사용자가 제보자인지 확인합니다.
+func (s *Submission) SubmittedBy(userID int) bool {
+	return s.SubmitterID == userID
+}
+
+// CheckPending은 제보가 아직 검토 대기 중인지 확인합니다.
+func (s *Submission) CheckPending() error {
+	if s.Status != StatusPending {
+		return ErrAlreadyReviewed
+	}
+	return nil
+}
+
+// Accept는 제보를 수락합니다. courseID에는 게시할 코스에 배정한 ID를 넘기며, 호출자는 수락한 제보를 저장한 뒤 게시합니다.
+func (s *Submission) Accept(reviewerID, courseID int, comment string, now time.Time) error {
+	if err := s.CheckPending(); err != nil {
+		return err
+	}
+	comment, err := normalizeComment(comment)
+	if err != nil {
+		return err
+	}
+	s.CourseID = courseID
+	s.review(StatusAccepted, reviewerID, comment, now)
+	return nil
+}
+
+// Reject는 제보를 반려합니다. 반려 사유가 필요하며, 이미 있는 코스와 같으면 duplicateOf에 그 코스 ID를 넘깁니다.
+func (s *Submission) Reject(reviewerID, duplicateOf int, comment string, now time.Time) error {
+	if err := s.CheckPending(); err != nil {
+		return err
+	}
+	comment, err := normalizeComment(comment)
+	if err != nil {
+		return err
+	}
+	if comment == "" {
+		return ErrCommentRequired
+	}
+	s.DuplicateOf = duplicateOf
+	s.review(StatusRejected, reviewerID, comment, now)
+	return nil
+}
+
+func (s *Submission) review(status Status, reviewerID int, comment string, now time.Time) {
+	s.Status = status
+	s.ReviewerID = reviewerID
+	s.ReviewComment = comment
+	s.ReviewedAt = &now
+}
+
+func normalizeComment(comment string) (string, error) {
+	comment = strings.TrimSpace(comment)
+	if utf8.RuneCountInString(comment) > MaxTextLength {
+		return "", fmt.Errorf("%w: 검토 의견은 %d자까지 입력할 수 있습니다", ErrInvalidSubmission, MaxTextLength)
+	}
+	return comment, nil
+}
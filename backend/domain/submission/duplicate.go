@@ -0,0 +1,105 @@
+package submission
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+)
+
+const (
+	// EndpointRadiusKm는 출발지·도착지가 이 거리 안에 있으면 같은 길로 보는 반경입니다.
+	EndpointRadiusKm = 2.0
+	// NameSimilarityThreshold는 이름 유사도가 이 값 이상이면 같은 코스일 수 있다고 보는 기준입니다.
+	NameSimilarityThreshold = 0.6
+	// maxDuplicates는 중복 후보를 최대 몇 개까지 보여줄지입니다.
+	maxDuplicates = 5
+)
+
+// Duplicate는 제보한 코스와 같을 수 있는 기존 코스입니다.
+type Duplicate struct {
+	Course *course.CourseAggregate
+	// EndpointsMatch는 출발지와 도착지가 모두 EndpointRadiusKm 안에 있는지 여부입니다. 반대 방향으로 달리는 코스도 포함합니다.
+	EndpointsMatch bool
+	// StartDistanceKm, EndDistanceKm는 방향을 맞춘 출발지·도착지 사이 거리입니다.
+	StartDistanceKm float64
+	EndDistanceKm   float64
+	// NameSimilarity는 이름의 글자 2-gram 다이스 계수(0~1)로, 언어별 이름 중 가장 높은 값입니다.
+	NameSimilarity float64
+}
+
+// FindDuplicates는 제보한 코스와 출발지·도착지가 가깝거나 이름이 비슷한 기존 코스를 찾습니다.
+// 출발지·도착지가 겹치는 코스를 먼저, 그다음 이름이 비슷한 순으로 최대 5개를 반환합니다.
+func FindDuplicates(proposed *course.CourseAggregate, existing []*course.CourseAggregate) []Duplicate {
+	var result []Duplicate
+	for _, c := range existing {
+		d := Duplicate{Course: c}
+		d.StartDistanceKm, d.EndDistanceKm, d.EndpointsMatch = compareEndpoints(proposed, c)
+		for _, name := range proposed.Name {
+			for _, other := range c.Name {
+				d.NameSimilarity = max(d.NameSimilarity, nameSimilarity(name, other))
+			}
+		}
+		if d.EndpointsMatch || d.NameSimilarity >= NameSimilarityThreshold {
+			result = append(result, d)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].EndpointsMatch != result[j].EndpointsMatch {
+			return result[i].EndpointsMatch
+		}
+		return result[i].NameSimilarity > result[j].NameSimilarity
+	})
+	if len(result) > maxDuplicates {
+		result = result[:maxDuplicates]
+	}
+	return result
+}
+
+// compareEndpoints는 같은 방향과 반대 방향 중 더 가까운 쪽의 출발지·도착지 거리를 반환합니다.
+func compareEndpoints(a, b *course.CourseAggregate) (start, end float64, match bool) {
+	aStart, ok1 := a.StartPoint()
+	aEnd, ok2 := a.EndPoint()
+	bStart, ok3 := b.StartPoint()
+	bEnd, ok4 := b.EndPoint()
+	if !ok1 || !ok2 || !ok3 || !ok4 {
+		return 0, 0, false
+	}
+	start, end = aStart.DistanceKm(bStart), aEnd.DistanceKm(bEnd)
+	if rs, re := aStart.DistanceKm(bEnd), aEnd.DistanceKm(bStart); rs+re < start+end {
+		start, end = rs, re
+	}
+	return start, end, start <= EndpointRadiusKm && end <= EndpointRadiusKm
+}
+
+// nameSimilarity는 공백·기호와 "코스"를 뺀 두 이름의 글자 2-gram 다이스 계수입니다.
+func nameSimilarity(a, b string) float64 {
+	ga, gb := nameBigrams(a), nameBigrams(b)
+	if len(ga) == 0 || len(gb) == 0 {
+		return 0
+	}
+	shared := 0
+	for g := range ga {
+		if gb[g] {
+			shared++
+		}
+	}
+	return 2 * float64(shared) / float64(len(ga)+len(gb))
+}
+
+func nameBigrams(name string) map[string]bool {
+	name = strings.ToLower(name)
+	name = strings.NewReplacer("코스", "", "course", "", "コース", "").Replace(name)
+	var runes []rune
+	for _, r := range name {
+		if unicode.IsLetter(r) || unicode.IsNumber(r) {
+			runes = append(runes, r)
+		}
+	}
+	grams := map[string]bool{}
+	for i := 1; i < len(runes); i++ {
+		grams[string(runes[i-1:i+1])] = true
+	}
+	return grams
+}
@@ -0,0 +1,98 @@
+package submission
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+)
+
+func TestNameSimilarity(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want float64
+	}{
+		{"중미산 ~ 유명산 코스", "중미산~유명산", 1},
+		{"Yumyeongsan Course", "yumyeongsan", 1},
+		{"중미산", "유명산", 0},
+		// 글자 2-gram 5개씩 중 3개가 같으면 기준값과 정확히 같다.
+		{"가나다라마바", "가나다라사아", 0.6},
+		{"가나다라마", "가나다바사", 0.5},
+		{"중미산 유명산", "중미산 드라이브", 4.0 / 11},
+		{"산", "산", 0},
+		{"코스", "코스", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+" / "+tt.b, func(t *testing.T) {
+			if got := nameSimilarity(tt.a, tt.b); math.Abs(got-tt.want) > 1e-12 {
+				t.Fatalf("nameSimilarity() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// 위도 0.001도는 약 111m입니다.
+func lineCourse(id int, name string, startLat, endLat float64) *course.CourseAggregate {
+	return &course.CourseAggregate{ID: id, Name: i18n.Text(name), Nav: []course.CourseNav{
+		{Geolocation: course.CourseGeolocation{Latitude: startLat, Longitude: 127.0}},
+		{Geolocation: course.CourseGeolocation{Latitude: endLat, Longitude: 127.0}},
+	}}
+}
+
+func TestCompareEndpoints(t *testing.T) {
+	proposed := lineCourse(0, "제보", 37.0, 37.2)
+	tests := []struct {
+		name      string
+		other     *course.CourseAggregate
+		wantMatch bool
+		wantStart float64
+		wantEnd   float64
+	}{
+		{"같은 출발지와 도착지", lineCourse(1, "", 37.0, 37.2), true, 0, 0},
+		{"반대 방향으로 달리는 코스", lineCourse(2, "", 37.2, 37.0), true, 0, 0},
+		{"도착지가 반경 바로 안", lineCourse(3, "", 37.0, 37.217), true, 0, 1.89},
+		{"도착지가 반경 바로 밖", lineCourse(4, "", 37.0, 37.219), false, 0, 2.11},
+		{"출발지가 반경 밖", lineCourse(5, "", 36.98, 37.2), false, 2.22, 0},
+		{"포인트가 없는 코스", &course.CourseAggregate{ID: 6}, false, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, match := compareEndpoints(proposed, tt.other)
+			if match != tt.wantMatch || math.Abs(start-tt.wantStart) > 0.01 || math.Abs(end-tt.wantEnd) > 0.01 {
+				t.Fatalf("compareEndpoints() = %.3f, %.3f, %v; want %.2f, %.2f, %v", start, end, match, tt.wantStart, tt.wantEnd, tt.wantMatch)
+			}
+		})
+	}
+}
+
+func TestFindDuplicates(t *testing.T) {
+	proposed := lineCourse(0, "가나다라마바", 37.0, 37.2)
+	existing := []*course.CourseAggregate{
+		lineCourse(1, "전혀 다른 길", 35.0, 35.2),
+		lineCourse(2, "가나다라사아", 35.0, 35.2),   // 이름 유사도 0.6
+		lineCourse(3, "가나다바사", 35.0, 35.2),    // 이름 유사도 0.5
+		lineCourse(4, "전혀 다른 길", 37.2, 37.0),  // 반대 방향
+		lineCourse(5, "가나다라마바", 37.0, 37.217), // 같은 길, 같은 이름
+		lineCourse(6, "가나다라마바", 35.0, 35.2),   // 이름만 같다
+	}
+	var got []int
+	for _, d := range FindDuplicates(proposed, existing) {
+		got = append(got, d.Course.ID)
+	}
+	// 출발지·도착지가 겹치는 코스를 먼저, 그 안에서는 이름이 비슷한 순으로 놓는다.
+	if want := []int{5, 4, 6, 2}; !slices.Equal(got, want) {
+		t.Fatalf("duplicates = %v, want %v", got, want)
+	}
+
+	// 후보는 최대 5개까지만 보여준다.
+	var many []*course.CourseAggregate
+	for id := 1; id <= maxDuplicates+2; id++ {
+		many = append(many, lineCourse(id, fmt.Sprintf("가나다라마바 %d", id), 35.0, 35.2))
+	}
+	if n := len(FindDuplicates(proposed, many)); n != maxDuplicates {
+		t.Fatalf("len(duplicates) = %d, want %d", n, maxDuplicates)
+	}
+}
@@ -0,0 +1,16 @@
+package submission
+
+// Filter는 제보 목록 조회 조건입니다. 0이나 빈 값인 조건은 적용하지 않습니다.
+type Filter struct {
+	SubmitterID int
+	Status      Status
+}
+
+// SubmissionRepository는 코스 제보 저장/조회를 담당하는 인터페이스입니다.
+type SubmissionRepository interface {
+	// Save는 제보를 저장합니다. ID가 0이면 새 ID를 배정합니다.
+	Save(s *Submission) error
+	FindByID(id int) (*Submission, error)
+	// Find는 조건에 맞는 제보를 최근 제보 순으로 반환합니다.
+	Find(filter Filter) ([]*Submission, error)
+}
@@ -0,0 +1,49 @@
+package trip
+
+import (
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+)
+
+// Estimate는 구간의 예상 거리와 소요 시간입니다.
+type Estimate struct {
+	DistanceKm float64
+	Duration   time.Duration
+}
+
+// Estimator는 코스 사이 이동 구간과 코스 주행 구간의 거리와 시간을 추정합니다.
+// 직선거리 기반 추정 외에 경로 탐색 엔진을 쓰는 구현으로 바꿀 수 있습니다.
+type Estimator interface {
+	Transit(from, to course.CourseGeolocation) Estimate
+	Course(c *course.CourseAggregate) Estimate
+}
+
+// HaversineEstimator는 직선거리에 우회 계수를 곱하고 평균 속도로 나눠 추정합니다.
+type HaversineEstimator struct {
+	// TransitDetour는 이동 구간의 실제 도로 거리 / 직선거리 비율입니다.
+	TransitDetour   float64
+	TransitSpeedKmh float64
+	// CourseDetour는 굽은 길이 많은 코스 구간의 실제 도로 거리 / 직선거리 비율입니다.
+	CourseDetour   float64
+	CourseSpeedKmh float64
+}
+
+// DefaultEstimator는 국도·고속도로 혼합 이동(60km/h)과 와인딩 코스 주행(40km/h)을 가정한 추정기입니다.
+var DefaultEstimator = HaversineEstimator{TransitDetour: 1.3, TransitSpeedKmh: 60, CourseDetour: 1.4, CourseSpeedKmh: 40}
+
+func (e HaversineEstimator) Transit(from, to course.CourseGeolocation) Estimate {
+	return estimate(from.DistanceKm(to)*e.TransitDetour, e.TransitSpeedKmh)
+}
+
+// Course는 도로에 맞춘 코스 경로가 있으면 그 거리를, 없으면 내비게이션 포인트 직선거리에 우회 계수를 곱한 거리를 사용합니다.
+func (e HaversineEstimator) Course(c *course.CourseAggregate) Estimate {
+	if c.Route != nil && c.Route.Reachable() {
+		return estimate(c.Route.DistanceKm, e.CourseSpeedKmh)
+	}
+	return estimate(c.ApproxLengthKm()*e.CourseDetour, e.CourseSpeedKmh)
+}
+
+func estimate(km, speedKmh float64) Estimate {
+	return Estimate{DistanceKm: km, Duration: time.Duration(km / speedKmh * float64(time.Hour)).Round(time.Minute)}
+}
@@ -0,0 +1,72 @@
+package trip
+
+import (
+	"encoding/xml"
+	"io"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+)
+
+type gpxDocument struct {
+	XMLName   xml.Name   `xml:"gpx"`
+	Version   string     `xml:"version,attr"`
+	Creator   string     `xml:"creator,attr"`
+	Namespace string     `xml:"xmlns,attr"`
+	Name      string     `xml:"metadata>name"`
+	Waypoints []gpxPoint `xml:"wpt"`
+	Routes    []gpxRoute `xml:"rte"`
+}
+
+type gpxRoute struct {
+	Name   string     `xml:"name"`
+	Type   string     `xml:"type"`
+	Points []gpxPoint `xml:"rtept"`
+}
+
+type gpxPoint struct {
+	Lat  float64 `xml:"lat,attr"`
+	Lon  float64 `xml:"lon,attr"`
+	Name string  `xml:"name,omitempty"`
+}
+
+func point(g course.CourseGeolocation, name string) gpxPoint {
+	return gpxPoint{Lat: g.Latitude, Lon: g.Longitude, Name: name}
+}
+
+// WriteGPX는 일정을 GPX 1.1 문서로 씁니다. 각 구간은 경로(rte) 하나가 되며,
+// 코스 구간은 내비게이션 포인트를, 이동 구간은 양 끝점만 담습니다. 출발지와 각 코스 시작점은 웨이포인트(wpt)로 넣습니다.
+// 이름은 lang 언어로 쓰며, title과 startName은 문서 이름과 출발지 웨이포인트 이름입니다.
+func (it *Itinerary) WriteGPX(w io.Writer, lang i18n.Lang, title, startName string) error {
+	doc := gpxDocument{
+		Version:   "1.1",
+		Creator:   "Winding Road Finder",
+		Namespace: "http://www.topografix.com/GPX/1/1",
+		Name:      title,
+		Waypoints: []gpxPoint{point(it.Start, startName)},
+	}
+	for _, l := range it.Legs {
+		switch l.Kind {
+		case LegCourse:
+			name := l.Course.Name.In(lang)
+			doc.Waypoints = append(doc.Waypoints, point(l.From, name))
+			rte := gpxRoute{Name: name, Type: string(LegCourse)}
+			for _, n := range l.Course.Nav {
+				rte.Points = append(rte.Points, point(n.Geolocation, n.Name.In(lang)))
+			}
+			doc.Routes = append(doc.Routes, rte)
+		case LegTransit:
+			name := "→ " + startName
+			if l.Course != nil {
+				name = "→ " + l.Course.Name.In(lang)
+			}
+			doc.Routes = append(doc.Routes, gpxRoute{Name: name, Type: string(LegTransit), Points: []gpxPoint{point(l.From, ""), point(l.To, "")}})
+		}
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	return enc.Encode(doc)
+}
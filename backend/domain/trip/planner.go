@@ -0,0 +1,258 @@
+package trip
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+)
+
+// MaxCourses는 여행 하나에 넣을 수 있는 최대 코스 수입니다.
+const MaxCourses = 15
+
+var (
+	ErrNoCourses             = errors.New("여행에 포함할 코스가 없습니다")
+	ErrTooManyCourses        = errors.New("여행에는 코스를 15개까지 넣을 수 있습니다")
+	ErrInvalidBudget         = errors.New("시간 예산이 올바르지 않습니다")
+	ErrBudgetTooShort        = errors.New("시간 예산 안에 넣을 수 있는 코스가 없습니다")
+	ErrNoRoute               = errors.New("내비게이션 정보가 없는 코스는 여행에 넣을 수 없습니다")
+	ErrUnknownCourse         = errors.New("존재하지 않는 코스입니다")
+	ErrUnknownRecommendation = errors.New("존재하지 않는 추천입니다")
+)
+
+// LegKind는 일정 구간의 종류입니다.
+type LegKind string
+
+const (
+	// LegTransit은 출발지나 이전 코스 끝에서 다음 코스 시작(또는 출발지)까지 이동하는 구간입니다.
+	LegTransit LegKind = "transit"
+	// LegCourse는 코스를 내비게이션 포인트 순서대로 주행하는 구간입니다.
+	LegCourse LegKind = "course"
+)
+
+// Leg는 일정의 한 구간입니다. Course는 코스 구간에서만 값이 있으며, 이동 구간은 도착할 코스(복귀 구간은 nil)입니다.
+type Leg struct {
+	Kind   LegKind
+	Course *course.CourseAggregate
+	From   course.CourseGeolocation
+	To     course.CourseGeolocation
+	Estimate
+	// Offset은 여행 출발부터 이 구간 시작까지 걸린 시간입니다.
+	Offset time.Duration
+}
+
+// Options는 여행 계획 조건입니다.
+type Options struct {
+	Start course.CourseGeolocation
+	// Budget은 전체 여행 시간 한도입니다. 0이면 제한이 없습니다.
+	Budget time.Duration
+	// ReturnToStart가 true면 마지막 코스 뒤에 출발지로 돌아오는 구간을 포함합니다.
+	ReturnToStart bool
+}
+
+// Itinerary는 코스 순서를 정한 여행 일정입니다.
+type Itinerary struct {
+	Options
+	Legs []Leg
+	// Dropped는 시간 예산을 넘겨 일정에서 뺀 코스입니다. 뺀 순서대로 담습니다.
+	Dropped []*course.CourseAggregate
+	Estimate
+	// TransitKm는 코스 사이 이동 거리 합입니다.
+	TransitKm float64
+}
+
+// Courses는 일정에 포함된 코스를 주행 순서대로 반환합니다.
+func (it *Itinerary) Courses() []*course.CourseAggregate {
+	var courses []*course.CourseAggregate
+	for _, l := range it.Legs {
+		if l.Kind == LegCourse {
+			courses = append(courses, l.Course)
+		}
+	}
+	return courses
+}
+
+// Plan은 코스 사이 이동 시간이 가장 짧아지도록 순서를 정해 일정을 만듭니다.
+// 순서는 최근접 이웃으로 시작해 2-opt로 개선하는 휴리스틱이며, 각 코스는 첫 내비게이션 포인트에서 시작해 마지막 포인트에서 끝납니다.
+// 시간 예산을 넘으면 빼었을 때 전체 시간이 가장 많이 줄어드는 코스부터 하나씩 Dropped로 옮기고 순서를 다시 정합니다.
+// 코스 하나도 예산 안에 들지 않으면 빈 일정 대신 ErrBudgetTooShort를 반환합니다.
+// 같은 코스가 여러 번 있으면 한 번만 넣습니다.
+func Plan(courses []*course.CourseAggregate, opts Options, est Estimator) (*Itinerary, error) {
+	if opts.Budget < 0 {
+		return nil, ErrInvalidBudget
+	}
+	courses = uniqueCourses(courses)
+	if len(courses) == 0 {
+		return nil, ErrNoCourses
+	}
+	if len(courses) > MaxCourses {
+		return nil, ErrTooManyCourses
+	}
+	for _, c := range courses {
+		if len(c.Nav) == 0 {
+			return nil, ErrNoRoute
+		}
+	}
+
+	m := newCostMatrix(courses, opts, est)
+	order := m.improve(m.nearestNeighbour())
+	it := build(courses, order, opts, est)
+	var dropped []*course.CourseAggregate
+	for opts.Budget > 0 && it.Duration > opts.Budget && len(order) > 0 {
+		bestK, bestDuration := -1, time.Duration(0)
+		for k := range order {
+			d := build(courses, slices.Delete(slices.Clone(order), k, k+1), opts, est).Duration
+			if bestK < 0 || d < bestDuration {
+				bestK, bestDuration = k, d
+			}
+		}
+		dropped = append(dropped, courses[order[bestK]])
+		order = m.improve(slices.Delete(order, bestK, bestK+1))
+		it = build(courses, order, opts, est)
+	}
+	if len(order) == 0 {
+		return nil, ErrBudgetTooShort
+	}
+	it.Dropped = dropped
+	return it, nil
+}
+
+func uniqueCourses(courses []*course.CourseAggregate) []*course.CourseAggregate {
+	seen := map[int]bool{}
+	var result []*course.CourseAggregate
+	for _, c := range courses {
+		if c != nil && !seen[c.ID] {
+			seen[c.ID] = true
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+func entry(c *course.CourseAggregate) course.CourseGeolocation {
+	return c.Nav[0].Geolocation
+}
+
+func exit(c *course.CourseAggregate) course.CourseGeolocation {
+	return c.Nav[len(c.Nav)-1].Geolocation
+}
+
+// costMatrix는 코스 간 이동 시간입니다. between[i][j]는 i 코스 끝에서 j 코스 시작까지입니다.
+// 코스 주행 시간은 순서와 무관하므로 이동 시간만 최소화합니다.
+type costMatrix struct {
+	between   [][]time.Duration
+	fromStart []time.Duration
+	toStart   []time.Duration
+	roundTrip bool
+}
+
+func newCostMatrix(courses []*course.CourseAggregate, opts Options, est Estimator) *costMatrix {
+	n := len(courses)
+	m := &costMatrix{between: make([][]time.Duration, n), fromStart: make([]time.Duration, n), toStart: make([]time.Duration, n), roundTrip: opts.ReturnToStart}
+	for i, a := range courses {
+		m.fromStart[i] = est.Transit(opts.Start, entry(a)).Duration
+		m.toStart[i] = est.Transit(exit(a), opts.Start).Duration
+		m.between[i] = make([]time.Duration, n)
+		for j, b := range courses {
+			if i != j {
+				m.between[i][j] = est.Transit(exit(a), entry(b)).Duration
+			}
+		}
+	}
+	return m
+}
+
+func (m *costMatrix) cost(order []int) time.Duration {
+	if len(order) == 0 {
+		return 0
+	}
+	total := m.fromStart[order[0]]
+	for k := 1; k < len(order); k++ {
+		total += m.between[order[k-1]][order[k]]
+	}
+	if m.roundTrip {
+		total += m.toStart[order[len(order)-1]]
+	}
+	return total
+}
+
+// nearestNeighbour는 출발지에서 가장 가까운 코스부터 차례로 방문하는 순서를 만듭니다.
+func (m *costMatrix) nearestNeighbour() []int {
+	n := len(m.fromStart)
+	visited := make([]bool, n)
+	order := make([]int, 0, n)
+	for len(order) < n {
+		best := -1
+		for j := 0; j < n; j++ {
+			if visited[j] {
+				continue
+			}
+			if best < 0 || m.next(order, j) < m.next(order, best) {
+				best = j
+			}
+		}
+		visited[best] = true
+		order = append(order, best)
+	}
+	return order
+}
+
+func (m *costMatrix) next(order []int, j int) time.Duration {
+	if len(order) == 0 {
+		return m.fromStart[j]
+	}
+	return m.between[order[len(order)-1]][j]
+}
+
+// improve는 구간을 뒤집어 이동 시간이 줄어드는 동안 2-opt 교환을 반복합니다.
+// 이동 시간이 방향에 따라 다를 수 있으므로 매번 전체 비용을 다시 계산합니다.
+func (m *costMatrix) improve(order []int) []int {
+	best := m.cost(order)
+	for improved := true; improved; {
+		improved = false
+		for i := 0; i < len(order)-1; i++ {
+			for j := i + 1; j < len(order); j++ {
+				reverse(order[i : j+1])
+				if c := m.cost(order); c < best {
+					best = c
+					improved = true
+				} else {
+					reverse(order[i : j+1])
+				}
+			}
+		}
+	}
+	return order
+}
+
+func reverse(s []int) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+// build는 정해진 순서로 이동·코스 구간을 이어 일정을 만듭니다.
+func build(courses []*course.CourseAggregate, order []int, opts Options, est Estimator) *Itinerary {
+	it := &Itinerary{Options: opts}
+	add := func(l Leg) {
+		l.Offset = it.Duration
+		it.Legs = append(it.Legs, l)
+		it.DistanceKm += l.DistanceKm
+		it.Duration += l.Duration
+		if l.Kind == LegTransit {
+			it.TransitKm += l.DistanceKm
+		}
+	}
+	at := opts.Start
+	for _, i := range order {
+		c := courses[i]
+		add(Leg{Kind: LegTransit, Course: c, From: at, To: entry(c), Estimate: est.Transit(at, entry(c))})
+		add(Leg{Kind: LegCourse, Course: c, From: entry(c), To: exit(c), Estimate: est.Course(c)})
+		at = exit(c)
+	}
+	if opts.ReturnToStart && len(order) > 0 {
+		add(Leg{Kind: LegTransit, From: at, To: opts.Start, Estimate: est.Transit(at, opts.Start)})
+	}
+	return it
+}
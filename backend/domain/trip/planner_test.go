@@ -0,0 +1,157 @@
+package trip
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+)
+
+// lineEstimator는 경도 0.01도를 이동 1분으로 보고, 코스 주행 시간은 코스 ID별로 정합니다.
+type lineEstimator map[int]time.Duration
+
+func (e lineEstimator) Transit(from, to course.CourseGeolocation) Estimate {
+	d := math.Abs(to.Longitude-from.Longitude) * 100
+	return Estimate{DistanceKm: d, Duration: time.Duration(math.Round(d)) * time.Minute}
+}
+
+func (e lineEstimator) Course(c *course.CourseAggregate) Estimate {
+	return Estimate{Duration: e[c.ID]}
+}
+
+// at은 출발지에서 경도 x*0.01도 떨어진 곳에서 시작하고 끝나는 코스입니다.
+func at(id int, x float64) *course.CourseAggregate {
+	p := course.CourseGeolocation{Latitude: 37, Longitude: 127 + x*0.01}
+	return &course.CourseAggregate{ID: id, Nav: []course.CourseNav{{Geolocation: p}, {Geolocation: p}}}
+}
+
+var home = course.CourseGeolocation{Latitude: 37, Longitude: 127}
+
+func ids(courses []*course.CourseAggregate) []int {
+	var out []int
+	for _, c := range courses {
+		out = append(out, c.ID)
+	}
+	return out
+}
+
+func TestPlanOrder(t *testing.T) {
+	tests := []struct {
+		name    string
+		courses []*course.CourseAggregate
+		round   bool
+		want    []int
+		// transit는 출발지에서 마지막 코스(또는 복귀)까지의 이동 시간 합(분)입니다.
+		transit time.Duration
+	}{
+		{"가까운 코스부터", []*course.CourseAggregate{at(1, 3), at(2, 1), at(3, 2)}, false, []int{2, 3, 1}, 3},
+		// 최근접 이웃은 1 → -2 → 4(1+3+6분)이지만, 2-opt로 앞 구간을 뒤집으면 -2 → 1 → 4(2+3+3분)다.
+		{"2-opt로 개선", []*course.CourseAggregate{at(1, 1), at(2, -2), at(3, 4)}, false, []int{2, 1, 3}, 8},
+		{"출발지로 돌아온다", []*course.CourseAggregate{at(1, 1), at(2, -2), at(3, 4)}, true, []int{2, 1, 3}, 12},
+		{"같은 코스와 nil은 한 번만", []*course.CourseAggregate{at(1, 2), nil, at(1, 2), at(2, 1)}, false, []int{2, 1}, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			it, err := Plan(tt.courses, Options{Start: home, ReturnToStart: tt.round}, lineEstimator{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := ids(it.Courses()); !slices.Equal(got, tt.want) {
+				t.Fatalf("order = %v, want %v", got, tt.want)
+			}
+			if it.Duration != tt.transit*time.Minute || math.Abs(it.TransitKm-float64(tt.transit)) > 1e-6 {
+				t.Fatalf("duration = %v, transitKm = %v; want %v", it.Duration, it.TransitKm, tt.transit*time.Minute)
+			}
+			wantLegs := 2 * len(tt.want)
+			if tt.round {
+				wantLegs++
+			}
+			if len(it.Legs) != wantLegs {
+				t.Fatalf("len(Legs) = %d, want %d", len(it.Legs), wantLegs)
+			}
+			last := it.Legs[len(it.Legs)-1]
+			if tt.round && (last.Kind != LegTransit || last.Course != nil || last.To != home) {
+				t.Fatalf("last leg = %+v, want a transit back to start", last)
+			}
+			var offset time.Duration
+			for i, l := range it.Legs {
+				if l.Offset != offset {
+					t.Errorf("Legs[%d].Offset = %v, want %v", i, l.Offset, offset)
+				}
+				offset += l.Duration
+			}
+		})
+	}
+}
+
+// 예산을 넘으면 빼었을 때 전체 시간이 가장 많이 줄어드는 코스부터 뺀다.
+func TestPlanBudget(t *testing.T) {
+	// 출발지에서 1, 2, 3분 거리에 코스가 있고 주행 시간은 10, 60, 10분이다. 전부 돌면 3+80 = 83분.
+	courses := func() []*course.CourseAggregate { return []*course.CourseAggregate{at(1, 1), at(2, 2), at(3, 3)} }
+	est := lineEstimator{1: 10 * time.Minute, 2: 60 * time.Minute, 3: 10 * time.Minute}
+	tests := []struct {
+		name    string
+		budget  time.Duration
+		want    []int
+		dropped []int
+		err     error
+	}{
+		{"예산 없음", 0, []int{1, 2, 3}, nil, nil},
+		{"딱 맞는 예산", 83 * time.Minute, []int{1, 2, 3}, nil, nil},
+		{"가장 긴 코스를 뺀다", 82 * time.Minute, []int{1, 3}, []int{2}, nil},
+		// 2를 빼면 23분. 3을 빼면 11분, 1을 빼면 13분이므로 3을 뺀다.
+		{"두 코스를 뺀다", 15 * time.Minute, []int{1}, []int{2, 3}, nil},
+		{"코스 하나도 들지 않는다", 10 * time.Minute, nil, nil, ErrBudgetTooShort},
+		{"음수 예산", -time.Minute, nil, nil, ErrInvalidBudget},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			it, err := Plan(courses(), Options{Start: home, Budget: tt.budget}, est)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Plan() error = %v, want %v", err, tt.err)
+			}
+			if err != nil {
+				if it != nil {
+					t.Fatalf("Plan() = %+v, want nil", it)
+				}
+				return
+			}
+			if got := ids(it.Courses()); !slices.Equal(got, tt.want) {
+				t.Fatalf("order = %v, want %v", got, tt.want)
+			}
+			if got := ids(it.Dropped); !slices.Equal(got, tt.dropped) {
+				t.Fatalf("dropped = %v, want %v", got, tt.dropped)
+			}
+			if tt.budget > 0 && it.Duration > tt.budget {
+				t.Fatalf("duration = %v, over budget %v", it.Duration, tt.budget)
+			}
+		})
+	}
+}
+
+func TestPlanRejects(t *testing.T) {
+	tooMany := make([]*course.CourseAggregate, MaxCourses+1)
+	for i := range tooMany {
+		tooMany[i] = at(i+1, float64(i))
+	}
+	tests := []struct {
+		name    string
+		courses []*course.CourseAggregate
+		want    error
+	}{
+		{"코스 없음", nil, ErrNoCourses},
+		{"nil만 있음", []*course.CourseAggregate{nil}, ErrNoCourses},
+		{"코스 16개", tooMany, ErrTooManyCourses},
+		{"내비게이션 포인트 없음", []*course.CourseAggregate{at(1, 1), {ID: 2}}, ErrNoRoute},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Plan(tt.courses, Options{Start: home}, lineEstimator{}); !errors.Is(err, tt.want) {
+				t.Fatalf("Plan() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
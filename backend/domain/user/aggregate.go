@@ -0,0 +1,158 @@
+package user
+
+import (
+	"errors"
+	"net/mail"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidEmail       = errors.New("이메일 형식이 올바르지 않습니다")
+	ErrWeakPassword       = errors.New("비밀번호는 8자 이상이어야 합니다")
+	ErrEmailTaken         = errors.New("이미 가입된 이메일입니다")
+	ErrInvalidCredentials = errors.New("이메일 또는 비밀번호가 올바르지 않습니다")
+	ErrUserNotFound       = errors.New("사용자를 찾을 수 없습니다")
+	ErrIdentityLinked     = errors.New("다른 계정에 연결된 외부 계정입니다")
+	ErrInvalidIdentity    = errors.New("외부 계정 정보(공급자, 사용자 ID)가 없습니다")
+	ErrLinkRequired       = errors.New("같은 이메일로 가입된 계정이 있습니다. 그 계정으로 로그인한 뒤 외부 계정을 연결해야 합니다")
+)
+
+// User는 사용자 계정 도메인 모델입니다.
+type User struct {
+	ID int
+	// Email은 로그인 이메일입니다. 이메일을 주지 않는 공급자(카카오 등)로만 가입한 사용자는 비어 있습니다.
+	Email       string
+	DisplayName string
+	// PasswordHash는 bcrypt 해시입니다. 비밀번호 없이 외부 계정으로만 가입한 사용자는 비어 있습니다.
+	PasswordHash string
+	Role         Role
+	// Identities는 연결된 외부 로그인(OIDC) 계정 목록입니다.
+	Identities []ExternalIdentity
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// NewUser는 이메일과 비밀번호로 viewer 권한의 새 사용자를 만듭니다. ID는 저장소가 배정합니다.
+func NewUser(email, displayName, password string, now time.Time) (*User, error) {
+	email, err := NormalizeEmail(email)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := HashPassword(password)
+	if err != nil {
+		return nil, err
+	}
+	displayName = strings.TrimSpace(displayName)
+	if displayName == "" {
+		displayName = email[:strings.Index(email, "@")]
+	}
+	return &User{
+		Email:        email,
+		DisplayName:  displayName,
+		PasswordHash: hash,
+		Role:         RoleViewer,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}, nil
+}
+
+// NewExternalUser는 외부 계정 정보로 비밀번호 없는 viewer 사용자를 만듭니다.
+// 공급자가 인증한 이메일만 계정 이메일로 쓰며, 이메일이 없거나 인증되지 않았으면 이메일 없는 계정을 만듭니다.
+func NewExternalUser(claims ExternalClaims, now time.Time) (*User, error) {
+	if claims.Provider == "" || claims.Subject == "" {
+		return nil, ErrInvalidIdentity
+	}
+	email := claims.VerifiedEmail()
+	displayName := strings.TrimSpace(claims.Name)
+	switch {
+	case displayName != "":
+	case email != "":
+		displayName = email[:strings.Index(email, "@")]
+	default:
+		displayName = claims.Provider + " 사용자"
+	}
+	u := &User{
+		Email:       email,
+		DisplayName: displayName,
+		Role:        RoleViewer,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	u.LinkIdentity(claims, now)
+	return u, nil
+}
+
+// LinkIdentity는 외부 계정을 연결합니다. 이미 연결된 계정이면 이메일만 갱신합니다.
+func (u *User) LinkIdentity(claims ExternalClaims, now time.Time) {
+	for i, id := range u.Identities {
+		if id.Provider == claims.Provider && id.Subject == claims.Subject {
+			u.Identities[i].Email = claims.Email
+			return
+		}
+	}
+	u.Identities = append(u.Identities, ExternalIdentity{
+		Provider: claims.Provider,
+		Subject:  claims.Subject,
+		Email:    claims.Email,
+		LinkedAt: now,
+	})
+	u.UpdatedAt = now
+}
+
+// HasIdentity는 외부 계정이 연결되어 있는지 확인합니다.
+func (u *User) HasIdentity(provider, subject string) bool {
+	for _, id := range u.Identities {
+		if id.Provider == provider && id.Subject == subject {
+			return true
+		}
+	}
+	return false
+}
+
+// Authenticate는 비밀번호가 일치하는지 확인합니다.
+func (u *User) Authenticate(password string) error {
+	if u.PasswordHash == "" {
+		return RejectPassword(password)
+	}
+	if !CheckPassword(u.PasswordHash, password) {
+		return ErrInvalidCredentials
+	}
+	return nil
+}
+
+// ChangePassword는 비밀번호 정책을 확인하고 비밀번호를 바꿉니다.
+func (u *User) ChangePassword(password string, now time.Time) error {
+	hash, err := HashPassword(password)
+	if err != nil {
+		return err
+	}
+	u.PasswordHash = hash
+	u.UpdatedAt = now
+	return nil
+}
+
+// ChangeRole은 사용자 권한을 바꿉니다.
+func (u *User) ChangeRole(role Role, now time.Time) error {
+	if !role.Valid() {
+		return ErrInvalidRole
+	}
+	u.Role = role
+	u.UpdatedAt = now
+	return nil
+}
+
+// Principal은 인증된 사용자의 신원 정보를 반환합니다.
+func (u *User) Principal() Principal {
+	return Principal{UserID: u.ID, Email: u.Email, Role: u.Role}
+}
+
+// NormalizeEmail은 이메일 형식을 검사하고 소문자로 정규화합니다.
+func NormalizeEmail(email string) (string, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return "", ErrInvalidEmail
+	}
+	return email, nil
+}
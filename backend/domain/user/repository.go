@@ -0,0 +1,12 @@
+package user
+
+// UserRepository는 사용자 계정 저장/조회를 담당하는 인터페이스입니다.
+type UserRepository interface {
+	// Save는 사용자를 저장합니다. ID가 0이면 새 ID를 배정합니다.
+	Save(u *User) error
+	FindByID(id int) (*User, error)
+	FindByEmail(email string) (*User, error)
+	// FindByIdentity는 외부 계정이 연결된 사용자를 찾습니다.
+	FindByIdentity(provider, subject string) (*User, error)
+	FindAll() ([]*User, error)
+}
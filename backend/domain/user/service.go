@@ -0,0 +1,41 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrInvalidToken은 서명, 만료, 종류가 올바르지 않은 토큰입니다.
+var ErrInvalidToken = errors.New("유효하지 않은 토큰입니다")
+
+// TokenPair는 발급된 액세스/리프레시 토큰입니다.
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+	// ExpiresAt은 액세스 토큰 만료 시각입니다.
+	ExpiresAt time.Time
+}
+
+// TokenService는 인증 토큰 발급·검증을 담당하는 인터페이스입니다.
+type TokenService interface {
+	// Issue는 사용자에게 액세스 토큰과 리프레시 토큰을 발급합니다.
+	Issue(u *User) (TokenPair, error)
+	// Verify는 액세스 토큰을 검증하고 요청 사용자 정보를 반환합니다.
+	Verify(token string) (*Principal, error)
+	// ParseRefresh는 리프레시 토큰을 검증하고 사용자 ID를 반환합니다.
+	ParseRefresh(token string) (int, error)
+}
+
+// ErrUnknownProvider는 설정되지 않은 외부 로그인 공급자입니다.
+var ErrUnknownProvider = errors.New("알 수 없는 로그인 공급자입니다")
+
+// IdentityProvider는 OIDC 인가 코드 흐름(PKCE)을 지원하는 외부 로그인 공급자입니다.
+type IdentityProvider interface {
+	// Name은 공급자 이름입니다. (예: google, kakao)
+	Name() string
+	// AuthCodeURL은 사용자를 보낼 인가 요청 URL을 만듭니다. codeChallenge는 S256 방식입니다.
+	AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error)
+	// Exchange는 인가 코드를 토큰으로 교환하고 ID 토큰을 검증해 사용자 정보를 반환합니다.
+	Exchange(ctx context.Context, code, codeVerifier, nonce string) (ExternalClaims, error)
+}
@@ -0,0 +1,104 @@
+package user
+
+import (
+	"errors"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// ErrInvalidRole은 알 수 없는 권한입니다.
+var ErrInvalidRole = errors.New("알 수 없는 권한입니다")
+
+// Role은 사용자 권한입니다. viewer < editor < admin 순으로 상위 권한이 하위 권한을 포함합니다.
+type Role string
+
+const (
+	// RoleViewer는 조회와 개인 데이터 작성만 가능한 기본 권한입니다.
+	RoleViewer Role = "viewer"
+	// RoleEditor는 코스와 추천 데이터를 수정할 수 있는 권한입니다.
+	RoleEditor Role = "editor"
+	// RoleAdmin은 사용자 관리까지 가능한 최상위 권한입니다.
+	RoleAdmin Role = "admin"
+)
+
+var roleRank = map[Role]int{RoleViewer: 1, RoleEditor: 2, RoleAdmin: 3}
+
+// Valid는 정의된 권한인지 확인합니다.
+func (r Role) Valid() bool {
+	_, ok := roleRank[r]
+	return ok
+}
+
+// Allows는 이 권한이 required 권한의 작업을 수행할 수 있는지 확인합니다.
+func (r Role) Allows(required Role) bool {
+	return r.Valid() && roleRank[r] >= roleRank[required]
+}
+
+// Principal은 요청을 보낸 인증된 사용자입니다.
+type Principal struct {
+	UserID int
+	Email  string
+	Role   Role
+}
+
+// ExternalIdentity는 사용자에게 연결된 외부 로그인 계정입니다.
+type ExternalIdentity struct {
+	// Provider는 IdentityProvider 이름입니다. (예: google, kakao)
+	Provider string
+	// Subject는 공급자가 발급한 사용자 고유 ID(sub)입니다.
+	Subject  string
+	Email    string
+	LinkedAt time.Time
+}
+
+// ExternalClaims는 외부 공급자가 확인해 준 사용자 정보입니다.
+type ExternalClaims struct {
+	Provider      string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+// VerifiedEmail은 공급자가 인증한 이메일을 정규화해 반환합니다. 이메일이 없거나 인증되지 않았으면 빈 문자열입니다.
+func (c ExternalClaims) VerifiedEmail() string {
+	if !c.EmailVerified {
+		return ""
+	}
+	email, err := NormalizeEmail(c.Email)
+	if err != nil {
+		return ""
+	}
+	return email
+}
+
+// MinPasswordLength는 비밀번호 최소 길이입니다.
+const MinPasswordLength = 8
+
+// HashPassword는 비밀번호 정책을 확인하고 bcrypt 해시를 만듭니다.
+func HashPassword(password string) (string, error) {
+	if len([]rune(password)) < MinPasswordLength {
+		return "", ErrWeakPassword
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// CheckPassword는 비밀번호가 해시와 일치하는지 확인합니다.
+func CheckPassword(hash, password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
+
+// dummyPasswordHash는 어떤 비밀번호와도 맞지 않는 고정 해시입니다. HashPassword와 같은 bcrypt.DefaultCost로 만들었습니다.
+const dummyPasswordHash = "$2a$10$DmIPDpoiPQkVRTLJzv5FkOoRRCAPKDReiJgnd49tBWhjSRQ8gk3mG"
+
+// RejectPassword는 비밀번호를 고정 해시와 비교한 뒤 ErrInvalidCredentials를 반환합니다.
+// 가입되지 않은 이메일이나 비밀번호가 없는 계정의 로그인도 해시 비교 시간만큼 걸리게 해 응답 시간으로 가입 여부를 알 수 없게 합니다.
+func RejectPassword(password string) error {
+	CheckPassword(dummyPasswordHash, password)
+	return ErrInvalidCredentials
+}
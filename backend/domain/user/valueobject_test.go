@@ -0,0 +1,41 @@
+package user
+
+import (
+	"errors"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// 고정 해시는 실제 비밀번호 해시와 같은 비용이어야 가입되지 않은 이메일의 로그인도 같은 시간이 걸린다.
+func TestDummyPasswordHashCost(t *testing.T) {
+	cost, err := bcrypt.Cost([]byte(dummyPasswordHash))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cost != bcrypt.DefaultCost {
+		t.Fatalf("cost = %d, want %d", cost, bcrypt.DefaultCost)
+	}
+}
+
+func TestAuthenticateWithoutPassword(t *testing.T) {
+	tests := []struct {
+		name     string
+		password string
+	}{
+		{"빈 비밀번호", ""},
+		{"아무 비밀번호", "password1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := RejectPassword(tt.password); !errors.Is(err, ErrInvalidCredentials) {
+				t.Fatalf("RejectPassword() error = %v, want %v", err, ErrInvalidCredentials)
+			}
+			// 외부 계정으로만 가입해 비밀번호가 없는 사용자입니다.
+			u := &User{Email: "oidc@example.com"}
+			if err := u.Authenticate(tt.password); !errors.Is(err, ErrInvalidCredentials) {
+				t.Fatalf("Authenticate() error = %v, want %v", err, ErrInvalidCredentials)
+			}
+		})
+	}
+}
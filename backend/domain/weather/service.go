@@ -0,0 +1,40 @@
+package weather
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrUnavailable은 예보 제공자에게서 예보를 받지 못했을 때 반환합니다.
+var ErrUnavailable = errors.New("날씨 예보를 가져올 수 없습니다")
+
+// DryWindow는 노면이 마른 상태인지 판단할 때 살펴보는 예보 구간입니다.
+const DryWindow = 6 * time.Hour
+
+// Provider는 격자 좌표의 시간별 예보를 제공합니다. (기상청 단기예보, 테스트용 파일 등)
+type Provider interface {
+	Forecast(ctx context.Context, cell GridCell) (*Forecast, error)
+}
+
+// CachedProvider는 받아 둔 예보를 격자별로 보관하는 Provider입니다.
+// 목록 필터처럼 외부 호출 없이 빠르게 판단해야 할 때 Cached로 보관된 예보만 조회합니다.
+type CachedProvider interface {
+	Provider
+	Cached(cell GridCell) (*Forecast, bool)
+}
+
+// DryRoads는 now부터 DryWindow 동안 노면이 젖을 만한 예보가 없는지 확인합니다.
+// 그 구간의 예보가 하나도 없으면 판단할 수 없으므로 false를 반환합니다.
+func DryRoads(f *Forecast, now time.Time) bool {
+	hours := f.Upcoming(now, DryWindow)
+	if len(hours) == 0 {
+		return false
+	}
+	for _, h := range hours {
+		if h.Wet() {
+			return false
+		}
+	}
+	return true
+}
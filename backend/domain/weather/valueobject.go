@@ -0,0 +1,132 @@
+package weather
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// GridCell은 기상청 동네예보 격자(5km) 좌표입니다. 같은 격자의 코스 지점은 예보를 공유합니다.
+type GridCell struct {
+	NX int
+	NY int
+}
+
+func (g GridCell) String() string {
+	return fmt.Sprintf("%d,%d", g.NX, g.NY)
+}
+
+// 기상청 격자 변환(Lambert 정각원추도법) 상수입니다.
+const (
+	earthRadiusKm = 6371.00877
+	gridKm        = 5.0
+	stdLat1       = 30.0
+	stdLat2       = 60.0
+	originLng     = 126.0
+	originLat     = 38.0
+	originX       = 43
+	originY       = 136
+)
+
+// ToGrid는 위도/경도를 기상청 동네예보 격자 좌표로 변환합니다.
+func ToGrid(lat, lng float64) GridCell {
+	const rad = math.Pi / 180
+	re := earthRadiusKm / gridKm
+	slat1, slat2 := stdLat1*rad, stdLat2*rad
+	olng, olat := originLng*rad, originLat*rad
+
+	sn := math.Log(math.Cos(slat1)/math.Cos(slat2)) / math.Log(math.Tan(math.Pi/4+slat2/2)/math.Tan(math.Pi/4+slat1/2))
+	sf := math.Pow(math.Tan(math.Pi/4+slat1/2), sn) * math.Cos(slat1) / sn
+	ro := re * sf / math.Pow(math.Tan(math.Pi/4+olat/2), sn)
+
+	ra := re * sf / math.Pow(math.Tan(math.Pi/4+lat*rad/2), sn)
+	theta := lng*rad - olng
+	if theta > math.Pi {
+		theta -= 2 * math.Pi
+	}
+	if theta < -math.Pi {
+		theta += 2 * math.Pi
+	}
+	theta *= sn
+	return GridCell{
+		NX: int(math.Floor(ra*math.Sin(theta) + originX + 0.5)),
+		NY: int(math.Floor(ro - ra*math.Cos(theta) + originY + 0.5)),
+	}
+}
+
+// Precipitation은 강수 형태입니다.
+type Precipitation string
+
+const (
+	PrecipitationNone   Precipitation = "none"
+	PrecipitationRain   Precipitation = "rain"
+	PrecipitationSleet  Precipitation = "sleet"
+	PrecipitationSnow   Precipitation = "snow"
+	PrecipitationShower Precipitation = "shower"
+)
+
+// Sky는 하늘 상태입니다.
+type Sky string
+
+const (
+	SkyClear    Sky = "clear"
+	SkyCloudy   Sky = "cloudy"
+	SkyOvercast Sky = "overcast"
+)
+
+// Hourly는 한 시간 단위 예보입니다.
+type Hourly struct {
+	Time         time.Time
+	TemperatureC float64
+	// PrecipitationChance는 강수확률(%)입니다.
+	PrecipitationChance int
+	Precipitation       Precipitation
+	// PrecipitationMm는 1시간 강수량(mm)입니다.
+	PrecipitationMm float64
+	Sky             Sky
+	WindSpeedMs     float64
+	HumidityPct     int
+}
+
+// Wet은 노면이 젖을 만한 예보인지 확인합니다.
+func (h Hourly) Wet() bool {
+	falling := h.Precipitation != "" && h.Precipitation != PrecipitationNone
+	return falling || h.PrecipitationMm > 0 || h.PrecipitationChance >= WetChanceThreshold
+}
+
+// WetChanceThreshold는 노면이 젖을 것으로 보는 강수확률(%)입니다.
+const WetChanceThreshold = 40
+
+// Forecast는 격자 하나의 시간별 예보입니다. Hours는 시각 순입니다.
+type Forecast struct {
+	Cell     GridCell
+	IssuedAt time.Time
+	Hours    []Hourly
+}
+
+// At은 t가 속한 시간의 예보를 반환합니다. t가 예보 범위를 벗어나면 false를 반환합니다.
+func (f *Forecast) At(t time.Time) (Hourly, bool) {
+	for i, h := range f.Hours {
+		if t.Before(h.Time) {
+			if i == 0 {
+				return Hourly{}, false
+			}
+			return f.Hours[i-1], true
+		}
+	}
+	if n := len(f.Hours); n > 0 && t.Before(f.Hours[n-1].Time.Add(time.Hour)) {
+		return f.Hours[n-1], true
+	}
+	return Hourly{}, false
+}
+
+// Upcoming은 from부터 d 동안의 예보를 반환합니다. from이 속한 시간도 포함합니다.
+func (f *Forecast) Upcoming(from time.Time, d time.Duration) []Hourly {
+	var result []Hourly
+	for _, h := range f.Hours {
+		if h.Time.Add(time.Hour).After(from) && h.Time.Before(from.Add(d)) {
+			result = append(result, h)
+		}
+	}
+	return result
+}
@@ -0,0 +1,110 @@
+package auth
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+)
+
+const (
+	tokenTypeAccess  = "access"
+	tokenTypeRefresh = "refresh"
+	issuer           = "winding-road-finder"
+)
+
+type claims struct {
+	jwt.RegisteredClaims
+	Email string    `json:"email,omitempty"`
+	Role  user.Role `json:"role,omitempty"`
+	Type  string    `json:"typ"`
+}
+
+// JWTTokenService는 HS256으로 서명한 JWT를 발급·검증하는 user.TokenService 구현체입니다.
+type JWTTokenService struct {
+	secret     []byte
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+	now        func() time.Time
+}
+
+func NewJWTTokenService(secret []byte, accessTTL, refreshTTL time.Duration) *JWTTokenService {
+	return &JWTTokenService{secret: secret, accessTTL: accessTTL, refreshTTL: refreshTTL, now: time.Now}
+}
+
+// Issue는 사용자에게 액세스 토큰과 리프레시 토큰을 발급합니다.
+// 리프레시 토큰에는 권한을 담지 않아 재발급 시 저장소의 최신 권한이 반영됩니다.
+func (s *JWTTokenService) Issue(u *user.User) (user.TokenPair, error) {
+	now := s.now()
+	expiresAt := now.Add(s.accessTTL)
+	access, err := s.sign(claims{
+		RegisteredClaims: s.registered(u.ID, now, expiresAt),
+		Email:            u.Email,
+		Role:             u.Role,
+		Type:             tokenTypeAccess,
+	})
+	if err != nil {
+		return user.TokenPair{}, err
+	}
+	refresh, err := s.sign(claims{
+		RegisteredClaims: s.registered(u.ID, now, now.Add(s.refreshTTL)),
+		Type:             tokenTypeRefresh,
+	})
+	if err != nil {
+		return user.TokenPair{}, err
+	}
+	return user.TokenPair{AccessToken: access, RefreshToken: refresh, ExpiresAt: expiresAt}, nil
+}
+
+func (s *JWTTokenService) Verify(token string) (*user.Principal, error) {
+	c, err := s.parse(token, tokenTypeAccess)
+	if err != nil {
+		return nil, err
+	}
+	id, err := strconv.Atoi(c.Subject)
+	if err != nil || !c.Role.Valid() {
+		return nil, user.ErrInvalidToken
+	}
+	return &user.Principal{UserID: id, Email: c.Email, Role: c.Role}, nil
+}
+
+func (s *JWTTokenService) ParseRefresh(token string) (int, error) {
+	c, err := s.parse(token, tokenTypeRefresh)
+	if err != nil {
+		return 0, err
+	}
+	id, err := strconv.Atoi(c.Subject)
+	if err != nil {
+		return 0, user.ErrInvalidToken
+	}
+	return id, nil
+}
+
+func (s *JWTTokenService) registered(userID int, issuedAt, expiresAt time.Time) jwt.RegisteredClaims {
+	return jwt.RegisteredClaims{
+		Issuer:    issuer,
+		Subject:   strconv.Itoa(userID),
+		IssuedAt:  jwt.NewNumericDate(issuedAt),
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+	}
+}
+
+func (s *JWTTokenService) sign(c claims) (string, error) {
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, c).SignedString(s.secret)
+}
+
+func (s *JWTTokenService) parse(token, tokenType string) (*claims, error) {
+	var c claims
+	_, err := jwt.ParseWithClaims(token, &c, func(*jwt.Token) (any, error) {
+		return s.secret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithIssuer(issuer), jwt.WithTimeFunc(s.now))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", user.ErrInvalidToken, err)
+	}
+	if c.Type != tokenType {
+		return nil, fmt.Errorf("%w: %s 토큰이 아닙니다", user.ErrInvalidToken, tokenType)
+	}
+	return &c, nil
+}
@@ -0,0 +1,143 @@
+// Package httpfixture는 외부 HTTP 응답을 파일에 기록하고 다시 재생하는 http.RoundTripper를 제공합니다.
+// 외부 서비스 없이 링크 해석 등 연동 코드를 같은 응답으로 반복 확인할 때 사용합니다.
+package httpfixture
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"sync"
+)
+
+// Response는 기록된 응답 하나입니다. 리다이렉트 확인에 필요한 상태 코드와 헤더, 본문을 담습니다.
+type Response struct {
+	Status int               `json:"status"`
+	Header map[string]string `json:"header,omitempty"`
+	Body   string            `json:"body,omitempty"`
+}
+
+// Fixtures는 "METHOD URL" 키별 기록된 응답입니다.
+type Fixtures map[string]Response
+
+func key(req *http.Request) string {
+	return req.Method + " " + req.URL.String()
+}
+
+// Load는 기록 파일을 읽습니다.
+func Load(path string) (Fixtures, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var f Fixtures
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return f, nil
+}
+
+// Save는 기록을 키 순서대로 파일에 씁니다. URL을 읽기 쉽도록 &, <, >는 이스케이프하지 않습니다.
+func (f Fixtures) Save(path string) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(f); err != nil {
+		return err
+	}
+	return os.WriteFile(path, buf.Bytes(), 0o644)
+}
+
+func (r Response) toHTTP(req *http.Request) *http.Response {
+	header := http.Header{}
+	for k, v := range r.Header {
+		header.Set(k, v)
+	}
+	return &http.Response{
+		StatusCode:    r.Status,
+		Status:        fmt.Sprintf("%d %s", r.Status, http.StatusText(r.Status)),
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewBufferString(r.Body)),
+		ContentLength: int64(len(r.Body)),
+		Request:       req,
+	}
+}
+
+// Replay는 기록된 응답만 돌려주는 RoundTripper입니다. 기록에 없는 요청은 에러를 반환합니다.
+type Replay struct {
+	fixtures Fixtures
+}
+
+func NewReplay(fixtures Fixtures) *Replay {
+	return &Replay{fixtures: fixtures}
+}
+
+func (t *Replay) RoundTrip(req *http.Request) (*http.Response, error) {
+	r, ok := t.fixtures[key(req)]
+	if !ok {
+		return nil, fmt.Errorf("기록된 응답이 없습니다: %s", key(req))
+	}
+	return r.toHTTP(req), nil
+}
+
+// Recorder는 실제 요청을 보내고 응답을 기록하는 RoundTripper입니다. Location 등 recordHeaders에 있는 헤더만 기록합니다.
+type Recorder struct {
+	inner         http.RoundTripper
+	recordHeaders []string
+	mu            sync.Mutex
+	fixtures      Fixtures
+}
+
+// NewRecorder는 inner로 요청을 보내는 기록기를 만듭니다. inner가 nil이면 http.DefaultTransport를 사용합니다.
+func NewRecorder(inner http.RoundTripper, recordHeaders ...string) *Recorder {
+	if inner == nil {
+		inner = http.DefaultTransport
+	}
+	sort.Strings(recordHeaders)
+	return &Recorder{inner: inner, recordHeaders: recordHeaders, fixtures: Fixtures{}}
+}
+
+func (t *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := t.inner.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(body))
+
+	r := Response{Status: res.StatusCode, Body: string(body)}
+	for _, h := range t.recordHeaders {
+		if v := res.Header.Get(h); v != "" {
+			if r.Header == nil {
+				r.Header = map[string]string{}
+			}
+			r.Header[h] = v
+		}
+	}
+	t.mu.Lock()
+	t.fixtures[key(req)] = r
+	t.mu.Unlock()
+	return res, nil
+}
+
+// Fixtures는 지금까지 기록한 응답을 반환합니다.
+func (t *Recorder) Fixtures() Fixtures {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	result := make(Fixtures, len(t.fixtures))
+	for k, v := range t.fixtures {
+		result[k] = v
+	}
+	return result
+}
@@ -0,0 +1,169 @@
+package naver
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/navlink"
+)
+
+// maxRedirects는 단축 링크를 따라갈 최대 리다이렉트 횟수입니다.
+const maxRedirects = 5
+
+// LinkResolver는 naver.me 단축 링크를 따라가 네이버 지도 길찾기 URL에서 경로 지점을 읽습니다. navlink.Resolver를 구현합니다.
+type LinkResolver struct {
+	client *http.Client
+}
+
+// NewLinkResolver는 transport로 요청하는 링크 해석기를 만듭니다. transport가 nil이면 http.DefaultTransport를 사용합니다.
+// 기록된 응답으로 확인하려면 httpfixture.Replay를 넘깁니다.
+func NewLinkResolver(transport http.RoundTripper) *LinkResolver {
+	return &LinkResolver{client: &http.Client{
+		Transport: transport,
+		Timeout:   10 * time.Second,
+		// 리다이렉트는 직접 따라가며 길찾기 URL이 나오면 멈춥니다.
+		CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+	}}
+}
+
+func (r *LinkResolver) Resolve(ctx context.Context, link string) (*navlink.Directions, error) {
+	current := link
+	for i := 0; i <= maxRedirects; i++ {
+		u, err := url.Parse(current)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", navlink.ErrUnsupportedLink, err)
+		}
+		if points, ok, err := ParseDirectionsURL(u); ok || err != nil {
+			if err != nil {
+				return nil, err
+			}
+			return &navlink.Directions{URL: current, Points: points}, nil
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, current, nil)
+		if err != nil {
+			return nil, err
+		}
+		// 모바일 브라우저로 보이면 앱 설치 페이지로 보내므로 데스크톱 브라우저로 요청합니다.
+		req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0 Safari/537.36")
+		res, err := r.client.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", navlink.ErrUnavailable, err)
+		}
+		res.Body.Close()
+		if res.StatusCode < 300 || res.StatusCode >= 400 {
+			if res.StatusCode >= 400 {
+				return nil, fmt.Errorf("%w: %s: HTTP %d", navlink.ErrUnavailable, current, res.StatusCode)
+			}
+			return nil, fmt.Errorf("%w: %s", navlink.ErrUnsupportedLink, current)
+		}
+		next, err := res.Location()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", navlink.ErrUnavailable, err)
+		}
+		current = next.String()
+	}
+	return nil, fmt.Errorf("%w: 리다이렉트가 너무 많습니다", navlink.ErrUnavailable)
+}
+
+// ParseDirectionsURL은 네이버 지도 길찾기 URL에서 출발지, 경유지, 도착지를 순서대로 읽습니다.
+// 길찾기 URL이 아니면 ok가 false이며, 길찾기 URL이지만 지점을 읽을 수 없으면 에러를 반환합니다. 지원 형식:
+//
+//	https://map.naver.com/p/directions/{출발지}/{도착지}/{경유지}/{이동수단}   (v5도 같은 형식)
+//	  지점은 "x,y,이름,장소ID,종류", 경유지는 ":"로 구분하며 없으면 "-". 좌표는 EPSG:3857 또는 경도,위도
+//	https://m.map.naver.com/route.nhn?sx=..&sy=..&sname=..&ex=..&ey=..&ename=..
+func ParseDirectionsURL(u *url.URL) ([]navlink.Point, bool, error) {
+	host := strings.ToLower(u.Hostname())
+	if host != "map.naver.com" && host != "m.map.naver.com" {
+		return nil, false, nil
+	}
+	if strings.HasSuffix(u.Path, "/route.nhn") {
+		return parseMobileRoute(u.Query())
+	}
+	segments := strings.Split(strings.Trim(u.EscapedPath(), "/"), "/")
+	for i, s := range segments {
+		if s != "directions" {
+			continue
+		}
+		rest := segments[i+1:]
+		if len(rest) < 2 {
+			return nil, true, fmt.Errorf("%w: 출발지와 도착지가 없습니다", navlink.ErrUnsupportedLink)
+		}
+		start, err := parsePathPoint(rest[0])
+		if err != nil {
+			return nil, true, err
+		}
+		goal, err := parsePathPoint(rest[1])
+		if err != nil {
+			return nil, true, err
+		}
+		points := []navlink.Point{start}
+		if len(rest) > 2 && rest[2] != "-" && rest[2] != "" {
+			for _, raw := range strings.Split(rest[2], ":") {
+				via, err := parsePathPoint(raw)
+				if err != nil {
+					return nil, true, err
+				}
+				points = append(points, via)
+			}
+		}
+		return append(points, goal), true, nil
+	}
+	return nil, false, nil
+}
+
+func parsePathPoint(escaped string) (navlink.Point, error) {
+	raw, err := url.PathUnescape(escaped)
+	if err != nil {
+		return navlink.Point{}, fmt.Errorf("%w: %v", navlink.ErrUnsupportedLink, err)
+	}
+	fields := strings.Split(raw, ",")
+	if len(fields) < 2 {
+		return navlink.Point{}, fmt.Errorf("%w: 지점 형식이 올바르지 않습니다 (%s)", navlink.ErrUnsupportedLink, raw)
+	}
+	geo, err := toGeolocation(fields[0], fields[1])
+	if err != nil {
+		return navlink.Point{}, err
+	}
+	p := navlink.Point{Geolocation: geo}
+	if len(fields) > 2 {
+		p.Name = fields[2]
+	}
+	return p, nil
+}
+
+func parseMobileRoute(q url.Values) ([]navlink.Point, bool, error) {
+	var points []navlink.Point
+	for _, prefix := range []string{"s", "e"} {
+		geo, err := toGeolocation(q.Get(prefix+"x"), q.Get(prefix+"y"))
+		if err != nil {
+			return nil, true, err
+		}
+		points = append(points, navlink.Point{Name: q.Get(prefix + "name"), Geolocation: geo})
+	}
+	return points, true, nil
+}
+
+// toGeolocation은 x, y 좌표를 위도/경도로 바꿉니다. 경도·위도 범위를 벗어나면 EPSG:3857(미터)로 봅니다.
+func toGeolocation(rawX, rawY string) (course.CourseGeolocation, error) {
+	x, errX := strconv.ParseFloat(rawX, 64)
+	y, errY := strconv.ParseFloat(rawY, 64)
+	if errX != nil || errY != nil {
+		return course.CourseGeolocation{}, fmt.Errorf("%w: 좌표가 올바르지 않습니다 (%s, %s)", navlink.ErrUnsupportedLink, rawX, rawY)
+	}
+	if math.Abs(x) <= 180 && math.Abs(y) <= 90 {
+		return course.CourseGeolocation{Latitude: y, Longitude: x}, nil
+	}
+	const earthRadiusM = 6378137
+	return course.CourseGeolocation{
+		Latitude:  (2*math.Atan(math.Exp(y/earthRadiusM)) - math.Pi/2) * 180 / math.Pi,
+		Longitude: x / earthRadiusM * 180 / math.Pi,
+	}, nil
+}
@@ -0,0 +1,335 @@
+package naver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+	"github.com/sunDar0/winding-road-finder/backend/domain/navlink"
+	"github.com/sunDar0/winding-road-finder/backend/infrastructure/external/httpfixture"
+)
+
+// 예시 응답이 가리키는 코스 좌표입니다. (data/courses.json 1, 2, 5, 6, 7번)
+var (
+	okcheon      = course.CourseGeolocation{Latitude: 37.52054568060469, Longitude: 127.45918360699554}
+	jungmisan    = course.CourseGeolocation{Latitude: 37.57934895263598, Longitude: 127.45799919873076}
+	yumyeong     = course.CourseGeolocation{Latitude: 37.59178107416535, Longitude: 127.49086884722374}
+	cheongpyeong = course.CourseGeolocation{Latitude: 37.73603066199542, Longitude: 127.42617656210103}
+	loco         = course.CourseGeolocation{Latitude: 37.74708757698912, Longitude: 127.49802839429647}
+	yeongjang    = course.CourseGeolocation{Latitude: 37.77550256106715, Longitude: 126.90843880559656}
+	songchu      = course.CourseGeolocation{Latitude: 37.71766123435577, Longitude: 126.97255244164121}
+	hansung      = course.CourseGeolocation{Latitude: 37.588765196284925, Longitude: 127.00586782275876}
+	palgakjeong  = course.CourseGeolocation{Latitude: 37.601749262527164, Longitude: 126.98044285428895}
+	changuimun   = course.CourseGeolocation{Latitude: 37.59311464526646, Longitude: 126.96654325507156}
+	// yangpyeongPlaza는 6번 순환 코스의 출발지이자 도착지입니다.
+	yangpyeongPlaza = course.CourseGeolocation{Latitude: 37.51158730194126, Longitude: 127.44272266729025}
+)
+
+// exampleLinks는 실제 네이버 응답이 아니라 코스 좌표로 직접 만든 예시 응답입니다.
+// 링크 파싱, 비교, 채우기 로직을 네트워크 없이 확인하려고 ParseDirectionsURL이 지원하는
+// 세 형식(p/directions의 EPSG:3857 좌표, v5/directions의 경도·위도, 모바일 route.nhn)으로 만들었습니다.
+// 실제로 기록한 응답은 TestResolveRecordedCourseLinks가 확인합니다.
+//   - example-drift의 경유지(북악팔각정)는 허용 거리 확인용으로 코스 좌표보다 북쪽으로 약 280m 옮겼습니다.
+//   - http://naver.me/example-redirect는 https로 옮기는 리다이렉트를 흉내 냅니다.
+var exampleLinks = httpfixture.Fixtures{
+	"GET http://naver.me/example-redirect":               {Status: 301, Header: map[string]string{"Location": "https://naver.me/example-redirect"}},
+	"GET https://map.naver.com/p/entry/place/1000000001": {Status: 200, Body: "<!doctype html><title>네이버 지도</title>"},
+	"GET https://naver.me/example-drift":                 {Status: 302, Header: map[string]string{"Location": "https://map.naver.com/p/directions/14138228.5337873,4521493.9087014,%EC%84%9C%EC%9A%B8%20%EC%84%B1%EB%B6%81%EA%B5%AC%20%ED%95%9C%EC%84%B1%EB%8C%80%EC%9E%85%EA%B5%AC%EC%97%AD,,PLACE_POI/14133850.9429367,4522104.9472307,%EC%84%9C%EC%9A%B8%20%EC%A2%85%EB%A1%9C%EA%B5%AC%20%EC%B0%BD%EC%9D%98%EB%AC%B8,,PLACE_POI/14135398.2392438,4523669.3725397,%EB%B6%81%EC%95%85%ED%8C%94%EA%B0%81%EC%A0%95,,PLACE_POI/car?c=12.00,0,0,0,dh"}},
+	"GET https://naver.me/example-expired":               {Status: 404, Body: "Not Found"},
+	"GET https://naver.me/example-mobile":                {Status: 302, Header: map[string]string{"Location": "https://m.map.naver.com/route.nhn?menu=route&sname=%EC%98%81%EC%9E%A51%EB%A6%AC%EB%A7%88%EC%9D%84%ED%9A%8C%EA%B4%80&sx=126.90843880559656&sy=37.77550256106715&ename=%EC%86%A1%EC%B6%94%EA%B0%80%EB%A7%88%EA%B3%A8+%EB%B3%B8%EA%B4%80&ex=126.97255244164121&ey=37.71766123435577&pathType=0&showMap=true"}},
+	"GET https://naver.me/example-p":                     {Status: 302, Header: map[string]string{"Location": "https://map.naver.com/p/directions/14188691.4160571,4511914.6600303,%EC%96%91%ED%8F%89%EA%B5%B0%20%EC%98%A5%EC%B2%9C%EB%A9%B4%20%EC%98%A5%EC%B2%9C%EB%86%8D%ED%98%91%ED%95%98%EB%82%98%EB%A1%9C%EB%A7%88%ED%8A%B8,,PLACE_POI/14192218.6008650,4521917.5948010,%EC%9C%A0%EB%AA%85%EC%82%B0%EC%9E%90%EC%97%B0%ED%9C%B4%EC%96%91%EB%A6%BC,,PLACE_POI/14188559.5683322,4520171.1766289,%EC%A4%91%EB%AF%B8%EC%82%B0%EC%82%BC%EA%B1%B0%EB%A6%AC%28%EC%A4%91%EB%AF%B8%EC%82%B0%EC%B2%9C%EB%AC%B8%EB%8C%80%29,,PLACE_POI/car?c=12.00,0,0,0,dh"}},
+	"GET https://naver.me/example-place":                 {Status: 302, Header: map[string]string{"Location": "https://map.naver.com/p/entry/place/1000000001"}},
+	"GET https://naver.me/example-redirect":              {Status: 302, Header: map[string]string{"Location": "https://map.naver.com/p/directions/14186858.9926311,4510657.3932322,SK%EC%97%94%ED%81%AC%EB%A6%B0%20%EC%96%91%ED%8F%89%ED%94%84%EB%9D%BC%EC%9E%90%EC%A3%BC%EC%9C%A0%EC%86%8C,,PLACE_POI/14186858.9926311,4510657.3932322,SK%EC%97%94%ED%81%AC%EB%A6%B0%20%EC%96%91%ED%8F%89%ED%94%84%EB%9D%BC%EC%9E%90%EC%A3%BC%EC%9C%A0%EC%86%8C,,PLACE_POI/14192454.0943487,4523264.1743697,%EC%9C%A0%EB%AA%85%EC%82%B0%20%EC%82%BC%EA%B1%B0%EB%A6%AC,,PLACE_POI:14199136.6343542,4531983.6728893,%EB%84%90%EB%AF%B8%EC%9E%AC,,PLACE_POI:14215074.4009311,4524481.9028880,%EB%B0%B1%EC%96%91%EC%B9%98%20%EC%98%9B%EA%B8%B8,,PLACE_POI/car?c=12.00,0,0,0,dh"}},
+	"GET https://naver.me/example-v5":                    {Status: 302, Header: map[string]string{"Location": "https://map.naver.com/v5/directions/127.426177,37.736031,%EA%B0%80%ED%8F%89%EA%B5%B0%20%EC%B2%AD%ED%8F%89%EB%A9%B4%20%EC%B2%AD%ED%8F%89%EC%97%AD,,ADDRESS_POI/127.498028,37.747088,%EB%A1%9C%EC%BD%94%EA%B0%A4%EB%9F%AC%EB%A6%AC,,ADDRESS_POI/-/car"}},
+}
+
+func newReplayResolver() *LinkResolver {
+	return NewLinkResolver(httpfixture.NewReplay(exampleLinks))
+}
+
+func TestResolve(t *testing.T) {
+	resolver := newReplayResolver()
+	tests := []struct {
+		name   string
+		link   string
+		names  []string
+		points []course.CourseGeolocation
+		err    error
+	}{
+		{
+			name:   "p/directions, EPSG:3857 좌표와 경유지",
+			link:   "https://naver.me/example-p",
+			names:  []string{"양평군 옥천면 옥천농협하나로마트", "중미산삼거리(중미산천문대)", "유명산자연휴양림"},
+			points: []course.CourseGeolocation{okcheon, jungmisan, yumyeong},
+		},
+		{
+			name:   "v5/directions, 경도·위도 좌표와 빈 경유지",
+			link:   "https://naver.me/example-v5",
+			names:  []string{"가평군 청평면 청평역", "로코갤러리"},
+			points: []course.CourseGeolocation{cheongpyeong, loco},
+		},
+		{
+			name:   "모바일 route.nhn 쿼리",
+			link:   "https://naver.me/example-mobile",
+			names:  []string{"영장1리마을회관", "송추가마골 본관"},
+			points: []course.CourseGeolocation{yeongjang, songchu},
+		},
+		{
+			name:  "http에서 https로 리다이렉트한 뒤 경유지 세 개",
+			link:  "http://naver.me/example-redirect",
+			names: []string{"SK엔크린 양평프라자주유소", "유명산 삼거리", "널미재", "백양치 옛길", "SK엔크린 양평프라자주유소"},
+		},
+		{name: "장소 페이지", link: "https://naver.me/example-place", err: navlink.ErrUnsupportedLink},
+		{name: "만료된 링크", link: "https://naver.me/example-expired", err: navlink.ErrUnavailable},
+		{name: "응답이 없는 링크", link: "https://naver.me/unknown", err: navlink.ErrUnavailable},
+		{name: "이미 길찾기 URL이면 요청하지 않는다", link: "https://map.naver.com/v5/directions/127.426177,37.736031,a/127.498028,37.747088,b/-/car", names: []string{"a", "b"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := resolver.Resolve(context.Background(), tt.link)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("Resolve() error = %v, want %v", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(d.Points) != len(tt.names) {
+				t.Fatalf("len(Points) = %d, want %d", len(d.Points), len(tt.names))
+			}
+			for i, p := range d.Points {
+				if p.Name != tt.names[i] {
+					t.Errorf("Points[%d].Name = %q, want %q", i, p.Name, tt.names[i])
+				}
+				// 좌표 변환과 반올림 오차는 1m 안이어야 한다.
+				if i < len(tt.points) && p.Geolocation.DistanceKm(tt.points[i])*1000 > 1 {
+					t.Errorf("Points[%d] = %v, want %v", i, p.Geolocation, tt.points[i])
+				}
+			}
+		})
+	}
+}
+
+// 리다이렉트가 끝나지 않으면 maxRedirects번까지만 따라간다.
+func TestResolveTooManyRedirects(t *testing.T) {
+	resolver := NewLinkResolver(httpfixture.NewReplay(httpfixture.Fixtures{
+		"GET https://naver.me/loop": {Status: 302, Header: map[string]string{"Location": "https://naver.me/loop"}},
+	}))
+	if _, err := resolver.Resolve(context.Background(), "https://naver.me/loop"); !errors.Is(err, navlink.ErrUnavailable) {
+		t.Fatalf("Resolve() error = %v, want %v", err, navlink.ErrUnavailable)
+	}
+}
+
+func TestParseDirectionsURL(t *testing.T) {
+	tests := []struct {
+		name   string
+		raw    string
+		ok     bool
+		points int
+		err    bool
+	}{
+		{"다른 호스트", "https://naver.me/example-p", false, 0, false},
+		{"길찾기가 아닌 지도 URL", "https://map.naver.com/p/entry/place/1000000001", false, 0, false},
+		{"대문자 호스트와 이동수단 없음", "https://MAP.naver.com/p/directions/127.1,37.1,a/127.2,37.2,b", true, 2, false},
+		{"경유지 두 개", "https://map.naver.com/p/directions/127.1,37.1,a/127.4,37.4,d/127.2,37.2,b:127.3,37.3,c/car", true, 4, false},
+		{"도착지 없음", "https://map.naver.com/p/directions/127.1,37.1,a", true, 0, true},
+		{"좌표가 아님", "https://map.naver.com/p/directions/abc,37.1,a/127.2,37.2,b/-/car", true, 0, true},
+		{"좌표가 하나뿐", "https://map.naver.com/p/directions/127.1/127.2,37.2,b/-/car", true, 0, true},
+		{"모바일 좌표 누락", "https://m.map.naver.com/route.nhn?sx=126.9&sy=37.7&ename=b", true, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := url.Parse(tt.raw)
+			if err != nil {
+				t.Fatal(err)
+			}
+			points, ok, err := ParseDirectionsURL(u)
+			if ok != tt.ok || (err != nil) != tt.err || len(points) != tt.points {
+				t.Fatalf("ParseDirectionsURL() = %d points, %v, %v; want %d, %v, error %v", len(points), ok, err, tt.points, tt.ok, tt.err)
+			}
+			if tt.err && !errors.Is(err, navlink.ErrUnsupportedLink) {
+				t.Fatalf("error = %v, want %v", err, navlink.ErrUnsupportedLink)
+			}
+		})
+	}
+}
+
+func navOf(points ...course.CourseGeolocation) []course.CourseNav {
+	nav := make([]course.CourseNav, len(points))
+	for i, p := range points {
+		nav[i] = course.CourseNav{Name: i18n.Text("기존 포인트"), Geolocation: p}
+	}
+	return nav
+}
+
+// 링크 지점과 코스 포인트가 허용 거리(150m)보다 멀면 어긋난 지점으로 본다.
+func TestCompareWithResolvedLinks(t *testing.T) {
+	resolver := newReplayResolver()
+	tests := []struct {
+		name       string
+		link       string
+		nav        []course.CourseNav
+		consistent bool
+		drifted    []int
+	}{
+		{"코스와 같은 링크", "https://naver.me/example-p", navOf(okcheon, jungmisan, yumyeong), true, nil},
+		// example-drift의 경유지는 코스 좌표보다 약 280m 북쪽에 있다.
+		{"경유지가 어긋난 링크", "https://naver.me/example-drift", navOf(hansung, palgakjeong, changuimun), false, []int{1}},
+		{"허용 거리 안에서 옮긴 포인트", "https://naver.me/example-v5", navOf(cheongpyeong, offsetNorth(loco, navlink.DriftToleranceM-10)), true, nil},
+		{"허용 거리를 넘겨 옮긴 포인트", "https://naver.me/example-v5", navOf(offsetNorth(cheongpyeong, navlink.DriftToleranceM+10), loco), false, []int{0}},
+		// 지점 수가 다르면 출발지와 도착지만 비교한다.
+		{"경유지가 빠진 코스", "https://naver.me/example-p", navOf(okcheon, yumyeong), false, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := resolver.Resolve(context.Background(), tt.link)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r := navlink.Compare(&course.CourseAggregate{ID: 1, Nav: tt.nav}, d)
+			if r.Consistent() != tt.consistent {
+				t.Fatalf("Consistent() = %v, want %v (%+v)", r.Consistent(), tt.consistent, r)
+			}
+			var drifted []int
+			for _, p := range r.Drifted() {
+				drifted = append(drifted, p.Nav)
+			}
+			if !slices.Equal(drifted, tt.drifted) {
+				t.Fatalf("Drifted() navs = %v, want %v", drifted, tt.drifted)
+			}
+		})
+	}
+}
+
+// offsetNorth는 지점을 북쪽으로 m미터 옮긴 좌표를 반환합니다.
+func offsetNorth(g course.CourseGeolocation, m float64) course.CourseGeolocation {
+	g.Latitude += m / (6371000 * math.Pi / 180)
+	return g
+}
+
+func TestBackfillFromResolvedLinks(t *testing.T) {
+	resolver := newReplayResolver()
+	tests := []struct {
+		name  string
+		link  string
+		nav   []course.CourseNav
+		ok    bool
+		types []string
+		// kept는 기존 포인트를 그대로 쓴 인덱스입니다.
+		kept []int
+	}{
+		{"포인트가 없는 코스", "https://naver.me/example-p", nil, true, []string{"출발지", "경유지 1", "도착지"}, nil},
+		{"경유지가 빠진 코스", "https://naver.me/example-p", navOf(okcheon, yumyeong), true, []string{"출발지", "경유지 1", "도착지"}, []int{0, 2}},
+		{"경유지 세 개를 채운다", "http://naver.me/example-redirect", navOf(yangpyeongPlaza, yangpyeongPlaza), true, []string{"출발지", "경유지 1", "경유지 2", "경유지 3", "도착지"}, []int{0, 4}},
+		{"이미 포인트 수가 같은 코스", "https://naver.me/example-p", navOf(okcheon, jungmisan, yumyeong), false, nil, nil},
+		{"출발지가 어긋난 코스", "https://naver.me/example-p", navOf(offsetNorth(okcheon, 500), yumyeong), false, nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := resolver.Resolve(context.Background(), tt.link)
+			if err != nil {
+				t.Fatal(err)
+			}
+			nav, ok := navlink.Backfill(&course.CourseAggregate{ID: 1, Nav: tt.nav}, d)
+			if ok != tt.ok {
+				t.Fatalf("Backfill() ok = %v, want %v", ok, tt.ok)
+			}
+			if !ok {
+				return
+			}
+			if len(nav) != len(tt.types) {
+				t.Fatalf("len(nav) = %d, want %d", len(nav), len(tt.types))
+			}
+			for i, n := range nav {
+				if n.Type != tt.types[i] {
+					t.Errorf("nav[%d].Type = %q, want %q", i, n.Type, tt.types[i])
+				}
+				kept := n.Name[i18n.Korean] == "기존 포인트"
+				if want := slices.Contains(tt.kept, i); kept != want {
+					t.Errorf("nav[%d] kept existing = %v, want %v", i, kept, want)
+				}
+			}
+		})
+	}
+}
+
+// recordedLinksPath는 cmd/navlinks -record로 기록한 실제 응답 파일입니다.
+const recordedLinksPath = "testdata/links.json"
+
+// 실제로 기록한 응답이 있으면 코스마다 링크를 풀어 data/courses.json의 출발지·도착지와 맞는지 확인한다.
+func TestResolveRecordedCourseLinks(t *testing.T) {
+	fixtures, err := httpfixture.Load(recordedLinksPath)
+	if errors.Is(err, os.ErrNotExist) {
+		t.Skipf("%s가 없습니다. backend에서 go run ./cmd/navlinks -record infrastructure/external/naver/%s로 기록하세요", recordedLinksPath, recordedLinksPath)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile("../../../data/courses.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// courses.json에서 링크와 좌표만 읽습니다.
+	var courses []struct {
+		ID          int    `json:"id"`
+		NaverMapUrl string `json:"naverMapUrl"`
+		Nav         []struct {
+			Geolocation course.CourseGeolocation `json:"geolocation"`
+		} `json:"nav"`
+	}
+	if err := json.Unmarshal(data, &courses); err != nil {
+		t.Fatal(err)
+	}
+	// 여러 코스가 같은 링크를 쓰면 아직 자기 링크가 없는 코스이므로 확인하지 않습니다.
+	owners := map[string]int{}
+	for _, c := range courses {
+		owners[c.NaverMapUrl]++
+	}
+	resolver := NewLinkResolver(httpfixture.NewReplay(fixtures))
+	checked := 0
+	for _, c := range courses {
+		if _, ok := fixtures["GET "+c.NaverMapUrl]; !ok || owners[c.NaverMapUrl] > 1 || len(c.Nav) < 2 {
+			continue
+		}
+		checked++
+		t.Run(strconv.Itoa(c.ID), func(t *testing.T) {
+			d, err := resolver.Resolve(context.Background(), c.NaverMapUrl)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(d.Points) < 2 {
+				t.Fatalf("len(Points) = %d, want at least 2", len(d.Points))
+			}
+			// 경유지는 코스와 어긋날 수 있지만(cmd/navlinks가 찾는 경우) 출발지와 도착지는 같은 코스여야 한다.
+			ends := []struct{ got, want course.CourseGeolocation }{
+				{d.Points[0].Geolocation, c.Nav[0].Geolocation},
+				{d.Points[len(d.Points)-1].Geolocation, c.Nav[len(c.Nav)-1].Geolocation},
+			}
+			for _, e := range ends {
+				if km := e.got.DistanceKm(e.want); km > 1 {
+					t.Errorf("link point %v is %.1fkm from course point %v", e.got, km, e.want)
+				}
+			}
+			nav := make([]course.CourseNav, len(c.Nav))
+			for i, n := range c.Nav {
+				nav[i] = course.CourseNav{Geolocation: n.Geolocation}
+			}
+			if r := navlink.Compare(&course.CourseAggregate{ID: c.ID, Nav: nav}, d); !r.Consistent() {
+				t.Logf("코스와 어긋난 링크: %+v", r)
+			}
+		})
+	}
+	if checked == 0 {
+		t.Fatalf("%s에 data/courses.json의 naverMapUrl 응답이 없습니다", recordedLinksPath)
+	}
+}
@@ -0,0 +1,216 @@
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// MockUser는 모의 OIDC 서버가 로그인시켜 주는 사용자입니다.
+type MockUser struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+// DefaultMockUsers는 모의 서버의 기본 사용자입니다. 인가 요청의 login_hint로 이메일이나 subject를 지정해 고를 수 있습니다.
+// mock-1003은 카카오처럼 이메일을 주지 않는 사용자입니다.
+var DefaultMockUsers = []MockUser{
+	{Subject: "mock-1001", Email: "driver@example.com", EmailVerified: true, Name: "드라이버"},
+	{Subject: "mock-1002", Email: "unverified@example.com", EmailVerified: false, Name: "미인증 사용자"},
+	{Subject: "mock-1003", Name: "이메일 없는 사용자"},
+}
+
+const mockKeyID = "mock-key"
+
+type mockGrant struct {
+	clientID      string
+	redirectURI   string
+	codeChallenge string
+	nonce         string
+	user          MockUser
+	expiresAt     time.Time
+}
+
+// MockServer는 로그인 화면 없이 바로 인가 코드를 발급하는 OIDC 서버입니다.
+// 외부 공급자 없이 인가 코드 흐름(PKCE 검증 포함)을 로컬에서 확인할 때 사용합니다.
+type MockServer struct {
+	issuer string
+	users  []MockUser
+	key    *rsa.PrivateKey
+	mux    *http.ServeMux
+
+	mu     sync.Mutex
+	grants map[string]mockGrant
+}
+
+// NewMockServer는 issuer URL에서 동작하는 모의 서버를 만듭니다. 서명 키는 실행할 때마다 새로 만듭니다.
+func NewMockServer(issuer string, users []MockUser) (*MockServer, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+	if len(users) == 0 {
+		users = DefaultMockUsers
+	}
+	s := &MockServer{
+		issuer: strings.TrimSuffix(issuer, "/"),
+		users:  users,
+		key:    key,
+		mux:    http.NewServeMux(),
+		grants: map[string]mockGrant{},
+	}
+	s.mux.HandleFunc("GET /.well-known/openid-configuration", s.handleDiscovery)
+	s.mux.HandleFunc("GET /authorize", s.handleAuthorize)
+	s.mux.HandleFunc("POST /token", s.handleToken)
+	s.mux.HandleFunc("GET /jwks", s.handleJWKS)
+	return s, nil
+}
+
+func (s *MockServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *MockServer) handleDiscovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"issuer":                                s.issuer,
+		"authorization_endpoint":                s.issuer + "/authorize",
+		"token_endpoint":                        s.issuer + "/token",
+		"jwks_uri":                              s.issuer + "/jwks",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"code_challenge_methods_supported":      []string{"S256"},
+	})
+}
+
+func (s *MockServer) handleAuthorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	redirectURI, err := url.Parse(q.Get("redirect_uri"))
+	if err != nil || redirectURI.Scheme == "" || q.Get("client_id") == "" || q.Get("response_type") != "code" {
+		http.Error(w, "invalid_request", http.StatusBadRequest)
+		return
+	}
+	if q.Get("code_challenge") == "" || q.Get("code_challenge_method") != "S256" {
+		http.Error(w, "PKCE(S256) is required", http.StatusBadRequest)
+		return
+	}
+	u := s.users[0]
+	if hint := q.Get("login_hint"); hint != "" {
+		found := false
+		for _, candidate := range s.users {
+			if candidate.Subject == hint || (candidate.Email != "" && strings.EqualFold(candidate.Email, hint)) {
+				u, found = candidate, true
+			}
+		}
+		if !found {
+			http.Error(w, "unknown login_hint", http.StatusBadRequest)
+			return
+		}
+	}
+
+	code := randomString()
+	s.mu.Lock()
+	s.grants[code] = mockGrant{
+		clientID:      q.Get("client_id"),
+		redirectURI:   q.Get("redirect_uri"),
+		codeChallenge: q.Get("code_challenge"),
+		nonce:         q.Get("nonce"),
+		user:          u,
+		expiresAt:     time.Now().Add(time.Minute),
+	}
+	s.mu.Unlock()
+
+	params := redirectURI.Query()
+	params.Set("code", code)
+	params.Set("state", q.Get("state"))
+	redirectURI.RawQuery = params.Encode()
+	http.Redirect(w, r, redirectURI.String(), http.StatusFound)
+}
+
+func (s *MockServer) handleToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil || r.PostForm.Get("grant_type") != "authorization_code" {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "unsupported_grant_type"})
+		return
+	}
+	code := r.PostForm.Get("code")
+	s.mu.Lock()
+	grant, ok := s.grants[code]
+	delete(s.grants, code)
+	s.mu.Unlock()
+	if !ok || time.Now().After(grant.expiresAt) {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant", "error_description": "unknown or expired code"})
+		return
+	}
+	if r.PostForm.Get("client_id") != grant.clientID || r.PostForm.Get("redirect_uri") != grant.redirectURI {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant", "error_description": "client_id or redirect_uri mismatch"})
+		return
+	}
+	sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if base64.RawURLEncoding.EncodeToString(sum[:]) != grant.codeChallenge {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant", "error_description": "code_verifier mismatch"})
+		return
+	}
+
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":            s.issuer,
+		"sub":            grant.user.Subject,
+		"aud":            grant.clientID,
+		"iat":            now.Unix(),
+		"exp":            now.Add(5 * time.Minute).Unix(),
+		"nonce":          grant.nonce,
+		"email":          grant.user.Email,
+		"email_verified": grant.user.EmailVerified,
+		"name":           grant.user.Name,
+	})
+	token.Header["kid"] = mockKeyID
+	idToken, err := token.SignedString(s.key)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "server_error"})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": randomString(),
+		"token_type":   "Bearer",
+		"expires_in":   300,
+		"id_token":     idToken,
+	})
+}
+
+func (s *MockServer) handleJWKS(w http.ResponseWriter, r *http.Request) {
+	pub := s.key.PublicKey
+	writeJSON(w, http.StatusOK, map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": mockKeyID,
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		}},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func randomString() string {
+	b := make([]byte, 24)
+	rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,281 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+	"github.com/sunDar0/winding-road-finder/backend/infrastructure/tracing"
+)
+
+// ErrProvider는 공급자와의 통신 또는 ID 토큰 검증 실패입니다.
+var ErrProvider = errors.New("외부 로그인 공급자 오류")
+
+// jwksRefreshInterval은 모르는 kid 때문에 JWKS를 다시 읽는 최소 간격입니다.
+// 위조한 kid로 공급자에 요청을 쏟아내지 못하게 합니다.
+const jwksRefreshInterval = time.Minute
+
+// Config는 OIDC 공급자 하나의 설정입니다.
+type Config struct {
+	Name         string
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+}
+
+// discoveryDocument는 /.well-known/openid-configuration 응답입니다.
+type discoveryDocument struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+type idTokenClaims struct {
+	jwt.RegisteredClaims
+	Nonce         string `json:"nonce"`
+	Email         string `json:"email"`
+	EmailVerified any    `json:"email_verified"`
+	Name          string `json:"name"`
+	Nickname      string `json:"nickname"`
+}
+
+// Provider는 디스커버리 문서와 JWKS로 동작하는 표준 OIDC 공급자입니다. user.IdentityProvider를 구현합니다.
+type Provider struct {
+	cfg    Config
+	client *http.Client
+	now    func() time.Time
+
+	mu        sync.Mutex
+	discovery *discoveryDocument
+	keys      map[string]*rsa.PublicKey
+	// keysFetchedAt은 JWKS를 마지막으로 읽은 시각입니다.
+	keysFetchedAt time.Time
+}
+
+func NewProvider(cfg Config) *Provider {
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = []string{"openid", "email", "profile"}
+	}
+	return &Provider{cfg: cfg, client: &http.Client{Transport: tracing.Transport(nil), Timeout: 10 * time.Second}, now: time.Now}
+}
+
+func (p *Provider) Name() string {
+	return p.cfg.Name
+}
+
+func (p *Provider) AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error) {
+	doc, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	q := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {p.cfg.ClientID},
+		"redirect_uri":          {p.cfg.RedirectURL},
+		"scope":                 {strings.Join(p.cfg.Scopes, " ")},
+		"state":                 {state},
+		"nonce":                 {nonce},
+		"code_challenge":        {codeChallenge},
+		"code_challenge_method": {"S256"},
+	}
+	sep := "?"
+	if strings.Contains(doc.AuthorizationEndpoint, "?") {
+		sep = "&"
+	}
+	return doc.AuthorizationEndpoint + sep + q.Encode(), nil
+}
+
+func (p *Provider) Exchange(ctx context.Context, code, codeVerifier, nonce string) (user.ExternalClaims, error) {
+	doc, err := p.discover(ctx)
+	if err != nil {
+		return user.ExternalClaims{}, err
+	}
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.cfg.RedirectURL},
+		"client_id":     {p.cfg.ClientID},
+		"code_verifier": {codeVerifier},
+	}
+	if p.cfg.ClientSecret != "" {
+		form.Set("client_secret", p.cfg.ClientSecret)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, doc.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return user.ExternalClaims{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	var token struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	status, err := p.doJSON(req, &token)
+	if err != nil {
+		return user.ExternalClaims{}, err
+	}
+	if status != http.StatusOK || token.IDToken == "" {
+		return user.ExternalClaims{}, fmt.Errorf("%w: 토큰 교환 실패 (%d %s %s)", ErrProvider, status, token.Error, token.ErrorDescription)
+	}
+	return p.verifyIDToken(ctx, doc, token.IDToken, nonce)
+}
+
+// verifyIDToken은 ID 토큰의 서명, 발급자, 대상, 만료, nonce를 검증합니다.
+func (p *Provider) verifyIDToken(ctx context.Context, doc *discoveryDocument, raw, nonce string) (user.ExternalClaims, error) {
+	var claims idTokenClaims
+	_, err := jwt.ParseWithClaims(raw, &claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		return p.key(ctx, doc, kid)
+	}, jwt.WithValidMethods([]string{"RS256"}), jwt.WithIssuer(doc.Issuer), jwt.WithAudience(p.cfg.ClientID),
+		jwt.WithExpirationRequired(), jwt.WithTimeFunc(p.now), jwt.WithLeeway(time.Minute))
+	if err != nil {
+		return user.ExternalClaims{}, fmt.Errorf("%w: ID 토큰 검증 실패: %v", ErrProvider, err)
+	}
+	if claims.Nonce != nonce {
+		return user.ExternalClaims{}, fmt.Errorf("%w: nonce가 일치하지 않습니다", ErrProvider)
+	}
+	name := claims.Name
+	if name == "" {
+		name = claims.Nickname
+	}
+	return user.ExternalClaims{
+		Provider:      p.cfg.Name,
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: isTrue(claims.EmailVerified),
+		Name:          name,
+	}, nil
+}
+
+// isTrue는 email_verified 값을 해석합니다. 일부 공급자는 불리언 대신 문자열 "true"를 보냅니다.
+func isTrue(v any) bool {
+	switch b := v.(type) {
+	case bool:
+		return b
+	case string:
+		ok, _ := strconv.ParseBool(b)
+		return ok
+	}
+	return false
+}
+
+// discover는 디스커버리 문서를 한 번 읽어 캐시합니다.
+func (p *Provider) discover(ctx context.Context) (*discoveryDocument, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(p.cfg.Issuer, "/")+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, err
+	}
+	var doc discoveryDocument
+	status, err := p.doJSON(req, &doc)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK || doc.AuthorizationEndpoint == "" || doc.TokenEndpoint == "" || doc.JWKSURI == "" {
+		return nil, fmt.Errorf("%w: 디스커버리 문서를 읽을 수 없습니다 (%d)", ErrProvider, status)
+	}
+	// 발급자가 다른 문서는 다른 공급자를 가리키므로 받아들이지 않습니다.
+	if strings.TrimSuffix(doc.Issuer, "/") != strings.TrimSuffix(p.cfg.Issuer, "/") {
+		return nil, fmt.Errorf("%w: 디스커버리 문서의 발급자(%q)가 설정(%q)과 다릅니다", ErrProvider, doc.Issuer, p.cfg.Issuer)
+	}
+	p.discovery = &doc
+	return p.discovery, nil
+}
+
+// key는 kid에 해당하는 서명 검증 키를 반환합니다. 모르는 kid면 키 교체로 보고 JWKS를 다시 읽되,
+// jwksRefreshInterval 안에는 다시 읽지 않습니다.
+func (p *Provider) key(ctx context.Context, doc *discoveryDocument, kid string) (*rsa.PublicKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if k, ok := p.keys[kid]; ok {
+		return k, nil
+	}
+	if p.keys != nil && p.now().Sub(p.keysFetchedAt) < jwksRefreshInterval {
+		return nil, fmt.Errorf("알 수 없는 서명 키: %s", kid)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, doc.JWKSURI, nil)
+	if err != nil {
+		return nil, err
+	}
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	status, err := p.doJSON(req, &set)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("%w: JWKS를 읽을 수 없습니다 (%d)", ErrProvider, status)
+	}
+	p.keys = make(map[string]*rsa.PublicKey, len(set.Keys))
+	p.keysFetchedAt = p.now()
+	for _, jwk := range set.Keys {
+		if k, err := jwk.rsaPublicKey(); err == nil {
+			p.keys[jwk.Kid] = k
+		}
+	}
+	if k, ok := p.keys[kid]; ok {
+		return k, nil
+	}
+	// kid 없이 키 하나만 공개하는 공급자도 허용합니다.
+	if kid == "" && len(p.keys) == 1 {
+		for _, k := range p.keys {
+			return k, nil
+		}
+	}
+	return nil, fmt.Errorf("알 수 없는 서명 키: %s", kid)
+}
+
+func (jwk jsonWebKey) rsaPublicKey() (*rsa.PublicKey, error) {
+	if jwk.Kty != "RSA" {
+		return nil, fmt.Errorf("지원하지 않는 키 타입: %s", jwk.Kty)
+	}
+	n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+	if err != nil {
+		return nil, err
+	}
+	e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+	if err != nil {
+		return nil, err
+	}
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+}
+
+func (p *Provider) doJSON(req *http.Request, v any) (int, error) {
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrProvider, err)
+	}
+	defer resp.Body.Close()
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return resp.StatusCode, fmt.Errorf("%w: 응답 파싱 실패: %v", ErrProvider, err)
+	}
+	return resp.StatusCode, nil
+}
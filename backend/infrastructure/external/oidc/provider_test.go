@@ -0,0 +1,102 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newMockProvider는 모의 서버를 띄우고 JWKS 요청 횟수를 세는 공급자를 만듭니다.
+func newMockProvider(t *testing.T) (*Provider, *atomic.Int32) {
+	t.Helper()
+	var mock *MockServer
+	var jwksFetches atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/jwks" {
+			jwksFetches.Add(1)
+		}
+		mock.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	var err error
+	if mock, err = NewMockServer(srv.URL, nil); err != nil {
+		t.Fatal(err)
+	}
+	return NewProvider(Config{Name: "mock", Issuer: srv.URL + "/", ClientID: "winding-road-finder"}), &jwksFetches
+}
+
+func TestDiscoverRejectsIssuerMismatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		issuer string
+	}{
+		{"다른 발급자", "https://accounts.example.com"},
+		{"발급자 없음", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				writeJSON(w, http.StatusOK, map[string]string{
+					"issuer":                 tt.issuer,
+					"authorization_endpoint": "https://accounts.example.com/authorize",
+					"token_endpoint":         "https://accounts.example.com/token",
+					"jwks_uri":               "https://accounts.example.com/jwks",
+				})
+			}))
+			defer srv.Close()
+			p := NewProvider(Config{Name: "mock", Issuer: srv.URL})
+			if _, err := p.discover(context.Background()); !errors.Is(err, ErrProvider) {
+				t.Fatalf("discover() error = %v, want %v", err, ErrProvider)
+			}
+			if p.discovery != nil {
+				t.Fatal("discover() cached a document from another issuer")
+			}
+		})
+	}
+}
+
+// 설정한 발급자 끝의 /는 디스커버리 문서와 비교할 때 무시한다.
+func TestDiscoverAcceptsTrailingSlash(t *testing.T) {
+	p, _ := newMockProvider(t)
+	if _, err := p.discover(context.Background()); err != nil {
+		t.Fatalf("discover() error = %v", err)
+	}
+}
+
+// 모르는 kid가 와도 jwksRefreshInterval 안에는 JWKS를 다시 읽지 않는다.
+func TestKeyRateLimitsRefresh(t *testing.T) {
+	p, jwksFetches := newMockProvider(t)
+	now := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	p.now = func() time.Time { return now }
+	ctx := context.Background()
+	doc, err := p.discover(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.key(ctx, doc, mockKeyID); err != nil {
+		t.Fatalf("key(%q) error = %v", mockKeyID, err)
+	}
+	for i := range 5 {
+		if _, err := p.key(ctx, doc, "forged"); err == nil {
+			t.Fatalf("key(forged) #%d returned a key", i)
+		}
+	}
+	if got := jwksFetches.Load(); got != 1 {
+		t.Fatalf("JWKS fetched %d times within the interval, want 1", got)
+	}
+	if _, err := p.key(ctx, doc, mockKeyID); err != nil {
+		t.Fatalf("key(%q) after unknown kids error = %v", mockKeyID, err)
+	}
+
+	now = now.Add(jwksRefreshInterval)
+	if _, err := p.key(ctx, doc, "rotated"); err == nil {
+		t.Fatal("key(rotated) returned a key")
+	}
+	if got := jwksFetches.Load(); got != 2 {
+		t.Fatalf("JWKS fetched %d times after the interval, want 2", got)
+	}
+}
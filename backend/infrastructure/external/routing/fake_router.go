@@ -0,0 +1,50 @@
+package routing
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/routing"
+)
+
+// FakeRouter는 지점 사이를 직선으로 잇는 경로 탐색기입니다. 경로 탐색 엔진 없이 개발하거나 테스트할 때 사용합니다.
+// routing.Router를 구현합니다.
+type FakeRouter struct {
+	// Detour는 직선거리에 곱하는 도로 우회 계수입니다.
+	Detour   float64
+	SpeedKmh float64
+	// Unreachable에 있는 지점으로 들어가거나 나오는 구간은 routing.ErrNoRoute를 반환합니다.
+	Unreachable []course.CourseGeolocation
+	// SnapOffsetsM은 지점별로 돌려줄 도로까지의 거리(m)입니다. 없으면 0입니다.
+	SnapOffsetsM map[course.CourseGeolocation]float64
+}
+
+// NewFakeRouter는 우회 계수 1.3, 평균 40km/h로 추정하는 직선 경로 탐색기를 만듭니다.
+func NewFakeRouter() *FakeRouter {
+	return &FakeRouter{Detour: 1.3, SpeedKmh: 40}
+}
+
+func (r *FakeRouter) Route(ctx context.Context, points []course.CourseGeolocation) (*routing.Route, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	route := &routing.Route{}
+	for i, p := range points {
+		if slices.Contains(r.Unreachable, p) {
+			return nil, routing.ErrNoRoute
+		}
+		route.SnapOffsetsM = append(route.SnapOffsetsM, r.SnapOffsetsM[p])
+		if i == 0 {
+			continue
+		}
+		km := points[i-1].DistanceKm(p) * r.Detour
+		route.Legs = append(route.Legs, routing.Leg{
+			DistanceKm: km,
+			Duration:   time.Duration(km / r.SpeedKmh * float64(time.Hour)),
+			Geometry:   []course.CourseGeolocation{points[i-1], p},
+		})
+	}
+	return route, nil
+}
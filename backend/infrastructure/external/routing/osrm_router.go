@@ -0,0 +1,99 @@
+package routing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/routing"
+)
+
+// OSRMRouter는 OSRM HTTP API(route/v1)로 도로 경로를 구합니다. routing.Router를 구현합니다.
+type OSRMRouter struct {
+	baseURL string
+	profile string
+	client  *http.Client
+}
+
+// NewOSRMRouter는 OSRM 서버 주소(예: http://localhost:5000)로 경로 탐색기를 만듭니다. profile이 비어 있으면 driving을 사용합니다.
+func NewOSRMRouter(baseURL, profile string) *OSRMRouter {
+	if profile == "" {
+		profile = "driving"
+	}
+	return &OSRMRouter{baseURL: strings.TrimSuffix(baseURL, "/"), profile: profile, client: &http.Client{Timeout: 30 * time.Second}}
+}
+
+type osrmResponse struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Routes  []struct {
+		Legs []struct {
+			Distance float64 `json:"distance"`
+			Duration float64 `json:"duration"`
+			Steps    []struct {
+				Geometry struct {
+					Coordinates [][2]float64 `json:"coordinates"`
+				} `json:"geometry"`
+			} `json:"steps"`
+		} `json:"legs"`
+	} `json:"routes"`
+	Waypoints []struct {
+		Distance float64 `json:"distance"`
+	} `json:"waypoints"`
+}
+
+func (r *OSRMRouter) Route(ctx context.Context, points []course.CourseGeolocation) (*routing.Route, error) {
+	coords := make([]string, len(points))
+	for i, p := range points {
+		coords[i] = strconv.FormatFloat(p.Longitude, 'f', 6, 64) + "," + strconv.FormatFloat(p.Latitude, 'f', 6, 64)
+	}
+	url := fmt.Sprintf("%s/route/v1/%s/%s?overview=false&steps=true&geometries=geojson", r.baseURL, r.profile, strings.Join(coords, ";"))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := r.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", routing.ErrUnavailable, err)
+	}
+	defer res.Body.Close()
+
+	// OSRM은 경로가 없을 때도 400과 함께 code를 담은 JSON을 반환합니다.
+	var body osrmResponse
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("%w: HTTP %d: %v", routing.ErrUnavailable, res.StatusCode, err)
+	}
+	switch body.Code {
+	case "Ok":
+	case "NoRoute", "NoSegment":
+		return nil, fmt.Errorf("%w: %s", routing.ErrNoRoute, body.Message)
+	default:
+		return nil, fmt.Errorf("%w: %s %s", routing.ErrUnavailable, body.Code, body.Message)
+	}
+	if len(body.Routes) == 0 {
+		return nil, routing.ErrNoRoute
+	}
+
+	route := &routing.Route{}
+	for _, l := range body.Routes[0].Legs {
+		leg := routing.Leg{DistanceKm: l.Distance / 1000, Duration: time.Duration(l.Duration * float64(time.Second))}
+		for _, step := range l.Steps {
+			for _, c := range step.Geometry.Coordinates {
+				p := course.CourseGeolocation{Latitude: c[1], Longitude: c[0]}
+				if n := len(leg.Geometry); n == 0 || leg.Geometry[n-1] != p {
+					leg.Geometry = append(leg.Geometry, p)
+				}
+			}
+		}
+		route.Legs = append(route.Legs, leg)
+	}
+	for _, w := range body.Waypoints {
+		route.SnapOffsetsM = append(route.SnapOffsetsM, w.Distance)
+	}
+	return route, nil
+}
@@ -0,0 +1,62 @@
+package weather
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/weather"
+	"github.com/sunDar0/winding-road-finder/backend/infrastructure/metrics"
+)
+
+type cacheEntry struct {
+	forecast  *weather.Forecast
+	fetchedAt time.Time
+}
+
+// CachedProvider는 다른 제공자의 예보를 격자별로 ttl 동안 보관합니다. weather.CachedProvider를 구현합니다.
+// 갱신에 실패하면 이전 예보를 계속 사용합니다.
+type CachedProvider struct {
+	inner   weather.Provider
+	ttl     time.Duration
+	mu      sync.Mutex
+	entries map[weather.GridCell]cacheEntry
+	now     func() time.Time
+	metrics *metrics.Metrics
+}
+
+// NewCachedProvider는 inner의 예보를 보관하는 제공자를 만듭니다. m이 있으면 조회마다 "weather" 캐시 적중 여부를 기록합니다.
+func NewCachedProvider(inner weather.Provider, ttl time.Duration, m *metrics.Metrics) *CachedProvider {
+	return &CachedProvider{inner: inner, ttl: ttl, entries: map[weather.GridCell]cacheEntry{}, now: time.Now, metrics: m}
+}
+
+func (p *CachedProvider) Forecast(ctx context.Context, cell weather.GridCell) (*weather.Forecast, error) {
+	p.mu.Lock()
+	entry, ok := p.entries[cell]
+	p.mu.Unlock()
+	hit := ok && p.now().Sub(entry.fetchedAt) < p.ttl
+	p.metrics.ObserveCache("weather", hit)
+	if hit {
+		return entry.forecast, nil
+	}
+
+	f, err := p.inner.Forecast(ctx, cell)
+	if err != nil {
+		if ok {
+			return entry.forecast, nil
+		}
+		return nil, err
+	}
+	p.mu.Lock()
+	p.entries[cell] = cacheEntry{forecast: f, fetchedAt: p.now()}
+	p.mu.Unlock()
+	return f, nil
+}
+
+// Cached는 외부 호출 없이 보관된 예보를 반환합니다. 만료된 예보도 반환합니다.
+func (p *CachedProvider) Cached(cell weather.GridCell) (*weather.Forecast, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	entry, ok := p.entries[cell]
+	return entry.forecast, ok
+}
@@ -0,0 +1,89 @@
+package weather
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/weather"
+)
+
+// countingProvider는 호출 횟수를 세고, fail이면 weather.ErrUnavailable을 반환하는 제공자입니다.
+type countingProvider struct {
+	calls int
+	fail  bool
+}
+
+func (p *countingProvider) Forecast(ctx context.Context, cell weather.GridCell) (*weather.Forecast, error) {
+	p.calls++
+	if p.fail {
+		return nil, weather.ErrUnavailable
+	}
+	return &weather.Forecast{Cell: cell, IssuedAt: time.Unix(int64(p.calls), 0)}, nil
+}
+
+func TestCachedProvider(t *testing.T) {
+	cell := weather.GridCell{NX: 60, NY: 127}
+	base := time.Date(2026, 5, 1, 9, 0, 0, 0, kst)
+	tests := []struct {
+		name string
+		// after는 첫 조회 뒤 두 번째 조회까지 흐른 시간입니다.
+		after     time.Duration
+		failAfter bool
+		wantCalls int
+		// wantFirst는 두 번째 조회가 첫 예보를 그대로 돌려주는지 여부입니다.
+		wantFirst bool
+	}{
+		{"TTL 안에서는 다시 조회하지 않는다", 59 * time.Minute, false, 1, true},
+		{"TTL이 지나면 다시 조회한다", time.Hour, false, 2, false},
+		{"갱신에 실패하면 이전 예보를 사용한다", 2 * time.Hour, true, 2, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inner := &countingProvider{}
+			p := NewCachedProvider(inner, time.Hour, nil)
+			now := base
+			p.now = func() time.Time { return now }
+
+			first, err := p.Forecast(context.Background(), cell)
+			if err != nil {
+				t.Fatal(err)
+			}
+			now = now.Add(tt.after)
+			inner.fail = tt.failAfter
+			second, err := p.Forecast(context.Background(), cell)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if inner.calls != tt.wantCalls {
+				t.Fatalf("calls = %d, want %d", inner.calls, tt.wantCalls)
+			}
+			if (second == first) != tt.wantFirst {
+				t.Fatalf("second == first is %v, want %v", second == first, tt.wantFirst)
+			}
+			if cached, ok := p.Cached(cell); !ok || cached != second {
+				t.Fatalf("Cached() = %v, %v; want the last forecast", cached, ok)
+			}
+		})
+	}
+}
+
+// 보관된 예보가 없는 격자의 조회 실패는 그대로 돌려주고 아무것도 보관하지 않는다.
+func TestCachedProviderMissWithoutForecast(t *testing.T) {
+	cell := weather.GridCell{NX: 60, NY: 127}
+	p := NewCachedProvider(&countingProvider{fail: true}, time.Hour, nil)
+	if _, err := p.Forecast(context.Background(), cell); !errors.Is(err, weather.ErrUnavailable) {
+		t.Fatalf("Forecast() error = %v, want %v", err, weather.ErrUnavailable)
+	}
+	if _, ok := p.Cached(cell); ok {
+		t.Fatal("Cached() ok = true, want false")
+	}
+}
+
+func TestDisabledProvider(t *testing.T) {
+	p := NewCachedProvider(DisabledProvider{}, time.Hour, nil)
+	if _, err := p.Forecast(context.Background(), weather.GridCell{NX: 60, NY: 127}); !errors.Is(err, weather.ErrUnavailable) {
+		t.Fatalf("Forecast() error = %v, want %v", err, weather.ErrUnavailable)
+	}
+}
@@ -0,0 +1,16 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/weather"
+)
+
+// DisabledProvider는 날씨 예보 제공자를 설정하지 않았을 때 사용하는 제공자입니다.
+// 항상 weather.ErrUnavailable을 반환합니다. weather.Provider를 구현합니다.
+type DisabledProvider struct{}
+
+func (DisabledProvider) Forecast(ctx context.Context, cell weather.GridCell) (*weather.Forecast, error) {
+	return nil, fmt.Errorf("%w: 날씨 예보 제공자가 설정되지 않았습니다", weather.ErrUnavailable)
+}
@@ -0,0 +1,183 @@
+package weather
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/weather"
+	"github.com/sunDar0/winding-road-finder/backend/infrastructure/tracing"
+)
+
+// DefaultKMAEndpoint는 기상청 단기예보 조회 API 주소입니다. (공공데이터포털 VilageFcstInfoService_2.0)
+const DefaultKMAEndpoint = "https://apis.data.go.kr/1360000/VilageFcstInfoService_2.0/getVilageFcst"
+
+var kst = time.FixedZone("KST", 9*60*60)
+
+// kmaBaseHours는 단기예보 발표 시각입니다. 발표 후 약 10분 뒤부터 조회할 수 있습니다.
+var kmaBaseHours = []int{2, 5, 8, 11, 14, 17, 20, 23}
+
+// KMAProvider는 기상청 단기예보 API로 격자 예보를 조회합니다. weather.Provider를 구현합니다.
+type KMAProvider struct {
+	endpoint   string
+	serviceKey string
+	client     *http.Client
+	now        func() time.Time
+}
+
+// NewKMAProvider는 공공데이터포털 인증키(디코딩 값)로 단기예보 제공자를 만듭니다. endpoint가 비어 있으면 DefaultKMAEndpoint를 사용합니다.
+func NewKMAProvider(endpoint, serviceKey string) *KMAProvider {
+	if endpoint == "" {
+		endpoint = DefaultKMAEndpoint
+	}
+	return &KMAProvider{endpoint: endpoint, serviceKey: serviceKey, client: &http.Client{Transport: tracing.Transport(nil), Timeout: 10 * time.Second}, now: time.Now}
+}
+
+type kmaResponse struct {
+	Response struct {
+		Header struct {
+			ResultCode string `json:"resultCode"`
+			ResultMsg  string `json:"resultMsg"`
+		} `json:"header"`
+		Body struct {
+			Items struct {
+				Item []kmaItem `json:"item"`
+			} `json:"items"`
+		} `json:"body"`
+	} `json:"response"`
+}
+
+type kmaItem struct {
+	Category  string `json:"category"`
+	FcstDate  string `json:"fcstDate"`
+	FcstTime  string `json:"fcstTime"`
+	FcstValue string `json:"fcstValue"`
+}
+
+// Forecast는 격자의 단기예보를 조회합니다. 인증키가 URL에 들어가므로 요청 URL은 스팬에 남기지 않습니다.
+func (p *KMAProvider) Forecast(ctx context.Context, cell weather.GridCell) (_ *weather.Forecast, err error) {
+	ctx, span := tracing.Start(ctx, "KMAProvider.Forecast", attribute.Int("weather.nx", cell.NX), attribute.Int("weather.ny", cell.NY))
+	defer func() { tracing.End(span, err) }()
+	base := latestBaseTime(p.now())
+	q := url.Values{}
+	q.Set("serviceKey", p.serviceKey)
+	q.Set("pageNo", "1")
+	q.Set("numOfRows", "1000")
+	q.Set("dataType", "JSON")
+	q.Set("base_date", base.Format("20060102"))
+	q.Set("base_time", base.Format("1504"))
+	q.Set("nx", strconv.Itoa(cell.NX))
+	q.Set("ny", strconv.Itoa(cell.NY))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.endpoint+"?"+q.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", weather.ErrUnavailable, err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: HTTP %d", weather.ErrUnavailable, res.StatusCode)
+	}
+	var body kmaResponse
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		// 인증키 오류 등은 XML로 응답하므로 디코딩 실패도 예보 없음으로 처리합니다.
+		return nil, fmt.Errorf("%w: %v", weather.ErrUnavailable, err)
+	}
+	if code := body.Response.Header.ResultCode; code != "00" {
+		return nil, fmt.Errorf("%w: %s %s", weather.ErrUnavailable, code, body.Response.Header.ResultMsg)
+	}
+	return &weather.Forecast{Cell: cell, IssuedAt: base, Hours: parseKMAItems(body.Response.Body.Items.Item)}, nil
+}
+
+// latestBaseTime은 now 시점에 조회할 수 있는 가장 최근 발표 시각(KST)을 구합니다.
+func latestBaseTime(now time.Time) time.Time {
+	t := now.In(kst).Add(-10 * time.Minute)
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, kst)
+	for i := len(kmaBaseHours) - 1; i >= 0; i-- {
+		if t.Hour() >= kmaBaseHours[i] {
+			return day.Add(time.Duration(kmaBaseHours[i]) * time.Hour)
+		}
+	}
+	return day.Add(-time.Hour) // 전날 23시
+}
+
+// parseKMAItems는 항목(category)별로 나뉜 응답을 시간별 예보로 묶습니다.
+func parseKMAItems(items []kmaItem) []weather.Hourly {
+	byTime := map[time.Time]*weather.Hourly{}
+	for _, it := range items {
+		t, err := time.ParseInLocation("200601021504", it.FcstDate+it.FcstTime, kst)
+		if err != nil {
+			continue
+		}
+		h, ok := byTime[t]
+		if !ok {
+			h = &weather.Hourly{Time: t, Precipitation: weather.PrecipitationNone}
+			byTime[t] = h
+		}
+		v := strings.TrimSpace(it.FcstValue)
+		switch it.Category {
+		case "TMP":
+			h.TemperatureC, _ = strconv.ParseFloat(v, 64)
+		case "POP":
+			h.PrecipitationChance, _ = strconv.Atoi(v)
+		case "PTY":
+			h.Precipitation = kmaPrecipitation[v]
+		case "PCP":
+			h.PrecipitationMm = parseKMAAmount(v)
+		case "SKY":
+			h.Sky = kmaSky[v]
+		case "WSD":
+			h.WindSpeedMs, _ = strconv.ParseFloat(v, 64)
+		case "REH":
+			h.HumidityPct, _ = strconv.Atoi(v)
+		}
+	}
+	hours := make([]weather.Hourly, 0, len(byTime))
+	for _, h := range byTime {
+		hours = append(hours, *h)
+	}
+	sort.Slice(hours, func(i, j int) bool { return hours[i].Time.Before(hours[j].Time) })
+	return hours
+}
+
+var kmaPrecipitation = map[string]weather.Precipitation{
+	"0": weather.PrecipitationNone,
+	"1": weather.PrecipitationRain,
+	"2": weather.PrecipitationSleet,
+	"3": weather.PrecipitationSnow,
+	"4": weather.PrecipitationShower,
+}
+
+var kmaSky = map[string]weather.Sky{
+	"1": weather.SkyClear,
+	"3": weather.SkyCloudy,
+	"4": weather.SkyOvercast,
+}
+
+// parseKMAAmount는 "강수없음", "1mm 미만", "1.2mm", "30.0~50.0mm", "50.0mm 이상" 형식의 강수량을 mm로 바꿉니다.
+// 범위는 하한을, "1mm 미만"은 0.5를 사용합니다.
+func parseKMAAmount(v string) float64 {
+	switch {
+	case v == "" || v == "강수없음" || v == "0":
+		return 0
+	case strings.Contains(v, "미만"):
+		return 0.5
+	}
+	v = strings.TrimSuffix(strings.TrimSpace(strings.TrimSuffix(v, "이상")), "mm")
+	if i := strings.Index(v, "~"); i >= 0 {
+		v = v[:i]
+	}
+	amount, _ := strconv.ParseFloat(strings.TrimSuffix(v, "mm"), 64)
+	return amount
+}
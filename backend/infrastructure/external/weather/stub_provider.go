@@ -0,0 +1,78 @@
+package weather
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/weather"
+)
+
+// stubHour는 스텁 파일의 한 시간 예보입니다.
+type stubHour struct {
+	TemperatureC        float64               `json:"temperatureC"`
+	PrecipitationChance int                   `json:"precipitationChance"`
+	Precipitation       weather.Precipitation `json:"precipitation"`
+	PrecipitationMm     float64               `json:"precipitationMm"`
+	Sky                 weather.Sky           `json:"sky"`
+	WindSpeedMs         float64               `json:"windSpeedMs"`
+	HumidityPct         int                   `json:"humidityPct"`
+}
+
+// stubFile은 스텁 예보 파일 형식입니다. cells는 "nx,ny" 격자별 예보, default는 나머지 격자의 예보입니다.
+// 각 목록의 첫 항목은 현재 시간의 예보이며 이후 항목은 한 시간씩 이어집니다.
+type stubFile struct {
+	Default []stubHour            `json:"default"`
+	Cells   map[string][]stubHour `json:"cells"`
+}
+
+// StubProvider는 JSON 파일의 예보를 현재 시각 기준으로 돌려주는 제공자입니다.
+// 외부 API 키 없이 개발하거나 테스트할 때 사용합니다. weather.Provider를 구현합니다.
+type StubProvider struct {
+	file stubFile
+	now  func() time.Time
+}
+
+// NewStubProvider는 스텁 예보 파일을 읽어 제공자를 만듭니다.
+func NewStubProvider(path string) (*StubProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var file stubFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &StubProvider{file: file, now: time.Now}, nil
+}
+
+func (p *StubProvider) Forecast(ctx context.Context, cell weather.GridCell) (*weather.Forecast, error) {
+	hours, ok := p.file.Cells[cell.String()]
+	if !ok {
+		hours = p.file.Default
+	}
+	if len(hours) == 0 {
+		return nil, fmt.Errorf("%w: 스텁 예보가 없습니다 (%s)", weather.ErrUnavailable, cell)
+	}
+	start := p.now().In(kst).Truncate(time.Hour)
+	f := &weather.Forecast{Cell: cell, IssuedAt: start, Hours: make([]weather.Hourly, len(hours))}
+	for i, h := range hours {
+		precipitation := h.Precipitation
+		if precipitation == "" {
+			precipitation = weather.PrecipitationNone
+		}
+		f.Hours[i] = weather.Hourly{
+			Time:                start.Add(time.Duration(i) * time.Hour),
+			TemperatureC:        h.TemperatureC,
+			PrecipitationChance: h.PrecipitationChance,
+			Precipitation:       precipitation,
+			PrecipitationMm:     h.PrecipitationMm,
+			Sky:                 h.Sky,
+			WindSpeedMs:         h.WindSpeedMs,
+			HumidityPct:         h.HumidityPct,
+		}
+	}
+	return f, nil
+}
@@ -0,0 +1,74 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RequestIDKey는 로그 줄에 요청 ID를 남기는 속성 이름입니다.
+const RequestIDKey = "request_id"
+
+type requestIDContextKey struct{}
+
+// WithRequestID는 요청 ID를 담은 컨텍스트를 반환합니다. 이 컨텍스트로 남긴 로그(InfoContext 등)에는 요청 ID가 붙습니다.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDContextKey{}, id)
+}
+
+// RequestIDFrom은 컨텍스트의 요청 ID를 반환합니다. 없으면 빈 문자열입니다.
+func RequestIDFrom(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDContextKey{}).(string)
+	return id
+}
+
+// New는 format("text" 또는 "json")으로 w에 쓰는 로거를 만듭니다.
+// level은 slog.LevelVar를 넘기면 실행 중에 바꿀 수 있습니다.
+func New(w io.Writer, format string, level slog.Leveler) (*slog.Logger, error) {
+	opts := &slog.HandlerOptions{Level: level}
+	var h slog.Handler
+	switch strings.ToLower(format) {
+	case "text", "":
+		h = slog.NewTextHandler(w, opts)
+	case "json":
+		h = slog.NewJSONHandler(w, opts)
+	default:
+		return nil, fmt.Errorf("알 수 없는 로그 형식입니다: %s (text, json)", format)
+	}
+	return slog.New(requestIDHandler{h}), nil
+}
+
+// ParseLevel은 debug, info, warn, error(대소문자 무시)를 로그 레벨로 바꿉니다.
+func ParseLevel(s string) (slog.Level, error) {
+	var level slog.Level
+	err := level.UnmarshalText([]byte(s))
+	return level, err
+}
+
+// requestIDHandler는 컨텍스트에 요청 ID가 있으면 로그 줄에 request_id 속성을 붙입니다.
+// 기록 중인 트레이스 스팬이 있으면 trace_id, span_id도 붙여 로그와 트레이스를 이어 볼 수 있게 합니다.
+type requestIDHandler struct {
+	slog.Handler
+}
+
+func (h requestIDHandler) Handle(ctx context.Context, r slog.Record) error {
+	if id := RequestIDFrom(ctx); id != "" {
+		r.AddAttrs(slog.String(RequestIDKey, id))
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		r.AddAttrs(slog.String("trace_id", sc.TraceID().String()), slog.String("span_id", sc.SpanID().String()))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h requestIDHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return requestIDHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h requestIDHandler) WithGroup(name string) slog.Handler {
+	return requestIDHandler{h.Handler.WithGroup(name)}
+}
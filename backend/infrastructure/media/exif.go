@@ -0,0 +1,225 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+)
+
+// exifInfo는 사진 처리에 필요한 EXIF 항목입니다.
+type exifInfo struct {
+	// Orientation은 EXIF 방향 값(1~8)입니다. 없으면 1입니다.
+	Orientation int
+	Location    *course.CourseGeolocation
+	TakenAt     *time.Time
+}
+
+const (
+	tagOrientation       = 0x0112
+	tagExifIFD           = 0x8769
+	tagGPSIFD            = 0x8825
+	tagDateTimeOriginal  = 0x9003
+	tagOffsetTimeOrig    = 0x9011
+	tagGPSLatitudeRef    = 0x0001
+	tagGPSLatitude       = 0x0002
+	tagGPSLongitudeRef   = 0x0003
+	tagGPSLongitude      = 0x0004
+	exifTypeShort        = 3
+	exifTypeLong         = 4
+	exifTypeRational     = 5
+	maxIFDEntries        = 1000
+	exifDateTimeLayout   = "2006:01:02 15:04:05"
+	exifOffsetTimeLayout = "-07:00"
+)
+
+var errNoExif = errors.New("EXIF 정보가 없습니다")
+
+// exifTypeSizes는 EXIF 값 형식별 한 개의 바이트 수입니다.
+var exifTypeSizes = map[uint16]uint32{1: 1, 2: 1, 3: 2, 4: 4, 5: 8, 6: 1, 7: 1, 8: 2, 9: 4, 10: 8, 11: 4, 12: 8}
+
+// readJPEGExif는 JPEG의 APP1 세그먼트에서 EXIF를 읽습니다. EXIF가 없으면 errNoExif를 반환합니다.
+// 손상된 항목은 건너뛰며, 필요한 항목만 읽습니다.
+func readJPEGExif(data []byte) (*exifInfo, error) {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return nil, errNoExif
+	}
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xFF {
+			return nil, errNoExif
+		}
+		marker := data[i+1]
+		if marker == 0xD8 || (marker >= 0xD0 && marker <= 0xD7) || marker == 0x01 || marker == 0xFF {
+			i++
+			continue
+		}
+		if marker == 0xDA || marker == 0xD9 {
+			break // 이미지 데이터 시작: 메타데이터 세그먼트는 이 앞에만 있습니다.
+		}
+		length := int(binary.BigEndian.Uint16(data[i+2:]))
+		if length < 2 || i+2+length > len(data) {
+			return nil, errNoExif
+		}
+		segment := data[i+4 : i+2+length]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return parseTIFF(segment[6:])
+		}
+		i += 2 + length
+	}
+	return nil, errNoExif
+}
+
+// tiffReader는 TIFF 구조의 EXIF 데이터를 읽습니다. 모든 오프셋은 TIFF 헤더 기준입니다.
+type tiffReader struct {
+	data  []byte
+	order binary.ByteOrder
+}
+
+type ifdEntry struct {
+	typ   uint16
+	count uint32
+	value []byte
+}
+
+func parseTIFF(data []byte) (*exifInfo, error) {
+	if len(data) < 8 {
+		return nil, errNoExif
+	}
+	r := &tiffReader{data: data}
+	switch string(data[:2]) {
+	case "II":
+		r.order = binary.LittleEndian
+	case "MM":
+		r.order = binary.BigEndian
+	default:
+		return nil, errNoExif
+	}
+	if r.order.Uint16(data[2:]) != 42 {
+		return nil, errNoExif
+	}
+	info := &exifInfo{Orientation: 1}
+	ifd0 := r.readIFD(r.order.Uint32(data[4:]))
+	if e, ok := ifd0[tagOrientation]; ok {
+		if v, ok := r.uint(e); ok && v >= 1 && v <= 8 {
+			info.Orientation = int(v)
+		}
+	}
+	if e, ok := ifd0[tagExifIFD]; ok {
+		if offset, ok := r.uint(e); ok {
+			info.TakenAt = r.takenAt(r.readIFD(offset))
+		}
+	}
+	if e, ok := ifd0[tagGPSIFD]; ok {
+		if offset, ok := r.uint(e); ok {
+			info.Location = r.location(r.readIFD(offset))
+		}
+	}
+	return info, nil
+}
+
+// readIFD는 offset의 IFD 항목을 태그별로 읽습니다. 범위를 벗어난 항목은 버립니다.
+func (r *tiffReader) readIFD(offset uint32) map[uint16]ifdEntry {
+	entries := map[uint16]ifdEntry{}
+	if uint64(offset)+2 > uint64(len(r.data)) {
+		return entries
+	}
+	n := int(r.order.Uint16(r.data[offset:]))
+	if n > maxIFDEntries {
+		return entries
+	}
+	for i := 0; i < n; i++ {
+		at := uint64(offset) + 2 + uint64(i)*12
+		if at+12 > uint64(len(r.data)) {
+			break
+		}
+		raw := r.data[at : at+12]
+		tag, typ, count := r.order.Uint16(raw), r.order.Uint16(raw[2:]), r.order.Uint32(raw[4:])
+		size, ok := exifTypeSizes[typ]
+		if !ok {
+			continue
+		}
+		total := uint64(size) * uint64(count)
+		value := raw[8:12]
+		if total > 4 {
+			start := uint64(r.order.Uint32(raw[8:]))
+			if start+total > uint64(len(r.data)) {
+				continue
+			}
+			value = r.data[start : start+total]
+		} else {
+			value = value[:total]
+		}
+		entries[tag] = ifdEntry{typ: typ, count: count, value: value}
+	}
+	return entries
+}
+
+func (r *tiffReader) uint(e ifdEntry) (uint32, bool) {
+	switch {
+	case e.typ == exifTypeShort && len(e.value) >= 2:
+		return uint32(r.order.Uint16(e.value)), true
+	case e.typ == exifTypeLong && len(e.value) >= 4:
+		return r.order.Uint32(e.value), true
+	}
+	return 0, false
+}
+
+func asciiValue(e ifdEntry) string {
+	return string(bytes.TrimRight(e.value, "\x00 "))
+}
+
+// takenAt은 촬영 시각을 읽습니다. 시간대 정보(OffsetTimeOriginal)가 없으면 한국 시간으로 봅니다.
+func (r *tiffReader) takenAt(ifd map[uint16]ifdEntry) *time.Time {
+	e, ok := ifd[tagDateTimeOriginal]
+	if !ok {
+		return nil
+	}
+	loc := course.KST
+	if o, ok := ifd[tagOffsetTimeOrig]; ok {
+		if t, err := time.Parse(exifOffsetTimeLayout, asciiValue(o)); err == nil {
+			_, offset := t.Zone()
+			loc = time.FixedZone("", offset)
+		}
+	}
+	t, err := time.ParseInLocation(exifDateTimeLayout, asciiValue(e), loc)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+// location은 GPS IFD의 도·분·초 좌표를 십진 위경도로 바꿉니다.
+func (r *tiffReader) location(ifd map[uint16]ifdEntry) *course.CourseGeolocation {
+	lat, ok1 := r.degrees(ifd[tagGPSLatitude])
+	lon, ok2 := r.degrees(ifd[tagGPSLongitude])
+	if !ok1 || !ok2 {
+		return nil
+	}
+	if asciiValue(ifd[tagGPSLatitudeRef]) == "S" {
+		lat = -lat
+	}
+	if asciiValue(ifd[tagGPSLongitudeRef]) == "W" {
+		lon = -lon
+	}
+	if lat < -90 || lat > 90 || lon < -180 || lon > 180 || (lat == 0 && lon == 0) {
+		return nil
+	}
+	return &course.CourseGeolocation{Latitude: lat, Longitude: lon}
+}
+
+func (r *tiffReader) degrees(e ifdEntry) (float64, bool) {
+	if e.typ != exifTypeRational || e.count < 3 || len(e.value) < 24 {
+		return 0, false
+	}
+	var parts [3]float64
+	for i := range parts {
+		num, den := r.order.Uint32(e.value[i*8:]), r.order.Uint32(e.value[i*8+4:])
+		if den == 0 {
+			return 0, false
+		}
+		parts[i] = float64(num) / float64(den)
+	}
+	return parts[0] + parts[1]/60 + parts[2]/3600, true
+}
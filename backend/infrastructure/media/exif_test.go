@@ -0,0 +1,317 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/photo"
+)
+
+// tiffBuilder는 테스트용 TIFF(EXIF) 데이터를 만듭니다. IFD는 쓴 순서대로 헤더 뒤에 놓입니다.
+type tiffBuilder struct {
+	order tiffOrder
+	data  []byte
+}
+
+// tiffOrder는 binary.LittleEndian, binary.BigEndian입니다.
+type tiffOrder interface {
+	binary.ByteOrder
+	binary.AppendByteOrder
+}
+
+type tiffEntry struct {
+	tag, typ uint16
+	count    uint32
+	value    []byte
+	// offset이 0이 아니면 value 대신 이 오프셋을 값 위치로 씁니다.
+	offset uint32
+}
+
+func newTIFF(order tiffOrder) *tiffBuilder {
+	b := &tiffBuilder{order: order, data: []byte("II")}
+	if order == binary.BigEndian {
+		b.data = []byte("MM")
+	}
+	b.data = order.AppendUint16(b.data, 42)
+	b.data = order.AppendUint32(b.data, 0)
+	return b
+}
+
+// ifd는 IFD를 덧붙이고 그 오프셋을 반환합니다. 4바이트보다 긴 값은 IFD 바로 뒤에 둡니다.
+func (b *tiffBuilder) ifd(entries ...tiffEntry) uint32 {
+	offset := uint32(len(b.data))
+	extra := offset + 2 + 12*uint32(len(entries)) + 4
+	var values []byte
+	b.data = b.order.AppendUint16(b.data, uint16(len(entries)))
+	for _, e := range entries {
+		b.data = b.order.AppendUint16(b.data, e.tag)
+		b.data = b.order.AppendUint16(b.data, e.typ)
+		b.data = b.order.AppendUint32(b.data, e.count)
+		switch {
+		case e.offset != 0:
+			b.data = b.order.AppendUint32(b.data, e.offset)
+		case len(e.value) > 4:
+			b.data = b.order.AppendUint32(b.data, extra+uint32(len(values)))
+			values = append(values, e.value...)
+		default:
+			b.data = append(b.data, make([]byte, 4)...)
+			copy(b.data[len(b.data)-4:], e.value)
+		}
+	}
+	b.data = b.order.AppendUint32(b.data, 0)
+	b.data = append(b.data, values...)
+	return offset
+}
+
+func (b *tiffBuilder) bytes(ifd0 uint32) []byte {
+	b.order.PutUint32(b.data[4:], ifd0)
+	return b.data
+}
+
+func (b *tiffBuilder) short(tag uint16, v uint16) tiffEntry {
+	return tiffEntry{tag: tag, typ: exifTypeShort, count: 1, value: b.order.AppendUint16(nil, v)}
+}
+
+func (b *tiffBuilder) long(tag uint16, v uint32) tiffEntry {
+	return tiffEntry{tag: tag, typ: exifTypeLong, count: 1, value: b.order.AppendUint32(nil, v)}
+}
+
+func (b *tiffBuilder) ascii(tag uint16, s string) tiffEntry {
+	return tiffEntry{tag: tag, typ: 2, count: uint32(len(s) + 1), value: append([]byte(s), 0)}
+}
+
+// dms는 도·분·초를 유리수 세 개로 씁니다.
+func (b *tiffBuilder) dms(tag uint16, deg, min, secTimes100 uint32) tiffEntry {
+	var v []byte
+	for _, r := range [][2]uint32{{deg, 1}, {min, 1}, {secTimes100, 100}} {
+		v = b.order.AppendUint32(v, r[0])
+		v = b.order.AppendUint32(v, r[1])
+	}
+	return tiffEntry{tag: tag, typ: exifTypeRational, count: 3, value: v}
+}
+
+// gpsTIFF는 방향 값과 GPS 좌표(37°30'N, 127°0'36"E 또는 참조 방향)를 담은 TIFF를 만듭니다.
+func gpsTIFF(order tiffOrder, orientation uint16, latRef, lonRef string) []byte {
+	b := newTIFF(order)
+	gps := b.ifd(
+		b.ascii(tagGPSLatitudeRef, latRef),
+		b.dms(tagGPSLatitude, 37, 30, 0),
+		b.ascii(tagGPSLongitudeRef, lonRef),
+		b.dms(tagGPSLongitude, 127, 0, 3600),
+	)
+	exif := b.ifd(
+		b.ascii(tagDateTimeOriginal, "2025:05:01 09:30:00"),
+		b.ascii(tagOffsetTimeOrig, "+02:00"),
+	)
+	return b.bytes(b.ifd(b.short(tagOrientation, orientation), b.long(tagExifIFD, exif), b.long(tagGPSIFD, gps)))
+}
+
+func TestParseTIFF(t *testing.T) {
+	seoul := &course.CourseGeolocation{Latitude: 37.5, Longitude: 127.01}
+	takenAt := time.Date(2025, 5, 1, 9, 30, 0, 0, time.FixedZone("", 2*60*60))
+	takenAtKST := time.Date(2025, 5, 1, 9, 30, 0, 0, course.KST)
+
+	// 오프셋이 잘못된 구조를 만드는 함수들입니다.
+	selfExif := func() []byte {
+		b := newTIFF(binary.LittleEndian)
+		// IFD0은 헤더 바로 뒤(8)에 놓이므로, EXIF·GPS IFD가 IFD0 자신을 가리킨다.
+		return b.bytes(b.ifd(b.short(tagOrientation, 3), b.long(tagExifIFD, 8), b.long(tagGPSIFD, 8)))
+	}
+	outOfRangeIFD := func() []byte {
+		b := newTIFF(binary.BigEndian)
+		return b.bytes(b.ifd(b.short(tagOrientation, 6), b.long(tagExifIFD, 1<<31), b.long(tagGPSIFD, math.MaxUint32)))
+	}
+	truncatedIFD := func() []byte {
+		b := newTIFF(binary.LittleEndian)
+		data := b.bytes(b.ifd(b.short(tagOrientation, 8), b.long(tagGPSIFD, 0)))
+		// 항목 수는 그대로 두고 두 번째 항목 중간에서 자른다.
+		return data[:8+2+12+5]
+	}
+	valueOutOfRange := func() []byte {
+		b := newTIFF(binary.LittleEndian)
+		lat := b.dms(tagGPSLatitude, 37, 30, 0)
+		lat.offset = 1 << 20
+		gps := b.ifd(lat, b.dms(tagGPSLongitude, 127, 0, 3600))
+		return b.bytes(b.ifd(b.long(tagGPSIFD, gps)))
+	}
+	hugeCount := func() []byte {
+		b := newTIFF(binary.LittleEndian)
+		lat := b.dms(tagGPSLatitude, 37, 30, 0)
+		lat.count, lat.offset = math.MaxUint32, 8
+		gps := b.ifd(lat, b.dms(tagGPSLongitude, 127, 0, 3600))
+		return b.bytes(b.ifd(b.long(tagGPSIFD, gps)))
+	}
+	zeroDenominator := func() []byte {
+		b := newTIFF(binary.LittleEndian)
+		lat := b.dms(tagGPSLatitude, 37, 30, 0)
+		binary.LittleEndian.PutUint32(lat.value[4:], 0)
+		gps := b.ifd(lat, b.dms(tagGPSLongitude, 127, 0, 3600))
+		return b.bytes(b.ifd(b.long(tagGPSIFD, gps)))
+	}
+	noOffsetTime := func() []byte {
+		b := newTIFF(binary.LittleEndian)
+		exif := b.ifd(b.ascii(tagDateTimeOriginal, "2025:05:01 09:30:00"))
+		return b.bytes(b.ifd(b.short(tagOrientation, 9), b.long(tagExifIFD, exif)))
+	}
+
+	tests := []struct {
+		name        string
+		data        []byte
+		orientation int
+		location    *course.CourseGeolocation
+		takenAt     *time.Time
+	}{
+		{"리틀 엔디언", gpsTIFF(binary.LittleEndian, 6, "N", "E"), 6, seoul, &takenAt},
+		{"빅 엔디언", gpsTIFF(binary.BigEndian, 3, "N", "E"), 3, seoul, &takenAt},
+		{"남위·서경", gpsTIFF(binary.LittleEndian, 1, "S", "W"), 1, &course.CourseGeolocation{Latitude: -37.5, Longitude: -127.01}, &takenAt},
+		{"시간대가 없으면 한국 시간", noOffsetTime(), 1, nil, &takenAtKST},
+		{"IFD0을 가리키는 하위 IFD", selfExif(), 3, nil, nil},
+		{"범위를 벗어난 IFD 오프셋", outOfRangeIFD(), 6, nil, nil},
+		{"중간에 잘린 IFD", truncatedIFD(), 8, nil, nil},
+		{"범위를 벗어난 값 오프셋", valueOutOfRange(), 1, nil, nil},
+		{"값 개수가 넘치는 항목", hugeCount(), 1, nil, nil},
+		{"분모가 0인 좌표", zeroDenominator(), 1, nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := parseTIFF(tt.data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if info.Orientation != tt.orientation {
+				t.Errorf("Orientation = %d, want %d", info.Orientation, tt.orientation)
+			}
+			if (info.Location == nil) != (tt.location == nil) ||
+				info.Location != nil && (math.Abs(info.Location.Latitude-tt.location.Latitude) > 1e-9 || math.Abs(info.Location.Longitude-tt.location.Longitude) > 1e-9) {
+				t.Errorf("Location = %v, want %v", info.Location, tt.location)
+			}
+			if (info.TakenAt == nil) != (tt.takenAt == nil) || info.TakenAt != nil && !info.TakenAt.Equal(*tt.takenAt) {
+				t.Errorf("TakenAt = %v, want %v", info.TakenAt, tt.takenAt)
+			}
+		})
+	}
+}
+
+func TestParseTIFFInvalidHeader(t *testing.T) {
+	for name, data := range map[string][]byte{
+		"짧은 데이터":    []byte("II*\x00"),
+		"알 수 없는 순서": []byte("XX*\x00\x08\x00\x00\x00"),
+		"잘못된 식별자":   []byte("II\x2b\x00\x08\x00\x00\x00"),
+	} {
+		if _, err := parseTIFF(data); !errors.Is(err, errNoExif) {
+			t.Errorf("%s: error = %v, want %v", name, err, errNoExif)
+		}
+	}
+}
+
+// segment는 JPEG 세그먼트 하나를 만듭니다.
+func segment(marker byte, payload []byte) []byte {
+	s := []byte{0xFF, marker}
+	s = binary.BigEndian.AppendUint16(s, uint16(len(payload)+2))
+	return append(s, payload...)
+}
+
+func encodeJPEG(t *testing.T, w, h int) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for x := range w {
+		img.Set(x, 0, color.RGBA{R: 255, A: 255})
+	}
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, nil); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// withSegments는 JPEG의 SOI 바로 뒤에 세그먼트를 넣습니다.
+func withSegments(jpg []byte, segments ...[]byte) []byte {
+	out := append([]byte{}, jpg[:2]...)
+	for _, s := range segments {
+		out = append(out, s...)
+	}
+	return append(out, jpg[2:]...)
+}
+
+func TestReadJPEGExif(t *testing.T) {
+	jpg := encodeJPEG(t, 4, 2)
+	exif := segment(0xE1, append([]byte("Exif\x00\x00"), gpsTIFF(binary.LittleEndian, 6, "N", "E")...))
+	xmp := segment(0xE1, []byte("http://ns.adobe.com/xap/1.0/\x00<x:xmpmeta/>"))
+	truncated := exif[:len(exif)-10]
+
+	tests := []struct {
+		name string
+		data []byte
+		want error
+	}{
+		{"EXIF가 있는 JPEG", withSegments(jpg, exif), nil},
+		{"XMP 다음에 EXIF", withSegments(jpg, xmp, exif), nil},
+		{"EXIF가 없는 JPEG", jpg, errNoExif},
+		{"XMP만 있는 JPEG", withSegments(jpg, xmp), errNoExif},
+		{"잘린 APP1 세그먼트", append(append([]byte{}, jpg[:2]...), truncated...), errNoExif},
+		{"JPEG가 아님", []byte("\x89PNG\r\n\x1a\n"), errNoExif},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := readJPEGExif(tt.data)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("readJPEGExif() error = %v, want %v", err, tt.want)
+			}
+			if tt.want == nil && (info.Orientation != 6 || info.Location == nil) {
+				t.Fatalf("info = %+v, want orientation 6 with a location", info)
+			}
+		})
+	}
+}
+
+// hasAPP1은 이미지 데이터 앞의 메타데이터 세그먼트 중 APP1(EXIF, XMP)이 있는지 확인합니다.
+func hasAPP1(t *testing.T, jpg []byte) bool {
+	t.Helper()
+	for i := 2; i+4 <= len(jpg); {
+		marker := jpg[i+1]
+		if marker == 0xDA {
+			return false
+		}
+		if marker == 0xE1 {
+			return true
+		}
+		i += 2 + int(binary.BigEndian.Uint16(jpg[i+2:]))
+	}
+	t.Fatal("no start of scan")
+	return false
+}
+
+// 방향 값에 맞춰 돌린 변형 이미지에는 촬영 위치가 담긴 원본 메타데이터가 남지 않는다.
+func TestProcessStripsMetadata(t *testing.T) {
+	exif := segment(0xE1, append([]byte("Exif\x00\x00"), gpsTIFF(binary.BigEndian, 6, "N", "E")...))
+	xmp := segment(0xE1, []byte("http://ns.adobe.com/xap/1.0/\x00<x:xmpmeta/>"))
+	upload := withSegments(encodeJPEG(t, 40, 20), exif, xmp)
+
+	processed, err := NewImageProcessor().Process(upload, []photo.VariantSpec{{Name: photo.VariantThumb, MaxEdge: 10}, {Name: photo.VariantLarge, MaxEdge: 100}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if processed.Location == nil || processed.TakenAt == nil {
+		t.Fatalf("processed location, takenAt = %v, %v; want both", processed.Location, processed.TakenAt)
+	}
+	sizes := [][2]int{{5, 10}, {20, 40}}
+	for i, v := range processed.Variants {
+		if v.Width != sizes[i][0] || v.Height != sizes[i][1] {
+			t.Errorf("%s: %dx%d, want %dx%d", v.Name, v.Width, v.Height, sizes[i][0], sizes[i][1])
+		}
+		if hasAPP1(t, v.Data) {
+			t.Errorf("%s: variant has an APP1 segment", v.Name)
+		}
+		if _, err := readJPEGExif(v.Data); !errors.Is(err, errNoExif) {
+			t.Errorf("%s: readJPEGExif() error = %v, want %v", v.Name, err, errNoExif)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package media
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/jpeg"
+	_ "image/png" // PNG 업로드 디코딩
+	"net/http"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/photo"
+	"golang.org/x/image/draw"
+)
+
+// jpegQuality는 변형 이미지의 JPEG 품질입니다.
+const jpegQuality = 85
+
+// maxPixels는 디코딩할 이미지의 최대 화소 수입니다(6000x4000). 작은 파일로 거대한 이미지를 만드는 업로드를 막습니다.
+const maxPixels = 24_000_000
+
+// maxConcurrent는 동시에 디코딩하는 이미지 수입니다. 원본 화소를 메모리에 올리므로 업로드가 몰려도 이 수만큼만 처리합니다.
+const maxConcurrent = 2
+
+// ImageProcessor는 표준 라이브러리 디코더로 JPEG, PNG를 읽어 JPEG 변형 이미지를 만듭니다. photo.ImageProcessor를 구현합니다.
+// 디코딩한 화소만 다시 인코딩하므로 EXIF, XMP 등 원본 메타데이터는 변형 이미지에 남지 않습니다.
+type ImageProcessor struct {
+	// slots는 동시에 처리 중인 이미지 수를 maxConcurrent로 제한합니다.
+	slots chan struct{}
+}
+
+func NewImageProcessor() *ImageProcessor {
+	return &ImageProcessor{slots: make(chan struct{}, maxConcurrent)}
+}
+
+func (p *ImageProcessor) Process(data []byte, specs []photo.VariantSpec) (*photo.Processed, error) {
+	contentType := http.DetectContentType(data)
+	if contentType != "image/jpeg" && contentType != "image/png" {
+		return nil, fmt.Errorf("%w: %s", photo.ErrUnsupportedImage, contentType)
+	}
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", photo.ErrUnsupportedImage, err)
+	}
+	if cfg.Width*cfg.Height > maxPixels {
+		return nil, fmt.Errorf("%w: %dx%d", photo.ErrImageTooLarge, cfg.Width, cfg.Height)
+	}
+	p.slots <- struct{}{}
+	defer func() { <-p.slots }()
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", photo.ErrUnsupportedImage, err)
+	}
+	// 원본 크기로 돌리지 않도록 가장 큰 변형 크기로 먼저 줄입니다. 돌려도 긴 변의 길이는 같습니다.
+	largest := 0
+	for _, spec := range specs {
+		largest = max(largest, spec.MaxEdge)
+	}
+	img = resize(img, largest)
+
+	result := &photo.Processed{}
+	if contentType == "image/jpeg" {
+		if info, err := readJPEGExif(data); err == nil {
+			img = applyOrientation(img, info.Orientation)
+			result.Location, result.TakenAt = info.Location, info.TakenAt
+		}
+	}
+	for _, spec := range specs {
+		resized := resize(img, spec.MaxEdge)
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, resized, &jpeg.Options{Quality: jpegQuality}); err != nil {
+			return nil, err
+		}
+		b := resized.Bounds()
+		result.Variants = append(result.Variants, photo.ProcessedVariant{
+			Name:        spec.Name,
+			Width:       b.Dx(),
+			Height:      b.Dy(),
+			ContentType: "image/jpeg",
+			Data:        buf.Bytes(),
+		})
+	}
+	return result, nil
+}
+
+// resize는 긴 변이 maxEdge가 되도록 줄입니다. 이미 작으면 크기를 유지하되 불투명한 RGBA로 옮겨 그립니다.
+// PNG의 투명한 부분은 JPEG로 인코딩할 때 검게 나오지 않도록 흰 배경 위에 그립니다.
+func resize(src image.Image, maxEdge int) image.Image {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if long := max(w, h); long > maxEdge {
+		w, h = max(1, w*maxEdge/long), max(1, h*maxEdge/long)
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.Draw(dst, dst.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, b, draw.Over, nil)
+	return dst
+}
+
+// applyOrientation은 EXIF 방향 값에 맞춰 이미지를 돌리거나 뒤집어, 메타데이터 없이도 바로 보이게 합니다.
+func applyOrientation(src image.Image, orientation int) image.Image {
+	if orientation <= 1 || orientation > 8 {
+		return src
+	}
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	// 5~8은 가로세로가 바뀝니다.
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2: // 좌우 반전
+				dx, dy = w-1-x, y
+			case 3: // 180도 회전
+				dx, dy = w-1-x, h-1-y
+			case 4: // 상하 반전
+				dx, dy = x, h-1-y
+			case 5: // 좌우 반전 후 270도 회전
+				dx, dy = y, x
+			case 6: // 시계 방향 90도 회전
+				dx, dy = h-1-y, x
+			case 7: // 좌우 반전 후 90도 회전
+				dx, dy = h-1-y, w-1-x
+			case 8: // 반시계 방향 90도 회전
+				dx, dy = y, w-1-x
+			}
+			dst.Set(dx, dy, src.At(b.Min.X+x, b.Min.Y+y))
+		}
+	}
+	return dst
+}
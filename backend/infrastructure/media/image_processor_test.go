@@ -0,0 +1,112 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"testing"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/photo"
+)
+
+var testSpecs = []photo.VariantSpec{{Name: photo.VariantThumb, MaxEdge: 10}, {Name: photo.VariantLarge, MaxEdge: 100}}
+
+// pngWithSize는 IHDR의 가로세로만 w, h로 바꾼 PNG입니다. 화소 데이터 없이 크기 검사만 확인합니다.
+func pngWithSize(t *testing.T, w, h uint32) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 1, 1))); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+	// 8바이트 시그니처 뒤 IHDR: 길이(4) 종류(4) 가로(4) 세로(4) … CRC(4)
+	binary.BigEndian.PutUint32(data[16:], w)
+	binary.BigEndian.PutUint32(data[20:], h)
+	binary.BigEndian.PutUint32(data[29:], crc32.ChecksumIEEE(data[12:29]))
+	return data
+}
+
+func TestProcessRejectsLargeImages(t *testing.T) {
+	tests := []struct {
+		name string
+		w, h uint32
+		want error
+	}{
+		{"6000x4000", 6000, 4000, nil},
+		{"6001x4000", 6001, 4000, photo.ErrImageTooLarge},
+		{"48MP", 8000, 6000, photo.ErrImageTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewImageProcessor().Process(pngWithSize(t, tt.w, tt.h), testSpecs)
+			if tt.want == nil {
+				// 크기 검사를 통과하면 화소 데이터가 없어 디코딩에서 실패한다.
+				if !errors.Is(err, photo.ErrUnsupportedImage) {
+					t.Fatalf("Process() error = %v, want %v", err, photo.ErrUnsupportedImage)
+				}
+				return
+			}
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("Process() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+// 원본보다 작은 변형만 만들 때도 줄인 뒤 돌린 결과가 방향에 맞아야 한다.
+func TestProcessDownscalesBeforeRotating(t *testing.T) {
+	// encodeJPEG는 맨 윗줄이 빨간 이미지를 만든다. 시계 방향으로 90도 돌리면 오른쪽 끝 열이 된다.
+	exif := segment(0xE1, append([]byte("Exif\x00\x00"), gpsTIFF(binary.BigEndian, 6, "N", "E")...))
+	processed, err := NewImageProcessor().Process(withSegments(encodeJPEG(t, 400, 200), exif), testSpecs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sizes := [][2]int{{5, 10}, {50, 100}}
+	for i, v := range processed.Variants {
+		if v.Width != sizes[i][0] || v.Height != sizes[i][1] {
+			t.Fatalf("%s: %dx%d, want %dx%d", v.Name, v.Width, v.Height, sizes[i][0], sizes[i][1])
+		}
+	}
+	large, err := jpeg.Decode(bytes.NewReader(processed.Variants[1].Data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	right, _, _, _ := large.At(49, 50).RGBA()
+	left, _, _, _ := large.At(0, 50).RGBA()
+	if right <= left {
+		t.Fatalf("red at right edge = %d, left edge = %d; want the top row rotated to the right", right>>8, left>>8)
+	}
+}
+
+// 처리 중인 이미지가 maxConcurrent개면 다음 업로드는 자리가 날 때까지 기다린다.
+func TestProcessLimitsConcurrency(t *testing.T) {
+	p := NewImageProcessor()
+	for range maxConcurrent {
+		p.slots <- struct{}{}
+	}
+	upload := encodeJPEG(t, 4, 2)
+	done := make(chan error, 1)
+	go func() {
+		_, err := p.Process(upload, testSpecs)
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		t.Fatalf("Process() finished while all slots were taken: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+	<-p.slots
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Process() did not finish after a slot was freed")
+	}
+}
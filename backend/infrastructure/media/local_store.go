@@ -0,0 +1,83 @@
+package media
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/photo"
+)
+
+// LocalStore는 사진 파일을 로컬 디렉터리에 저장합니다. photo.MediaStore를 구현합니다.
+// 저장한 파일은 서버가 baseURL 경로로 정적 파일로 제공합니다.
+type LocalStore struct {
+	dir     string
+	baseURL string
+}
+
+// NewLocalStore는 dir에 파일을 저장하고 baseURL(예: /media) 아래 주소를 돌려주는 저장소를 만듭니다.
+func NewLocalStore(dir, baseURL string) *LocalStore {
+	return &LocalStore{dir: dir, baseURL: strings.TrimSuffix(baseURL, "/")}
+}
+
+// Dir은 파일을 저장하는 디렉터리입니다.
+func (s *LocalStore) Dir() string {
+	return s.dir
+}
+
+func (s *LocalStore) Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error {
+	target, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return fmt.Errorf("%w: %v", photo.ErrStoreUnavailable, err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(target), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("%w: %v", photo.ErrStoreUnavailable, err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := io.Copy(tmp, body); err != nil {
+		tmp.Close()
+		return fmt.Errorf("%w: %v", photo.ErrStoreUnavailable, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("%w: %v", photo.ErrStoreUnavailable, err)
+	}
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return fmt.Errorf("%w: %v", photo.ErrStoreUnavailable, err)
+	}
+	if err := os.Rename(tmp.Name(), target); err != nil {
+		return fmt.Errorf("%w: %v", photo.ErrStoreUnavailable, err)
+	}
+	return nil
+}
+
+func (s *LocalStore) Delete(ctx context.Context, key string) error {
+	target, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(target); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("%w: %v", photo.ErrStoreUnavailable, err)
+	}
+	return nil
+}
+
+func (s *LocalStore) URL(key string) string {
+	return s.baseURL + "/" + key
+}
+
+// path는 key를 저장 디렉터리 안의 파일 경로로 바꿉니다. 디렉터리 밖을 가리키는 key는 거부합니다.
+func (s *LocalStore) path(key string) (string, error) {
+	clean := path.Clean("/" + key)
+	if clean == "/" || clean != "/"+key {
+		return "", fmt.Errorf("%w: 잘못된 키 %q", photo.ErrStoreUnavailable, key)
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(clean)), nil
+}
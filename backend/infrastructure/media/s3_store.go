@@ -0,0 +1,167 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/photo"
+	"github.com/sunDar0/winding-road-finder/backend/infrastructure/tracing"
+)
+
+// S3Config는 S3 호환 스토리지(AWS S3, MinIO 등) 연결 설정입니다.
+type S3Config struct {
+	// Endpoint는 스토리지 주소입니다. (예: http://localhost:9000)
+	Endpoint  string
+	Bucket    string
+	Region    string
+	AccessKey string
+	SecretKey string
+	// PublicURL은 저장한 파일을 내려받을 주소의 접두어입니다. 비어 있으면 Endpoint/Bucket을 사용합니다.
+	PublicURL string
+}
+
+// S3Store는 S3 호환 스토리지에 path-style 주소와 AWS 서명 버전 4로 파일을 저장합니다. photo.MediaStore를 구현합니다.
+// 버킷은 미리 만들어 두어야 하며, 사진을 바로 보여주려면 익명 읽기를 허용하거나 PublicURL에 CDN 주소를 지정합니다.
+type S3Store struct {
+	cfg    S3Config
+	client *http.Client
+	now    func() time.Time
+}
+
+func NewS3Store(cfg S3Config) *S3Store {
+	cfg.Endpoint = strings.TrimSuffix(cfg.Endpoint, "/")
+	if cfg.Region == "" {
+		cfg.Region = "us-east-1"
+	}
+	if cfg.PublicURL == "" {
+		cfg.PublicURL = cfg.Endpoint + "/" + cfg.Bucket
+	}
+	cfg.PublicURL = strings.TrimSuffix(cfg.PublicURL, "/")
+	return &S3Store{cfg: cfg, client: &http.Client{Transport: tracing.Transport(nil), Timeout: 60 * time.Second}, now: time.Now}
+}
+
+func (s *S3Store) Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error {
+	data, err := io.ReadAll(io.LimitReader(body, size))
+	if err != nil {
+		return err
+	}
+	return s.do(ctx, http.MethodPut, key, data, map[string]string{"Content-Type": contentType})
+}
+
+func (s *S3Store) Delete(ctx context.Context, key string) error {
+	return s.do(ctx, http.MethodDelete, key, nil, nil)
+}
+
+func (s *S3Store) URL(key string) string {
+	return s.cfg.PublicURL + "/" + escapePath(key)
+}
+
+func (s *S3Store) do(ctx context.Context, method, key string, body []byte, headers map[string]string) error {
+	target, err := url.Parse(s.cfg.Endpoint + "/" + escapePath(s.cfg.Bucket) + "/" + escapePath(key))
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, method, target.String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.ContentLength = int64(len(body))
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	s.sign(req, target, body)
+	res, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %v", photo.ErrStoreUnavailable, err)
+	}
+	defer res.Body.Close()
+	// 없는 객체를 지우는 것은 성공으로 봅니다. (S3는 204, 일부 구현은 404)
+	if res.StatusCode/100 == 2 || (method == http.MethodDelete && res.StatusCode == http.StatusNotFound) {
+		return nil
+	}
+	detail, _ := io.ReadAll(io.LimitReader(res.Body, 512))
+	return fmt.Errorf("%w: %s %s: HTTP %d %s", photo.ErrStoreUnavailable, method, key, res.StatusCode, strings.TrimSpace(string(detail)))
+}
+
+// sign은 요청에 AWS 서명 버전 4 Authorization 헤더를 붙입니다. Host와 이때까지 요청에 설정한 모든 헤더에 서명하며,
+// 쿼리 문자열은 사용하지 않습니다. 전송 단계에서 더해지는 헤더(User-Agent 등)는 서명하지 않습니다.
+func (s *S3Store) sign(req *http.Request, target *url.URL, body []byte) {
+	now := s.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(body)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": target.Host}
+	for name, values := range req.Header {
+		trimmed := make([]string, len(values))
+		for i, v := range values {
+			trimmed[i] = strings.Join(strings.Fields(v), " ")
+		}
+		headers[strings.ToLower(name)] = strings.Join(trimmed, ",")
+	}
+	names := slices.Sorted(maps.Keys(headers))
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+	canonical := strings.Join([]string{
+		req.Method,
+		escapePath(target.Path),
+		"",
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	scope := date + "/" + s.cfg.Region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + sha256Hex([]byte(canonical))
+
+	key := hmacSHA256([]byte("AWS4"+s.cfg.SecretKey), date)
+	for _, part := range []string{s.cfg.Region, "s3", "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.cfg.AccessKey, scope, signedHeaders, signature))
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// escapePath는 경로 구분자(/)를 남기고 나머지를 RFC 3986 방식으로 인코딩합니다. (서명 버전 4의 URI 인코딩)
+// 영문자, 숫자와 -._~ 외의 바이트는 모두 %XX로 바꿉니다. url.PathEscape는 $, +, = 등을 남겨 서명이 맞지 않습니다.
+func escapePath(p string) string {
+	var b strings.Builder
+	for i := 0; i < len(p); i++ {
+		c := p[i]
+		switch {
+		case c == '/' || c == '-' || c == '.' || c == '_' || c == '~',
+			'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9':
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,159 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "winding_road"
+
+// Metrics는 서버가 /metrics로 내보내는 Prometheus 지표입니다.
+// 모든 메서드는 nil 수신자에서 아무 일도 하지 않으므로, 지표가 필요 없는 도구에서는 nil을 넘기면 됩니다.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	requestDuration *prometheus.HistogramVec
+	requestErrors   *prometheus.CounterVec
+	repoLoads       *prometheus.CounterVec
+	repoLoadSeconds *prometheus.HistogramVec
+	eventsImported  prometheus.Counter
+	imageGenerated  *prometheus.CounterVec
+	cacheLookups    *prometheus.CounterVec
+}
+
+// New는 Go 런타임·프로세스 지표를 포함한 새 지표 묶음을 만듭니다.
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "라우트별 HTTP 요청 처리 시간",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+		requestErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "http_request_errors_total",
+			Help:      "라우트별 4xx, 5xx 응답 수",
+		}, []string{"method", "route", "class"}),
+		repoLoads: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "repository_loads_total",
+			Help:      "데이터 파일 읽기 횟수 (kind: load는 처음 읽기, reload는 파일이 바뀌어 다시 읽기)",
+		}, []string{"repository", "kind", "result"}),
+		repoLoadSeconds: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "repository_load_duration_seconds",
+			Help:      "데이터 파일을 읽고 파생 정보를 붙이는 데 걸린 시간",
+			Buckets:   []float64{.001, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+		}, []string{"repository"}),
+		eventsImported: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "course_events_imported_total",
+			Help:      "courses.json 변경에서 가져온 코스 이벤트 수",
+		}),
+		imageGenerated: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "course_image_generations_total",
+			Help:      "네이버 지도 코스 이미지 생성 결과",
+		}, []string{"result"}),
+		cacheLookups: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "cache_lookups_total",
+			Help:      "캐시 조회 결과 (적중률 = hit / (hit + miss))",
+		}, []string{"cache", "result"}),
+	}
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.requestDuration, m.requestErrors, m.repoLoads, m.repoLoadSeconds,
+		m.eventsImported, m.imageGenerated, m.cacheLookups,
+	)
+	return m
+}
+
+// Handler는 Prometheus 텍스트 형식으로 지표를 내보내는 핸들러입니다.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// ObserveRequest는 요청 하나의 처리 시간과, 4xx·5xx 응답이면 에러 수를 기록합니다.
+// route는 "/api/courses/:id" 같은 라우트 패턴이며, 등록되지 않은 경로는 빈 문자열입니다.
+// 표준이 아닌 메서드는 라벨 값이 끝없이 늘지 않도록 "OTHER"로 묶습니다.
+func (m *Metrics) ObserveRequest(method, route string, status int, elapsed time.Duration) {
+	if m == nil {
+		return
+	}
+	if !standardMethods[method] {
+		method = "OTHER"
+	}
+	if route == "" {
+		route = "unmatched"
+	}
+	m.requestDuration.WithLabelValues(method, route, strconv.Itoa(status)).Observe(elapsed.Seconds())
+	switch {
+	case status >= 500:
+		m.requestErrors.WithLabelValues(method, route, "5xx").Inc()
+	case status >= 400:
+		m.requestErrors.WithLabelValues(method, route, "4xx").Inc()
+	}
+}
+
+// standardMethods는 ObserveRequest가 라벨로 그대로 쓰는 HTTP 메서드입니다.
+var standardMethods = map[string]bool{
+	http.MethodGet: true, http.MethodHead: true, http.MethodPost: true, http.MethodPut: true, http.MethodPatch: true,
+	http.MethodDelete: true, http.MethodConnect: true, http.MethodOptions: true, http.MethodTrace: true,
+}
+
+// ObserveLoad는 저장소가 데이터 파일을 읽은 결과를 기록합니다. reload는 이미 읽은 파일이 바뀌어 다시 읽은 경우입니다.
+func (m *Metrics) ObserveLoad(repository string, reload bool, err error, elapsed time.Duration) {
+	if m == nil {
+		return
+	}
+	kind, result := "load", "ok"
+	if reload {
+		kind = "reload"
+	}
+	if err != nil {
+		result = "error"
+	}
+	m.repoLoads.WithLabelValues(repository, kind, result).Inc()
+	m.repoLoadSeconds.WithLabelValues(repository).Observe(elapsed.Seconds())
+}
+
+// AddImportedEvents는 데이터 파일에서 가져온 코스 이벤트 수를 더합니다.
+func (m *Metrics) AddImportedEvents(n int) {
+	if m == nil {
+		return
+	}
+	m.eventsImported.Add(float64(n))
+}
+
+// ObserveImageGeneration은 코스 이미지 생성 결과를 기록합니다.
+func (m *Metrics) ObserveImageGeneration(err error) {
+	if m == nil {
+		return
+	}
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	m.imageGenerated.WithLabelValues(result).Inc()
+}
+
+// ObserveCache는 cache 이름의 캐시 조회가 적중했는지 기록합니다.
+func (m *Metrics) ObserveCache(cache string, hit bool) {
+	if m == nil {
+		return
+	}
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	m.cacheLookups.WithLabelValues(cache, result).Inc()
+}
@@ -0,0 +1,34 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+// 표준이 아닌 메서드는 OTHER 하나로 묶어 라벨 값이 늘지 않게 한다.
+func TestObserveRequestMethodLabel(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		want   string
+	}{
+		{"표준 메서드", "GET", "GET"},
+		{"PROPFIND", "PROPFIND", "OTHER"},
+		{"임의 메서드", "X-RANDOM-1234", "OTHER"},
+		{"소문자 메서드", "get", "OTHER"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New()
+			m.ObserveRequest(tt.method, "/api/courses", 404, time.Millisecond)
+			if got := testutil.ToFloat64(m.requestErrors.WithLabelValues(tt.want, "/api/courses", "4xx")); got != 1 {
+				t.Fatalf("errors{method=%q} = %v, want 1", tt.want, got)
+			}
+			if n := testutil.CollectAndCount(m.requestErrors); n != 1 {
+				t.Fatalf("error series = %d, want 1", n)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package command
+
+import (
+	"encoding/json"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/audit"
+)
+
+// auditRecord는 audit.json 파일의 작업 기록 항목입니다.
+type auditRecord struct {
+	ID         int            `json:"id"`
+	ActorID    int            `json:"actorId"`
+	ActorEmail string         `json:"actorEmail"`
+	Action     string         `json:"action"`
+	TargetType string         `json:"targetType"`
+	TargetID   int            `json:"targetId"`
+	Changes    []changeRecord `json:"changes,omitempty"`
+	At         time.Time      `json:"at"`
+}
+
+type changeRecord struct {
+	Path   string          `json:"path"`
+	Before json.RawMessage `json:"before,omitempty"`
+	After  json.RawMessage `json:"after,omitempty"`
+}
+
+// AuditCommandRepositoryImpl는 audit.json 파일에 관리자 작업 기록을 쌓는 구현체입니다.
+type AuditCommandRepositoryImpl struct {
+	file    jsonFile
+	mu      sync.Mutex
+	records []auditRecord
+	loaded  bool
+}
+
+func NewAuditCommandRepository(stateDir string) *AuditCommandRepositoryImpl {
+	return &AuditCommandRepositoryImpl{file: newJSONFile(stateDir, "audit.json")}
+}
+
+// ensureLoaded는 처음 접근할 때 파일을 읽습니다. 호출자가 잠금을 잡고 있어야 합니다.
+func (repo *AuditCommandRepositoryImpl) ensureLoaded() error {
+	if repo.loaded {
+		return nil
+	}
+	if err := repo.file.load(&repo.records); err != nil {
+		return err
+	}
+	repo.loaded = true
+	return nil
+}
+
+func (repo *AuditCommandRepositoryImpl) Append(e *audit.Entry) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if err := repo.ensureLoaded(); err != nil {
+		return err
+	}
+	id := 1
+	if n := len(repo.records); n > 0 {
+		id = repo.records[n-1].ID + 1
+	}
+	changes := make([]changeRecord, len(e.Changes))
+	for i, ch := range e.Changes {
+		changes[i] = changeRecord(ch)
+	}
+	records := append(slices.Clip(repo.records), auditRecord{
+		ID:         id,
+		ActorID:    e.ActorID,
+		ActorEmail: e.ActorEmail,
+		Action:     string(e.Action),
+		TargetType: string(e.TargetType),
+		TargetID:   e.TargetID,
+		Changes:    changes,
+		At:         e.At,
+	})
+	if err := repo.file.save(records); err != nil {
+		return err
+	}
+	repo.records = records
+	e.ID = id
+	return nil
+}
+
+func (repo *AuditCommandRepositoryImpl) Find(filter audit.Filter) ([]*audit.Entry, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if err := repo.ensureLoaded(); err != nil {
+		return nil, err
+	}
+	var entries []*audit.Entry
+	for i := len(repo.records) - 1; i >= 0; i-- {
+		r := repo.records[i]
+		if filter.TargetType != "" && r.TargetType != string(filter.TargetType) {
+			continue
+		}
+		if filter.TargetID != 0 && r.TargetID != filter.TargetID {
+			continue
+		}
+		if filter.ActorID != 0 && r.ActorID != filter.ActorID {
+			continue
+		}
+		changes := make([]audit.Change, len(r.Changes))
+		for j, ch := range r.Changes {
+			changes[j] = audit.Change(ch)
+		}
+		entries = append(entries, &audit.Entry{
+			ID:         r.ID,
+			ActorID:    r.ActorID,
+			ActorEmail: r.ActorEmail,
+			Action:     audit.Action(r.Action),
+			TargetType: audit.TargetType(r.TargetType),
+			TargetID:   r.TargetID,
+			Changes:    changes,
+			At:         r.At,
+		})
+	}
+	return entries, nil
+}
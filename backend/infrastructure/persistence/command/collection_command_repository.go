@@ -0,0 +1,93 @@
+package command
+
+import (
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/collection"
+)
+
+// collectionRecord는 collections.json 파일의 컬렉션 항목입니다.
+type collectionRecord struct {
+	ID         int       `json:"id"`
+	OwnerID    int       `json:"ownerId"`
+	Name       string    `json:"name"`
+	CourseIDs  []int     `json:"courseIds"`
+	Public     bool      `json:"public"`
+	ShareToken string    `json:"shareToken,omitempty"`
+	CreatedAt  time.Time `json:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+// CollectionCommandRepositoryImpl는 collections.json 파일에 사용자 컬렉션을 저장하는 구현체입니다.
+type CollectionCommandRepositoryImpl struct {
+	store *recordStore[collection.Collection, collectionRecord]
+}
+
+func NewCollectionCommandRepository(stateDir string) *CollectionCommandRepositoryImpl {
+	return &CollectionCommandRepositoryImpl{store: newRecordStore(newJSONFile(stateDir, "collections.json"), recordCodec[collection.Collection, collectionRecord]{
+		id:     func(c *collection.Collection) int { return c.ID },
+		setID:  func(c *collection.Collection, id int) { c.ID = id },
+		clone:  cloneCollection,
+		decode: decodeCollection,
+		encode: encodeCollection,
+	})}
+}
+
+func decodeCollection(r collectionRecord) (*collection.Collection, error) {
+	return &collection.Collection{
+		ID:         r.ID,
+		OwnerID:    r.OwnerID,
+		Name:       r.Name,
+		CourseIDs:  append([]int{}, r.CourseIDs...),
+		Public:     r.Public,
+		ShareToken: r.ShareToken,
+		CreatedAt:  r.CreatedAt,
+		UpdatedAt:  r.UpdatedAt,
+	}, nil
+}
+
+func encodeCollection(c *collection.Collection) collectionRecord {
+	return collectionRecord{
+		ID:         c.ID,
+		OwnerID:    c.OwnerID,
+		Name:       c.Name,
+		CourseIDs:  c.CourseIDs,
+		Public:     c.Public,
+		ShareToken: c.ShareToken,
+		CreatedAt:  c.CreatedAt,
+		UpdatedAt:  c.UpdatedAt,
+	}
+}
+
+func (repo *CollectionCommandRepositoryImpl) Save(c *collection.Collection) error {
+	return repo.store.save(c)
+}
+
+func (repo *CollectionCommandRepositoryImpl) Update(id int, change func(*collection.Collection) error) (*collection.Collection, error) {
+	return repo.store.update(id, change)
+}
+
+func (repo *CollectionCommandRepositoryImpl) Delete(id int) error {
+	return repo.store.delete(id)
+}
+
+func (repo *CollectionCommandRepositoryImpl) FindByID(id int) (*collection.Collection, error) {
+	return repo.store.findByID(id)
+}
+
+func (repo *CollectionCommandRepositoryImpl) FindByShareToken(token string) (*collection.Collection, error) {
+	if token == "" {
+		return nil, nil
+	}
+	return repo.store.find(func(c *collection.Collection) bool { return c.Public && c.ShareToken == token })
+}
+
+func (repo *CollectionCommandRepositoryImpl) FindByOwner(ownerID int) ([]*collection.Collection, error) {
+	return repo.store.filter(func(c *collection.Collection) bool { return c.OwnerID == ownerID })
+}
+
+func cloneCollection(c *collection.Collection) *collection.Collection {
+	clone := *c
+	clone.CourseIDs = append([]int{}, c.CourseIDs...)
+	return &clone
+}
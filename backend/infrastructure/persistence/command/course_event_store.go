@@ -0,0 +1,228 @@
+package command
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+)
+
+// courseEventRecord는 course_events.jsonl 파일의 한 줄입니다.
+type courseEventRecord struct {
+	Seq      int             `json:"seq"`
+	CourseID int             `json:"courseId"`
+	Version  int             `json:"version"`
+	Type     string          `json:"type"`
+	ActorID  int             `json:"actorId,omitempty"`
+	Reason   string          `json:"reason,omitempty"`
+	At       time.Time       `json:"at"`
+	Data     json.RawMessage `json:"data,omitempty"`
+}
+
+// CourseEventStoreImpl는 course_events.jsonl 파일에 코스 이벤트를 한 줄씩 덧붙이는 구현체입니다.
+// 기존 줄은 다시 쓰지 않습니다. 쓰기에 실패하면 덧붙이기 전 길이로 되돌리고,
+// 프로세스가 저장 도중 중단되어 마지막 줄이 잘렸으면 처음 읽을 때 그 줄을 잘라냅니다.
+type CourseEventStoreImpl struct {
+	path     string
+	mu       sync.Mutex
+	records  []courseEventRecord
+	versions map[int]int // 코스별 마지막 버전
+	loaded   bool
+	logger   *slog.Logger
+}
+
+func NewCourseEventStore(stateDir string, logger *slog.Logger) *CourseEventStoreImpl {
+	return &CourseEventStoreImpl{path: filepath.Join(stateDir, "course_events.jsonl"), logger: logger}
+}
+
+// ensureLoaded는 처음 접근할 때 파일을 읽습니다. 호출자가 잠금을 잡고 있어야 합니다.
+func (store *CourseEventStoreImpl) ensureLoaded() error {
+	if store.loaded {
+		return nil
+	}
+	data, err := os.ReadFile(store.path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	var records []courseEventRecord
+	versions := make(map[int]int)
+	offset := 0
+	for offset < len(data) {
+		end := bytes.IndexByte(data[offset:], '\n')
+		if end < 0 {
+			// 줄바꿈 없이 끝난 마지막 줄은 쓰다 만 이벤트입니다.
+			store.logger.Warn("코스 이벤트 파일의 마지막 줄이 잘려 있어 버립니다", "path", store.path, "bytes", len(data)-offset)
+			if err := os.Truncate(store.path, int64(offset)); err != nil {
+				return err
+			}
+			break
+		}
+		line := data[offset : offset+end]
+		offset += end + 1
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		var r courseEventRecord
+		if err := json.Unmarshal(line, &r); err != nil {
+			return fmt.Errorf("%s: %d번째 이벤트: %w", store.path, len(records)+1, err)
+		}
+		records = append(records, r)
+		versions[r.CourseID] = r.Version
+	}
+	store.records = records
+	store.versions = versions
+	store.loaded = true
+	return nil
+}
+
+func (store *CourseEventStoreImpl) Append(events []*course.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if err := store.ensureLoaded(); err != nil {
+		return err
+	}
+	seq := 0
+	if n := len(store.records); n > 0 {
+		seq = store.records[n-1].Seq
+	}
+	versions := make(map[int]int)
+	records := make([]courseEventRecord, len(events))
+	var buf bytes.Buffer
+	for i, e := range events {
+		version, ok := versions[e.CourseID]
+		if !ok {
+			version = store.versions[e.CourseID]
+		}
+		version++
+		versions[e.CourseID] = version
+		records[i] = courseEventRecord{
+			Seq:      seq + i + 1,
+			CourseID: e.CourseID,
+			Version:  version,
+			Type:     string(e.Type),
+			ActorID:  e.ActorID,
+			Reason:   e.Reason,
+			At:       e.At,
+			Data:     e.Data,
+		}
+		line, err := json.Marshal(records[i])
+		if err != nil {
+			return err
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	if err := appendFile(store.path, buf.Bytes()); err != nil {
+		var partial *partialAppendError
+		if errors.As(err, &partial) {
+			// 잘린 줄을 지우지 못했으므로 다음 접근 때 파일을 다시 읽어 잘라냅니다.
+			store.logger.Error("코스 이벤트 파일에 쓰다 만 줄을 지우지 못했습니다", "path", store.path, "error", err)
+			store.loaded = false
+		}
+		return err
+	}
+	store.records = append(slices.Clip(store.records), records...)
+	for i, e := range events {
+		e.Seq, e.Version = records[i].Seq, records[i].Version
+		store.versions[e.CourseID] = records[i].Version
+	}
+	return nil
+}
+
+// writeFile은 파일에 data를 씁니다. 테스트에서 쓰기 도중 실패하는 경우를 흉내 낼 때 바꿉니다.
+var writeFile = (*os.File).Write
+
+// partialAppendError는 덧붙이다 실패한 뒤 파일을 원래 길이로 되돌리지도 못했음을 나타냅니다.
+type partialAppendError struct {
+	err, truncateErr error
+}
+
+func (e *partialAppendError) Error() string {
+	return fmt.Sprintf("%v (원래 길이로 되돌리지 못함: %v)", e.err, e.truncateErr)
+}
+
+func (e *partialAppendError) Unwrap() error { return e.err }
+
+// appendFile은 data를 파일 끝에 덧붙이고 디스크에 반영될 때까지 기다립니다.
+// 쓰기나 동기화에 실패하면 파일을 덧붙이기 전 길이로 잘라 일부만 쓰인 줄을 남기지 않습니다.
+func appendFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	_, err = writeFile(f, data)
+	if err == nil {
+		err = f.Sync()
+	}
+	if err != nil {
+		if truncErr := f.Truncate(info.Size()); truncErr != nil {
+			err = &partialAppendError{err: err, truncateErr: truncErr}
+		}
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func (store *CourseEventStoreImpl) FindAfter(seq int) ([]*course.Event, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if err := store.ensureLoaded(); err != nil {
+		return nil, err
+	}
+	start := sort.Search(len(store.records), func(i int) bool { return store.records[i].Seq > seq })
+	events := make([]*course.Event, 0, len(store.records)-start)
+	for _, r := range store.records[start:] {
+		events = append(events, r.toEvent())
+	}
+	return events, nil
+}
+
+func (store *CourseEventStoreImpl) FindByCourse(courseID int) ([]*course.Event, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if err := store.ensureLoaded(); err != nil {
+		return nil, err
+	}
+	var events []*course.Event
+	for _, r := range store.records {
+		if r.CourseID == courseID {
+			events = append(events, r.toEvent())
+		}
+	}
+	return events, nil
+}
+
+func (r courseEventRecord) toEvent() *course.Event {
+	return &course.Event{
+		Seq:      r.Seq,
+		CourseID: r.CourseID,
+		Version:  r.Version,
+		Type:     course.EventType(r.Type),
+		ActorID:  r.ActorID,
+		Reason:   r.Reason,
+		At:       r.At,
+		Data:     slices.Clone(r.Data),
+	}
+}
@@ -0,0 +1,156 @@
+package command
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+)
+
+var discardLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+var eventTime = time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+
+func ratingsEvent(courseID int) *course.Event {
+	return &course.Event{CourseID: courseID, Type: course.EventRatingsChanged, ActorID: 1, At: eventTime, Data: []byte(`{"tech":3}`)}
+}
+
+func seqsOf(events []*course.Event) (seqs, versions []int) {
+	for _, e := range events {
+		seqs = append(seqs, e.Seq)
+		versions = append(versions, e.Version)
+	}
+	return seqs, versions
+}
+
+func TestCourseEventStoreAppendAssignsSeqAndVersion(t *testing.T) {
+	dir := t.TempDir()
+	store := NewCourseEventStore(dir, discardLogger)
+	if err := store.Append([]*course.Event{ratingsEvent(1), ratingsEvent(2)}); err != nil {
+		t.Fatal(err)
+	}
+	events := []*course.Event{ratingsEvent(1)}
+	if err := store.Append(events); err != nil {
+		t.Fatal(err)
+	}
+	if events[0].Seq != 3 || events[0].Version != 2 {
+		t.Fatalf("appended seq, version = %d, %d; want 3, 2", events[0].Seq, events[0].Version)
+	}
+
+	// 파일에서 다시 읽어도 순번과 코스별 버전이 그대로다.
+	reloaded := NewCourseEventStore(dir, discardLogger)
+	all, err := reloaded.FindAfter(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	seqs, versions := seqsOf(all)
+	if want := []int{1, 2, 3}; !slices.Equal(seqs, want) || !slices.Equal(versions, []int{1, 1, 2}) {
+		t.Fatalf("seqs = %v, versions = %v; want %v, [1 1 2]", seqs, versions, want)
+	}
+	if after, _ := reloaded.FindAfter(2); len(after) != 1 || after[0].CourseID != 1 {
+		t.Fatalf("FindAfter(2) = %+v, want course 1 only", after)
+	}
+	if byCourse, _ := reloaded.FindByCourse(1); len(byCourse) != 2 || byCourse[1].Version != 2 || !byCourse[1].At.Equal(eventTime) {
+		t.Fatalf("FindByCourse(1) = %+v", byCourse)
+	}
+}
+
+// 저장 도중 중단되어 줄바꿈 없이 끝난 마지막 줄은 처음 읽을 때 잘라내고, 그 뒤로 이어 쓴다.
+func TestCourseEventStoreDropsTrailingPartialLine(t *testing.T) {
+	dir := t.TempDir()
+	if err := NewCourseEventStore(dir, discardLogger).Append([]*course.Event{ratingsEvent(1)}); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "course_events.jsonl")
+	complete, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, append(bytes.Clone(complete), `{"seq":2,"courseId":1,"ver`...), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	store := NewCourseEventStore(dir, discardLogger)
+	if events, err := store.FindAfter(0); err != nil || len(events) != 1 {
+		t.Fatalf("FindAfter(0) = %d events, %v; want 1", len(events), err)
+	}
+	if data, _ := os.ReadFile(path); !bytes.Equal(data, complete) {
+		t.Fatalf("file after load = %q, want %q", data, complete)
+	}
+	if err := store.Append([]*course.Event{ratingsEvent(1)}); err != nil {
+		t.Fatal(err)
+	}
+	events, err := NewCourseEventStore(dir, discardLogger).FindAfter(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if seqs, versions := seqsOf(events); !slices.Equal(seqs, []int{1, 2}) || !slices.Equal(versions, []int{1, 2}) {
+		t.Fatalf("seqs = %v, versions = %v; want [1 2], [1 2]", seqs, versions)
+	}
+}
+
+// 쓰기에 실패하면 파일을 덧붙이기 전 길이로 되돌리고, 실패한 이벤트에는 순번을 배정하지 않는다.
+func TestCourseEventStoreAppendRollsBackFailedWrite(t *testing.T) {
+	errDiskFull := errors.New("디스크가 가득 참")
+	tests := []struct {
+		name  string
+		write func(f *os.File, data []byte) (int, error)
+	}{
+		{"절반만 쓰고 실패", func(f *os.File, data []byte) (int, error) {
+			n, _ := f.Write(data[:len(data)/2])
+			return n, errDiskFull
+		}},
+		{"마지막 줄바꿈 직전까지 쓰고 실패", func(f *os.File, data []byte) (int, error) {
+			n, _ := f.Write(data[:len(data)-1])
+			return n, errDiskFull
+		}},
+		{"아무것도 쓰지 못함", func(*os.File, []byte) (int, error) { return 0, errDiskFull }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, "course_events.jsonl")
+			store := NewCourseEventStore(dir, discardLogger)
+			if err := store.Append([]*course.Event{ratingsEvent(1)}); err != nil {
+				t.Fatal(err)
+			}
+			before, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			writeFile = tt.write
+			t.Cleanup(func() { writeFile = (*os.File).Write })
+			failed := []*course.Event{ratingsEvent(1), ratingsEvent(2)}
+			if err := store.Append(failed); !errors.Is(err, errDiskFull) {
+				t.Fatalf("Append() error = %v, want %v", err, errDiskFull)
+			}
+			if failed[0].Seq != 0 || failed[1].Seq != 0 {
+				t.Fatalf("failed events got seqs %d, %d", failed[0].Seq, failed[1].Seq)
+			}
+			if data, _ := os.ReadFile(path); !bytes.Equal(data, before) {
+				t.Fatalf("file after failed append = %q, want %q", data, before)
+			}
+
+			// 다시 쓰면 실패한 이벤트가 없었던 것처럼 순번과 버전이 이어진다.
+			writeFile = (*os.File).Write
+			if err := store.Append([]*course.Event{ratingsEvent(1)}); err != nil {
+				t.Fatal(err)
+			}
+			events, err := NewCourseEventStore(dir, discardLogger).FindAfter(0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if seqs, versions := seqsOf(events); !slices.Equal(seqs, []int{1, 2}) || !slices.Equal(versions, []int{1, 2}) {
+				t.Fatalf("seqs = %v, versions = %v; want [1 2], [1 2]", seqs, versions)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package command
+
+import "github.com/sunDar0/winding-road-finder/backend/domain/course"
+
+// courseRecord는 제보와 수정안에 담아 저장하는 코스입니다. courses.json 항목과 같은 모양이며, 필드 순서가 파일의 키 순서입니다.
+type courseRecord struct {
+	ID              int                 `json:"id"`
+	Name            localizedRecord     `json:"name"`
+	Region          string              `json:"region"`
+	Tagline         localizedRecord     `json:"tagline"`
+	Characteristics localizedRecord     `json:"characteristics"`
+	NaverMapUrl     string              `json:"naverMapUrl"`
+	Nav             []courseNavRecord   `json:"nav"`
+	Notes           localizedRecord     `json:"notes"`
+	Styles          []string            `json:"styles"`
+	Ratings         ratingsRecord       `json:"ratings"`
+	Availability    *availabilityRecord `json:"availability,omitempty"`
+}
+
+type courseNavRecord struct {
+	Type        string            `json:"type"`
+	Name        localizedRecord   `json:"name"`
+	Geolocation geolocationRecord `json:"geolocation"`
+	Summit      bool              `json:"summit,omitempty"`
+}
+
+type geolocationRecord struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+type availabilityRecord struct {
+	Closures          []periodRecord `json:"closures,omitempty"`
+	RecommendedMonths []int          `json:"recommendedMonths,omitempty"`
+	Restrictions      []periodRecord `json:"restrictions,omitempty"`
+}
+
+// periodRecord는 계절 통제(MM-DD) 또는 시간대 제한(HH:MM) 기간입니다.
+type periodRecord struct {
+	From   string          `json:"from"`
+	To     string          `json:"to"`
+	Reason localizedRecord `json:"reason,omitempty"`
+}
+
+func toCourseRecord(id int, c *course.CourseAggregate) courseRecord {
+	nav := make([]courseNavRecord, len(c.Nav))
+	for i, n := range c.Nav {
+		nav[i] = courseNavRecord{
+			Type:        n.Type,
+			Name:        localizedRecord(n.Name),
+			Geolocation: geolocationRecord{Latitude: n.Geolocation.Latitude, Longitude: n.Geolocation.Longitude},
+			Summit:      n.Summit,
+		}
+	}
+	styles := c.Styles
+	if styles == nil {
+		styles = []string{}
+	}
+	return courseRecord{
+		ID:              id,
+		Name:            localizedRecord(c.Name),
+		Region:          c.Region,
+		Tagline:         localizedRecord(c.Tagline),
+		Characteristics: localizedRecord(c.Characteristics),
+		NaverMapUrl:     c.NaverMapUrl,
+		Nav:             nav,
+		Notes:           localizedRecord(c.Notes),
+		Styles:          styles,
+		Ratings:         ratingsRecord(c.Ratings),
+		Availability:    toAvailabilityRecord(c.Availability),
+	}
+}
+
+func toAvailabilityRecord(a course.Availability) *availabilityRecord {
+	if len(a.Closures) == 0 && len(a.RecommendedMonths) == 0 && len(a.Restrictions) == 0 {
+		return nil
+	}
+	r := &availabilityRecord{}
+	for _, cl := range a.Closures {
+		r.Closures = append(r.Closures, periodRecord{From: cl.From.String(), To: cl.To.String(), Reason: localizedRecord(cl.Reason)})
+	}
+	for _, m := range a.RecommendedMonths {
+		r.RecommendedMonths = append(r.RecommendedMonths, int(m))
+	}
+	for _, w := range a.Restrictions {
+		r.Restrictions = append(r.Restrictions, periodRecord{From: w.From.String(), To: w.To.String(), Reason: localizedRecord(w.Reason)})
+	}
+	return r
+}
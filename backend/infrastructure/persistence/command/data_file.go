@@ -0,0 +1,209 @@
+package command
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"slices"
+	"sort"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+)
+
+// dataFile은 저장소에 함께 관리하는 큐레이션 데이터 파일(data/*.json)입니다. 최상위는 id 필드를 가진 항목 배열입니다.
+// 바뀐 항목만 다시 인코딩하고 나머지 항목은 원본 그대로 두며, 파일의 들여쓰기와 끝 줄바꿈을 유지합니다.
+// 다른 도구가 파일을 고칠 수 있으므로 매번 파일을 다시 읽습니다. 동시 접근 제어는 각 저장소의 뮤텍스가 담당합니다.
+type dataFile struct {
+	path string
+}
+
+// dataItem은 데이터 파일 항목 하나입니다.
+type dataItem struct {
+	id  int
+	raw json.RawMessage
+}
+
+func (f dataFile) load() ([]dataItem, error) {
+	data, err := os.ReadFile(f.path)
+	if err != nil {
+		return nil, err
+	}
+	var raws []json.RawMessage
+	if err := json.Unmarshal(data, &raws); err != nil {
+		return nil, err
+	}
+	items := make([]dataItem, len(raws))
+	for i, raw := range raws {
+		var head struct {
+			ID int `json:"id"`
+		}
+		if err := json.Unmarshal(raw, &head); err != nil {
+			return nil, err
+		}
+		items[i] = dataItem{id: head.ID, raw: raw}
+	}
+	return items, nil
+}
+
+// find는 id 항목을 반환합니다. 없으면 nil입니다.
+func (f dataFile) find(id int) (json.RawMessage, error) {
+	items, err := f.load()
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		if item.id == id {
+			return item.raw, nil
+		}
+	}
+	return nil, nil
+}
+
+// all은 모든 항목을 파일 순서대로 반환합니다.
+func (f dataFile) all() ([]json.RawMessage, error) {
+	items, err := f.load()
+	if err != nil {
+		return nil, err
+	}
+	raws := make([]json.RawMessage, len(items))
+	for i, item := range items {
+		raws[i] = item.raw
+	}
+	return raws, nil
+}
+
+// upsert는 id 항목을 record로 바꾸고, 없으면 끝에 추가합니다. id가 0이면 가장 큰 ID+1을 배정해 반환합니다.
+// record는 배정된 ID로 저장할 값을 만듭니다.
+func (f dataFile) upsert(id int, record func(id int) any) (int, error) {
+	items, err := f.load()
+	if err != nil {
+		return 0, err
+	}
+	if id == 0 {
+		id = nextItemID(items)
+	}
+	raw, err := encodeRecord(record(id))
+	if err != nil {
+		return 0, err
+	}
+	i := slices.IndexFunc(items, func(item dataItem) bool { return item.id == id })
+	if i >= 0 {
+		items[i].raw = raw
+	} else {
+		items = append(items, dataItem{id: id, raw: raw})
+	}
+	return id, f.save(items)
+}
+
+// nextID는 새 항목에 배정할 ID(가장 큰 ID + 1)를 반환합니다.
+func (f dataFile) nextID() (int, error) {
+	items, err := f.load()
+	if err != nil {
+		return 0, err
+	}
+	return nextItemID(items), nil
+}
+
+func nextItemID(items []dataItem) int {
+	id := 0
+	for _, item := range items {
+		id = max(id, item.id)
+	}
+	return id + 1
+}
+
+// remove는 id 항목을 지웁니다. 없으면 false를 반환합니다.
+func (f dataFile) remove(id int) (bool, error) {
+	items, err := f.load()
+	if err != nil {
+		return false, err
+	}
+	i := slices.IndexFunc(items, func(item dataItem) bool { return item.id == id })
+	if i < 0 {
+		return false, nil
+	}
+	return true, f.save(slices.Delete(items, i, i+1))
+}
+
+func (f dataFile) save(items []dataItem) error {
+	original, err := os.ReadFile(f.path)
+	if err != nil {
+		return err
+	}
+	var compact bytes.Buffer
+	compact.WriteByte('[')
+	for i, item := range items {
+		if i > 0 {
+			compact.WriteByte(',')
+		}
+		if err := json.Compact(&compact, item.raw); err != nil {
+			return err
+		}
+	}
+	compact.WriteByte(']')
+	var out bytes.Buffer
+	if err := json.Indent(&out, compact.Bytes(), "", detectIndent(original)); err != nil {
+		return err
+	}
+	if bytes.HasSuffix(original, []byte("\n")) {
+		out.WriteByte('\n')
+	}
+	return writeFileAtomic(f.path, out.Bytes())
+}
+
+// detectIndent는 파일 두 번째 줄의 앞 공백을 들여쓰기 단위로 사용합니다. 알 수 없으면 2칸입니다.
+func detectIndent(data []byte) string {
+	if i := bytes.IndexByte(data, '\n'); i >= 0 {
+		line := data[i+1:]
+		n := len(line) - len(bytes.TrimLeft(line, " \t"))
+		if n > 0 {
+			return string(line[:n])
+		}
+	}
+	return "  "
+}
+
+// encodeRecord는 설명 문구의 &, <, >를 이스케이프하지 않고 인코딩합니다.
+func encodeRecord(v any) (json.RawMessage, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSpace(buf.Bytes()), nil
+}
+
+// localizedRecord는 번역 문자열을 ko, en, ja(그 밖의 언어는 코드순) 순서로 씁니다.
+type localizedRecord i18n.LocalizedText
+
+func (t localizedRecord) MarshalJSON() ([]byte, error) {
+	var langs []i18n.Lang
+	for lang := range t {
+		if !slices.Contains(i18n.SupportedLangs, lang) {
+			langs = append(langs, lang)
+		}
+	}
+	sort.Slice(langs, func(i, j int) bool { return langs[i] < langs[j] })
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for _, lang := range append(slices.Clone(i18n.SupportedLangs), langs...) {
+		v, ok := t[lang]
+		if !ok {
+			continue
+		}
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(string(lang))
+		value, err := encodeRecord(v)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,125 @@
+package command
+
+import (
+	"sort"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/drive"
+)
+
+// driveRecord는 drives.json 파일의 주행 기록 항목입니다.
+type driveRecord struct {
+	ID        int    `json:"id"`
+	UserID    int    `json:"userId"`
+	CourseID  int    `json:"courseId"`
+	DrivenOn  string `json:"drivenOn"`
+	Direction string `json:"direction"`
+	Car       string `json:"car,omitempty"`
+	Weather   string `json:"weather,omitempty"`
+	// Track은 [위도, 경도] 배열입니다.
+	Track     [][2]float64 `json:"track,omitempty"`
+	CreatedAt time.Time    `json:"createdAt"`
+	UpdatedAt time.Time    `json:"updatedAt"`
+}
+
+// DriveCommandRepositoryImpl는 drives.json 파일에 주행 기록을 저장하는 구현체입니다.
+type DriveCommandRepositoryImpl struct {
+	store *recordStore[drive.Drive, driveRecord]
+}
+
+func NewDriveCommandRepository(stateDir string) *DriveCommandRepositoryImpl {
+	return &DriveCommandRepositoryImpl{store: newRecordStore(newJSONFile(stateDir, "drives.json"), recordCodec[drive.Drive, driveRecord]{
+		id:     func(d *drive.Drive) int { return d.ID },
+		setID:  func(d *drive.Drive, id int) { d.ID = id },
+		clone:  cloneDrive,
+		decode: decodeDrive,
+		encode: encodeDrive,
+	})}
+}
+
+func decodeDrive(r driveRecord) (*drive.Drive, error) {
+	drivenOn, err := time.Parse(dateLayout, r.DrivenOn)
+	if err != nil {
+		return nil, err
+	}
+	d := &drive.Drive{
+		ID:        r.ID,
+		UserID:    r.UserID,
+		CourseID:  r.CourseID,
+		DrivenOn:  drivenOn,
+		Direction: drive.Direction(r.Direction),
+		Car:       r.Car,
+		Weather:   drive.Weather(r.Weather),
+		CreatedAt: r.CreatedAt,
+		UpdatedAt: r.UpdatedAt,
+	}
+	if len(r.Track) > 0 {
+		d.Track = &drive.Track{Points: make([]course.CourseGeolocation, len(r.Track))}
+		for i, p := range r.Track {
+			d.Track.Points[i] = course.CourseGeolocation{Latitude: p[0], Longitude: p[1]}
+		}
+	}
+	return d, nil
+}
+
+func encodeDrive(d *drive.Drive) driveRecord {
+	record := driveRecord{
+		ID:        d.ID,
+		UserID:    d.UserID,
+		CourseID:  d.CourseID,
+		DrivenOn:  d.DrivenOn.Format(dateLayout),
+		Direction: string(d.Direction),
+		Car:       d.Car,
+		Weather:   string(d.Weather),
+		CreatedAt: d.CreatedAt,
+		UpdatedAt: d.UpdatedAt,
+	}
+	if d.Track != nil {
+		record.Track = make([][2]float64, len(d.Track.Points))
+		for i, p := range d.Track.Points {
+			record.Track[i] = [2]float64{p.Latitude, p.Longitude}
+		}
+	}
+	return record
+}
+
+func (repo *DriveCommandRepositoryImpl) Save(d *drive.Drive) error {
+	return repo.store.save(d)
+}
+
+func (repo *DriveCommandRepositoryImpl) Update(id int, change func(*drive.Drive) error) (*drive.Drive, error) {
+	return repo.store.update(id, change)
+}
+
+func (repo *DriveCommandRepositoryImpl) Delete(id int) error {
+	return repo.store.delete(id)
+}
+
+func (repo *DriveCommandRepositoryImpl) FindByID(id int) (*drive.Drive, error) {
+	return repo.store.findByID(id)
+}
+
+func (repo *DriveCommandRepositoryImpl) FindByUser(userID int) ([]*drive.Drive, error) {
+	result, err := repo.store.filter(func(d *drive.Drive) bool { return d.UserID == userID })
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if !result[i].DrivenOn.Equal(result[j].DrivenOn) {
+			return result[i].DrivenOn.After(result[j].DrivenOn)
+		}
+		return result[i].ID > result[j].ID
+	})
+	return result, nil
+}
+
+// cloneDrive는 주행 기록을 복사합니다. 궤적 점 배열은 수정되지 않고 통째로 교체되므로 공유합니다.
+func cloneDrive(d *drive.Drive) *drive.Drive {
+	clone := *d
+	if d.Track != nil {
+		track := *d.Track
+		clone.Track = &track
+	}
+	return &clone
+}
@@ -0,0 +1,118 @@
+package command
+
+import (
+	"maps"
+	"sort"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/hazard"
+)
+
+// hazardRecord는 hazards.json 파일의 위험 신고 항목입니다.
+type hazardRecord struct {
+	ID         int                 `json:"id"`
+	CourseID   int                 `json:"courseId"`
+	ReporterID int                 `json:"reporterId"`
+	Type       string              `json:"type"`
+	Severity   string              `json:"severity"`
+	Latitude   float64             `json:"latitude"`
+	Longitude  float64             `json:"longitude"`
+	Note       string              `json:"note,omitempty"`
+	ReportedAt time.Time           `json:"reportedAt"`
+	ExpiresAt  time.Time           `json:"expiresAt"`
+	Votes      map[int]hazard.Vote `json:"votes,omitempty"`
+	Hidden     bool                `json:"hidden,omitempty"`
+}
+
+// HazardCommandRepositoryImpl는 hazards.json 파일에 위험 신고를 저장하는 구현체입니다.
+type HazardCommandRepositoryImpl struct {
+	store *recordStore[hazard.Hazard, hazardRecord]
+}
+
+func NewHazardCommandRepository(stateDir string) *HazardCommandRepositoryImpl {
+	return &HazardCommandRepositoryImpl{store: newRecordStore(newJSONFile(stateDir, "hazards.json"), recordCodec[hazard.Hazard, hazardRecord]{
+		id:     func(h *hazard.Hazard) int { return h.ID },
+		setID:  func(h *hazard.Hazard, id int) { h.ID = id },
+		clone:  cloneHazard,
+		decode: decodeHazard,
+		encode: encodeHazard,
+	})}
+}
+
+func decodeHazard(r hazardRecord) (*hazard.Hazard, error) {
+	severity, err := hazard.ParseSeverity(r.Severity)
+	if err != nil {
+		return nil, err
+	}
+	votes := r.Votes
+	if votes == nil {
+		votes = map[int]hazard.Vote{}
+	}
+	return &hazard.Hazard{
+		ID:         r.ID,
+		CourseID:   r.CourseID,
+		ReporterID: r.ReporterID,
+		Type:       hazard.Type(r.Type),
+		Severity:   severity,
+		Location:   course.CourseGeolocation{Latitude: r.Latitude, Longitude: r.Longitude},
+		Note:       r.Note,
+		ReportedAt: r.ReportedAt,
+		ExpiresAt:  r.ExpiresAt,
+		Votes:      votes,
+		Hidden:     r.Hidden,
+	}, nil
+}
+
+func encodeHazard(h *hazard.Hazard) hazardRecord {
+	return hazardRecord{
+		ID:         h.ID,
+		CourseID:   h.CourseID,
+		ReporterID: h.ReporterID,
+		Type:       string(h.Type),
+		Severity:   h.Severity.String(),
+		Latitude:   h.Location.Latitude,
+		Longitude:  h.Location.Longitude,
+		Note:       h.Note,
+		ReportedAt: h.ReportedAt,
+		ExpiresAt:  h.ExpiresAt,
+		Votes:      h.Votes,
+		Hidden:     h.Hidden,
+	}
+}
+
+func (repo *HazardCommandRepositoryImpl) Save(h *hazard.Hazard) error {
+	return repo.store.save(h)
+}
+
+func (repo *HazardCommandRepositoryImpl) Update(id int, change func(*hazard.Hazard) error) (*hazard.Hazard, error) {
+	return repo.store.update(id, change)
+}
+
+func (repo *HazardCommandRepositoryImpl) FindByID(id int) (*hazard.Hazard, error) {
+	return repo.store.findByID(id)
+}
+
+func (repo *HazardCommandRepositoryImpl) FindByCourse(courseID int) ([]*hazard.Hazard, error) {
+	result, err := repo.store.filter(func(h *hazard.Hazard) bool { return h.CourseID == courseID })
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].ReportedAt.After(result[j].ReportedAt)
+	})
+	return result, nil
+}
+
+func (repo *HazardCommandRepositoryImpl) FindAll() ([]*hazard.Hazard, error) {
+	return repo.store.all()
+}
+
+func cloneHazard(h *hazard.Hazard) *hazard.Hazard {
+	clone := *h
+	clone.Votes = maps.Clone(h.Votes)
+	if clone.Votes == nil {
+		clone.Votes = map[int]hazard.Vote{}
+	}
+	return &clone
+}
@@ -0,0 +1,61 @@
+package command
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// jsonFile은 쓰기 저장소가 사용하는 JSON 파일입니다.
+// 임시 파일에 쓴 뒤 이름을 바꿔 저장 도중 중단되어도 기존 파일이 깨지지 않도록 합니다.
+// 동시 접근 제어는 각 저장소의 뮤텍스가 담당합니다.
+type jsonFile struct {
+	path string
+}
+
+func newJSONFile(dir, name string) jsonFile {
+	return jsonFile{path: filepath.Join(dir, name)}
+}
+
+// load는 파일을 읽어 v에 디코딩합니다. 파일이 없으면 v를 그대로 둡니다.
+func (f jsonFile) load(v any) error {
+	data, err := os.ReadFile(f.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// save는 v를 JSON으로 인코딩해 파일에 씁니다.
+func (f jsonFile) save(v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(f.path, data)
+}
+
+// writeFileAtomic은 같은 디렉터리의 임시 파일에 쓴 뒤 이름을 바꿔 path를 교체합니다.
+func writeFileAtomic(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,160 @@
+package command
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/photo"
+)
+
+// photoRecord는 photos.json 파일의 사진 항목입니다.
+type photoRecord struct {
+	ID         int                `json:"id"`
+	CourseID   int                `json:"courseId"`
+	UploaderID int                `json:"uploaderId"`
+	Caption    string             `json:"caption,omitempty"`
+	RouteKm    *float64           `json:"routeKm,omitempty"`
+	RoutePoint *geolocationRecord `json:"routePoint,omitempty"`
+	Variants   []variantRecord    `json:"variants"`
+	UploadedAt time.Time          `json:"uploadedAt"`
+}
+
+type variantRecord struct {
+	Name   string `json:"name"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+	Key    string `json:"key"`
+}
+
+// PhotoCommandRepositoryImpl는 photos.json 파일에 사진 정보를 저장하는 구현체입니다.
+type PhotoCommandRepositoryImpl struct {
+	file    jsonFile
+	mu      sync.Mutex
+	records []photoRecord
+	loaded  bool
+}
+
+func NewPhotoCommandRepository(stateDir string) *PhotoCommandRepositoryImpl {
+	return &PhotoCommandRepositoryImpl{file: newJSONFile(stateDir, "photos.json")}
+}
+
+// ensureLoaded는 처음 접근할 때 파일을 읽습니다. 호출자가 잠금을 잡고 있어야 합니다.
+func (repo *PhotoCommandRepositoryImpl) ensureLoaded() error {
+	if repo.loaded {
+		return nil
+	}
+	if err := repo.file.load(&repo.records); err != nil {
+		return err
+	}
+	repo.loaded = true
+	return nil
+}
+
+func (repo *PhotoCommandRepositoryImpl) Save(p *photo.Photo) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if err := repo.ensureLoaded(); err != nil {
+		return err
+	}
+	id := p.ID
+	if id == 0 {
+		for _, existing := range repo.records {
+			id = max(id, existing.ID)
+		}
+		id++
+	}
+	record := photoRecord{
+		ID:         id,
+		CourseID:   p.CourseID,
+		UploaderID: p.UploaderID,
+		Caption:    p.Caption,
+		RouteKm:    p.RouteKm,
+		Variants:   make([]variantRecord, len(p.Variants)),
+		UploadedAt: p.UploadedAt,
+	}
+	if l := p.RoutePoint; l != nil {
+		record.RoutePoint = &geolocationRecord{Latitude: l.Latitude, Longitude: l.Longitude}
+	}
+	for i, v := range p.Variants {
+		record.Variants[i] = variantRecord{Name: string(v.Name), Width: v.Width, Height: v.Height, Key: v.Key}
+	}
+	records := slices.Clone(repo.records)
+	if i := slices.IndexFunc(records, func(existing photoRecord) bool { return existing.ID == id }); i >= 0 {
+		records[i] = record
+	} else {
+		records = append(records, record)
+	}
+	if err := repo.file.save(records); err != nil {
+		return err
+	}
+	repo.records = records
+	p.ID = id
+	return nil
+}
+
+func (repo *PhotoCommandRepositoryImpl) FindByID(id int) (*photo.Photo, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if err := repo.ensureLoaded(); err != nil {
+		return nil, err
+	}
+	for _, r := range repo.records {
+		if r.ID == id {
+			return r.toPhoto(), nil
+		}
+	}
+	return nil, nil
+}
+
+func (repo *PhotoCommandRepositoryImpl) FindByCourse(courseID int) ([]*photo.Photo, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if err := repo.ensureLoaded(); err != nil {
+		return nil, err
+	}
+	var result []*photo.Photo
+	for _, r := range repo.records {
+		if r.CourseID == courseID {
+			result = append(result, r.toPhoto())
+		}
+	}
+	return result, nil
+}
+
+func (repo *PhotoCommandRepositoryImpl) Delete(id int) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if err := repo.ensureLoaded(); err != nil {
+		return err
+	}
+	records := slices.DeleteFunc(slices.Clone(repo.records), func(r photoRecord) bool { return r.ID == id })
+	if len(records) == len(repo.records) {
+		return photo.ErrPhotoNotFound
+	}
+	if err := repo.file.save(records); err != nil {
+		return err
+	}
+	repo.records = records
+	return nil
+}
+
+func (r photoRecord) toPhoto() *photo.Photo {
+	p := &photo.Photo{
+		ID:         r.ID,
+		CourseID:   r.CourseID,
+		UploaderID: r.UploaderID,
+		Caption:    r.Caption,
+		RouteKm:    r.RouteKm,
+		Variants:   make([]photo.Variant, len(r.Variants)),
+		UploadedAt: r.UploadedAt,
+	}
+	if l := r.RoutePoint; l != nil {
+		p.RoutePoint = &course.CourseGeolocation{Latitude: l.Latitude, Longitude: l.Longitude}
+	}
+	for i, v := range r.Variants {
+		p.Variants[i] = photo.Variant{Name: photo.VariantName(v.Name), Width: v.Width, Height: v.Height, Key: v.Key}
+	}
+	return p
+}
@@ -0,0 +1,100 @@
+package command
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/recommendation"
+)
+
+// recommendationRecord는 recommendations.json 파일의 추천 항목입니다. 필드 순서가 파일의 키 순서입니다.
+type recommendationRecord struct {
+	ID          int             `json:"id"`
+	Title       localizedRecord `json:"title"`
+	Description localizedRecord `json:"description"`
+	CourseIds   []int           `json:"courseIds,omitempty"`
+	Rule        string          `json:"rule,omitempty"`
+	PinnedIds   []int           `json:"pinnedIds,omitempty"`
+	ExcludedIds []int           `json:"excludedIds,omitempty"`
+}
+
+// RecommendationCommandRepositoryImpl는 추천 데이터 파일(recommendations.json)을 고치는 구현체입니다.
+type RecommendationCommandRepositoryImpl struct {
+	file dataFile
+	mu   sync.Mutex
+}
+
+func NewRecommendationCommandRepository(path string) *RecommendationCommandRepositoryImpl {
+	return &RecommendationCommandRepositoryImpl{file: dataFile{path: path}}
+}
+
+func (repo *RecommendationCommandRepositoryImpl) FindAll() ([]*recommendation.Recommendation, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	raws, err := repo.file.all()
+	if err != nil {
+		return nil, err
+	}
+	recs := make([]*recommendation.Recommendation, len(raws))
+	for i, raw := range raws {
+		recs[i] = &recommendation.Recommendation{}
+		if err := json.Unmarshal(raw, recs[i]); err != nil {
+			return nil, err
+		}
+	}
+	return recs, nil
+}
+
+func (repo *RecommendationCommandRepositoryImpl) FindById(id int) (*recommendation.Recommendation, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	raw, err := repo.file.find(id)
+	if err != nil || raw == nil {
+		return nil, err
+	}
+	var rec recommendation.Recommendation
+	if err := json.Unmarshal(raw, &rec); err != nil {
+		return nil, err
+	}
+	return &rec, nil
+}
+
+func (repo *RecommendationCommandRepositoryImpl) NextID() (int, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	return repo.file.nextID()
+}
+
+func (repo *RecommendationCommandRepositoryImpl) Save(r *recommendation.Recommendation) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	id, err := repo.file.upsert(r.ID, func(id int) any {
+		return recommendationRecord{
+			ID:          id,
+			Title:       localizedRecord(r.Title),
+			Description: localizedRecord(r.Description),
+			CourseIds:   r.CourseIds,
+			Rule:        r.Rule,
+			PinnedIds:   r.PinnedIds,
+			ExcludedIds: r.ExcludedIds,
+		}
+	})
+	if err != nil {
+		return err
+	}
+	r.ID = id
+	return nil
+}
+
+func (repo *RecommendationCommandRepositoryImpl) Delete(id int) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	found, err := repo.file.remove(id)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return recommendation.ErrRecommendationNotFound
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+package command
+
+import (
+	"slices"
+	"sync"
+)
+
+// recordCodec은 recordStore가 저장하는 항목 T와 파일 레코드 R 사이의 변환 방법입니다.
+type recordCodec[T, R any] struct {
+	id     func(*T) int
+	setID  func(*T, int)
+	clone  func(*T) *T
+	decode func(R) (*T, error)
+	encode func(*T) R
+	// conflict는 다른 항목 existing과 함께 저장할 수 없으면 오류를 반환합니다. nil이면 검사하지 않습니다.
+	conflict func(existing, item *T) error
+}
+
+// recordStore는 JSON 파일 하나에 ID를 가진 항목 목록을 저장하는 쓰기 저장소의 공통 부분입니다.
+// 처음 접근할 때 파일을 읽어 메모리에 두고, 변경은 잠금 안에서 파일에 쓴 뒤 메모리 상태를 교체합니다.
+// 호출자에게는 항상 복사본을 돌려주므로 잠금 밖에서 고쳐도 저장된 상태는 바뀌지 않습니다.
+type recordStore[T, R any] struct {
+	file   jsonFile
+	codec  recordCodec[T, R]
+	mu     sync.Mutex
+	items  []*T
+	loaded bool
+}
+
+func newRecordStore[T, R any](file jsonFile, codec recordCodec[T, R]) *recordStore[T, R] {
+	return &recordStore[T, R]{file: file, codec: codec}
+}
+
+// ensureLoaded는 처음 접근할 때 파일을 읽습니다. 호출자가 잠금을 잡고 있어야 합니다.
+func (s *recordStore[T, R]) ensureLoaded() error {
+	if s.loaded {
+		return nil
+	}
+	var records []R
+	if err := s.file.load(&records); err != nil {
+		return err
+	}
+	items := make([]*T, 0, len(records))
+	for _, r := range records {
+		item, err := s.codec.decode(r)
+		if err != nil {
+			return err
+		}
+		items = append(items, item)
+	}
+	s.items = items
+	s.loaded = true
+	return nil
+}
+
+// persist는 항목 목록을 파일에 쓰고 메모리 상태를 교체합니다. 호출자가 잠금을 잡고 있어야 합니다.
+func (s *recordStore[T, R]) persist(items []*T) error {
+	records := make([]R, len(items))
+	for i, item := range items {
+		records[i] = s.codec.encode(item)
+	}
+	if err := s.file.save(records); err != nil {
+		return err
+	}
+	s.items = items
+	return nil
+}
+
+// upsert는 item의 복사본으로 같은 ID 항목을 바꾸고, 없으면 끝에 추가합니다. ID가 0이면 가장 큰 ID+1을 item에 배정합니다.
+// 호출자가 잠금을 잡고 있어야 합니다.
+func (s *recordStore[T, R]) upsert(item *T) error {
+	id := s.codec.id(item)
+	items := make([]*T, 0, len(s.items)+1)
+	maxID := 0
+	replaced := false
+	stored := s.codec.clone(item)
+	for _, existing := range s.items {
+		existingID := s.codec.id(existing)
+		maxID = max(maxID, existingID)
+		if id != 0 && existingID == id {
+			items = append(items, stored)
+			replaced = true
+			continue
+		}
+		if s.codec.conflict != nil {
+			if err := s.codec.conflict(existing, item); err != nil {
+				return err
+			}
+		}
+		items = append(items, existing)
+	}
+	if !replaced {
+		if id == 0 {
+			s.codec.setID(item, maxID+1)
+			s.codec.setID(stored, maxID+1)
+		}
+		items = append(items, stored)
+	}
+	return s.persist(items)
+}
+
+// save는 item을 저장합니다. ID가 0이면 새 ID를 배정합니다.
+func (s *recordStore[T, R]) save(item *T) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.ensureLoaded(); err != nil {
+		return err
+	}
+	return s.upsert(item)
+}
+
+// update는 id 항목을 잠금 안에서 읽어 change로 바꾼 뒤 저장하고, 저장한 항목의 복사본을 반환합니다.
+// 읽기와 저장 사이에 다른 변경이 끼어들지 않으므로 동시에 고쳐도 변경이 사라지지 않습니다.
+// 항목이 없으면 nil을, change가 실패하면 아무것도 저장하지 않고 그 오류를 반환합니다.
+func (s *recordStore[T, R]) update(id int, change func(*T) error) (*T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.ensureLoaded(); err != nil {
+		return nil, err
+	}
+	i := slices.IndexFunc(s.items, func(item *T) bool { return s.codec.id(item) == id })
+	if i < 0 {
+		return nil, nil
+	}
+	item := s.codec.clone(s.items[i])
+	if err := change(item); err != nil {
+		return nil, err
+	}
+	// change가 ID를 바꿔도 같은 항목을 고치도록 되돌립니다.
+	s.codec.setID(item, id)
+	if err := s.upsert(item); err != nil {
+		return nil, err
+	}
+	return s.codec.clone(item), nil
+}
+
+// delete는 id 항목을 지웁니다.
+func (s *recordStore[T, R]) delete(id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.ensureLoaded(); err != nil {
+		return err
+	}
+	items := slices.DeleteFunc(slices.Clone(s.items), func(item *T) bool { return s.codec.id(item) == id })
+	return s.persist(items)
+}
+
+// find는 match를 만족하는 첫 항목의 복사본을 반환합니다. 없으면 nil입니다.
+func (s *recordStore[T, R]) find(match func(*T) bool) (*T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.ensureLoaded(); err != nil {
+		return nil, err
+	}
+	for _, item := range s.items {
+		if match(item) {
+			return s.codec.clone(item), nil
+		}
+	}
+	return nil, nil
+}
+
+// findByID는 id 항목의 복사본을 반환합니다. 없으면 nil입니다.
+func (s *recordStore[T, R]) findByID(id int) (*T, error) {
+	return s.find(func(item *T) bool { return s.codec.id(item) == id })
+}
+
+// filter는 match를 만족하는 항목의 복사본을 저장 순서대로 반환합니다. 없으면 nil입니다.
+func (s *recordStore[T, R]) filter(match func(*T) bool) ([]*T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.ensureLoaded(); err != nil {
+		return nil, err
+	}
+	var result []*T
+	for _, item := range s.items {
+		if match(item) {
+			result = append(result, s.codec.clone(item))
+		}
+	}
+	return result, nil
+}
+
+// all은 모든 항목의 복사본을 저장 순서대로 반환합니다.
+func (s *recordStore[T, R]) all() ([]*T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.ensureLoaded(); err != nil {
+		return nil, err
+	}
+	items := make([]*T, len(s.items))
+	for i, item := range s.items {
+		items[i] = s.codec.clone(item)
+	}
+	return items, nil
+}
@@ -0,0 +1,110 @@
+package command
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/hazard"
+	"github.com/sunDar0/winding-road-finder/backend/domain/review"
+)
+
+func TestRecordStoreSaveAssignsIDsAndReloads(t *testing.T) {
+	dir := t.TempDir()
+	repo := NewHazardCommandRepository(dir)
+	now := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	for i := 1; i <= 3; i++ {
+		h := &hazard.Hazard{CourseID: 1, ReporterID: i, Type: hazard.TypeGravel, Severity: hazard.SeverityLow, ReportedAt: now, ExpiresAt: now.Add(time.Hour)}
+		if err := repo.Save(h); err != nil {
+			t.Fatal(err)
+		}
+		if h.ID != i {
+			t.Fatalf("assigned ID = %d, want %d", h.ID, i)
+		}
+	}
+
+	// 돌려받은 항목을 고쳐도 저장된 상태는 바뀌지 않는다.
+	h, _ := repo.FindByID(2)
+	h.Votes[9] = hazard.VoteConfirm
+	if again, _ := repo.FindByID(2); len(again.Votes) != 0 {
+		t.Fatalf("stored votes changed through returned copy: %v", again.Votes)
+	}
+
+	// 파일에서 다시 읽어도 같다.
+	all, err := NewHazardCommandRepository(dir).FindAll()
+	if err != nil || len(all) != 3 || all[2].ReporterID != 3 {
+		t.Fatalf("reloaded = %+v, %v", all, err)
+	}
+}
+
+func TestRecordStoreUpdate(t *testing.T) {
+	repo := NewReviewCommandRepository(t.TempDir())
+	now := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	ratings := course.CourseRatings{Tech: 3, Speed: 3, Scenery: 3, Road: 3, Access: 3}
+	for userID := 1; userID <= 2; userID++ {
+		if err := repo.Save(&review.Review{CourseID: 1, UserID: userID, Ratings: ratings, VisitedOn: now, CreatedAt: now}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	errStop := errors.New("stop")
+
+	tests := []struct {
+		name   string
+		id     int
+		change func(*review.Review) error
+		want   error
+		found  bool
+		hidden bool
+	}{
+		{"변경 저장", 1, func(r *review.Review) error { r.Hidden = true; return nil }, nil, true, true},
+		{"없는 항목", 99, func(r *review.Review) error { t.Fatal("change called"); return nil }, nil, false, false},
+		{"변경 실패는 저장하지 않음", 2, func(r *review.Review) error { r.Hidden = true; return errStop }, errStop, false, false},
+		{"유일성 위반", 2, func(r *review.Review) error { r.UserID = 1; return nil }, review.ErrAlreadyReviewed, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := repo.Update(tt.id, tt.change)
+			if !errors.Is(err, tt.want) || (r != nil) != tt.found {
+				t.Fatalf("Update(%d) = %+v, %v; want found=%v, err=%v", tt.id, r, err, tt.found, tt.want)
+			}
+			if stored, _ := repo.FindByID(tt.id); stored != nil && stored.Hidden != tt.hidden {
+				t.Fatalf("stored Hidden = %v, want %v", stored.Hidden, tt.hidden)
+			}
+		})
+	}
+}
+
+func TestRecordStoreConcurrentUpdatesAreNotLost(t *testing.T) {
+	dir := t.TempDir()
+	repo := NewHazardCommandRepository(dir)
+	now := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	h := &hazard.Hazard{CourseID: 1, ReporterID: 1, Type: hazard.TypeGravel, Severity: hazard.SeverityLow, ReportedAt: now, ExpiresAt: now.Add(time.Hour)}
+	if err := repo.Save(h); err != nil {
+		t.Fatal(err)
+	}
+
+	const voters = 30
+	var wg sync.WaitGroup
+	for userID := 2; userID < 2+voters; userID++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := repo.Update(h.ID, func(h *hazard.Hazard) error {
+				return h.CastVote(userID, hazard.VoteConfirm, now)
+			}); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	reloaded, err := NewHazardCommandRepository(dir).FindByID(h.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if confirms, _ := reloaded.Tally(); confirms != voters {
+		t.Fatalf("confirms = %d, want %d", confirms, voters)
+	}
+}
@@ -0,0 +1,115 @@
+package command
+
+import (
+	"sort"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/review"
+)
+
+// dateLayout은 날짜만 저장하는 필드의 형식입니다.
+const dateLayout = "2006-01-02"
+
+// reviewRecord는 reviews.json 파일의 리뷰 항목입니다.
+type reviewRecord struct {
+	ID        int           `json:"id"`
+	CourseID  int           `json:"courseId"`
+	UserID    int           `json:"userId"`
+	Ratings   ratingsRecord `json:"ratings"`
+	Text      string        `json:"text"`
+	VisitedOn string        `json:"visitedOn"`
+	CreatedAt time.Time     `json:"createdAt"`
+	Hidden    bool          `json:"hidden,omitempty"`
+}
+
+type ratingsRecord struct {
+	Tech    int `json:"tech"`
+	Speed   int `json:"speed"`
+	Scenery int `json:"scenery"`
+	Road    int `json:"road"`
+	Access  int `json:"access"`
+}
+
+// ReviewCommandRepositoryImpl는 reviews.json 파일에 코스 리뷰를 저장하는 구현체입니다.
+type ReviewCommandRepositoryImpl struct {
+	store *recordStore[review.Review, reviewRecord]
+}
+
+func NewReviewCommandRepository(stateDir string) *ReviewCommandRepositoryImpl {
+	return &ReviewCommandRepositoryImpl{store: newRecordStore(newJSONFile(stateDir, "reviews.json"), recordCodec[review.Review, reviewRecord]{
+		id:     func(r *review.Review) int { return r.ID },
+		setID:  func(r *review.Review, id int) { r.ID = id },
+		clone:  cloneReview,
+		decode: decodeReview,
+		encode: encodeReview,
+		conflict: func(existing, r *review.Review) error {
+			if existing.CourseID == r.CourseID && existing.UserID == r.UserID {
+				return review.ErrAlreadyReviewed
+			}
+			return nil
+		},
+	})}
+}
+
+func decodeReview(r reviewRecord) (*review.Review, error) {
+	visitedOn, err := time.Parse(dateLayout, r.VisitedOn)
+	if err != nil {
+		return nil, err
+	}
+	return &review.Review{
+		ID:        r.ID,
+		CourseID:  r.CourseID,
+		UserID:    r.UserID,
+		Ratings:   course.CourseRatings(r.Ratings),
+		Text:      r.Text,
+		VisitedOn: visitedOn,
+		CreatedAt: r.CreatedAt,
+		Hidden:    r.Hidden,
+	}, nil
+}
+
+func encodeReview(r *review.Review) reviewRecord {
+	return reviewRecord{
+		ID:        r.ID,
+		CourseID:  r.CourseID,
+		UserID:    r.UserID,
+		Ratings:   ratingsRecord(r.Ratings),
+		Text:      r.Text,
+		VisitedOn: r.VisitedOn.Format(dateLayout),
+		CreatedAt: r.CreatedAt,
+		Hidden:    r.Hidden,
+	}
+}
+
+func (repo *ReviewCommandRepositoryImpl) Save(r *review.Review) error {
+	return repo.store.save(r)
+}
+
+func (repo *ReviewCommandRepositoryImpl) Update(id int, change func(*review.Review) error) (*review.Review, error) {
+	return repo.store.update(id, change)
+}
+
+func (repo *ReviewCommandRepositoryImpl) FindByID(id int) (*review.Review, error) {
+	return repo.store.findByID(id)
+}
+
+func (repo *ReviewCommandRepositoryImpl) FindByCourse(courseID int) ([]*review.Review, error) {
+	result, err := repo.store.filter(func(r *review.Review) bool { return r.CourseID == courseID })
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].CreatedAt.After(result[j].CreatedAt)
+	})
+	return result, nil
+}
+
+func (repo *ReviewCommandRepositoryImpl) FindAll() ([]*review.Review, error) {
+	return repo.store.all()
+}
+
+func cloneReview(r *review.Review) *review.Review {
+	clone := *r
+	return &clone
+}
@@ -0,0 +1,164 @@
+package command
+
+import (
+	"encoding/json"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/revision"
+)
+
+// revisionRecord는 revisions.json 파일의 수정안 항목입니다. 제안한 코스는 courses.json과 같은 형식으로 저장합니다.
+type revisionRecord struct {
+	ID            int             `json:"id"`
+	CourseID      int             `json:"courseId"`
+	BaseVersion   int             `json:"baseVersion"`
+	AuthorID      int             `json:"authorId"`
+	Status        string          `json:"status"`
+	Summary       string          `json:"summary"`
+	Course        json.RawMessage `json:"course"`
+	ReviewerID    int             `json:"reviewerId,omitempty"`
+	ReviewComment string          `json:"reviewComment,omitempty"`
+	CreatedAt     time.Time       `json:"createdAt"`
+	UpdatedAt     time.Time       `json:"updatedAt"`
+	SubmittedAt   *time.Time      `json:"submittedAt,omitempty"`
+	ReviewedAt    *time.Time      `json:"reviewedAt,omitempty"`
+}
+
+// RevisionCommandRepositoryImpl는 revisions.json 파일에 코스 수정안을 저장하는 구현체입니다.
+type RevisionCommandRepositoryImpl struct {
+	file    jsonFile
+	mu      sync.Mutex
+	records []revisionRecord
+	loaded  bool
+}
+
+func NewRevisionCommandRepository(stateDir string) *RevisionCommandRepositoryImpl {
+	return &RevisionCommandRepositoryImpl{file: newJSONFile(stateDir, "revisions.json")}
+}
+
+// ensureLoaded는 처음 접근할 때 파일을 읽습니다. 호출자가 잠금을 잡고 있어야 합니다.
+func (repo *RevisionCommandRepositoryImpl) ensureLoaded() error {
+	if repo.loaded {
+		return nil
+	}
+	if err := repo.file.load(&repo.records); err != nil {
+		return err
+	}
+	repo.loaded = true
+	return nil
+}
+
+func (repo *RevisionCommandRepositoryImpl) Save(r *revision.Revision) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if err := repo.ensureLoaded(); err != nil {
+		return err
+	}
+	id := r.ID
+	if id == 0 {
+		for _, existing := range repo.records {
+			id = max(id, existing.ID)
+		}
+		id++
+	}
+	courseJSON, err := json.Marshal(toCourseRecord(r.CourseID, r.Course))
+	if err != nil {
+		return err
+	}
+	record := revisionRecord{
+		ID:            id,
+		CourseID:      r.CourseID,
+		BaseVersion:   r.BaseVersion,
+		AuthorID:      r.AuthorID,
+		Status:        string(r.Status),
+		Summary:       r.Summary,
+		Course:        courseJSON,
+		ReviewerID:    r.ReviewerID,
+		ReviewComment: r.ReviewComment,
+		CreatedAt:     r.CreatedAt,
+		UpdatedAt:     r.UpdatedAt,
+		SubmittedAt:   r.SubmittedAt,
+		ReviewedAt:    r.ReviewedAt,
+	}
+	records := slices.Clone(repo.records)
+	if i := slices.IndexFunc(records, func(existing revisionRecord) bool { return existing.ID == id }); i >= 0 {
+		records[i] = record
+	} else {
+		records = append(records, record)
+	}
+	if err := repo.file.save(records); err != nil {
+		return err
+	}
+	repo.records = records
+	r.ID = id
+	return nil
+}
+
+func (repo *RevisionCommandRepositoryImpl) FindByID(id int) (*revision.Revision, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if err := repo.ensureLoaded(); err != nil {
+		return nil, err
+	}
+	for _, r := range repo.records {
+		if r.ID == id {
+			return r.toRevision()
+		}
+	}
+	return nil, nil
+}
+
+func (repo *RevisionCommandRepositoryImpl) Find(filter revision.Filter) ([]*revision.Revision, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if err := repo.ensureLoaded(); err != nil {
+		return nil, err
+	}
+	var result []*revision.Revision
+	for _, r := range repo.records {
+		if filter.AuthorID != 0 && r.AuthorID != filter.AuthorID {
+			continue
+		}
+		if filter.CourseID != 0 && r.CourseID != filter.CourseID {
+			continue
+		}
+		if filter.Status != "" && r.Status != string(filter.Status) {
+			continue
+		}
+		rev, err := r.toRevision()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, rev)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].UpdatedAt.After(result[j].UpdatedAt)
+	})
+	return result, nil
+}
+
+func (r revisionRecord) toRevision() (*revision.Revision, error) {
+	var c course.CourseAggregate
+	if err := json.Unmarshal(r.Course, &c); err != nil {
+		return nil, err
+	}
+	return &revision.Revision{
+		ID:            r.ID,
+		CourseID:      r.CourseID,
+		BaseVersion:   r.BaseVersion,
+		AuthorID:      r.AuthorID,
+		Status:        revision.Status(r.Status),
+		Summary:       r.Summary,
+		Course:        &c,
+		ReviewerID:    r.ReviewerID,
+		ReviewComment: r.ReviewComment,
+		CreatedAt:     r.CreatedAt,
+		UpdatedAt:     r.UpdatedAt,
+		SubmittedAt:   r.SubmittedAt,
+		ReviewedAt:    r.ReviewedAt,
+	}, nil
+}
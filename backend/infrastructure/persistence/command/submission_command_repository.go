@@ -0,0 +1,155 @@
+package command
+
+import (
+	"encoding/json"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/submission"
+)
+
+// submissionRecord는 submissions.json 파일의 코스 제보 항목입니다. 제보한 코스는 courses.json과 같은 형식으로 저장합니다.
+type submissionRecord struct {
+	ID            int             `json:"id"`
+	SubmitterID   int             `json:"submitterId"`
+	Course        json.RawMessage `json:"course"`
+	Note          string          `json:"note,omitempty"`
+	Status        string          `json:"status"`
+	CourseID      int             `json:"courseId,omitempty"`
+	DuplicateOf   int             `json:"duplicateOf,omitempty"`
+	ReviewerID    int             `json:"reviewerId,omitempty"`
+	ReviewComment string          `json:"reviewComment,omitempty"`
+	SubmittedAt   time.Time       `json:"submittedAt"`
+	ReviewedAt    *time.Time      `json:"reviewedAt,omitempty"`
+}
+
+// SubmissionCommandRepositoryImpl는 submissions.json 파일에 코스 제보를 저장하는 구현체입니다.
+type SubmissionCommandRepositoryImpl struct {
+	file    jsonFile
+	mu      sync.Mutex
+	records []submissionRecord
+	loaded  bool
+}
+
+func NewSubmissionCommandRepository(stateDir string) *SubmissionCommandRepositoryImpl {
+	return &SubmissionCommandRepositoryImpl{file: newJSONFile(stateDir, "submissions.json")}
+}
+
+// ensureLoaded는 처음 접근할 때 파일을 읽습니다. 호출자가 잠금을 잡고 있어야 합니다.
+func (repo *SubmissionCommandRepositoryImpl) ensureLoaded() error {
+	if repo.loaded {
+		return nil
+	}
+	if err := repo.file.load(&repo.records); err != nil {
+		return err
+	}
+	repo.loaded = true
+	return nil
+}
+
+func (repo *SubmissionCommandRepositoryImpl) Save(s *submission.Submission) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if err := repo.ensureLoaded(); err != nil {
+		return err
+	}
+	id := s.ID
+	if id == 0 {
+		for _, existing := range repo.records {
+			id = max(id, existing.ID)
+		}
+		id++
+	}
+	courseJSON, err := json.Marshal(toCourseRecord(0, s.Course))
+	if err != nil {
+		return err
+	}
+	record := submissionRecord{
+		ID:            id,
+		SubmitterID:   s.SubmitterID,
+		Course:        courseJSON,
+		Note:          s.Note,
+		Status:        string(s.Status),
+		CourseID:      s.CourseID,
+		DuplicateOf:   s.DuplicateOf,
+		ReviewerID:    s.ReviewerID,
+		ReviewComment: s.ReviewComment,
+		SubmittedAt:   s.SubmittedAt,
+		ReviewedAt:    s.ReviewedAt,
+	}
+	records := slices.Clone(repo.records)
+	if i := slices.IndexFunc(records, func(existing submissionRecord) bool { return existing.ID == id }); i >= 0 {
+		records[i] = record
+	} else {
+		records = append(records, record)
+	}
+	if err := repo.file.save(records); err != nil {
+		return err
+	}
+	repo.records = records
+	s.ID = id
+	return nil
+}
+
+func (repo *SubmissionCommandRepositoryImpl) FindByID(id int) (*submission.Submission, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if err := repo.ensureLoaded(); err != nil {
+		return nil, err
+	}
+	for _, r := range repo.records {
+		if r.ID == id {
+			return r.toSubmission()
+		}
+	}
+	return nil, nil
+}
+
+func (repo *SubmissionCommandRepositoryImpl) Find(filter submission.Filter) ([]*submission.Submission, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if err := repo.ensureLoaded(); err != nil {
+		return nil, err
+	}
+	var result []*submission.Submission
+	for _, r := range repo.records {
+		if filter.SubmitterID != 0 && r.SubmitterID != filter.SubmitterID {
+			continue
+		}
+		if filter.Status != "" && r.Status != string(filter.Status) {
+			continue
+		}
+		s, err := r.toSubmission()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, s)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].SubmittedAt.After(result[j].SubmittedAt)
+	})
+	return result, nil
+}
+
+func (r submissionRecord) toSubmission() (*submission.Submission, error) {
+	var c course.CourseAggregate
+	if err := json.Unmarshal(r.Course, &c); err != nil {
+		return nil, err
+	}
+	return &submission.Submission{
+		ID:            r.ID,
+		SubmitterID:   r.SubmitterID,
+		Course:        &c,
+		Note:          r.Note,
+		Status:        submission.Status(r.Status),
+		CourseID:      r.CourseID,
+		DuplicateOf:   r.DuplicateOf,
+		ReviewerID:    r.ReviewerID,
+		ReviewComment: r.ReviewComment,
+		SubmittedAt:   r.SubmittedAt,
+		ReviewedAt:    r.ReviewedAt,
+	}, nil
+}
@@ -0,0 +1,175 @@
+package command
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+)
+
+// userRecord는 users.json 파일의 사용자 항목입니다.
+type userRecord struct {
+	ID           int              `json:"id"`
+	Email        string           `json:"email"`
+	DisplayName  string           `json:"displayName"`
+	PasswordHash string           `json:"passwordHash,omitempty"`
+	Role         user.Role        `json:"role"`
+	Identities   []identityRecord `json:"identities,omitempty"`
+	CreatedAt    time.Time        `json:"createdAt"`
+	UpdatedAt    time.Time        `json:"updatedAt"`
+}
+
+type identityRecord struct {
+	Provider string    `json:"provider"`
+	Subject  string    `json:"subject"`
+	Email    string    `json:"email,omitempty"`
+	LinkedAt time.Time `json:"linkedAt"`
+}
+
+// UserCommandRepositoryImpl는 users.json 파일에 사용자 계정을 저장하는 구현체입니다.
+type UserCommandRepositoryImpl struct {
+	file   jsonFile
+	mu     sync.Mutex
+	users  []*user.User
+	loaded bool
+}
+
+func NewUserCommandRepository(stateDir string) *UserCommandRepositoryImpl {
+	return &UserCommandRepositoryImpl{file: newJSONFile(stateDir, "users.json")}
+}
+
+// ensureLoaded는 처음 접근할 때 파일을 읽습니다. 호출자가 쓰기 잠금을 잡고 있어야 합니다.
+func (repo *UserCommandRepositoryImpl) ensureLoaded() error {
+	if repo.loaded {
+		return nil
+	}
+	var records []userRecord
+	if err := repo.file.load(&records); err != nil {
+		return err
+	}
+	repo.users = make([]*user.User, len(records))
+	for i, r := range records {
+		repo.users[i] = &user.User{
+			ID:           r.ID,
+			Email:        r.Email,
+			DisplayName:  r.DisplayName,
+			PasswordHash: r.PasswordHash,
+			Role:         r.Role,
+			CreatedAt:    r.CreatedAt,
+			UpdatedAt:    r.UpdatedAt,
+		}
+		for _, id := range r.Identities {
+			repo.users[i].Identities = append(repo.users[i].Identities, user.ExternalIdentity(id))
+		}
+	}
+	repo.loaded = true
+	return nil
+}
+
+func (repo *UserCommandRepositoryImpl) Save(u *user.User) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if err := repo.ensureLoaded(); err != nil {
+		return err
+	}
+
+	users := make([]*user.User, 0, len(repo.users)+1)
+	maxID := 0
+	replaced := false
+	for _, existing := range repo.users {
+		maxID = max(maxID, existing.ID)
+		if existing.ID == u.ID {
+			users = append(users, u)
+			replaced = true
+			continue
+		}
+		if u.Email != "" && existing.Email == u.Email {
+			return user.ErrEmailTaken
+		}
+		for _, id := range u.Identities {
+			if existing.HasIdentity(id.Provider, id.Subject) {
+				return user.ErrIdentityLinked
+			}
+		}
+		users = append(users, existing)
+	}
+	if !replaced {
+		if u.ID == 0 {
+			u.ID = maxID + 1
+		}
+		users = append(users, u)
+	}
+	users[slices.Index(users, u)] = clone(u)
+	records := make([]userRecord, len(users))
+	for i, u := range users {
+		records[i] = userRecord{
+			ID:           u.ID,
+			Email:        u.Email,
+			DisplayName:  u.DisplayName,
+			PasswordHash: u.PasswordHash,
+			Role:         u.Role,
+			CreatedAt:    u.CreatedAt,
+			UpdatedAt:    u.UpdatedAt,
+		}
+		for _, id := range u.Identities {
+			records[i].Identities = append(records[i].Identities, identityRecord(id))
+		}
+	}
+	if err := repo.file.save(records); err != nil {
+		return err
+	}
+	repo.users = users
+	return nil
+}
+
+func (repo *UserCommandRepositoryImpl) FindByID(id int) (*user.User, error) {
+	return repo.find(func(u *user.User) bool { return u.ID == id })
+}
+
+// FindByEmail은 이메일로 사용자를 찾습니다. 이메일 없는 계정은 찾지 않습니다.
+func (repo *UserCommandRepositoryImpl) FindByEmail(email string) (*user.User, error) {
+	if email == "" {
+		return nil, nil
+	}
+	return repo.find(func(u *user.User) bool { return u.Email == email })
+}
+
+func (repo *UserCommandRepositoryImpl) FindByIdentity(provider, subject string) (*user.User, error) {
+	return repo.find(func(u *user.User) bool { return u.HasIdentity(provider, subject) })
+}
+
+func (repo *UserCommandRepositoryImpl) FindAll() ([]*user.User, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if err := repo.ensureLoaded(); err != nil {
+		return nil, err
+	}
+	users := make([]*user.User, len(repo.users))
+	for i, u := range repo.users {
+		users[i] = clone(u)
+	}
+	return users, nil
+}
+
+// find는 조건에 맞는 사용자의 복사본을 반환합니다. 호출자가 수정해도 Save 전까지 저장소에 반영되지 않습니다.
+func (repo *UserCommandRepositoryImpl) find(match func(*user.User) bool) (*user.User, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if err := repo.ensureLoaded(); err != nil {
+		return nil, err
+	}
+	for _, u := range repo.users {
+		if match(u) {
+			return clone(u), nil
+		}
+	}
+	return nil, nil
+}
+
+// clone은 저장소 밖에서 수정해도 저장된 값에 영향이 없도록 사용자를 복사합니다.
+func clone(u *user.User) *user.User {
+	c := *u
+	c.Identities = slices.Clone(u.Identities)
+	return &c
+}
@@ -0,0 +1,48 @@
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+)
+
+// 저장소에 함께 관리하는 코스 데이터는 사용자에게 보이는 모든 텍스트를 세 언어로 갖고, 지점 역할을 해석할 수 있어야 한다.
+func TestCourseDataIsFullyTranslated(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "..", "..", CoursesPath))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var courses []*course.CourseAggregate
+	if err := json.Unmarshal(data, &courses); err != nil {
+		t.Fatal(err)
+	}
+	if len(courses) == 0 {
+		t.Fatal("no courses")
+	}
+	for _, c := range courses {
+		fields := map[string]i18n.LocalizedText{
+			"name":            c.Name,
+			"tagline":         c.Tagline,
+			"characteristics": c.Characteristics,
+			"notes":           c.Notes,
+		}
+		for i, n := range c.Nav {
+			fields[fmt.Sprintf("nav[%d].name", i)] = n.Name
+			if _, _, ok := n.Role(); !ok {
+				t.Errorf("course %d nav[%d]: unknown type %q", c.ID, i, n.Type)
+			}
+		}
+		for field, text := range fields {
+			for _, lang := range i18n.SupportedLangs {
+				if text[lang] == "" {
+					t.Errorf("course %d %s: missing %s", c.ID, field, lang)
+				}
+			}
+		}
+	}
+}
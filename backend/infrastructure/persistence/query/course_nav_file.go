@@ -0,0 +1,143 @@
+package query
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+)
+
+// CoursesPath는 코스 데이터 파일입니다.
+const CoursesPath = "data/courses.json"
+
+// orderedField는 키 순서를 유지해 다시 쓰기 위한 JSON 객체 필드입니다.
+type orderedField struct {
+	Key   string
+	Value json.RawMessage
+}
+
+type orderedObject []orderedField
+
+func (o *orderedObject) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if _, err := dec.Token(); err != nil { // {
+		return err
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return err
+		}
+		*o = append(*o, orderedField{Key: tok.(string), Value: value})
+	}
+	return nil
+}
+
+func (o orderedObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, f := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(f.Key)
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(f.Value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// navRecord는 courses.json의 내비게이션 포인트 형식입니다.
+type navRecord struct {
+	Type        string        `json:"type"`
+	Name        orderedObject `json:"name"`
+	Geolocation navGeoRecord  `json:"geolocation"`
+	Summit      bool          `json:"summit,omitempty"`
+}
+
+type navGeoRecord struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// localizedRecord는 번역 문자열을 ko, en, ja 순으로 씁니다.
+func localizedRecord(t i18n.LocalizedText) orderedObject {
+	var o orderedObject
+	for _, lang := range i18n.SupportedLangs {
+		if v, ok := t[lang]; ok {
+			value, _ := json.Marshal(v)
+			o = append(o, orderedField{Key: string(lang), Value: value})
+		}
+	}
+	return o
+}
+
+// UpdateCourseNav는 코스 데이터 파일에서 지정한 코스의 nav만 바꿔 씁니다.
+// 다른 필드의 키 순서와 값은 그대로 두며, 파일은 2칸 들여쓰기로 다시 씁니다.
+func UpdateCourseNav(path string, nav map[int][]course.CourseNav) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var courses []orderedObject
+	if err := json.Unmarshal(data, &courses); err != nil {
+		return err
+	}
+	updated := 0
+	for _, c := range courses {
+		var id int
+		for _, f := range c {
+			if f.Key == "id" {
+				json.Unmarshal(f.Value, &id)
+			}
+		}
+		points, ok := nav[id]
+		if !ok {
+			continue
+		}
+		records := make([]navRecord, len(points))
+		for i, n := range points {
+			records[i] = navRecord{
+				Type:        n.Type,
+				Name:        localizedRecord(n.Name),
+				Geolocation: navGeoRecord{Latitude: n.Geolocation.Latitude, Longitude: n.Geolocation.Longitude},
+				Summit:      n.Summit,
+			}
+		}
+		value, err := json.Marshal(records)
+		if err != nil {
+			return err
+		}
+		for i := range c {
+			if c[i].Key == "nav" {
+				c[i].Value = value
+			}
+		}
+		updated++
+	}
+	if updated != len(nav) {
+		return fmt.Errorf("%s: 코스 %d개 중 %d개만 찾았습니다", path, len(nav), updated)
+	}
+
+	// 설명 문구의 &, <, >가 이스케이프되지 않도록 Encoder를 사용합니다.
+	var compact bytes.Buffer
+	enc := json.NewEncoder(&compact)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(courses); err != nil {
+		return err
+	}
+	var out bytes.Buffer
+	if err := json.Indent(&out, bytes.TrimSpace(compact.Bytes()), "", "  "); err != nil {
+		return err
+	}
+	return os.WriteFile(path, out.Bytes(), 0o644)
+}
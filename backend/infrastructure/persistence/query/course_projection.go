@@ -0,0 +1,191 @@
+package query
+
+import (
+	"cmp"
+	"errors"
+	"log/slog"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/region"
+	"github.com/sunDar0/winding-road-finder/backend/domain/style"
+	"github.com/sunDar0/winding-road-finder/backend/infrastructure/metrics"
+)
+
+// NewProjectedCourseQueryRepository는 코스 이벤트를 투영해 조회하는 저장소를 만듭니다.
+// courses.json은 계속 데이터 원본으로 편집할 수 있으며, 파일이 바뀌면 파일에서 고친 부분만
+// 작업자 없는 이벤트로 저장소에 가져옵니다. 이벤트 저장소가 비어 있으면 첫 조회 때 모든 코스의 생성 이벤트가 만들어집니다.
+func NewProjectedCourseQueryRepository(regionRepo region.RegionRepository, styleRepo style.StyleRepository, events course.EventStore, logger *slog.Logger, m *metrics.Metrics) *CourseQueryRepositoryImpl {
+	return &CourseQueryRepositoryImpl{
+		regionRepo: regionRepo,
+		styleRepo:  styleRepo,
+		events:     events,
+		logger:     logger,
+		metrics:    m,
+		projection: make(map[int]*course.CourseAggregate),
+		imported:   make(map[int]*course.CourseAggregate),
+	}
+}
+
+// project는 이벤트를 투영한 코스 목록을 반환합니다. 호출자가 잠금을 잡고 있어야 하며, 목록을 새로 만들었으면 true를 반환합니다.
+// courses.json은 수정 시각이 바뀌었을 때(처음 조회할 때 포함)만 가져옵니다.
+func (repo *CourseQueryRepositoryImpl) project() ([]*course.CourseAggregate, bool, error) {
+	changed, err := repo.catchUp()
+	if err != nil {
+		return nil, false, err
+	}
+	info, err := os.Stat(CoursesPath)
+	if err != nil {
+		return nil, false, err
+	}
+	if !info.ModTime().Equal(repo.modTime) {
+		if err := repo.importFile(); err != nil {
+			return nil, false, err
+		}
+		repo.modTime = info.ModTime()
+		imported, err := repo.catchUp()
+		if err != nil {
+			return nil, false, err
+		}
+		changed = changed || imported
+	}
+	if repo.courses != nil && !changed {
+		return repo.courses, false, nil
+	}
+	// 이미 반환한 목록을 읽는 요청이 있을 수 있으므로 복사본에 파생 정보를 붙입니다.
+	courses := make([]*course.CourseAggregate, 0, len(repo.projection))
+	for _, c := range repo.projection {
+		cp := *c
+		courses = append(courses, &cp)
+	}
+	slices.SortFunc(courses, func(a, b *course.CourseAggregate) int { return cmp.Compare(a.ID, b.ID) })
+	if err := repo.decorate(courses); err != nil {
+		return nil, false, err
+	}
+	repo.courses = courses
+	return courses, true, nil
+}
+
+// catchUp은 마지막으로 적용한 뒤 저장된 이벤트를 투영 상태에 적용합니다. 적용한 이벤트가 있으면 true를 반환합니다.
+// 작업자 없는 이벤트는 마지막으로 가져온 파일 내용(imported)에도 적용합니다.
+func (repo *CourseQueryRepositoryImpl) catchUp() (bool, error) {
+	events, err := repo.events.FindAfter(repo.seq)
+	if err != nil {
+		return false, err
+	}
+	for _, e := range events {
+		next, err := course.Apply(repo.projection[e.CourseID], e)
+		if err != nil {
+			return false, err
+		}
+		setCourse(repo.projection, e.CourseID, next)
+		if e.ActorID == 0 {
+			// 관리자 API가 파일에도 저장하던 때의 이벤트는 파일 기준 상태에 맞지 않을 수 있어 건너뜁니다.
+			if next, err := course.Apply(repo.imported[e.CourseID], e); err == nil {
+				setCourse(repo.imported, e.CourseID, next)
+			} else if !errors.Is(err, course.ErrInvalidEvent) {
+				return false, err
+			}
+		}
+		repo.seq = e.Seq
+	}
+	return len(events) > 0, nil
+}
+
+// importFile은 마지막으로 가져온 파일 내용과 courses.json을 비교해, 파일에서 고친 코스와 항목만 작업자 없는 이벤트로 저장합니다.
+// 이벤트는 투영 상태를 기준으로 만들므로, 관리자 API가 이벤트로만 저장한 변경은 파일에서 같은 항목을 고치지 않는 한 되돌리지 않습니다.
+// 관리자가 지운 코스를 파일에서 고쳤거나, 관리자가 쓴 ID로 파일에 코스를 더한 경우처럼 투영 상태에 맞지 않는 변경은 가져오지 않습니다.
+func (repo *CourseQueryRepositoryImpl) importFile() error {
+	courses, err := repo.readCoursesFile()
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	var events []*course.Event
+	add := func(id int, after *course.CourseAggregate) error {
+		edited, err := course.NewEvents(repo.imported[id], after, 0, "", now)
+		if err != nil || len(edited) == 0 {
+			return err
+		}
+		current := repo.projection[id]
+		if current == nil && after == nil {
+			return nil
+		}
+		changes, err := course.NewEvents(current, after, 0, "", now)
+		if err != nil {
+			return err
+		}
+		for _, e := range changes {
+			if slices.ContainsFunc(edited, func(f *course.Event) bool { return f.Type == e.Type }) {
+				events = append(events, e)
+			}
+		}
+		return nil
+	}
+	inFile := make(map[int]bool, len(courses))
+	for _, c := range courses {
+		inFile[c.ID] = true
+		if err := add(c.ID, c); err != nil {
+			return err
+		}
+	}
+	var removed []int
+	for id := range repo.imported {
+		if !inFile[id] {
+			removed = append(removed, id)
+		}
+	}
+	slices.Sort(removed)
+	for _, id := range removed {
+		if err := add(id, nil); err != nil {
+			return err
+		}
+	}
+	if len(events) > 0 {
+		repo.logger.Info("코스 데이터 파일에서 변경 이벤트를 가져왔습니다", "path", CoursesPath, "events", len(events))
+		repo.metrics.AddImportedEvents(len(events))
+	}
+	return repo.events.Append(events)
+}
+
+// setCourse는 c가 nil이면 코스를 지우고, 아니면 바꿉니다.
+func setCourse(courses map[int]*course.CourseAggregate, id int, c *course.CourseAggregate) {
+	if c == nil {
+		delete(courses, id)
+	} else {
+		courses[id] = c
+	}
+}
+
+func (repo *CourseQueryRepositoryImpl) FindEvents(courseID int) ([]*course.Event, error) {
+	if repo.events == nil {
+		return nil, nil
+	}
+	// 아직 가져오지 않은 파일 변경이 이력에 빠지지 않도록 먼저 동기화합니다.
+	if _, err := repo.loadCourses(); err != nil {
+		return nil, err
+	}
+	return repo.events.FindByCourse(courseID)
+}
+
+func (repo *CourseQueryRepositoryImpl) FindAt(courseID int, at time.Time) (*course.CourseAggregate, error) {
+	events, err := repo.FindEvents(courseID)
+	if err != nil {
+		return nil, err
+	}
+	n := 0
+	for n < len(events) && !events[n].At.After(at) {
+		n++
+	}
+	c, err := course.Replay(events[:n])
+	if err != nil || c == nil {
+		return nil, err
+	}
+	// 과거 시점에는 도로 경로가 없으므로 지역 코드만 붙입니다.
+	if err := repo.assignRegions([]*course.CourseAggregate{c}); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
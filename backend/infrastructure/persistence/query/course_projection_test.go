@@ -0,0 +1,185 @@
+package query
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+	"github.com/sunDar0/winding-road-finder/backend/domain/region"
+	"github.com/sunDar0/winding-road-finder/backend/domain/style"
+	commandRepo "github.com/sunDar0/winding-road-finder/backend/infrastructure/persistence/command"
+)
+
+type stubRegions struct{}
+
+func (stubRegions) FindAll() ([]*region.Region, error)        { return nil, nil }
+func (stubRegions) FindByCode(string) (*region.Region, error) { return nil, nil }
+
+type stubStyles struct{}
+
+func (stubStyles) FindAll() ([]*style.Style, error)        { return nil, nil }
+func (stubStyles) FindBySlug(string) (*style.Style, error) { return nil, nil }
+
+var discardLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// writeCourses는 작업 디렉터리의 courses.json을 courses로 바꾸고, 수정 시각을 modTime으로 맞춥니다.
+func writeCourses(t *testing.T, modTime time.Time, courses ...*course.CourseAggregate) {
+	t.Helper()
+	data, err := json.Marshal(courses)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(CoursesPath, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(CoursesPath, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func namedCourse(id int, name string) *course.CourseAggregate {
+	return &course.CourseAggregate{ID: id, Name: i18n.Text(name), Nav: []course.CourseNav{
+		{Type: "출발지", Geolocation: course.CourseGeolocation{Latitude: 37.5, Longitude: 127.0}},
+	}}
+}
+
+func eventTypes(t *testing.T, events course.EventStore, courseID int) []course.EventType {
+	t.Helper()
+	found, err := events.FindByCourse(courseID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var types []course.EventType
+	for _, e := range found {
+		types = append(types, e.Type)
+	}
+	return types
+}
+
+func courseIDs(t *testing.T, repo *CourseQueryRepositoryImpl) []int {
+	t.Helper()
+	courses, err := repo.FindAll(course.CourseFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []int
+	for _, c := range courses {
+		ids = append(ids, c.ID)
+	}
+	return ids
+}
+
+// courses.json이 바뀌면 투영 상태와의 차이를 이벤트로 가져오고, 이벤트만으로 과거 상태를 재구성한다.
+func TestProjectedCourseQueryRepository(t *testing.T) {
+	t.Chdir(t.TempDir())
+	if err := os.Mkdir("data", 0o755); err != nil {
+		t.Fatal(err)
+	}
+	events := commandRepo.NewCourseEventStore("state", discardLogger)
+	repo := NewProjectedCourseQueryRepository(stubRegions{}, stubStyles{}, events, discardLogger, nil)
+
+	// 처음 조회하면 파일의 모든 코스에 생성 이벤트가 만들어진다.
+	first := time.Date(2025, 5, 1, 9, 0, 0, 0, time.UTC)
+	writeCourses(t, first, namedCourse(1, "중미산"), namedCourse(2, "유명산"))
+	if ids := courseIDs(t, repo); !slices.Equal(ids, []int{1, 2}) {
+		t.Fatalf("course IDs = %v, want [1 2]", ids)
+	}
+	beforeEdit := time.Now()
+
+	// 파일에서 이름을 고치고, 코스를 지우고, 새 코스를 더한다.
+	writeCourses(t, first.Add(time.Minute), namedCourse(1, "중미산 ~ 유명산"), namedCourse(3, "비슬산"))
+	if ids := courseIDs(t, repo); !slices.Equal(ids, []int{1, 3}) {
+		t.Fatalf("course IDs after edit = %v, want [1 3]", ids)
+	}
+	tests := []struct {
+		courseID int
+		want     []course.EventType
+	}{
+		{1, []course.EventType{course.EventCreated, course.EventDetailsChanged}},
+		{2, []course.EventType{course.EventCreated, course.EventDeleted}},
+		{3, []course.EventType{course.EventCreated}},
+	}
+	for _, tt := range tests {
+		if got := eventTypes(t, events, tt.courseID); !slices.Equal(got, tt.want) {
+			t.Fatalf("course %d events = %v, want %v", tt.courseID, got, tt.want)
+		}
+	}
+
+	// 관리자가 저장한 이벤트는 파일이 바뀌지 않아도 조회에 반영되고, 파일 내용으로 되돌아가지 않는다.
+	ratings := course.CourseRatings{Tech: 5, Speed: 4, Scenery: 3, Road: 2, Access: 1}
+	data, _ := json.Marshal(ratings)
+	if err := events.Append([]*course.Event{{CourseID: 3, Type: course.EventRatingsChanged, ActorID: 7, At: time.Now(), Data: data}}); err != nil {
+		t.Fatal(err)
+	}
+	if c, err := repo.FindByID(3); err != nil || c == nil || c.Ratings != ratings {
+		t.Fatalf("FindByID(3) = %+v, %v; want ratings %+v", c, err, ratings)
+	}
+	if got := eventTypes(t, events, 3); len(got) != 2 {
+		t.Fatalf("course 3 events = %v, want created and ratings only", got)
+	}
+
+	// 과거 시점의 상태는 그때까지의 이벤트로 재구성한다.
+	if c, err := repo.FindAt(1, beforeEdit); err != nil || c == nil || c.Name.String() != "중미산" {
+		t.Fatalf("FindAt(1, before edit) = %+v, %v; want the original name", c, err)
+	}
+	if c, err := repo.FindAt(2, beforeEdit); err != nil || c == nil {
+		t.Fatalf("FindAt(2, before edit) = %v, %v; want the deleted course", c, err)
+	}
+	if c, err := repo.FindAt(2, time.Now()); err != nil || c != nil {
+		t.Fatalf("FindAt(2, now) = %v, %v; want nil", c, err)
+	}
+
+	// 관리자 변경은 파일에 없지만, 파일에서 다른 코스를 고쳐도 되돌아가지 않고 고친 부분만 가져온다.
+	writeCourses(t, first.Add(2*time.Minute), namedCourse(1, "유명산 ~ 중미산"), namedCourse(3, "비슬산"))
+	if c, err := repo.FindByID(1); err != nil || c == nil || c.Name.String() != "유명산 ~ 중미산" {
+		t.Fatalf("FindByID(1) after file edit = %+v, %v; want the new name", c, err)
+	}
+	if c, err := repo.FindByID(3); err != nil || c == nil || c.Ratings != ratings {
+		t.Fatalf("FindByID(3) after file edit = %+v, %v; want ratings %+v", c, err, ratings)
+	}
+	if got := eventTypes(t, events, 3); len(got) != 2 {
+		t.Fatalf("course 3 events = %v, want created and ratings only", got)
+	}
+
+	// 파일에서 같은 항목을 고치면 파일 내용을 가져온다.
+	edited := namedCourse(3, "비슬산")
+	edited.Ratings = course.CourseRatings{Tech: 1, Speed: 1, Scenery: 1, Road: 1, Access: 1}
+	writeCourses(t, first.Add(3*time.Minute), namedCourse(1, "유명산 ~ 중미산"), edited)
+	if c, err := repo.FindByID(3); err != nil || c == nil || c.Ratings != edited.Ratings {
+		t.Fatalf("FindByID(3) after rating edit = %+v, %v; want ratings %+v", c, err, edited.Ratings)
+	}
+	ratings = edited.Ratings
+
+	// 관리자가 지운 코스는 파일에 남아 있어도 다시 만들지 않는다.
+	deleted, err := course.NewEvents(namedCourse(1, "유명산 ~ 중미산"), nil, 7, "정리", time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := events.Append(deleted); err != nil {
+		t.Fatal(err)
+	}
+	writeCourses(t, first.Add(4*time.Minute), namedCourse(1, "중미산"), edited)
+	if ids := courseIDs(t, repo); !slices.Equal(ids, []int{3}) {
+		t.Fatalf("course IDs after admin delete = %v, want [3]", ids)
+	}
+	all, err := events.FindAfter(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 같은 이벤트 파일로 다시 시작하면 새 이벤트 없이 같은 상태가 된다.
+	reopened := commandRepo.NewCourseEventStore("state", discardLogger)
+	restarted := NewProjectedCourseQueryRepository(stubRegions{}, stubStyles{}, reopened, discardLogger, nil)
+	if c, err := restarted.FindByID(3); err != nil || c == nil || c.Ratings != ratings {
+		t.Fatalf("FindByID(3) after restart = %+v, %v; want ratings %+v", c, err, ratings)
+	}
+	if again, _ := reopened.FindAfter(0); len(again) != len(all) {
+		t.Fatalf("events after restart = %d, want %d", len(again), len(all))
+	}
+}
@@ -2,61 +2,179 @@ package query
 
 import (
 	"encoding/json"
+	"fmt"
+	"log/slog"
 	"os"
 	"strings"
 	"sync"
+	"time"
 
 	"slices"
 
 	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/region"
+	"github.com/sunDar0/winding-road-finder/backend/domain/style"
+	"github.com/sunDar0/winding-road-finder/backend/infrastructure/metrics"
 )
 
 // CourseQueryRepositoryImpl는 courses.json 파일을 읽어 데이터를 반환하는 구현체입니다.
+// 도구나 사람이 파일을 고치면 수정 시각이 바뀐 것을 보고 다시 읽습니다.
+// NewProjectedCourseQueryRepository로 만들면 파일 대신 코스 이벤트를 투영한 상태를 반환합니다.
 type CourseQueryRepositoryImpl struct {
-	courses []*course.CourseAggregate
-	once    sync.Once
-	loadErr error
+	regionRepo region.RegionRepository
+	styleRepo  style.StyleRepository
+	events     course.EventStore
+	logger     *slog.Logger
+	metrics    *metrics.Metrics
+	mu         sync.Mutex
+	courses    []*course.CourseAggregate
+	modTime    time.Time
+	// seq는 마지막으로 적용한 이벤트 순번, projection은 파생 정보를 붙이기 전의 투영 상태입니다.
+	seq        int
+	projection map[int]*course.CourseAggregate
+	// imported는 작업자 없는 이벤트만 적용한 상태로, 마지막으로 가져온 courses.json의 내용입니다.
+	imported map[int]*course.CourseAggregate
 }
 
-func NewCourseQueryRepository() *CourseQueryRepositoryImpl {
-	return &CourseQueryRepositoryImpl{}
+// NewCourseQueryRepository는 courses.json을 직접 읽는 저장소를 만듭니다. m이 nil이면 지표를 기록하지 않습니다.
+func NewCourseQueryRepository(regionRepo region.RegionRepository, styleRepo style.StyleRepository, logger *slog.Logger, m *metrics.Metrics) *CourseQueryRepositoryImpl {
+	return &CourseQueryRepositoryImpl{regionRepo: regionRepo, styleRepo: styleRepo, logger: logger, metrics: m}
 }
 
 func (repo *CourseQueryRepositoryImpl) loadCourses() ([]*course.CourseAggregate, error) {
-	repo.once.Do(func() {
-		file, err := os.Open("data/courses.json")
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	start, reload := time.Now(), repo.courses != nil
+	var courses []*course.CourseAggregate
+	var loaded bool
+	var err error
+	if repo.events != nil {
+		courses, loaded, err = repo.project()
+	} else {
+		courses, loaded, err = repo.readCourses()
+	}
+	// 목록을 새로 만들었거나 만들다 실패했을 때만 적재로 기록합니다.
+	if loaded || err != nil {
+		repo.metrics.ObserveLoad("courses", reload, err, time.Since(start))
+	}
+	return courses, err
+}
+
+// readCourses는 파일이 바뀌었을 때만 courses.json을 다시 읽습니다. 새로 읽었으면 true를 반환합니다.
+func (repo *CourseQueryRepositoryImpl) readCourses() ([]*course.CourseAggregate, bool, error) {
+	info, err := os.Stat(CoursesPath)
+	if err != nil {
+		return nil, false, err
+	}
+	if repo.courses != nil && info.ModTime().Equal(repo.modTime) {
+		return repo.courses, false, nil
+	}
+	courses, err := repo.readCoursesFile()
+	if err != nil {
+		return nil, false, err
+	}
+	if err := repo.decorate(courses); err != nil {
+		return nil, false, err
+	}
+	repo.courses = courses
+	repo.modTime = info.ModTime()
+	return courses, true, nil
+}
+
+// readCoursesFile은 courses.json을 읽고 스타일을 slug로 정규화합니다.
+func (repo *CourseQueryRepositoryImpl) readCoursesFile() ([]*course.CourseAggregate, error) {
+	data, err := os.ReadFile(CoursesPath)
+	if err != nil {
+		return nil, err
+	}
+	var courses []*course.CourseAggregate
+	if err := json.Unmarshal(data, &courses); err != nil {
+		return nil, err
+	}
+	if err := repo.normalizeStyles(courses); err != nil {
+		return nil, err
+	}
+	return courses, nil
+}
+
+// decorate는 지역 코드와 도로 경로 같은 파생 정보를 코스에 붙입니다.
+func (repo *CourseQueryRepositoryImpl) decorate(courses []*course.CourseAggregate) error {
+	if err := repo.assignRegions(courses); err != nil {
+		return err
+	}
+	routes, err := LoadCourseRoutes(CourseRoutesPath)
+	if err != nil {
+		return fmt.Errorf("%s: %w", CourseRoutesPath, err)
+	}
+	for _, c := range courses {
+		c.Route = routes[c.ID]
+	}
+	return nil
+}
+
+// normalizeStyles는 코스 스타일을 분류 체계의 slug로 정규화하고, 알 수 없는 스타일이 있으면 에러를 반환합니다.
+func (repo *CourseQueryRepositoryImpl) normalizeStyles(courses []*course.CourseAggregate) error {
+	styles, err := repo.styleRepo.FindAll()
+	if err != nil {
+		return err
+	}
+	taxonomy := style.NewTaxonomy(styles)
+	for _, c := range courses {
+		slugs, err := taxonomy.Normalize(c.Styles)
 		if err != nil {
-			repo.loadErr = err
-			return
+			return fmt.Errorf("코스 %d: %w", c.ID, err)
 		}
-		defer file.Close()
-		var courses []*course.CourseAggregate
-		if err := json.NewDecoder(file).Decode(&courses); err != nil {
-			repo.loadErr = err
-			return
+		c.Styles = slugs
+	}
+	return nil
+}
+
+// assignRegions는 출발지 좌표로 각 코스의 지역 코드를 배정합니다.
+func (repo *CourseQueryRepositoryImpl) assignRegions(courses []*course.CourseAggregate) error {
+	regions, err := repo.regionRepo.FindAll()
+	if err != nil {
+		return err
+	}
+	dir := region.NewDirectory(regions)
+	var mismatched []int
+	for _, c := range courses {
+		start, _ := c.StartPoint()
+		a := dir.Assign(c.Region, region.Point{Latitude: start.Latitude, Longitude: start.Longitude})
+		c.RegionCode = a.SidoCode
+		c.SubRegionCode = a.SigunguCode
+		if a.Mismatch {
+			mismatched = append(mismatched, c.ID)
 		}
-		repo.courses = courses
-	})
-	return repo.courses, repo.loadErr
+	}
+	if len(mismatched) > 0 {
+		repo.logger.Warn("출발지 좌표가 지정된 지역과 일치하지 않는 코스가 있습니다", "count", len(mismatched), "course_ids", mismatched)
+	}
+	return nil
 }
 
-func (repo *CourseQueryRepositoryImpl) FindAll(region, style, search string) ([]*course.CourseAggregate, error) {
+func (repo *CourseQueryRepositoryImpl) FindAll(filter course.CourseFilter) ([]*course.CourseAggregate, error) {
 	courses, err := repo.loadCourses()
 	if err != nil {
 		return nil, err
 	}
 	var result []*course.CourseAggregate
 	for _, c := range courses {
-		if region != "" && region != "all" && c.Region != region {
+		if !matchesRegion(c, filter.Region) {
 			continue
 		}
-		if style != "" && style != "all" {
-			found := slices.Contains(c.Styles, style)
+		if filter.Style != "" && filter.Style != "all" {
+			found := slices.Contains(c.Styles, filter.Style)
 			if !found {
 				continue
 			}
 		}
-		if search != "" && !strings.Contains(strings.ToLower(c.Name), strings.ToLower(search)) && !strings.Contains(strings.ToLower(c.Tagline), strings.ToLower(search)) && !strings.Contains(strings.ToLower(c.Characteristics), strings.ToLower(search)) && !strings.Contains(strings.ToLower(c.Region), strings.ToLower(search)) {
+		if filter.Search != "" && !matchesSearch(c, filter.Search, filter.SearchStyles) {
+			continue
+		}
+		if filter.Date != nil && !c.Availability.OpenOn(*filter.Date) {
+			continue
+		}
+		if filter.Month != 0 && !c.Availability.SuitableInMonth(filter.Month) {
 			continue
 		}
 		result = append(result, c)
@@ -64,6 +182,23 @@ func (repo *CourseQueryRepositoryImpl) FindAll(region, style, search string) ([]
 	return result, nil
 }
 
+func matchesRegion(c *course.CourseAggregate, region string) bool {
+	return region == "" || region == "all" || c.Region == region || c.RegionCode == region || c.SubRegionCode == region
+}
+
+// matchesSearch는 검색어가 코스 텍스트(모든 언어)에 포함되거나 검색어에 해당하는 스타일을 코스가 가지는지 확인합니다.
+func matchesSearch(c *course.CourseAggregate, search string, searchStyles []string) bool {
+	if c.Name.Contains(search) || c.Tagline.Contains(search) || c.Characteristics.Contains(search) || strings.Contains(strings.ToLower(c.Region), strings.ToLower(search)) {
+		return true
+	}
+	for _, s := range searchStyles {
+		if slices.Contains(c.Styles, s) {
+			return true
+		}
+	}
+	return false
+}
+
 func (repo *CourseQueryRepositoryImpl) FindByID(id int) (*course.CourseAggregate, error) {
 	courses, err := repo.loadCourses()
 	if err != nil {
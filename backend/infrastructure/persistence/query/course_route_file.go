@@ -0,0 +1,109 @@
+package query
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+)
+
+// CourseRoutesPath는 도로에 맞춘 코스 경로 파일입니다. cmd/snaproutes로 만듭니다.
+const CourseRoutesPath = "data/course_routes.json"
+
+type courseRouteRecord struct {
+	DistanceKm      float64            `json:"distanceKm"`
+	DurationSeconds int                `json:"durationSeconds"`
+	Geometry        [][2]float64       `json:"geometry,omitempty"` // [위도, 경도]
+	Issues          []courseRouteIssue `json:"issues,omitempty"`
+	ComputedAt      time.Time          `json:"computedAt"`
+}
+
+type courseRouteIssue struct {
+	Kind course.RouteIssueKind `json:"kind"`
+	Nav  int                   `json:"nav"`
+}
+
+// LoadCourseRoutes는 코스 ID별 경로를 읽습니다. 파일이 없으면 빈 결과를 반환합니다.
+func LoadCourseRoutes(path string) (map[int]*course.CourseRoute, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return map[int]*course.CourseRoute{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var records map[string]courseRouteRecord
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, err
+	}
+	routes := make(map[int]*course.CourseRoute, len(records))
+	for key, rec := range records {
+		id, err := strconv.Atoi(key)
+		if err != nil {
+			return nil, err
+		}
+		route := &course.CourseRoute{
+			DistanceKm: rec.DistanceKm,
+			Duration:   time.Duration(rec.DurationSeconds) * time.Second,
+			ComputedAt: rec.ComputedAt,
+			Geometry:   make([]course.CourseGeolocation, len(rec.Geometry)),
+		}
+		for i, p := range rec.Geometry {
+			route.Geometry[i] = course.CourseGeolocation{Latitude: p[0], Longitude: p[1]}
+		}
+		for _, issue := range rec.Issues {
+			route.Issues = append(route.Issues, course.RouteIssue{Kind: issue.Kind, Nav: issue.Nav})
+		}
+		routes[id] = route
+	}
+	return routes, nil
+}
+
+// SaveCourseRoutes는 코스 ID별 경로를 파일에 씁니다. 좌표는 소수점 5자리(약 1m)로 반올림하며,
+// 변경 내역을 비교하기 쉽도록 코스 하나를 한 줄에 ID 순으로 씁니다.
+func SaveCourseRoutes(path string, routes map[int]*course.CourseRoute) error {
+	ids := make([]int, 0, len(routes))
+	for id := range routes {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	var buf bytes.Buffer
+	buf.WriteString("{\n")
+	for i, id := range ids {
+		route := routes[id]
+		rec := courseRouteRecord{
+			DistanceKm:      math.Round(route.DistanceKm*100) / 100,
+			DurationSeconds: int(route.Duration / time.Second),
+			ComputedAt:      route.ComputedAt,
+			Geometry:        make([][2]float64, len(route.Geometry)),
+		}
+		for i, p := range route.Geometry {
+			rec.Geometry[i] = [2]float64{round5(p.Latitude), round5(p.Longitude)}
+		}
+		for _, issue := range route.Issues {
+			rec.Issues = append(rec.Issues, courseRouteIssue{Kind: issue.Kind, Nav: issue.Nav})
+		}
+		data, err := json.Marshal(rec)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(&buf, "  %q: %s", strconv.Itoa(id), data)
+		if i < len(ids)-1 {
+			buf.WriteByte(',')
+		}
+		buf.WriteByte('\n')
+	}
+	buf.WriteString("}\n")
+	return os.WriteFile(path, buf.Bytes(), 0o644)
+}
+
+func round5(v float64) float64 {
+	return math.Round(v*1e5) / 1e5
+}
@@ -0,0 +1,73 @@
+package query
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/routing"
+	routingInfra "github.com/sunDar0/winding-road-finder/backend/infrastructure/external/routing"
+)
+
+// 도로에 맞춘 경로를 저장했다 읽으면 좌표는 소수점 5자리로 반올림되고 나머지는 그대로다.
+func TestCourseRoutesRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "course_routes.json")
+	routes, err := LoadCourseRoutes(path)
+	if err != nil || len(routes) != 0 {
+		t.Fatalf("LoadCourseRoutes(없는 파일) = %v, %v; want empty", routes, err)
+	}
+
+	computedAt := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	router := routingInfra.NewFakeRouter()
+	router.Unreachable = []course.CourseGeolocation{{Latitude: 35.1, Longitude: 129.1}}
+	snapped := &course.CourseAggregate{ID: 7, Nav: []course.CourseNav{
+		{Geolocation: course.CourseGeolocation{Latitude: 37.123456789, Longitude: 127.000004}},
+		{Geolocation: course.CourseGeolocation{Latitude: 37.2, Longitude: 127.1}},
+		{Geolocation: course.CourseGeolocation{Latitude: 37.25, Longitude: 127.2}},
+	}}
+	unreachable := &course.CourseAggregate{ID: 3, Nav: []course.CourseNav{
+		{Geolocation: course.CourseGeolocation{Latitude: 35.0, Longitude: 129.0}},
+		{Geolocation: router.Unreachable[0]},
+	}}
+	for _, c := range []*course.CourseAggregate{snapped, unreachable} {
+		route, err := routing.SnapCourse(context.Background(), router, c, computedAt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		routes[c.ID] = route
+	}
+	if err := SaveCourseRoutes(path, routes); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := LoadCourseRoutes(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(loaded) != 2 {
+		t.Fatalf("len(loaded) = %d, want 2", len(loaded))
+	}
+	got, want := loaded[7], routes[7]
+	wantGeometry := []course.CourseGeolocation{
+		{Latitude: 37.12346, Longitude: 127},
+		{Latitude: 37.2, Longitude: 127.1},
+		{Latitude: 37.25, Longitude: 127.2},
+	}
+	if !reflect.DeepEqual(got.Geometry, wantGeometry) {
+		t.Fatalf("Geometry = %v, want %v", got.Geometry, wantGeometry)
+	}
+	if got.Duration != want.Duration.Truncate(time.Second) || !got.ComputedAt.Equal(computedAt) || len(got.Issues) != 0 {
+		t.Fatalf("route 7 = %+v, want duration %v, computedAt %v", got, want.Duration.Truncate(time.Second), computedAt)
+	}
+	if d := got.DistanceKm - want.DistanceKm; d < -0.005 || d > 0.005 {
+		t.Fatalf("DistanceKm = %v, want %v rounded to 0.01", got.DistanceKm, want.DistanceKm)
+	}
+
+	// 갈 수 없는 코스는 문제 목록만 남고 경로 좌표는 없다.
+	if got := loaded[3]; got.Reachable() || !reflect.DeepEqual(got.Issues, []course.RouteIssue{{Kind: course.RouteIssueUnreachable, Nav: 1}}) {
+		t.Fatalf("route 3 = %+v, want unreachable nav 1", got)
+	}
+}
@@ -7,6 +7,9 @@ import (
 	"github.com/sunDar0/winding-road-finder/backend/domain/recommendation"
 )
 
+// RecommendationsPath는 추천 데이터 파일입니다.
+const RecommendationsPath = "data/recommendations.json"
+
 // RecommendationQueryRepositoryImpl는 recommendations.json 파일을 읽어 추천 목록을 반환합니다.
 type RecommendationQueryRepositoryImpl struct{}
 
@@ -15,7 +18,7 @@ func NewRecommendationQueryRepository() *RecommendationQueryRepositoryImpl {
 }
 
 func (repo *RecommendationQueryRepositoryImpl) FindAll() ([]*recommendation.Recommendation, error) {
-	file, err := os.Open("data/recommendations.json")
+	file, err := os.Open(RecommendationsPath)
 	if err != nil {
 		return nil, err
 	}
@@ -28,7 +31,7 @@ func (repo *RecommendationQueryRepositoryImpl) FindAll() ([]*recommendation.Reco
 }
 
 func (repo *RecommendationQueryRepositoryImpl) FindById(id int) (*recommendation.Recommendation, error) {
-	file, err := os.Open("data/recommendations.json")
+	file, err := os.Open(RecommendationsPath)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,128 @@
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+	"github.com/sunDar0/winding-road-finder/backend/domain/region"
+)
+
+// geoJSONFeatureCollection은 regions.geojson 파일 구조입니다.
+type geoJSONFeatureCollection struct {
+	Features []geoJSONFeature `json:"features"`
+}
+
+type geoJSONFeature struct {
+	Properties struct {
+		Code       string             `json:"code"`
+		Name       i18n.LocalizedText `json:"name"`
+		Level      string             `json:"level"`
+		ParentCode string             `json:"parentCode"`
+		Aliases    []string           `json:"aliases"`
+	} `json:"properties"`
+	Geometry struct {
+		Type        string          `json:"type"`
+		Coordinates json.RawMessage `json:"coordinates"`
+	} `json:"geometry"`
+}
+
+// RegionQueryRepositoryImpl는 regions.geojson 파일을 읽어 지역 데이터를 반환하는 구현체입니다.
+type RegionQueryRepositoryImpl struct {
+	regions []*region.Region
+	once    sync.Once
+	loadErr error
+}
+
+func NewRegionQueryRepository() *RegionQueryRepositoryImpl {
+	return &RegionQueryRepositoryImpl{}
+}
+
+func (repo *RegionQueryRepositoryImpl) loadRegions() ([]*region.Region, error) {
+	repo.once.Do(func() {
+		file, err := os.Open("data/regions.geojson")
+		if err != nil {
+			repo.loadErr = err
+			return
+		}
+		defer file.Close()
+		var fc geoJSONFeatureCollection
+		if err := json.NewDecoder(file).Decode(&fc); err != nil {
+			repo.loadErr = err
+			return
+		}
+		regions := make([]*region.Region, 0, len(fc.Features))
+		for _, f := range fc.Features {
+			boundary, err := decodeGeometry(f.Geometry.Type, f.Geometry.Coordinates)
+			if err != nil {
+				repo.loadErr = fmt.Errorf("지역 %s 경계 파싱 실패: %v", f.Properties.Code, err)
+				return
+			}
+			regions = append(regions, &region.Region{
+				Code:       f.Properties.Code,
+				Name:       f.Properties.Name,
+				Level:      region.Level(f.Properties.Level),
+				ParentCode: f.Properties.ParentCode,
+				Aliases:    f.Properties.Aliases,
+				Boundary:   boundary,
+			})
+		}
+		repo.regions = regions
+	})
+	return repo.regions, repo.loadErr
+}
+
+// decodeGeometry는 GeoJSON Polygon/MultiPolygon 좌표를 도메인 경계로 변환합니다.
+func decodeGeometry(geomType string, raw json.RawMessage) (region.Boundary, error) {
+	switch geomType {
+	case "Polygon":
+		var coords [][][2]float64
+		if err := json.Unmarshal(raw, &coords); err != nil {
+			return nil, err
+		}
+		return region.Boundary{toPolygon(coords)}, nil
+	case "MultiPolygon":
+		var coords [][][][2]float64
+		if err := json.Unmarshal(raw, &coords); err != nil {
+			return nil, err
+		}
+		boundary := make(region.Boundary, len(coords))
+		for i, poly := range coords {
+			boundary[i] = toPolygon(poly)
+		}
+		return boundary, nil
+	default:
+		return nil, fmt.Errorf("지원하지 않는 geometry 타입: %s", geomType)
+	}
+}
+
+// toPolygon은 GeoJSON의 [경도, 위도] 좌표 배열을 다각형으로 변환합니다.
+func toPolygon(coords [][][2]float64) region.Polygon {
+	poly := make(region.Polygon, len(coords))
+	for i, ring := range coords {
+		poly[i] = make(region.Ring, len(ring))
+		for j, pt := range ring {
+			poly[i][j] = region.Point{Longitude: pt[0], Latitude: pt[1]}
+		}
+	}
+	return poly
+}
+
+func (repo *RegionQueryRepositoryImpl) FindAll() ([]*region.Region, error) {
+	return repo.loadRegions()
+}
+
+func (repo *RegionQueryRepositoryImpl) FindByCode(code string) (*region.Region, error) {
+	regions, err := repo.loadRegions()
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range regions {
+		if r.Code == code {
+			return r, nil
+		}
+	}
+	return nil, nil
+}
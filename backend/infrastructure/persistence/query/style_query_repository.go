@@ -0,0 +1,55 @@
+package query
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/style"
+)
+
+// StyleQueryRepositoryImpl는 styles.json 파일을 읽어 스타일 분류 체계를 반환하는 구현체입니다.
+type StyleQueryRepositoryImpl struct {
+	styles  []*style.Style
+	once    sync.Once
+	loadErr error
+}
+
+func NewStyleQueryRepository() *StyleQueryRepositoryImpl {
+	return &StyleQueryRepositoryImpl{}
+}
+
+func (repo *StyleQueryRepositoryImpl) loadStyles() ([]*style.Style, error) {
+	repo.once.Do(func() {
+		file, err := os.Open("data/styles.json")
+		if err != nil {
+			repo.loadErr = err
+			return
+		}
+		defer file.Close()
+		var styles []*style.Style
+		if err := json.NewDecoder(file).Decode(&styles); err != nil {
+			repo.loadErr = err
+			return
+		}
+		repo.styles = styles
+	})
+	return repo.styles, repo.loadErr
+}
+
+func (repo *StyleQueryRepositoryImpl) FindAll() ([]*style.Style, error) {
+	return repo.loadStyles()
+}
+
+func (repo *StyleQueryRepositoryImpl) FindBySlug(slug string) (*style.Style, error) {
+	styles, err := repo.loadStyles()
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range styles {
+		if s.Slug == slug {
+			return s, nil
+		}
+	}
+	return nil, nil
+}
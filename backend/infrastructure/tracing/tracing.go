@@ -0,0 +1,77 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/sunDar0/winding-road-finder/backend"
+
+// Config는 트레이스 내보내기 설정입니다.
+type Config struct {
+	// Enabled가 false면 스팬을 만들지 않습니다(no-op). 들어온 traceparent 헤더는 그대로 전달합니다.
+	Enabled     bool
+	ServiceName string
+	// SampleRatio는 상위 스팬이 없는 요청을 기록할 비율(0~1)입니다. 상위 스팬이 있으면 그 결정을 따릅니다.
+	SampleRatio float64
+}
+
+// Setup은 OTLP/HTTP로 스팬을 내보내는 전역 TracerProvider와 W3C Trace Context 전파기를 설정합니다.
+// 수집기 주소와 헤더는 OTEL_EXPORTER_OTLP_ENDPOINT 등 OpenTelemetry 표준 환경변수로 정합니다.
+// 반환한 함수는 종료할 때 남은 스팬을 내보냅니다.
+func Setup(ctx context.Context, cfg Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	if !cfg.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, err := resource.New(ctx,
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+		resource.WithAttributes(attribute.String("service.name", cfg.ServiceName)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// Start는 ctx의 스팬 아래에 name 스팬을 시작합니다. 이름은 "CourseQueryService.GetCourses"처럼 타입.메서드 형식을 씁니다.
+// Setup에서 트레이스를 켜지 않았으면 아무것도 기록하지 않는 스팬을 반환합니다.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End는 err가 있으면 스팬에 에러로 기록한 뒤 스팬을 끝냅니다.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Transport는 외부 API 요청마다 클라이언트 스팬을 만들고 traceparent 헤더를 붙이는 RoundTripper입니다.
+// base가 nil이면 http.DefaultTransport를 씁니다.
+func Transport(base http.RoundTripper) http.RoundTripper {
+	return otelhttp.NewTransport(base)
+}
@@ -0,0 +1,443 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	appCommand "github.com/sunDar0/winding-road-finder/backend/application/command"
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/hazard"
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+	"github.com/sunDar0/winding-road-finder/backend/domain/recommendation"
+	"github.com/sunDar0/winding-road-finder/backend/domain/review"
+	"github.com/sunDar0/winding-road-finder/backend/domain/style"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+	"github.com/sunDar0/winding-road-finder/backend/interfaces/messages"
+	"github.com/sunDar0/winding-road-finder/backend/middlewares"
+	"github.com/sunDar0/winding-road-finder/backend/models"
+)
+
+// AdminCommandController는 큐레이터의 코스·추천 수정과 리뷰·위험 신고 관리 요청을 처리합니다.
+type AdminCommandController struct {
+	service *appCommand.AdminCommandService
+}
+
+func NewAdminCommandController(service *appCommand.AdminCommandService) *AdminCommandController {
+	return &AdminCommandController{service: service}
+}
+
+// RegisterRoutes는 Gin 라우터에 엔드포인트를 등록합니다. /admin 아래 API는 editor 이상 권한이 필요합니다.
+func (ctrl *AdminCommandController) RegisterRoutes(rg *gin.RouterGroup) {
+	admin := rg.Group("/admin", middlewares.RequireRole(user.RoleEditor))
+	admin.POST("/courses", ctrl.CreateCourse)
+	admin.PUT("/courses/:id", ctrl.UpdateCourse)
+	admin.DELETE("/courses/:id", ctrl.DeleteCourse)
+	admin.POST("/courses/:id/images", ctrl.RenderCourseImages)
+	admin.POST("/recommendations", ctrl.CreateRecommendation)
+	admin.PUT("/recommendations/:id", ctrl.UpdateRecommendation)
+	admin.DELETE("/recommendations/:id", ctrl.DeleteRecommendation)
+	admin.PUT("/recommendations/:id/courses", ctrl.ReorderRecommendation)
+	admin.PATCH("/hazards/:id", ctrl.ModerateHazard)
+	admin.PATCH("/reviews/:id", ctrl.ModerateReview)
+}
+
+// @Summary 코스 추가 (관리자)
+// @Description 코스를 데이터 파일에 추가합니다. 스타일은 slug로 정규화해 저장합니다.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body models.AdminCourseRequest true "코스 정보"
+// @Success 201 {object} models.CreatedResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /admin/courses [post]
+func (ctrl *AdminCommandController) CreateCourse(c *gin.Context) {
+	agg, reason, ok := bindCourse(c)
+	if !ok {
+		return
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	if err := ctrl.service.CreateCourse(principal, agg, reason); err != nil {
+		respondAdminError(c, err)
+		return
+	}
+	c.Header("Location", fmt.Sprintf("/api/courses/%d", agg.ID))
+	c.JSON(http.StatusCreated, models.CreatedResponse{ID: agg.ID})
+}
+
+// @Summary 코스 수정 (관리자)
+// @Description 코스 전체를 요청 내용으로 바꿉니다.
+// @Tags admin
+// @Accept json
+// @Security BearerAuth
+// @Param id path int true "코스 ID"
+// @Param request body models.AdminCourseRequest true "코스 정보"
+// @Success 204
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /admin/courses/{id} [put]
+func (ctrl *AdminCommandController) UpdateCourse(c *gin.Context) {
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	agg, reason, ok := bindCourse(c)
+	if !ok {
+		return
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	respondAdminResult(c, ctrl.service.UpdateCourse(principal, id, agg, reason))
+}
+
+// @Summary 코스 삭제 (관리자)
+// @Description 추천이 가리키는 코스는 삭제할 수 없습니다. (409)
+// @Tags admin
+// @Security BearerAuth
+// @Param id path int true "코스 ID"
+// @Param reason query string false "삭제 사유 (코스 변경 이력에 기록)"
+// @Success 204
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 409 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /admin/courses/{id} [delete]
+func (ctrl *AdminCommandController) DeleteCourse(c *gin.Context) {
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	respondAdminResult(c, ctrl.service.DeleteCourse(principal, id, c.Query("reason")))
+}
+
+// @Summary 코스 지도 이미지 다시 생성 (관리자)
+// @Description 네이버 지도 API로 썸네일·상세 이미지를 다시 만듭니다. 네이버 API 설정이 없으면 503입니다.
+// @Tags admin
+// @Security BearerAuth
+// @Param id path int true "코스 ID"
+// @Success 204
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 502 {object} models.ErrorResponse
+// @Failure 503 {object} models.ErrorResponse
+// @Router /admin/courses/{id}/images [post]
+func (ctrl *AdminCommandController) RenderCourseImages(c *gin.Context) {
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	respondAdminResult(c, ctrl.service.RenderCourseImages(c.Request.Context(), principal, id))
+}
+
+// @Summary 추천 추가 (관리자)
+// @Description rule이 있으면 규칙 추천, 없으면 courseIds 순서대로 보여주는 추천을 만듭니다.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body models.AdminRecommendationRequest true "추천 정보"
+// @Success 201 {object} models.CreatedResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /admin/recommendations [post]
+func (ctrl *AdminCommandController) CreateRecommendation(c *gin.Context) {
+	var req models.AdminRecommendationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	rec := toRecommendation(req)
+	principal, _ := middlewares.PrincipalFrom(c)
+	if err := ctrl.service.CreateRecommendation(principal, rec); err != nil {
+		respondAdminError(c, err)
+		return
+	}
+	c.Header("Location", fmt.Sprintf("/api/recommendations/%d", rec.ID))
+	c.JSON(http.StatusCreated, models.CreatedResponse{ID: rec.ID})
+}
+
+// @Summary 추천 수정 (관리자)
+// @Description 추천 전체를 요청 내용으로 바꿉니다.
+// @Tags admin
+// @Accept json
+// @Security BearerAuth
+// @Param id path int true "추천 ID"
+// @Param request body models.AdminRecommendationRequest true "추천 정보"
+// @Success 204
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /admin/recommendations/{id} [put]
+func (ctrl *AdminCommandController) UpdateRecommendation(c *gin.Context) {
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	var req models.AdminRecommendationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	respondAdminResult(c, ctrl.service.UpdateRecommendation(principal, id, toRecommendation(req)))
+}
+
+// @Summary 추천 삭제 (관리자)
+// @Tags admin
+// @Security BearerAuth
+// @Param id path int true "추천 ID"
+// @Success 204
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /admin/recommendations/{id} [delete]
+func (ctrl *AdminCommandController) DeleteRecommendation(c *gin.Context) {
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	respondAdminResult(c, ctrl.service.DeleteRecommendation(principal, id))
+}
+
+// @Summary 추천 코스 순서 변경 (관리자)
+// @Description 드래그로 바꾼 순서를 저장합니다. 현재 코스 ID를 빠짐없이 원하는 순서로 보내야 하며, 규칙 추천은 고정 코스 순서를 바꿉니다.
+// @Tags admin
+// @Accept json
+// @Security BearerAuth
+// @Param id path int true "추천 ID"
+// @Param request body models.ReorderRecommendationRequest true "코스 순서"
+// @Success 204
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /admin/recommendations/{id}/courses [put]
+func (ctrl *AdminCommandController) ReorderRecommendation(c *gin.Context) {
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	var req models.ReorderRecommendationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	respondAdminResult(c, ctrl.service.ReorderRecommendation(principal, id, req.CourseIDs))
+}
+
+// @Summary 위험 신고 숨김/복구 (관리자)
+// @Description 숨긴 신고는 코스 응답과 신고 목록에서 빠집니다.
+// @Tags admin
+// @Accept json
+// @Security BearerAuth
+// @Param id path int true "신고 ID"
+// @Param request body models.ModerationRequest true "숨김 여부"
+// @Success 204
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /admin/hazards/{id} [patch]
+func (ctrl *AdminCommandController) ModerateHazard(c *gin.Context) {
+	id, req, ok := bindModeration(c)
+	if !ok {
+		return
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	respondAdminResult(c, ctrl.service.SetHazardHidden(principal, id, *req.Hidden))
+}
+
+// @Summary 리뷰 숨김/복구 (관리자)
+// @Description 숨긴 리뷰는 리뷰 목록과 사용자 평점 집계에서 빠집니다.
+// @Tags admin
+// @Accept json
+// @Security BearerAuth
+// @Param id path int true "리뷰 ID"
+// @Param request body models.ModerationRequest true "숨김 여부"
+// @Success 204
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /admin/reviews/{id} [patch]
+func (ctrl *AdminCommandController) ModerateReview(c *gin.Context) {
+	id, req, ok := bindModeration(c)
+	if !ok {
+		return
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	respondAdminResult(c, ctrl.service.SetReviewHidden(principal, id, *req.Hidden))
+}
+
+func bindModeration(c *gin.Context) (int, models.ModerationRequest, bool) {
+	var req models.ModerationRequest
+	id, ok := pathID(c, "id")
+	if !ok {
+		return 0, req, false
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return 0, req, false
+	}
+	return id, req, true
+}
+
+// bindCourse는 요청 본문을 코스와 변경 사유로 변환합니다. 날짜·시각 형식이 틀리면 400으로 응답합니다.
+func bindCourse(c *gin.Context) (*course.CourseAggregate, string, bool) {
+	var req models.AdminCourseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return nil, "", false
+	}
+	agg, err := toCourse(req)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidCourse, err)
+		return nil, "", false
+	}
+	return agg, req.Reason, true
+}
+
+// toCourse는 코스 요청을 도메인 모델로 바꿉니다.
+func toCourse(req models.AdminCourseRequest) (*course.CourseAggregate, error) {
+	agg := &course.CourseAggregate{
+		Name:            toLocalizedText(req.Name),
+		Region:          req.Region,
+		Tagline:         toLocalizedText(req.Tagline),
+		Characteristics: toLocalizedText(req.Characteristics),
+		NaverMapUrl:     req.NaverMapUrl,
+		Nav:             make([]course.CourseNav, len(req.Nav)),
+		Notes:           toLocalizedText(req.Notes),
+		Styles:          req.Styles,
+		Ratings:         course.CourseRatings(req.Ratings),
+	}
+	for i, n := range req.Nav {
+		agg.Nav[i] = course.CourseNav{
+			Type:        n.Type,
+			Name:        toLocalizedText(n.Name),
+			Geolocation: course.CourseGeolocation{Latitude: n.Geolocation.Latitude, Longitude: n.Geolocation.Longitude},
+			Summit:      n.Summit,
+		}
+	}
+	if a := req.Availability; a != nil {
+		var err error
+		if agg.Availability, err = toAvailability(*a); err != nil {
+			return nil, err
+		}
+	}
+	return agg, nil
+}
+
+func toAvailability(a models.AdminAvailabilityDto) (course.Availability, error) {
+	var result course.Availability
+	for _, p := range a.Closures {
+		from, err := course.ParseMonthDay(p.From)
+		if err != nil {
+			return result, err
+		}
+		to, err := course.ParseMonthDay(p.To)
+		if err != nil {
+			return result, err
+		}
+		result.Closures = append(result.Closures, course.SeasonalClosure{From: from, To: to, Reason: toLocalizedText(p.Reason)})
+	}
+	for _, m := range a.RecommendedMonths {
+		result.RecommendedMonths = append(result.RecommendedMonths, time.Month(m))
+	}
+	for _, p := range a.Restrictions {
+		from, err := course.ParseClockTime(p.From)
+		if err != nil {
+			return result, err
+		}
+		to, err := course.ParseClockTime(p.To)
+		if err != nil {
+			return result, err
+		}
+		result.Restrictions = append(result.Restrictions, course.TimeWindow{From: from, To: to, Reason: toLocalizedText(p.Reason)})
+	}
+	return result, nil
+}
+
+func toRecommendation(req models.AdminRecommendationRequest) *recommendation.Recommendation {
+	return &recommendation.Recommendation{
+		Title:       toLocalizedText(req.Title),
+		Description: toLocalizedText(req.Description),
+		CourseIds:   req.CourseIds,
+		Rule:        req.Rule,
+		PinnedIds:   req.PinnedIds,
+		ExcludedIds: req.ExcludedIds,
+	}
+}
+
+// toLocalizedText는 지원하지 않는 언어 코드와 빈 문자열을 버립니다.
+func toLocalizedText(t models.LocalizedTextDto) i18n.LocalizedText {
+	text := i18n.LocalizedText{}
+	for _, lang := range i18n.SupportedLangs {
+		if v := t[string(lang)]; v != "" {
+			text[lang] = v
+		}
+	}
+	return text
+}
+
+func respondAdminResult(c *gin.Context, err error) {
+	if err != nil {
+		respondAdminError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func respondAdminError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, course.ErrCourseNotFound), errors.Is(err, recommendation.ErrUnknownCourse):
+		respondError(c, http.StatusNotFound, messages.CourseNotFound, nil)
+	case errors.Is(err, course.ErrInvalidCourse), errors.Is(err, course.ErrInvalidRating):
+		respondError(c, http.StatusBadRequest, messages.InvalidCourse, err)
+	case errors.Is(err, style.ErrUnknownStyle):
+		respondError(c, http.StatusBadRequest, messages.UnknownStyle, err)
+	case errors.Is(err, course.ErrCourseInUse):
+		respondError(c, http.StatusConflict, messages.CourseInUse, err)
+	case errors.Is(err, course.ErrImagesUnavailable):
+		respondError(c, http.StatusServiceUnavailable, messages.ImagesUnavailable, nil)
+	case errors.Is(err, course.ErrImageGeneration):
+		respondError(c, http.StatusBadGateway, messages.ImageGenerationFailed, err)
+	case errors.Is(err, recommendation.ErrRecommendationNotFound):
+		respondError(c, http.StatusNotFound, messages.RecommendationNotFound, nil)
+	case errors.Is(err, recommendation.ErrInvalidRecommendation), errors.Is(err, recommendation.ErrInvalidRule):
+		respondError(c, http.StatusBadRequest, messages.InvalidRecommendation, err)
+	case errors.Is(err, recommendation.ErrInvalidOrder):
+		respondError(c, http.StatusBadRequest, messages.InvalidCourseOrder, nil)
+	case errors.Is(err, hazard.ErrHazardNotFound):
+		respondError(c, http.StatusNotFound, messages.HazardNotFound, nil)
+	case errors.Is(err, review.ErrReviewNotFound):
+		respondError(c, http.StatusNotFound, messages.ReviewNotFound, nil)
+	default:
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+	}
+}
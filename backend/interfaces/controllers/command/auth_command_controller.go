@@ -0,0 +1,139 @@
+package command
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	appCommand "github.com/sunDar0/winding-road-finder/backend/application/command"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+	"github.com/sunDar0/winding-road-finder/backend/interfaces/messages"
+	"github.com/sunDar0/winding-road-finder/backend/middlewares"
+	"github.com/sunDar0/winding-road-finder/backend/models"
+)
+
+// respondError는 요청 언어로 번역한 에러 응답을 반환합니다.
+// 서버 에러(5xx)의 원인은 접근 로그에 남도록 요청 에러로도 기록합니다.
+func respondError(c *gin.Context, status int, key string, err error) {
+	if status >= http.StatusInternalServerError && err != nil {
+		_ = c.Error(err)
+	}
+	c.JSON(status, messages.ErrorResponse(middlewares.LangFrom(c), key, err))
+}
+
+// AuthCommandController는 회원가입, 로그인, 토큰 재발급 요청을 처리합니다.
+type AuthCommandController struct {
+	service *appCommand.AuthCommandService
+}
+
+func NewAuthCommandController(service *appCommand.AuthCommandService) *AuthCommandController {
+	return &AuthCommandController{service: service}
+}
+
+// RegisterRoutes는 Gin 라우터에 엔드포인트를 등록합니다.
+func (ctrl *AuthCommandController) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.POST("/auth/signup", ctrl.Signup)
+	rg.POST("/auth/login", ctrl.Login)
+	rg.POST("/auth/refresh", ctrl.Refresh)
+}
+
+// @Summary 회원가입
+// @Description 이메일과 비밀번호로 viewer 권한 계정을 만들고 토큰을 발급합니다.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param lang query string false "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름"
+// @Param request body models.SignupRequest true "가입 정보"
+// @Success 201 {object} models.TokenResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 409 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /auth/signup [post]
+func (ctrl *AuthCommandController) Signup(c *gin.Context) {
+	var req models.SignupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	_, tokens, err := ctrl.service.Signup(req.Email, req.DisplayName, req.Password)
+	switch {
+	case errors.Is(err, user.ErrInvalidEmail):
+		respondError(c, http.StatusBadRequest, messages.InvalidEmail, nil)
+	case errors.Is(err, user.ErrWeakPassword):
+		respondError(c, http.StatusBadRequest, messages.WeakPassword, nil)
+	case errors.Is(err, user.ErrEmailTaken):
+		respondError(c, http.StatusConflict, messages.EmailTaken, nil)
+	case err != nil:
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+	default:
+		c.JSON(http.StatusCreated, toTokenResponse(tokens))
+	}
+}
+
+// @Summary 로그인
+// @Description 이메일과 비밀번호를 확인하고 액세스/리프레시 토큰을 발급합니다.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param lang query string false "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름"
+// @Param request body models.LoginRequest true "로그인 정보"
+// @Success 200 {object} models.TokenResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /auth/login [post]
+func (ctrl *AuthCommandController) Login(c *gin.Context) {
+	var req models.LoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	_, tokens, err := ctrl.service.Login(req.Email, req.Password)
+	switch {
+	case errors.Is(err, user.ErrInvalidCredentials):
+		respondError(c, http.StatusUnauthorized, messages.InvalidCredentials, nil)
+	case err != nil:
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+	default:
+		c.JSON(http.StatusOK, toTokenResponse(tokens))
+	}
+}
+
+// @Summary 토큰 재발급
+// @Description 리프레시 토큰으로 새 액세스/리프레시 토큰을 발급합니다. 변경된 권한이 새 토큰에 반영됩니다.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param lang query string false "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름"
+// @Param request body models.RefreshRequest true "리프레시 토큰"
+// @Success 200 {object} models.TokenResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /auth/refresh [post]
+func (ctrl *AuthCommandController) Refresh(c *gin.Context) {
+	var req models.RefreshRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	_, tokens, err := ctrl.service.Refresh(req.RefreshToken)
+	switch {
+	case errors.Is(err, user.ErrInvalidToken):
+		respondError(c, http.StatusUnauthorized, messages.InvalidToken, nil)
+	case err != nil:
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+	default:
+		c.JSON(http.StatusOK, toTokenResponse(tokens))
+	}
+}
+
+func toTokenResponse(tokens user.TokenPair) models.TokenResponse {
+	return models.TokenResponse{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(time.Until(tokens.ExpiresAt).Round(time.Second).Seconds()),
+	}
+}
@@ -0,0 +1,223 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	appCommand "github.com/sunDar0/winding-road-finder/backend/application/command"
+	"github.com/sunDar0/winding-road-finder/backend/domain/collection"
+	"github.com/sunDar0/winding-road-finder/backend/interfaces/messages"
+	"github.com/sunDar0/winding-road-finder/backend/middlewares"
+	"github.com/sunDar0/winding-road-finder/backend/models"
+)
+
+// CollectionCommandController는 사용자 컬렉션 생성·편집 요청을 처리합니다.
+type CollectionCommandController struct {
+	service *appCommand.CollectionCommandService
+}
+
+func NewCollectionCommandController(service *appCommand.CollectionCommandService) *CollectionCommandController {
+	return &CollectionCommandController{service: service}
+}
+
+// RegisterRoutes는 Gin 라우터에 엔드포인트를 등록합니다.
+func (ctrl *CollectionCommandController) RegisterRoutes(rg *gin.RouterGroup) {
+	me := rg.Group("/me/collections", middlewares.RequireAuth())
+	me.POST("", ctrl.CreateCollection)
+	me.PATCH("/:id", ctrl.UpdateCollection)
+	me.DELETE("/:id", ctrl.DeleteCollection)
+	me.POST("/:id/courses", ctrl.AddCourse)
+	me.PUT("/:id/courses", ctrl.ReorderCourses)
+	me.DELETE("/:id/courses/:courseId", ctrl.RemoveCourse)
+}
+
+// @Summary 컬렉션 생성
+// @Description 코스 목록(즐겨찾기 등)을 만듭니다. public이면 공유 링크가 발급됩니다.
+// @Tags collections
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body models.CreateCollectionRequest true "컬렉션 정보"
+// @Success 201 {object} models.CreatedResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /me/collections [post]
+func (ctrl *CollectionCommandController) CreateCollection(c *gin.Context) {
+	var req models.CreateCollectionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	col, err := ctrl.service.Create(principal, req.Name, req.CourseIDs, req.Public)
+	if err != nil {
+		respondCollectionError(c, err)
+		return
+	}
+	c.Header("Location", fmt.Sprintf("/api/me/collections/%d", col.ID))
+	c.JSON(http.StatusCreated, models.CreatedResponse{ID: col.ID})
+}
+
+// @Summary 컬렉션 이름/공개 여부 변경
+// @Description 비공개로 바꾸면 기존 공유 링크가 무효화되고, 다시 공개하면 새 링크가 발급됩니다.
+// @Tags collections
+// @Accept json
+// @Security BearerAuth
+// @Param id path int true "컬렉션 ID"
+// @Param request body models.UpdateCollectionRequest true "변경할 항목"
+// @Success 204
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /me/collections/{id} [patch]
+func (ctrl *CollectionCommandController) UpdateCollection(c *gin.Context) {
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	var req models.UpdateCollectionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	err := ctrl.service.Update(principal, id, appCommand.CollectionChanges{Name: req.Name, Public: req.Public})
+	respondCollectionResult(c, err)
+}
+
+// @Summary 컬렉션 삭제
+// @Tags collections
+// @Security BearerAuth
+// @Param id path int true "컬렉션 ID"
+// @Success 204
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /me/collections/{id} [delete]
+func (ctrl *CollectionCommandController) DeleteCollection(c *gin.Context) {
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	respondCollectionResult(c, ctrl.service.Delete(principal, id))
+}
+
+// @Summary 컬렉션에 코스 추가
+// @Description 코스를 컬렉션 끝에 추가합니다. 이미 담긴 코스면 그대로 둡니다.
+// @Tags collections
+// @Accept json
+// @Security BearerAuth
+// @Param id path int true "컬렉션 ID"
+// @Param request body models.AddCollectionCourseRequest true "추가할 코스"
+// @Success 204
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /me/collections/{id}/courses [post]
+func (ctrl *CollectionCommandController) AddCourse(c *gin.Context) {
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	var req models.AddCollectionCourseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	respondCollectionResult(c, ctrl.service.AddCourse(principal, id, req.CourseID))
+}
+
+// @Summary 컬렉션 코스 순서 변경
+// @Description 컬렉션에 담긴 코스 ID 전체를 원하는 순서로 보냅니다.
+// @Tags collections
+// @Accept json
+// @Security BearerAuth
+// @Param id path int true "컬렉션 ID"
+// @Param request body models.ReorderCollectionRequest true "새 순서"
+// @Success 204
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /me/collections/{id}/courses [put]
+func (ctrl *CollectionCommandController) ReorderCourses(c *gin.Context) {
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	var req models.ReorderCollectionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	respondCollectionResult(c, ctrl.service.Reorder(principal, id, req.CourseIDs))
+}
+
+// @Summary 컬렉션에서 코스 제거
+// @Tags collections
+// @Security BearerAuth
+// @Param id path int true "컬렉션 ID"
+// @Param courseId path int true "코스 ID"
+// @Success 204
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /me/collections/{id}/courses/{courseId} [delete]
+func (ctrl *CollectionCommandController) RemoveCourse(c *gin.Context) {
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	courseID, ok := pathID(c, "courseId")
+	if !ok {
+		return
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	respondCollectionResult(c, ctrl.service.RemoveCourse(principal, id, courseID))
+}
+
+// pathID는 경로 파라미터를 정수 ID로 읽습니다. 형식이 틀리면 400을 응답하고 false를 반환합니다.
+func pathID(c *gin.Context, name string) (int, bool) {
+	id, err := strconv.Atoi(c.Param(name))
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidID, nil)
+		return 0, false
+	}
+	return id, true
+}
+
+func respondCollectionResult(c *gin.Context, err error) {
+	if err != nil {
+		respondCollectionError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func respondCollectionError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, collection.ErrCollectionNotFound):
+		respondError(c, http.StatusNotFound, messages.CollectionNotFound, nil)
+	case errors.Is(err, collection.ErrUnknownCourse):
+		respondError(c, http.StatusNotFound, messages.CourseNotFound, nil)
+	case errors.Is(err, collection.ErrInvalidName):
+		respondError(c, http.StatusBadRequest, messages.InvalidCollectionName, nil)
+	case errors.Is(err, collection.ErrInvalidOrder):
+		respondError(c, http.StatusBadRequest, messages.InvalidCollectionOrder, nil)
+	case errors.Is(err, collection.ErrTooManyCourses):
+		respondError(c, http.StatusBadRequest, messages.CollectionFull, nil)
+	default:
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+	}
+}
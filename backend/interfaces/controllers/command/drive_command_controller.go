@@ -0,0 +1,219 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	appCommand "github.com/sunDar0/winding-road-finder/backend/application/command"
+	"github.com/sunDar0/winding-road-finder/backend/domain/drive"
+	"github.com/sunDar0/winding-road-finder/backend/interfaces/messages"
+	"github.com/sunDar0/winding-road-finder/backend/middlewares"
+	"github.com/sunDar0/winding-road-finder/backend/models"
+)
+
+// maxTrackUploadBytes는 GPX 업로드 요청 본문의 최대 크기입니다.
+const maxTrackUploadBytes = 5 << 20
+
+// DriveCommandController는 주행 기록 작성·편집 요청을 처리합니다.
+type DriveCommandController struct {
+	service *appCommand.DriveCommandService
+}
+
+func NewDriveCommandController(service *appCommand.DriveCommandService) *DriveCommandController {
+	return &DriveCommandController{service: service}
+}
+
+// RegisterRoutes는 Gin 라우터에 엔드포인트를 등록합니다.
+func (ctrl *DriveCommandController) RegisterRoutes(rg *gin.RouterGroup) {
+	me := rg.Group("/me/drives", middlewares.RequireAuth())
+	me.POST("", ctrl.RecordDrive)
+	me.PUT("/:id", ctrl.UpdateDrive)
+	me.DELETE("/:id", ctrl.DeleteDrive)
+	me.PUT("/:id/track", ctrl.UploadTrack)
+	me.DELETE("/:id/track", ctrl.DeleteTrack)
+}
+
+// @Summary 주행 기록 작성
+// @Description 코스를 달린 날짜, 방향, 차량, 날씨를 기록합니다.
+// @Tags drives
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body models.DriveRequest true "주행 기록"
+// @Success 201 {object} models.CreatedResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /me/drives [post]
+func (ctrl *DriveCommandController) RecordDrive(c *gin.Context) {
+	details, ok := bindDriveDetails(c)
+	if !ok {
+		return
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	d, err := ctrl.service.Record(principal, details)
+	if err != nil {
+		respondDriveError(c, err)
+		return
+	}
+	c.Header("Location", fmt.Sprintf("/api/me/drives/%d", d.ID))
+	c.JSON(http.StatusCreated, models.CreatedResponse{ID: d.ID})
+}
+
+// @Summary 주행 기록 수정
+// @Description 주행 기록의 입력 항목을 모두 바꿉니다. 업로드한 궤적은 유지합니다.
+// @Tags drives
+// @Accept json
+// @Security BearerAuth
+// @Param id path int true "주행 기록 ID"
+// @Param request body models.DriveRequest true "주행 기록"
+// @Success 204
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /me/drives/{id} [put]
+func (ctrl *DriveCommandController) UpdateDrive(c *gin.Context) {
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	details, ok := bindDriveDetails(c)
+	if !ok {
+		return
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	respondDriveResult(c, ctrl.service.Update(principal, id, details))
+}
+
+// @Summary 주행 기록 삭제
+// @Tags drives
+// @Security BearerAuth
+// @Param id path int true "주행 기록 ID"
+// @Success 204
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /me/drives/{id} [delete]
+func (ctrl *DriveCommandController) DeleteDrive(c *gin.Context) {
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	respondDriveResult(c, ctrl.service.Delete(principal, id))
+}
+
+// @Summary 주행 궤적 업로드
+// @Description GPX 파일을 multipart 폼의 file 필드로 올려 주행 기록에 궤적을 붙입니다. 기존 궤적은 교체됩니다. 최대 5MB.
+// @Tags drives
+// @Accept multipart/form-data
+// @Security BearerAuth
+// @Param id path int true "주행 기록 ID"
+// @Param file formData file true "GPX 파일"
+// @Success 204
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /me/drives/{id}/track [put]
+func (ctrl *DriveCommandController) UploadTrack(c *gin.Context) {
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxTrackUploadBytes)
+	fh, err := c.FormFile("file")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidTrack, err)
+		return
+	}
+	f, err := fh.Open()
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidTrack, err)
+		return
+	}
+	defer f.Close()
+	principal, _ := middlewares.PrincipalFrom(c)
+	respondDriveResult(c, ctrl.service.UploadTrack(principal, id, f))
+}
+
+// @Summary 주행 궤적 삭제
+// @Tags drives
+// @Security BearerAuth
+// @Param id path int true "주행 기록 ID"
+// @Success 204
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /me/drives/{id}/track [delete]
+func (ctrl *DriveCommandController) DeleteTrack(c *gin.Context) {
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	respondDriveResult(c, ctrl.service.DeleteTrack(principal, id))
+}
+
+// bindDriveDetails는 요청 본문을 주행 기록 입력으로 변환합니다. 실패하면 400을 응답하고 false를 반환합니다.
+func bindDriveDetails(c *gin.Context) (drive.Details, bool) {
+	var req models.DriveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return drive.Details{}, false
+	}
+	drivenOn, err := time.ParseInLocation("2006-01-02", req.DrivenOn, kst)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidDriveDate, err)
+		return drive.Details{}, false
+	}
+	direction, err := drive.ParseDirection(req.Direction)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return drive.Details{}, false
+	}
+	weather, err := drive.ParseWeather(req.Weather)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return drive.Details{}, false
+	}
+	return drive.Details{
+		CourseID:  req.CourseID,
+		DrivenOn:  drivenOn,
+		Direction: direction,
+		Car:       req.Car,
+		Weather:   weather,
+	}, true
+}
+
+func respondDriveResult(c *gin.Context, err error) {
+	if err != nil {
+		respondDriveError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func respondDriveError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, drive.ErrDriveNotFound):
+		respondError(c, http.StatusNotFound, messages.DriveNotFound, nil)
+	case errors.Is(err, drive.ErrUnknownCourse):
+		respondError(c, http.StatusNotFound, messages.CourseNotFound, nil)
+	case errors.Is(err, drive.ErrInvalidDriveDate):
+		respondError(c, http.StatusBadRequest, messages.InvalidDriveDate, nil)
+	case errors.Is(err, drive.ErrCarTooLong):
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+	case errors.Is(err, drive.ErrInvalidTrack):
+		respondError(c, http.StatusBadRequest, messages.InvalidTrack, err)
+	default:
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+	}
+}
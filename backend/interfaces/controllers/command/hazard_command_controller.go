@@ -0,0 +1,128 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	appCommand "github.com/sunDar0/winding-road-finder/backend/application/command"
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/hazard"
+	"github.com/sunDar0/winding-road-finder/backend/interfaces/messages"
+	"github.com/sunDar0/winding-road-finder/backend/middlewares"
+	"github.com/sunDar0/winding-road-finder/backend/models"
+)
+
+// HazardCommandController는 위험 신고와 투표 요청을 처리합니다.
+type HazardCommandController struct {
+	service *appCommand.HazardCommandService
+}
+
+func NewHazardCommandController(service *appCommand.HazardCommandService) *HazardCommandController {
+	return &HazardCommandController{service: service}
+}
+
+// RegisterRoutes는 Gin 라우터에 엔드포인트를 등록합니다.
+func (ctrl *HazardCommandController) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.POST("/courses/:id/hazards", middlewares.RequireAuth(), ctrl.ReportHazard)
+	rg.POST("/hazards/:id/votes", middlewares.RequireAuth(), ctrl.VoteHazard)
+}
+
+// @Summary 위험 신고
+// @Description 코스 위의 위험 요소를 위치, 유형, 위험도와 함께 신고합니다. 유형별 유효 기간이 지나면 자동으로 만료됩니다.
+// @Tags hazards
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "코스 ID"
+// @Param request body models.HazardRequest true "신고 내용"
+// @Success 201 {object} models.CreatedResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /courses/{id}/hazards [post]
+func (ctrl *HazardCommandController) ReportHazard(c *gin.Context) {
+	courseID, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	var req models.HazardRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	t, err := hazard.ParseType(req.Type)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidHazard, err)
+		return
+	}
+	severity, err := hazard.ParseSeverity(req.Severity)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidHazard, err)
+		return
+	}
+	location := course.CourseGeolocation{Latitude: req.Latitude, Longitude: req.Longitude}
+	principal, _ := middlewares.PrincipalFrom(c)
+	h, err := ctrl.service.Report(principal, courseID, t, severity, location, req.Note)
+	if err != nil {
+		respondHazardError(c, err)
+		return
+	}
+	c.Header("Location", fmt.Sprintf("/api/courses/%d/hazards", courseID))
+	c.JSON(http.StatusCreated, models.CreatedResponse{ID: h.ID})
+}
+
+// @Summary 위험 신고 확인/해소 투표
+// @Description confirm은 신고가 여전히 유효함을 확인해 만료 시각을 연장하고, dismiss는 해소되었음을 알립니다. 해소 투표가 3표 이상이고 확인보다 많으면 신고가 내려갑니다.
+// @Tags hazards
+// @Accept json
+// @Security BearerAuth
+// @Param id path int true "신고 ID"
+// @Param request body models.HazardVoteRequest true "투표"
+// @Success 204
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /hazards/{id}/votes [post]
+func (ctrl *HazardCommandController) VoteHazard(c *gin.Context) {
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	var req models.HazardVoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	vote, err := hazard.ParseVote(req.Vote)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	if _, err := ctrl.service.Vote(principal, id, vote); err != nil {
+		respondHazardError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func respondHazardError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, hazard.ErrHazardNotFound):
+		respondError(c, http.StatusNotFound, messages.HazardNotFound, nil)
+	case errors.Is(err, hazard.ErrUnknownCourse):
+		respondError(c, http.StatusNotFound, messages.CourseNotFound, nil)
+	case errors.Is(err, hazard.ErrTooFarFromCourse):
+		respondError(c, http.StatusBadRequest, messages.HazardTooFar, nil)
+	case errors.Is(err, hazard.ErrNoteTooLong):
+		respondError(c, http.StatusBadRequest, messages.InvalidHazard, err)
+	case errors.Is(err, hazard.ErrOwnReport):
+		respondError(c, http.StatusForbidden, messages.OwnHazardVote, nil)
+	default:
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+	}
+}
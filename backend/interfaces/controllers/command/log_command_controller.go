@@ -0,0 +1,59 @@
+package command
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+	"github.com/sunDar0/winding-road-finder/backend/infrastructure/logging"
+	"github.com/sunDar0/winding-road-finder/backend/interfaces/messages"
+	"github.com/sunDar0/winding-road-finder/backend/middlewares"
+	"github.com/sunDar0/winding-road-finder/backend/models"
+)
+
+// LogCommandController는 서버 로그 레벨 변경 요청을 처리합니다.
+type LogCommandController struct {
+	level  *slog.LevelVar
+	logger *slog.Logger
+}
+
+func NewLogCommandController(level *slog.LevelVar, logger *slog.Logger) *LogCommandController {
+	return &LogCommandController{level: level, logger: logger}
+}
+
+// RegisterRoutes는 Gin 라우터에 엔드포인트를 등록합니다. admin 권한이 필요합니다.
+func (ctrl *LogCommandController) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.PUT("/admin/log-level", middlewares.RequireRole(user.RoleAdmin), ctrl.SetLogLevel)
+}
+
+// @Summary 로그 레벨 변경
+// @Description 서버를 다시 시작하지 않고 로그 레벨을 바꿉니다. 다시 시작하면 LOG_LEVEL 값으로 돌아갑니다.
+// @Tags admin
+// @Accept json
+// @Security BearerAuth
+// @Param request body models.LogLevelDto true "로그 레벨 (debug, info, warn, error)"
+// @Success 204
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Router /admin/log-level [put]
+func (ctrl *LogCommandController) SetLogLevel(c *gin.Context) {
+	var req models.LogLevelDto
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	level, err := logging.ParseLevel(req.Level)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidLogLevel, nil)
+		return
+	}
+	previous := ctrl.level.Level()
+	ctrl.level.Set(level)
+	principal, _ := middlewares.PrincipalFrom(c)
+	ctrl.logger.WarnContext(c.Request.Context(), "로그 레벨을 바꿨습니다",
+		"from", strings.ToLower(previous.String()), "to", strings.ToLower(level.String()), "user_id", principal.UserID)
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,123 @@
+package command_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+	"github.com/sunDar0/winding-road-finder/backend/infrastructure/logging"
+	commandCtrl "github.com/sunDar0/winding-road-finder/backend/interfaces/controllers/command"
+	queryCtrl "github.com/sunDar0/winding-road-finder/backend/interfaces/controllers/query"
+	"github.com/sunDar0/winding-road-finder/backend/interfaces/messages"
+	"github.com/sunDar0/winding-road-finder/backend/middlewares"
+	"github.com/sunDar0/winding-road-finder/backend/models"
+)
+
+// roleTokens는 토큰 문자열을 그 이름의 권한을 가진 사용자로 검증하는 토큰 서비스입니다.
+type roleTokens struct{}
+
+func (roleTokens) Issue(*user.User) (user.TokenPair, error) { return user.TokenPair{}, nil }
+
+func (roleTokens) Verify(token string) (*user.Principal, error) {
+	role := user.Role(token)
+	if !role.Valid() {
+		return nil, errors.New("알 수 없는 토큰")
+	}
+	return &user.Principal{UserID: 1, Role: role}, nil
+}
+
+func (roleTokens) ParseRefresh(string) (int, error) { return 0, errors.New("지원하지 않음") }
+
+// newLogLevelRouter는 로그 레벨 조회, 변경 엔드포인트를 등록한 라우터를 만듭니다. 로그는 buf에 남습니다.
+func newLogLevelRouter(t *testing.T, level *slog.LevelVar, buf *bytes.Buffer) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	logger, err := logging.New(buf, "json", level)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := gin.New()
+	api := r.Group("/api", middlewares.Authenticate(roleTokens{}))
+	commandCtrl.NewLogCommandController(level, logger).RegisterRoutes(api)
+	queryCtrl.NewLogQueryController(level).RegisterRoutes(api)
+	return r
+}
+
+func serveLogLevel(r *gin.Engine, method, token, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, "/api/admin/log-level", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestSetLogLevel(t *testing.T) {
+	tests := []struct {
+		name     string
+		token    string
+		body     string
+		status   int
+		code     string     // 오류 응답의 메시지 키
+		want     slog.Level // 요청 뒤 로그 레벨
+		wantName string     // 조회 응답의 레벨 이름
+	}{
+		{"warn으로 바꾼다", string(user.RoleAdmin), `{"level":"warn"}`, http.StatusNoContent, "", slog.LevelWarn, "warn"},
+		{"대소문자를 가리지 않는다", string(user.RoleAdmin), `{"level":"DEBUG"}`, http.StatusNoContent, "", slog.LevelDebug, "debug"},
+		{"알 수 없는 레벨", string(user.RoleAdmin), `{"level":"verbose"}`, http.StatusBadRequest, messages.InvalidLogLevel, slog.LevelInfo, "info"},
+		{"레벨 없음", string(user.RoleAdmin), `{}`, http.StatusBadRequest, messages.InvalidParameter, slog.LevelInfo, "info"},
+		{"로그인하지 않았다", "", `{"level":"debug"}`, http.StatusUnauthorized, messages.Unauthorized, slog.LevelInfo, "info"},
+		{"관리자가 아니다", string(user.RoleEditor), `{"level":"debug"}`, http.StatusForbidden, messages.Forbidden, slog.LevelInfo, "info"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var level slog.LevelVar
+			var buf bytes.Buffer
+			r := newLogLevelRouter(t, &level, &buf)
+
+			w := serveLogLevel(r, http.MethodPut, tt.token, tt.body)
+			if w.Code != tt.status {
+				t.Fatalf("PUT status = %d, want %d: %s", w.Code, tt.status, w.Body)
+			}
+			if tt.code != "" {
+				var resp models.ErrorResponse
+				if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil || resp.Code != tt.code {
+					t.Fatalf("PUT error = %s, want code %s", w.Body, tt.code)
+				}
+			}
+			if level.Level() != tt.want {
+				t.Fatalf("level = %v, want %v", level.Level(), tt.want)
+			}
+			// 바꾼 경우에만 누가 무엇으로 바꿨는지 남긴다.
+			if logged := strings.Contains(buf.String(), `"to":"`+tt.wantName+`"`); logged != (tt.status == http.StatusNoContent) {
+				t.Fatalf("change log = %q", buf.String())
+			}
+
+			w = serveLogLevel(r, http.MethodGet, string(user.RoleAdmin), "")
+			var got models.LogLevelDto
+			if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil || w.Code != http.StatusOK || got.Level != tt.wantName {
+				t.Fatalf("GET = %d %s, want %q", w.Code, w.Body, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestGetLogLevelRequiresAdmin(t *testing.T) {
+	var level slog.LevelVar
+	r := newLogLevelRouter(t, &level, &bytes.Buffer{})
+	for token, want := range map[string]int{"": http.StatusUnauthorized, string(user.RoleEditor): http.StatusForbidden} {
+		if w := serveLogLevel(r, http.MethodGet, token, ""); w.Code != want {
+			t.Errorf("GET with token %q = %d, want %d", token, w.Code, want)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package command
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	appCommand "github.com/sunDar0/winding-road-finder/backend/application/command"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+	"github.com/sunDar0/winding-road-finder/backend/infrastructure/external/oidc"
+	"github.com/sunDar0/winding-road-finder/backend/interfaces/messages"
+	"github.com/sunDar0/winding-road-finder/backend/middlewares"
+	"github.com/sunDar0/winding-road-finder/backend/models"
+)
+
+const (
+	// stateCookie는 인가 요청을 시작한 브라우저에 state를 묶어 두는 쿠키입니다.
+	stateCookie = "oidc_state"
+	// ticketCookie는 콜백을 마친 브라우저만 토큰을 받아 갈 수 있도록 일회용 ticket을 담는 쿠키입니다.
+	ticketCookie   = "oidc_ticket"
+	oidcCookiePath = "/api/auth/oidc"
+)
+
+// OIDCCommandController는 외부 공급자 로그인(OIDC) 요청을 처리합니다.
+type OIDCCommandController struct {
+	service *appCommand.OIDCCommandService
+	// completeURL은 콜백을 마친 브라우저를 돌려보낼 프런트엔드 주소입니다.
+	completeURL string
+}
+
+func NewOIDCCommandController(service *appCommand.OIDCCommandService, completeURL string) *OIDCCommandController {
+	return &OIDCCommandController{service: service, completeURL: completeURL}
+}
+
+// RegisterRoutes는 Gin 라우터에 엔드포인트를 등록합니다.
+func (ctrl *OIDCCommandController) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.GET("/auth/providers", ctrl.GetProviders)
+	rg.POST("/auth/oidc/:provider/authorize", ctrl.Authorize)
+	rg.GET("/auth/oidc/:provider/callback", ctrl.Callback)
+	rg.POST("/auth/oidc/session", ctrl.Session)
+}
+
+// @Summary 외부 로그인 공급자 목록
+// @Description 설정된 OIDC 공급자 이름 목록을 반환합니다.
+// @Tags auth
+// @Produce json
+// @Success 200 {object} models.ProvidersResponse
+// @Router /auth/providers [get]
+func (ctrl *OIDCCommandController) GetProviders(c *gin.Context) {
+	c.JSON(http.StatusOK, models.ProvidersResponse{Providers: ctrl.service.Providers()})
+}
+
+// @Summary 외부 로그인 시작
+// @Description 공급자 인가 요청 URL(PKCE 적용)을 발급하고, state를 HttpOnly 쿠키(oidc_state)로 이 브라우저에 묶습니다.
+// @Description 클라이언트는 쿠키를 받을 수 있도록 credentials를 포함해 호출한 뒤 사용자를 이 URL로 이동시킵니다.
+// @Description 로그인한 상태(Authorization 헤더)로 호출하면 콜백에서 현재 계정에 외부 계정을 연결합니다.
+// @Tags auth
+// @Produce json
+// @Param lang query string false "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름"
+// @Param provider path string true "공급자 이름 (예: google, kakao, mock)"
+// @Success 200 {object} models.AuthorizeResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 502 {object} models.ErrorResponse
+// @Router /auth/oidc/{provider}/authorize [post]
+func (ctrl *OIDCCommandController) Authorize(c *gin.Context) {
+	principal, _ := middlewares.PrincipalFrom(c)
+	login, err := ctrl.service.StartLogin(c.Request.Context(), c.Param("provider"), principal)
+	switch {
+	case errors.Is(err, user.ErrUnknownProvider):
+		respondError(c, http.StatusNotFound, messages.UnknownProvider, nil)
+	case errors.Is(err, oidc.ErrProvider):
+		respondError(c, http.StatusBadGateway, messages.ProviderError, err)
+	case err != nil:
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+	default:
+		setCookie(c, stateCookie, login.State, oidcCookiePath, 10*time.Minute)
+		c.JSON(http.StatusOK, models.AuthorizeResponse{AuthorizationURL: login.URL})
+	}
+}
+
+// @Summary 외부 로그인 콜백
+// @Description 공급자가 돌려준 인가 코드를 교환한 뒤 브라우저를 프런트엔드(OIDC_COMPLETE_URL)로 돌려보냅니다. 토큰은 응답 본문이나 URL에 담지 않습니다.
+// @Description 로그인이면 일회용 ticket을 HttpOnly 쿠키로 남기며, 프런트엔드는 POST /auth/oidc/session으로 토큰을 받습니다.
+// @Description 계정 연결이면 ?linked={provider}, 실패하면 ?error={code}를 붙입니다.
+// @Description 같은 이메일의 계정이 있어도 자동으로 연결하지 않으며(error=link_required), 그 계정으로 로그인한 뒤 연결해야 합니다.
+// @Tags auth
+// @Param provider path string true "공급자 이름"
+// @Param code query string true "인가 코드"
+// @Param state query string true "인가 요청 시 발급한 state"
+// @Success 302
+// @Router /auth/oidc/{provider}/callback [get]
+func (ctrl *OIDCCommandController) Callback(c *gin.Context) {
+	provider := c.Param("provider")
+	bound, _ := c.Cookie(stateCookie)
+	clearCookie(c, stateCookie, oidcCookiePath)
+	if reason := c.Query("error"); reason != "" {
+		ctrl.redirect(c, url.Values{"error": {messages.LoginDenied}})
+		return
+	}
+	code, state := c.Query("code"), c.Query("state")
+	if code == "" || state == "" {
+		ctrl.redirect(c, url.Values{"error": {messages.InvalidParameter}})
+		return
+	}
+	result, err := ctrl.service.CompleteLogin(c.Request.Context(), provider, code, state, bound)
+	if err != nil {
+		ctrl.redirect(c, url.Values{"error": {callbackErrorKey(c, err)}})
+		return
+	}
+	if result.Linked {
+		ctrl.redirect(c, url.Values{"linked": {provider}})
+		return
+	}
+	setCookie(c, ticketCookie, result.Ticket, oidcCookiePath+"/session", time.Minute)
+	ctrl.redirect(c, nil)
+}
+
+// callbackErrorKey는 콜백 실패를 프런트엔드에 전달할 메시지 키로 바꿉니다. 예상하지 못한 에러는 로그에 남깁니다.
+func callbackErrorKey(c *gin.Context, err error) string {
+	switch {
+	case errors.Is(err, appCommand.ErrInvalidState):
+		return messages.InvalidState
+	case errors.Is(err, user.ErrIdentityLinked):
+		return messages.IdentityLinked
+	case errors.Is(err, user.ErrLinkRequired):
+		return messages.LinkRequired
+	case errors.Is(err, oidc.ErrProvider):
+		c.Error(err)
+		return messages.ProviderError
+	default:
+		c.Error(err)
+		return messages.InternalError
+	}
+}
+
+func (ctrl *OIDCCommandController) redirect(c *gin.Context, params url.Values) {
+	target := ctrl.completeURL
+	if len(params) > 0 {
+		sep := "?"
+		if strings.Contains(target, "?") {
+			sep = "&"
+		}
+		target += sep + params.Encode()
+	}
+	c.Redirect(http.StatusFound, target)
+}
+
+// @Summary 외부 로그인 토큰 발급
+// @Description 콜백이 남긴 일회용 ticket 쿠키(oidc_ticket)로 토큰을 발급합니다. ticket은 1분 동안 한 번만 쓸 수 있습니다.
+// @Tags auth
+// @Produce json
+// @Param lang query string false "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름"
+// @Success 200 {object} models.TokenResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Router /auth/oidc/session [post]
+func (ctrl *OIDCCommandController) Session(c *gin.Context) {
+	ticket, _ := c.Cookie(ticketCookie)
+	clearCookie(c, ticketCookie, oidcCookiePath+"/session")
+	_, tokens, err := ctrl.service.RedeemTicket(ticket)
+	switch {
+	case errors.Is(err, appCommand.ErrInvalidState), errors.Is(err, user.ErrUserNotFound):
+		respondError(c, http.StatusBadRequest, messages.InvalidState, nil)
+	case err != nil:
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+	default:
+		c.JSON(http.StatusOK, toTokenResponse(tokens))
+	}
+}
+
+// setCookie는 스크립트에서 읽을 수 없는(HttpOnly) SameSite=Lax 쿠키를 남깁니다.
+// Lax는 공급자에서 콜백으로 돌아오는 최상위 이동에는 쿠키를 보내고, 다른 사이트의 POST 요청에는 보내지 않습니다.
+func setCookie(c *gin.Context, name, value, path string, maxAge time.Duration) {
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     path,
+		MaxAge:   int(maxAge.Seconds()),
+		HttpOnly: true,
+		Secure:   c.Request.TLS != nil || c.GetHeader("X-Forwarded-Proto") == "https",
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+func clearCookie(c *gin.Context, name, path string) {
+	setCookie(c, name, "", path, -time.Second)
+}
@@ -0,0 +1,142 @@
+package command_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	appCommand "github.com/sunDar0/winding-road-finder/backend/application/command"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+	"github.com/sunDar0/winding-road-finder/backend/infrastructure/auth"
+	"github.com/sunDar0/winding-road-finder/backend/infrastructure/external/oidc"
+	commandRepo "github.com/sunDar0/winding-road-finder/backend/infrastructure/persistence/command"
+	commandCtrl "github.com/sunDar0/winding-road-finder/backend/interfaces/controllers/command"
+	"github.com/sunDar0/winding-road-finder/backend/models"
+)
+
+const completeURL = "http://localhost:3000/auth/complete"
+
+func newOIDCRouter(t *testing.T) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	var mock *oidc.MockServer
+	idp := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { mock.ServeHTTP(w, r) }))
+	t.Cleanup(idp.Close)
+	var err error
+	if mock, err = oidc.NewMockServer(idp.URL, nil); err != nil {
+		t.Fatal(err)
+	}
+	provider := oidc.NewProvider(oidc.Config{Name: "mock", Issuer: idp.URL, ClientID: "winding-road-finder", RedirectURL: "http://localhost:8080/api/auth/oidc/mock/callback"})
+	service := appCommand.NewOIDCCommandService(
+		commandRepo.NewUserCommandRepository(t.TempDir()),
+		auth.NewJWTTokenService([]byte("test-secret"), time.Minute, time.Hour),
+		[]user.IdentityProvider{provider})
+	r := gin.New()
+	commandCtrl.NewOIDCCommandController(service, completeURL).RegisterRoutes(r.Group("/api"))
+	return r
+}
+
+func serve(r *gin.Engine, method, target string, cookies ...*http.Cookie) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, nil)
+	for _, c := range cookies {
+		req.AddCookie(c)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func cookie(t *testing.T, w *httptest.ResponseRecorder, name string) *http.Cookie {
+	t.Helper()
+	for _, c := range w.Result().Cookies() {
+		if c.Name == name {
+			if !c.HttpOnly || c.SameSite != http.SameSiteLaxMode {
+				t.Fatalf("cookie %s is not HttpOnly/SameSite=Lax: %+v", name, c)
+			}
+			return c
+		}
+	}
+	t.Fatalf("cookie %s not set", name)
+	return nil
+}
+
+// callbackQuery는 authorize 응답의 인가 URL을 모의 공급자로 따라가 콜백 쿼리(code, state)를 얻습니다.
+func callbackQuery(t *testing.T, w *httptest.ResponseRecorder) string {
+	t.Helper()
+	var body models.AuthorizeResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	resp, err := client.Get(body.AuthorizationURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	loc, err := resp.Location()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return loc.RawQuery
+}
+
+func TestOIDCCallbackRequiresStateCookie(t *testing.T) {
+	r := newOIDCRouter(t)
+	w := serve(r, http.MethodPost, "/api/auth/oidc/mock/authorize")
+	if w.Code != http.StatusOK {
+		t.Fatalf("authorize status = %d", w.Code)
+	}
+	cookie(t, w, "oidc_state")
+	query := callbackQuery(t, w)
+
+	// 공격자가 시작한 요청의 콜백 URL을 state 쿠키가 없는 다른 브라우저에서 열면 거부된다.
+	w = serve(r, http.MethodGet, "/api/auth/oidc/mock/callback?"+query)
+	if w.Code != http.StatusFound || w.Header().Get("Location") != completeURL+"?error=invalid_state" {
+		t.Fatalf("callback without cookie = %d %s", w.Code, w.Header().Get("Location"))
+	}
+	for _, c := range w.Result().Cookies() {
+		if c.Name == "oidc_ticket" && c.Value != "" {
+			t.Fatal("ticket issued without state cookie")
+		}
+	}
+}
+
+func TestOIDCCallbackRedirectsAndSessionIssuesTokensOnce(t *testing.T) {
+	r := newOIDCRouter(t)
+	w := serve(r, http.MethodPost, "/api/auth/oidc/mock/authorize")
+	state := cookie(t, w, "oidc_state")
+	query := callbackQuery(t, w)
+
+	w = serve(r, http.MethodGet, "/api/auth/oidc/mock/callback?"+query, state)
+	if w.Code != http.StatusFound || w.Header().Get("Location") != completeURL {
+		t.Fatalf("callback = %d %s", w.Code, w.Header().Get("Location"))
+	}
+	if strings.Contains(w.Body.String(), "accessToken") || strings.Contains(w.Header().Get("Location"), "token") {
+		t.Fatal("callback exposed tokens")
+	}
+	ticket := cookie(t, w, "oidc_ticket")
+	if ticket.Path != "/api/auth/oidc/session" {
+		t.Fatalf("ticket cookie path = %s", ticket.Path)
+	}
+
+	w = serve(r, http.MethodPost, "/api/auth/oidc/session", ticket)
+	var tokens models.TokenResponse
+	if w.Code != http.StatusOK || json.Unmarshal(w.Body.Bytes(), &tokens) != nil || tokens.AccessToken == "" {
+		t.Fatalf("session = %d %s", w.Code, w.Body.String())
+	}
+	if w = serve(r, http.MethodPost, "/api/auth/oidc/session", ticket); w.Code != http.StatusBadRequest {
+		t.Fatalf("second session status = %d, want 400", w.Code)
+	}
+
+	// 이미 쓴 state로 다시 콜백하면 실패로 돌려보낸다.
+	w = serve(r, http.MethodGet, "/api/auth/oidc/mock/callback?"+query, state)
+	if loc, _ := url.Parse(w.Header().Get("Location")); loc.Query().Get("error") != "invalid_state" {
+		t.Fatalf("replayed callback = %s", w.Header().Get("Location"))
+	}
+}
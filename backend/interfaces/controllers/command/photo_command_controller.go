@@ -0,0 +1,135 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	appCommand "github.com/sunDar0/winding-road-finder/backend/application/command"
+	"github.com/sunDar0/winding-road-finder/backend/domain/photo"
+	"github.com/sunDar0/winding-road-finder/backend/interfaces/messages"
+	"github.com/sunDar0/winding-road-finder/backend/middlewares"
+	"github.com/sunDar0/winding-road-finder/backend/models"
+)
+
+// multipartOverhead는 업로드 요청 본문에서 사진 파일 외에 허용하는 크기(설명, multipart 경계 등)입니다.
+const multipartOverhead = 1 << 20
+
+// PhotoCommandController는 코스 사진 업로드와 삭제 요청을 처리합니다.
+type PhotoCommandController struct {
+	service *appCommand.PhotoCommandService
+}
+
+func NewPhotoCommandController(service *appCommand.PhotoCommandService) *PhotoCommandController {
+	return &PhotoCommandController{service: service}
+}
+
+// RegisterRoutes는 Gin 라우터에 엔드포인트를 등록합니다.
+func (ctrl *PhotoCommandController) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.POST("/courses/:id/photos", middlewares.RequireAuth(), ctrl.UploadPhoto)
+	rg.DELETE("/photos/:id", middlewares.RequireAuth(), ctrl.DeletePhoto)
+}
+
+// @Summary 코스 사진 업로드
+// @Description JPEG 또는 PNG 사진(최대 20MB)을 코스에 올립니다. 썸네일(320px), 중간(1024px), 큰(2048px) 크기로 다시 인코딩해 저장하며,
+// @Description 저장한 사진에는 EXIF 등 메타데이터가 남지 않습니다. EXIF에 GPS 위치가 있고 코스 경로에서 1km 안이면 경로 위에 배치합니다.
+// @Tags photos
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "코스 ID"
+// @Param file formData file true "사진 파일 (JPEG, PNG)"
+// @Param caption formData string false "사진 설명 (최대 200자)"
+// @Success 201 {object} models.CreatedResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 413 {object} models.ErrorResponse
+// @Failure 415 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Failure 503 {object} models.ErrorResponse
+// @Router /courses/{id}/photos [post]
+func (ctrl *PhotoCommandController) UploadPhoto(c *gin.Context) {
+	courseID, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, photo.MaxUploadBytes+multipartOverhead)
+	header, err := c.FormFile("file")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			respondError(c, http.StatusRequestEntityTooLarge, messages.ImageTooLarge, nil)
+			return
+		}
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	if header.Size > photo.MaxUploadBytes {
+		respondError(c, http.StatusRequestEntityTooLarge, messages.ImageTooLarge, nil)
+		return
+	}
+	file, err := header.Open()
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	defer file.Close()
+	data, err := io.ReadAll(file)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	p, err := ctrl.service.Upload(c.Request.Context(), principal, courseID, data, c.PostForm("caption"))
+	if err != nil {
+		respondPhotoError(c, err)
+		return
+	}
+	c.Header("Location", fmt.Sprintf("/api/courses/%d/photos", courseID))
+	c.JSON(http.StatusCreated, models.CreatedResponse{ID: p.ID})
+}
+
+// @Summary 코스 사진 삭제
+// @Description 사진과 저장한 변형 이미지를 지웁니다. 올린 사람과 editor 이상 권한 사용자만 지울 수 있습니다.
+// @Tags photos
+// @Security BearerAuth
+// @Param id path int true "사진 ID"
+// @Success 204
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /photos/{id} [delete]
+func (ctrl *PhotoCommandController) DeletePhoto(c *gin.Context) {
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	if err := ctrl.service.Delete(c.Request.Context(), principal, id); err != nil {
+		respondPhotoError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func respondPhotoError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, photo.ErrPhotoNotFound):
+		respondError(c, http.StatusNotFound, messages.PhotoNotFound, nil)
+	case errors.Is(err, photo.ErrUnknownCourse):
+		respondError(c, http.StatusNotFound, messages.CourseNotFound, nil)
+	case errors.Is(err, photo.ErrCaptionTooLong):
+		respondError(c, http.StatusBadRequest, messages.InvalidCaption, nil)
+	case errors.Is(err, photo.ErrImageTooLarge):
+		respondError(c, http.StatusRequestEntityTooLarge, messages.ImageTooLarge, nil)
+	case errors.Is(err, photo.ErrUnsupportedImage):
+		respondError(c, http.StatusUnsupportedMediaType, messages.UnsupportedImage, nil)
+	case errors.Is(err, photo.ErrStoreUnavailable):
+		respondError(c, http.StatusServiceUnavailable, messages.MediaUnavailable, nil)
+	default:
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+	}
+}
@@ -0,0 +1,98 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	appCommand "github.com/sunDar0/winding-road-finder/backend/application/command"
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/review"
+	"github.com/sunDar0/winding-road-finder/backend/interfaces/messages"
+	"github.com/sunDar0/winding-road-finder/backend/middlewares"
+	"github.com/sunDar0/winding-road-finder/backend/models"
+)
+
+// kst는 날짜만 받는 요청 값(방문일 등)을 해석하는 기준 시간대입니다.
+var kst = time.FixedZone("KST", 9*60*60)
+
+// ReviewCommandController는 코스 리뷰 작성 요청을 처리합니다.
+type ReviewCommandController struct {
+	service *appCommand.ReviewCommandService
+}
+
+func NewReviewCommandController(service *appCommand.ReviewCommandService) *ReviewCommandController {
+	return &ReviewCommandController{service: service}
+}
+
+// RegisterRoutes는 Gin 라우터에 엔드포인트를 등록합니다.
+func (ctrl *ReviewCommandController) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.POST("/courses/:id/reviews", middlewares.RequireAuth(), ctrl.PostReview)
+}
+
+// @Summary 코스 리뷰 작성
+// @Description 5가지 항목 점수, 본문, 방문일로 코스 리뷰를 작성합니다. 사용자당 코스 하나에 리뷰 하나만 작성할 수 있습니다.
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param lang query string false "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름"
+// @Param id path int true "코스 ID"
+// @Param request body models.ReviewRequest true "리뷰 내용"
+// @Success 201 {object} models.CreatedResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 409 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /courses/{id}/reviews [post]
+func (ctrl *ReviewCommandController) PostReview(c *gin.Context) {
+	courseID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidID, nil)
+		return
+	}
+	var req models.ReviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	visitedOn, err := time.ParseInLocation("2006-01-02", req.VisitedOn, kst)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidVisitDate, err)
+		return
+	}
+
+	principal, _ := middlewares.PrincipalFrom(c)
+	r, err := ctrl.service.PostReview(principal, courseID, toCourseRatings(req.Ratings), req.Text, visitedOn)
+	switch {
+	case errors.Is(err, course.ErrInvalidRating):
+		respondError(c, http.StatusBadRequest, messages.InvalidRating, err)
+	case errors.Is(err, review.ErrTextTooLong):
+		respondError(c, http.StatusBadRequest, messages.ReviewTooLong, nil)
+	case errors.Is(err, review.ErrInvalidVisitDate):
+		respondError(c, http.StatusBadRequest, messages.InvalidVisitDate, nil)
+	case errors.Is(err, review.ErrAlreadyReviewed):
+		respondError(c, http.StatusConflict, messages.AlreadyReviewed, nil)
+	case err != nil:
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+	case r == nil:
+		respondError(c, http.StatusNotFound, messages.CourseNotFound, nil)
+	default:
+		c.Header("Location", fmt.Sprintf("/api/courses/%d/reviews", courseID))
+		c.JSON(http.StatusCreated, models.CreatedResponse{ID: r.ID})
+	}
+}
+
+func toCourseRatings(dto models.CourseRatingsDto) course.CourseRatings {
+	return course.CourseRatings{
+		Tech:    dto.Tech,
+		Speed:   dto.Speed,
+		Scenery: dto.Scenery,
+		Road:    dto.Road,
+		Access:  dto.Access,
+	}
+}
@@ -0,0 +1,223 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	appCommand "github.com/sunDar0/winding-road-finder/backend/application/command"
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/revision"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+	"github.com/sunDar0/winding-road-finder/backend/interfaces/messages"
+	"github.com/sunDar0/winding-road-finder/backend/middlewares"
+	"github.com/sunDar0/winding-road-finder/backend/models"
+)
+
+// RevisionCommandController는 코스 수정안 작성과 검토 요청을 처리합니다.
+type RevisionCommandController struct {
+	service *appCommand.RevisionCommandService
+}
+
+func NewRevisionCommandController(service *appCommand.RevisionCommandService) *RevisionCommandController {
+	return &RevisionCommandController{service: service}
+}
+
+// RegisterRoutes는 Gin 라우터에 엔드포인트를 등록합니다. 수정안 작성은 로그인한 사용자 누구나,
+// 승인·반려는 editor 이상 권한 사용자가 할 수 있습니다.
+func (ctrl *RevisionCommandController) RegisterRoutes(rg *gin.RouterGroup) {
+	revisions := rg.Group("/revisions", middlewares.RequireAuth())
+	revisions.POST("", ctrl.CreateRevision)
+	revisions.PUT("/:id", ctrl.UpdateRevision)
+	revisions.POST("/:id/submit", ctrl.SubmitRevision)
+
+	admin := rg.Group("/admin/revisions", middlewares.RequireRole(user.RoleEditor))
+	admin.POST("/:id/approve", ctrl.ApproveRevision)
+	admin.POST("/:id/reject", ctrl.RejectRevision)
+}
+
+// @Summary 코스 수정안 작성
+// @Description 게시된 코스를 고치는 수정안(courseId) 또는 새 코스 제안(courseId 생략)을 초안으로 만듭니다.
+// @Description 코스 내용은 관리자 코스 수정과 같이 전체를 담고, reason에 변경 요약을 적습니다. 현재 코스 버전이 기준 버전이 됩니다.
+// @Tags revisions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body models.RevisionRequest true "수정안"
+// @Success 201 {object} models.CreatedResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 409 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /revisions [post]
+func (ctrl *RevisionCommandController) CreateRevision(c *gin.Context) {
+	req, agg, ok := bindRevision(c)
+	if !ok {
+		return
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	r, err := ctrl.service.Create(principal, req.CourseID, agg, req.Reason)
+	if err != nil {
+		respondRevisionError(c, err)
+		return
+	}
+	c.Header("Location", fmt.Sprintf("/api/revisions/%d", r.ID))
+	c.JSON(http.StatusCreated, models.CreatedResponse{ID: r.ID})
+}
+
+// @Summary 코스 수정안 편집
+// @Description 초안이나 반려된 수정안의 내용 전체를 바꿉니다. 반려된 수정안은 다시 초안이 되며, 현재 코스 버전이 새 기준 버전이 됩니다.
+// @Tags revisions
+// @Accept json
+// @Security BearerAuth
+// @Param id path int true "수정안 ID"
+// @Param request body models.RevisionRequest true "수정안 (courseId는 무시)"
+// @Success 204
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 409 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /revisions/{id} [put]
+func (ctrl *RevisionCommandController) UpdateRevision(c *gin.Context) {
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	req, agg, ok := bindRevision(c)
+	if !ok {
+		return
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	respondRevisionResult(c, ctrl.service.Update(principal, id, agg, req.Reason))
+}
+
+// @Summary 코스 수정안 검토 요청
+// @Description 초안을 검토 대기 상태로 바꿉니다. 작성자만 할 수 있습니다.
+// @Tags revisions
+// @Security BearerAuth
+// @Param id path int true "수정안 ID"
+// @Success 204
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 409 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /revisions/{id}/submit [post]
+func (ctrl *RevisionCommandController) SubmitRevision(c *gin.Context) {
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	respondRevisionResult(c, ctrl.service.Submit(principal, id))
+}
+
+// @Summary 코스 수정안 승인
+// @Description 검토 대기 중인 수정안을 게시합니다. 코스 변경 이벤트와 관리자 작업 기록이 남습니다.
+// @Description 자신이 작성한 수정안은 승인할 수 없고, 수정안을 만든 뒤 코스가 바뀌었으면 409(revision_outdated)로 응답합니다.
+// @Tags admin
+// @Accept json
+// @Security BearerAuth
+// @Param id path int true "수정안 ID"
+// @Param request body models.ReviewDecisionRequest false "검토 의견"
+// @Success 204
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 409 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /admin/revisions/{id}/approve [post]
+func (ctrl *RevisionCommandController) ApproveRevision(c *gin.Context) {
+	id, req, ok := bindReviewDecision(c)
+	if !ok {
+		return
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	respondRevisionResult(c, ctrl.service.Approve(principal, id, req.Comment))
+}
+
+// @Summary 코스 수정안 반려
+// @Description 검토 대기 중인 수정안을 반려 사유와 함께 돌려보냅니다. 작성자는 수정안을 편집해 다시 제출할 수 있습니다.
+// @Tags admin
+// @Accept json
+// @Security BearerAuth
+// @Param id path int true "수정안 ID"
+// @Param request body models.ReviewDecisionRequest true "반려 사유"
+// @Success 204
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 409 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /admin/revisions/{id}/reject [post]
+func (ctrl *RevisionCommandController) RejectRevision(c *gin.Context) {
+	id, req, ok := bindReviewDecision(c)
+	if !ok {
+		return
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	respondRevisionResult(c, ctrl.service.Reject(principal, id, req.Comment))
+}
+
+// bindRevision은 요청 본문을 수정안 요청과 코스 도메인 모델로 바꿉니다.
+func bindRevision(c *gin.Context) (models.RevisionRequest, *course.CourseAggregate, bool) {
+	var req models.RevisionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return req, nil, false
+	}
+	agg, err := toCourse(req.AdminCourseRequest)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidCourse, err)
+		return req, nil, false
+	}
+	return req, agg, true
+}
+
+// bindReviewDecision은 본문이 없으면 빈 의견으로 봅니다.
+func bindReviewDecision(c *gin.Context) (int, models.ReviewDecisionRequest, bool) {
+	var req models.ReviewDecisionRequest
+	id, ok := pathID(c, "id")
+	if !ok {
+		return 0, req, false
+	}
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+			return 0, req, false
+		}
+	}
+	return id, req, true
+}
+
+func respondRevisionResult(c *gin.Context, err error) {
+	if err != nil {
+		respondRevisionError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func respondRevisionError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, revision.ErrRevisionNotFound):
+		respondError(c, http.StatusNotFound, messages.RevisionNotFound, nil)
+	case errors.Is(err, revision.ErrInvalidSummary), errors.Is(err, revision.ErrInvalidComment), errors.Is(err, revision.ErrCommentRequired):
+		respondError(c, http.StatusBadRequest, messages.InvalidRevision, err)
+	case errors.Is(err, revision.ErrInvalidTransition):
+		respondError(c, http.StatusConflict, messages.InvalidRevisionState, nil)
+	case errors.Is(err, revision.ErrSelfReview):
+		respondError(c, http.StatusForbidden, messages.SelfReview, nil)
+	case errors.Is(err, revision.ErrOutdated):
+		respondError(c, http.StatusConflict, messages.RevisionOutdated, nil)
+	case errors.Is(err, revision.ErrCourseDeleted):
+		respondError(c, http.StatusConflict, messages.CourseDeleted, nil)
+	default:
+		respondAdminError(c, err)
+	}
+}
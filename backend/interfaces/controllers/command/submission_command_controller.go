@@ -0,0 +1,181 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	appCommand "github.com/sunDar0/winding-road-finder/backend/application/command"
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+	"github.com/sunDar0/winding-road-finder/backend/domain/submission"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+	"github.com/sunDar0/winding-road-finder/backend/interfaces/messages"
+	"github.com/sunDar0/winding-road-finder/backend/middlewares"
+	"github.com/sunDar0/winding-road-finder/backend/models"
+)
+
+// SubmissionCommandController는 새 코스 제보와 관리자의 수락·반려 요청을 처리합니다.
+type SubmissionCommandController struct {
+	service *appCommand.SubmissionCommandService
+}
+
+func NewSubmissionCommandController(service *appCommand.SubmissionCommandService) *SubmissionCommandController {
+	return &SubmissionCommandController{service: service}
+}
+
+// RegisterRoutes는 Gin 라우터에 엔드포인트를 등록합니다. 수락·반려는 editor 이상 권한이 필요합니다.
+func (ctrl *SubmissionCommandController) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.POST("/submissions", middlewares.RequireAuth(), ctrl.CreateSubmission)
+
+	admin := rg.Group("/admin/submissions", middlewares.RequireRole(user.RoleEditor))
+	admin.POST("/:id/accept", ctrl.AcceptSubmission)
+	admin.POST("/:id/reject", ctrl.RejectSubmission)
+}
+
+// @Summary 새 코스 제보
+// @Description 아직 없는 코스를 제보합니다. 출발지부터 도착지까지 내비게이션 포인트가 2개 이상 필요합니다.
+// @Description 출발지·도착지가 2km 안에 있거나(반대 방향 포함) 이름이 비슷한 기존 코스를 중복 후보로 함께 반환하며, 후보가 있어도 제보는 저장됩니다.
+// @Tags submissions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param lang query string false "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름"
+// @Param request body models.SubmissionRequest true "제보할 코스"
+// @Success 201 {object} models.SubmissionCreatedDto
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /submissions [post]
+func (ctrl *SubmissionCommandController) CreateSubmission(c *gin.Context) {
+	var req models.SubmissionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	agg := &course.CourseAggregate{
+		Name:    i18n.Text(req.Name),
+		Region:  req.Region,
+		Nav:     make([]course.CourseNav, len(req.Nav)),
+		Styles:  req.Styles,
+		Ratings: course.CourseRatings(req.Ratings),
+	}
+	for i, n := range req.Nav {
+		agg.Nav[i] = course.CourseNav{
+			Type:        n.Type,
+			Name:        i18n.Text(n.Name),
+			Geolocation: course.CourseGeolocation{Latitude: n.Geolocation.Latitude, Longitude: n.Geolocation.Longitude},
+		}
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	s, duplicates, err := ctrl.service.Submit(principal, agg, req.Note)
+	if err != nil {
+		respondSubmissionError(c, err)
+		return
+	}
+	lang := middlewares.LangFrom(c)
+	dto := models.SubmissionCreatedDto{ID: s.ID, Duplicates: make([]models.SubmissionDuplicateDto, len(duplicates))}
+	for i, d := range duplicates {
+		dto.Duplicates[i] = models.SubmissionDuplicateDto{
+			CourseID:        d.Course.ID,
+			Name:            d.Course.Name.In(lang),
+			EndpointsMatch:  d.EndpointsMatch,
+			StartDistanceKm: d.StartDistanceKm,
+			EndDistanceKm:   d.EndDistanceKm,
+			NameSimilarity:  d.NameSimilarity,
+		}
+	}
+	c.Header("Location", fmt.Sprintf("/api/submissions/%d", s.ID))
+	c.JSON(http.StatusCreated, dto)
+}
+
+// @Summary 코스 제보 수락
+// @Description 검토 대기 중인 제보를 코스로 게시합니다. course를 주면 제보 내용 대신 그 코스를 게시합니다(번역, 소개 문구 보완).
+// @Description 코스 변경 이벤트와 관리자 작업 기록이 남습니다.
+// @Tags admin
+// @Accept json
+// @Security BearerAuth
+// @Param id path int true "제보 ID"
+// @Param request body models.SubmissionAcceptRequest false "게시할 코스와 검토 의견"
+// @Success 204
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 409 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /admin/submissions/{id}/accept [post]
+func (ctrl *SubmissionCommandController) AcceptSubmission(c *gin.Context) {
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	var req models.SubmissionAcceptRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+			return
+		}
+	}
+	var agg *course.CourseAggregate
+	if req.Course != nil {
+		var err error
+		if agg, err = toCourse(*req.Course); err != nil {
+			respondError(c, http.StatusBadRequest, messages.InvalidCourse, err)
+			return
+		}
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	respondSubmissionResult(c, ctrl.service.Accept(principal, id, agg, req.Comment))
+}
+
+// @Summary 코스 제보 반려
+// @Description 검토 대기 중인 제보를 반려 사유와 함께 반려합니다. 이미 있는 코스와 같으면 duplicateOf에 그 코스 ID를 줍니다.
+// @Tags admin
+// @Accept json
+// @Security BearerAuth
+// @Param id path int true "제보 ID"
+// @Param request body models.SubmissionRejectRequest true "반려 사유"
+// @Success 204
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 409 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /admin/submissions/{id}/reject [post]
+func (ctrl *SubmissionCommandController) RejectSubmission(c *gin.Context) {
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	var req models.SubmissionRejectRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	respondSubmissionResult(c, ctrl.service.Reject(principal, id, req.DuplicateOf, req.Comment))
+}
+
+func respondSubmissionResult(c *gin.Context, err error) {
+	if err != nil {
+		respondSubmissionError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func respondSubmissionError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, submission.ErrSubmissionNotFound):
+		respondError(c, http.StatusNotFound, messages.SubmissionNotFound, nil)
+	case errors.Is(err, submission.ErrInvalidSubmission), errors.Is(err, submission.ErrCommentRequired):
+		respondError(c, http.StatusBadRequest, messages.InvalidSubmission, err)
+	case errors.Is(err, submission.ErrAlreadyReviewed):
+		respondError(c, http.StatusConflict, messages.SubmissionReviewed, nil)
+	default:
+		respondAdminError(c, err)
+	}
+}
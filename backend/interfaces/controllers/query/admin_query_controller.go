@@ -0,0 +1,163 @@
+package query
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	appQuery "github.com/sunDar0/winding-road-finder/backend/application/query"
+	"github.com/sunDar0/winding-road-finder/backend/domain/audit"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+	"github.com/sunDar0/winding-road-finder/backend/interfaces/messages"
+	"github.com/sunDar0/winding-road-finder/backend/middlewares"
+	"github.com/sunDar0/winding-road-finder/backend/models"
+)
+
+// AdminQueryController는 관리자 작업 기록과 관리용 리뷰·위험 신고 목록 조회 요청을 처리합니다.
+type AdminQueryController struct {
+	service *appQuery.AdminQueryService
+}
+
+func NewAdminQueryController(service *appQuery.AdminQueryService) *AdminQueryController {
+	return &AdminQueryController{service: service}
+}
+
+// RegisterRoutes는 Gin 라우터에 엔드포인트를 등록합니다. /admin 아래 API는 editor 이상 권한이 필요합니다.
+func (ctrl *AdminQueryController) RegisterRoutes(rg *gin.RouterGroup) {
+	admin := rg.Group("/admin", middlewares.RequireRole(user.RoleEditor))
+	admin.GET("/audit", ctrl.GetAuditLog)
+	admin.GET("/reviews", ctrl.GetReviews)
+	admin.GET("/hazards", ctrl.GetHazards)
+}
+
+// @Summary 관리자 작업 기록 조회
+// @Description 코스·추천 수정, 리뷰·위험 신고 숨김 등 관리자 작업을 작업자와 변경 전후 값과 함께 최신순으로 조회합니다.
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param targetType query string false "대상 종류 (course, recommendation, hazard, review)"
+// @Param targetId query int false "대상 ID"
+// @Param actorId query int false "작업자 사용자 ID"
+// @Param page query int false "페이지 번호 (1~100000), 기본 1"
+// @Param size query int false "페이지 크기, 기본 20, 최대 100"
+// @Success 200 {object} models.AuditPageDto
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /admin/audit [get]
+func (ctrl *AdminQueryController) GetAuditLog(c *gin.Context) {
+	page, size, err := queryPage(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	filter := audit.Filter{TargetType: audit.TargetType(c.Query("targetType"))}
+	if filter.TargetID, err = queryInt(c, "targetId", 0); err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	if filter.ActorID, err = queryInt(c, "actorId", 0); err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	result, err := ctrl.service.GetAuditLog(filter, page, size)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
+	}
+	dto := models.AuditPageDto{Items: make([]models.AuditEntryDto, len(result.Entries)), Page: page, Size: size, Total: result.Total}
+	for i, e := range result.Entries {
+		dto.Items[i] = toAuditEntryDto(e)
+	}
+	c.JSON(http.StatusOK, dto)
+}
+
+// @Summary 리뷰 관리 목록 조회
+// @Description 숨긴 리뷰를 포함해 최신순으로 조회합니다.
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param courseId query int false "코스 ID. 생략하면 전체 코스"
+// @Param page query int false "페이지 번호 (1~100000), 기본 1"
+// @Param size query int false "페이지 크기, 기본 20, 최대 100"
+// @Success 200 {object} models.ReviewPageDto
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /admin/reviews [get]
+func (ctrl *AdminQueryController) GetReviews(c *gin.Context) {
+	page, size, err := queryPage(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	courseID, err := queryInt(c, "courseId", 0)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	result, err := ctrl.service.GetReviews(courseID, page, size)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
+	}
+	dto := models.ReviewPageDto{Items: []models.ReviewDto{}, Page: page, Size: size, Total: result.Total}
+	for _, r := range result.Reviews {
+		dto.Items = append(dto.Items, toReviewDto(r))
+	}
+	c.JSON(http.StatusOK, dto)
+}
+
+// @Summary 위험 신고 관리 목록 조회
+// @Description 만료·해소·숨긴 신고를 포함해 최신순으로 조회합니다.
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param lang query string false "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름"
+// @Param courseId query int false "코스 ID. 생략하면 전체 코스"
+// @Success 200 {array} models.HazardDto
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /admin/hazards [get]
+func (ctrl *AdminQueryController) GetHazards(c *gin.Context) {
+	courseID, err := queryInt(c, "courseId", 0)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	hazards, err := ctrl.service.GetHazards(courseID)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
+	}
+	lang := middlewares.LangFrom(c)
+	dtos := make([]models.HazardDto, 0, len(hazards))
+	for _, h := range hazards {
+		dtos = append(dtos, toHazardDto(h, ctrl.service.IsActive(h), lang))
+	}
+	c.JSON(http.StatusOK, dtos)
+}
+
+// 도메인 모델을 DTO로 변환
+func toAuditEntryDto(e *audit.Entry) models.AuditEntryDto {
+	return models.AuditEntryDto{
+		ID:         e.ID,
+		Actor:      models.AuditActorDto{ID: e.ActorID, Email: e.ActorEmail},
+		Action:     string(e.Action),
+		TargetType: string(e.TargetType),
+		TargetID:   e.TargetID,
+		Changes:    toAuditChangeDtos(e.Changes),
+		At:         e.At,
+	}
+}
+
+func toAuditChangeDtos(changes []audit.Change) []models.AuditChangeDto {
+	dtos := make([]models.AuditChangeDto, len(changes))
+	for i, ch := range changes {
+		dtos[i] = models.AuditChangeDto{Path: ch.Path, Before: ch.Before, After: ch.After}
+	}
+	return dtos
+}
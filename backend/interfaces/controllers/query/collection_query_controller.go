@@ -0,0 +1,136 @@
+package query
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	appQuery "github.com/sunDar0/winding-road-finder/backend/application/query"
+	"github.com/sunDar0/winding-road-finder/backend/domain/collection"
+	"github.com/sunDar0/winding-road-finder/backend/interfaces/messages"
+	"github.com/sunDar0/winding-road-finder/backend/middlewares"
+	"github.com/sunDar0/winding-road-finder/backend/models"
+)
+
+// CollectionQueryController는 사용자 컬렉션 조회 요청을 처리합니다.
+type CollectionQueryController struct {
+	service *appQuery.CollectionQueryService
+	mappers *CourseMapperFactory
+}
+
+func NewCollectionQueryController(service *appQuery.CollectionQueryService, mappers *CourseMapperFactory) *CollectionQueryController {
+	return &CollectionQueryController{service: service, mappers: mappers}
+}
+
+// RegisterRoutes는 Gin 라우터에 엔드포인트를 등록합니다.
+func (ctrl *CollectionQueryController) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.GET("/me/collections", middlewares.RequireAuth(), ctrl.GetMyCollections)
+	rg.GET("/me/collections/:id", middlewares.RequireAuth(), ctrl.GetMyCollection)
+	rg.GET("/collections/shared/:token", ctrl.GetSharedCollection)
+}
+
+// @Summary 내 컬렉션 목록 조회
+// @Description 로그인한 사용자의 컬렉션 목록을 생성 순으로 조회합니다.
+// @Tags collections
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} models.CollectionDto
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /me/collections [get]
+func (ctrl *CollectionQueryController) GetMyCollections(c *gin.Context) {
+	principal, _ := middlewares.PrincipalFrom(c)
+	collections, err := ctrl.service.GetMyCollections(principal)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
+	}
+	dtos := make([]models.CollectionDto, 0, len(collections))
+	for _, col := range collections {
+		dtos = append(dtos, toCollectionDto(col))
+	}
+	c.JSON(http.StatusOK, dtos)
+}
+
+// @Summary 내 컬렉션 상세 조회
+// @Description 로그인한 사용자의 컬렉션을 담긴 코스 정보와 함께 조회합니다.
+// @Tags collections
+// @Produce json
+// @Security BearerAuth
+// @Param lang query string false "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름"
+// @Param id path int true "컬렉션 ID"
+// @Success 200 {object} models.CollectionDto
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /me/collections/{id} [get]
+func (ctrl *CollectionQueryController) GetMyCollection(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidID, nil)
+		return
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	result, err := ctrl.service.GetMyCollection(principal, id)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
+	}
+	if result == nil {
+		respondError(c, http.StatusNotFound, messages.CollectionNotFound, nil)
+		return
+	}
+	mapper, err := ctrl.mappers.forRequest(c)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
+	}
+	dto := toCollectionDto(result.Collection)
+	dto.Courses = mapper.toCourseDtos(result.Courses)
+	c.JSON(http.StatusOK, dto)
+}
+
+// @Summary 공개 컬렉션 조회
+// @Description 공유 링크로 공개 컬렉션을 추천 카테고리와 같은 형식으로 조회합니다. 로그인이 필요 없습니다.
+// @Tags collections
+// @Produce json
+// @Param lang query string false "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름"
+// @Param token path string true "공유 토큰"
+// @Success 200 {object} models.RecommendationDto
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /collections/shared/{token} [get]
+func (ctrl *CollectionQueryController) GetSharedCollection(c *gin.Context) {
+	rec, err := ctrl.service.GetSharedCollection(c.Param("token"))
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
+	}
+	if rec == nil {
+		respondError(c, http.StatusNotFound, messages.CollectionNotFound, nil)
+		return
+	}
+	mapper, err := ctrl.mappers.forRequest(c)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
+	}
+	c.JSON(http.StatusOK, mapper.toRecommendationDto(rec))
+}
+
+// 도메인 모델을 DTO로 변환
+func toCollectionDto(col *collection.Collection) models.CollectionDto {
+	dto := models.CollectionDto{
+		ID:        col.ID,
+		Name:      col.Name,
+		CourseIDs: col.CourseIDs,
+		Public:    col.Public,
+		CreatedAt: col.CreatedAt,
+		UpdatedAt: col.UpdatedAt,
+	}
+	if col.Public {
+		dto.ShareURL = "/api/collections/shared/" + col.ShareToken
+	}
+	return dto
+}
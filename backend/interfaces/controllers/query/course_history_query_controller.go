@@ -0,0 +1,87 @@
+package query
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	appQuery "github.com/sunDar0/winding-road-finder/backend/application/query"
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/interfaces/messages"
+	"github.com/sunDar0/winding-road-finder/backend/models"
+)
+
+// CourseHistoryQueryController는 코스 변경 이력 조회 요청을 처리합니다.
+type CourseHistoryQueryController struct {
+	service *appQuery.CourseHistoryQueryService
+	mappers *CourseMapperFactory
+}
+
+func NewCourseHistoryQueryController(service *appQuery.CourseHistoryQueryService, mappers *CourseMapperFactory) *CourseHistoryQueryController {
+	return &CourseHistoryQueryController{service: service, mappers: mappers}
+}
+
+// RegisterRoutes는 Gin 라우터에 엔드포인트를 등록합니다.
+func (ctrl *CourseHistoryQueryController) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.GET("/courses/:id/history", ctrl.GetHistory)
+}
+
+// @Summary 코스 변경 이력 조회
+// @Description 코스 변경 이벤트(생성, 평가 변경, 내비게이션 수정 등)를 작업자·사유·변경 전후 값과 함께 오래된 순으로 조회합니다.
+// @Description at을 주면 그 시점까지의 이벤트와 그 시점의 코스 상태를 재구성해 반환합니다. 삭제된 코스의 이력도 조회할 수 있습니다.
+// @Tags courses
+// @Produce json
+// @Param lang query string false "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름"
+// @Param id path int true "코스 ID"
+// @Param at query string false "조회 시점 (RFC 3339, 예: 2025-05-01T09:00:00+09:00). 생략 시 현재"
+// @Success 200 {object} models.CourseHistoryDto
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /courses/{id}/history [get]
+func (ctrl *CourseHistoryQueryController) GetHistory(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidID, nil)
+		return
+	}
+	at, err := queryTime(c, "at")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	history, err := ctrl.service.GetHistory(id, at)
+	if errors.Is(err, course.ErrCourseNotFound) {
+		respondError(c, http.StatusNotFound, messages.CourseNotFound, nil)
+		return
+	}
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
+	}
+	mapper, err := ctrl.mappers.forRequest(c)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
+	}
+	dto := models.CourseHistoryDto{CourseID: id, At: history.At, Events: make([]models.CourseEventDto, len(history.Entries))}
+	if history.Course != nil {
+		courseDto := mapper.toCourseDto(history.Course)
+		dto.Course = &courseDto
+	}
+	for i, entry := range history.Entries {
+		e := entry.Event
+		dto.Events[i] = models.CourseEventDto{
+			Version: e.Version,
+			Type:    string(e.Type),
+			Reason:  e.Reason,
+			At:      e.At,
+			Changes: toAuditChangeDtos(entry.Changes),
+		}
+		if entry.Actor != nil {
+			dto.Events[i].Actor = &models.CourseEventActorDto{ID: entry.Actor.ID, DisplayName: entry.Actor.DisplayName}
+		}
+	}
+	c.JSON(http.StatusOK, dto)
+}
@@ -0,0 +1,306 @@
+package query
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	appQuery "github.com/sunDar0/winding-road-finder/backend/application/query"
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/hazard"
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+	"github.com/sunDar0/winding-road-finder/backend/domain/recommendation"
+	"github.com/sunDar0/winding-road-finder/backend/domain/region"
+	"github.com/sunDar0/winding-road-finder/backend/domain/review"
+	"github.com/sunDar0/winding-road-finder/backend/domain/style"
+	"github.com/sunDar0/winding-road-finder/backend/interfaces/messages"
+	"github.com/sunDar0/winding-road-finder/backend/middlewares"
+	"github.com/sunDar0/winding-road-finder/backend/models"
+)
+
+// CourseMapperFactory는 코스 DTO 변환에 필요한 조회 서비스를 묶어 요청마다 변환기를 만듭니다.
+// 코스를 응답하는 컨트롤러는 이 팩토리를 공유합니다.
+type CourseMapperFactory struct {
+	styleService  *appQuery.StyleQueryService
+	regionService *appQuery.RegionQueryService
+	reviewService *appQuery.ReviewQueryService
+	hazardService *appQuery.HazardQueryService
+	navLinks      course.NavLinkOptions
+	now           func() time.Time
+}
+
+func NewCourseMapperFactory(styleService *appQuery.StyleQueryService, regionService *appQuery.RegionQueryService, reviewService *appQuery.ReviewQueryService, hazardService *appQuery.HazardQueryService, navLinks course.NavLinkOptions) *CourseMapperFactory {
+	return &CourseMapperFactory{styleService: styleService, regionService: regionService, reviewService: reviewService, hazardService: hazardService, navLinks: navLinks, now: time.Now}
+}
+
+// courseMapper는 코스 DTO 변환에 필요한 참조 데이터(스타일, 지역, 사용자 평점, 활성 위험 신고)와 응답 언어를 담습니다.
+type courseMapper struct {
+	taxonomy  *style.Taxonomy
+	regions   *region.Directory
+	community map[int]review.CommunityRatings
+	hazards   map[int]hazard.Summary
+	navLinks  course.NavLinkOptions
+	lang      i18n.Lang
+	now       time.Time
+}
+
+// forRequest는 요청 언어와 참조 데이터로 코스 DTO 변환기를 생성합니다.
+func (f *CourseMapperFactory) forRequest(c *gin.Context) (*courseMapper, error) {
+	taxonomy, err := f.styleService.Taxonomy()
+	if err != nil {
+		return nil, err
+	}
+	regions, err := f.regionService.Directory()
+	if err != nil {
+		return nil, err
+	}
+	community, err := f.reviewService.GetCommunityRatings()
+	if err != nil {
+		return nil, err
+	}
+	hazards, err := f.hazardService.GetActiveSummaries()
+	if err != nil {
+		return nil, err
+	}
+	return &courseMapper{taxonomy: taxonomy, regions: regions, community: community, hazards: hazards, navLinks: f.navLinks, lang: middlewares.LangFrom(c), now: f.now()}, nil
+}
+
+// 도메인 모델을 DTO로 변환
+func (m *courseMapper) toCourseDto(agg *course.CourseAggregate) models.CourseDto {
+	navs := make([]models.CourseNavDto, len(agg.Nav))
+	for i, n := range agg.Nav {
+		navs[i] = models.CourseNavDto{
+			Type: navType(m.lang, n),
+			Role: navRole(n),
+			Name: n.Name.In(m.lang),
+			Geolocation: models.CourseGeolocationDto{
+				Latitude:  n.Geolocation.Latitude,
+				Longitude: n.Geolocation.Longitude,
+			},
+		}
+	}
+	return models.CourseDto{
+		ID:              agg.ID,
+		Name:            agg.Name.In(m.lang),
+		Region:          m.regionName(agg.RegionCode, agg.Region),
+		RegionCode:      agg.RegionCode,
+		RegionName:      m.regionName(agg.RegionCode, agg.Region),
+		SubRegionCode:   agg.SubRegionCode,
+		SubRegionName:   m.regionName(agg.SubRegionCode, ""),
+		Tagline:         agg.Tagline.In(m.lang),
+		Characteristics: agg.Characteristics.In(m.lang),
+		NaverMapUrl:     agg.NaverMapUrl,
+		NavigationLinks: m.toNavigationLinks(agg),
+		ThumbnailImage:  fmt.Sprintf("/images/courses/thumbnails/course-%d.png", agg.ID),
+		DetailImage:     fmt.Sprintf("/images/courses/detail/course-%d.png", agg.ID),
+		Nav:             navs,
+		Notes:           agg.Notes.In(m.lang),
+		Styles:          m.styleNames(agg.Styles),
+		StyleSlugs:      agg.Styles,
+		Ratings: models.CourseRatingsDto{
+			Tech:    agg.Ratings.Tech,
+			Speed:   agg.Ratings.Speed,
+			Scenery: agg.Ratings.Scenery,
+			Road:    agg.Ratings.Road,
+			Access:  agg.Ratings.Access,
+		},
+		CommunityRatings: toCommunityRatingsDto(m.community[agg.ID]),
+		ActiveHazards:    toHazardBadgeDto(m.hazards[agg.ID]),
+		Availability:     m.toAvailabilityDto(agg.Availability),
+		Route:            m.toRouteSummaryDto(agg),
+	}
+}
+
+// navType은 내비게이션 포인트의 역할을 요청 언어의 이름("Start", "Waypoint 1" 등)으로 바꿉니다.
+// 역할을 알 수 없는 형식이면 저장된 Type을 그대로 반환합니다.
+func navType(lang i18n.Lang, n course.CourseNav) string {
+	role, ordinal, ok := n.Role()
+	if !ok {
+		return n.Type
+	}
+	if role == course.NavWaypoint && ordinal > 0 {
+		return messages.Get(lang, messages.NavWaypointN, ordinal)
+	}
+	return messages.Get(lang, messages.NavRoleKey(string(role)))
+}
+
+// navRole은 내비게이션 포인트의 역할(start, waypoint, end)을 반환합니다. 알 수 없으면 빈 값입니다.
+func navRole(n course.CourseNav) string {
+	role, _, _ := n.Role()
+	return string(role)
+}
+
+// toNavigationLinks는 앱별 내비게이션 딥 링크를 만듭니다. 경유지 제한으로 나뉜 링크는 주행 순서대로 담깁니다.
+func (m *courseMapper) toNavigationLinks(agg *course.CourseAggregate) map[string][]string {
+	links := agg.NavigationLinks(m.lang, m.navLinks)
+	if len(links) == 0 {
+		return nil
+	}
+	dto := make(map[string][]string, len(links))
+	for app, urls := range links {
+		dto[string(app)] = urls
+	}
+	return dto
+}
+
+// toAvailabilityDto는 이용 정보와 함께 현재 시각(KST) 기준 통행 가능 여부를 계산합니다.
+func (m *courseMapper) toAvailabilityDto(a course.Availability) models.AvailabilityDto {
+	dto := models.AvailabilityDto{
+		Closures:          make([]models.PeriodDto, len(a.Closures)),
+		RecommendedMonths: make([]int, len(a.RecommendedMonths)),
+		Restrictions:      make([]models.PeriodDto, len(a.Restrictions)),
+		OpenNow:           a.OpenAt(m.now),
+	}
+	for i, cl := range a.Closures {
+		dto.Closures[i] = models.PeriodDto{From: cl.From.String(), To: cl.To.String(), Reason: cl.Reason.In(m.lang)}
+	}
+	for i, month := range a.RecommendedMonths {
+		dto.RecommendedMonths[i] = int(month)
+	}
+	for i, w := range a.Restrictions {
+		dto.Restrictions[i] = models.PeriodDto{From: w.From.String(), To: w.To.String(), Reason: w.Reason.In(m.lang)}
+	}
+	if reason, closed := a.ClosedReasonAt(m.now); closed {
+		dto.ClosedReason = reason.In(m.lang)
+	}
+	return dto
+}
+
+// toRouteSummaryDto는 도로 경로 요약을 만듭니다. 경로를 구하지 않은 코스는 nil입니다.
+func (m *courseMapper) toRouteSummaryDto(agg *course.CourseAggregate) *models.CourseRouteSummaryDto {
+	if agg.Route == nil {
+		return nil
+	}
+	dto := &models.CourseRouteSummaryDto{
+		DistanceKm:      agg.Route.DistanceKm,
+		DurationMinutes: int(agg.Route.Duration.Round(time.Minute) / time.Minute),
+		Reachable:       agg.Route.Reachable(),
+		Issues:          make([]models.RouteIssueDto, 0, len(agg.Route.Issues)),
+	}
+	for _, issue := range agg.Route.Issues {
+		var name string
+		if issue.Nav >= 0 && issue.Nav < len(agg.Nav) {
+			name = agg.Nav[issue.Nav].Name.In(m.lang)
+		}
+		dto.Issues = append(dto.Issues, models.RouteIssueDto{
+			Kind:     string(issue.Kind),
+			NavIndex: issue.Nav,
+			NavName:  name,
+			Message:  messages.Get(m.lang, messages.RouteIssueKey(string(issue.Kind))),
+		})
+	}
+	return dto
+}
+
+func toHazardBadgeDto(summary hazard.Summary) models.HazardBadgeDto {
+	return models.HazardBadgeDto{Count: summary.Count, MaxSeverity: summary.MaxSeverity.String()}
+}
+
+func toCommunityRatingsDto(summary review.CommunityRatings) models.CommunityRatingsDto {
+	dto := models.CommunityRatingsDto{Count: summary.Count}
+	if summary.Count == 0 {
+		return dto
+	}
+	dto.Mean = &models.CommunityRatingsMeanDto{
+		Tech:    round3(summary.Mean[course.AxisTech]),
+		Speed:   round3(summary.Mean[course.AxisSpeed]),
+		Scenery: round3(summary.Mean[course.AxisScenery]),
+		Road:    round3(summary.Mean[course.AxisRoad]),
+		Access:  round3(summary.Mean[course.AxisAccess]),
+	}
+	dto.Distribution = make(map[string][5]int, len(summary.Distribution))
+	for axis, dist := range summary.Distribution {
+		dto.Distribution[string(axis)] = dist
+	}
+	return dto
+}
+
+func (m *courseMapper) toCourseDtos(aggs []*course.CourseAggregate) []models.CourseDto {
+	var dtos []models.CourseDto
+	for _, agg := range aggs {
+		dtos = append(dtos, m.toCourseDto(agg))
+	}
+	return dtos
+}
+
+func (m *courseMapper) toRecommendationDto(rec *appQuery.RecommendationWithCourses) models.RecommendationDto {
+	return models.RecommendationDto{
+		ID:          rec.ID,
+		Title:       rec.Title.In(m.lang),
+		Description: rec.Description.In(m.lang),
+		Courses:     m.toCourseDtos(rec.Courses),
+	}
+}
+
+func (m *courseMapper) toScoredCourseDtos(scored []*recommendation.ScoredCourse) []models.ScoredCourseDto {
+	dtos := make([]models.ScoredCourseDto, 0, len(scored))
+	for _, sc := range scored {
+		breakdown := make(map[string]float64, len(sc.Breakdown))
+		for comp, v := range sc.Breakdown {
+			breakdown[string(comp)] = round3(v)
+		}
+		reasons := make([]string, 0, len(sc.Reasons))
+		for _, r := range sc.Reasons {
+			reasons = append(reasons, m.reasonText(r))
+		}
+		dtos = append(dtos, models.ScoredCourseDto{
+			Course:     m.toCourseDto(sc.Course),
+			Score:      round3(sc.Score),
+			Breakdown:  breakdown,
+			Reasons:    reasons,
+			DistanceKm: sc.DistanceKm,
+		})
+	}
+	return dtos
+}
+
+// reasonText는 추천 사유를 요청 언어의 문구로 변환합니다.
+func (m *courseMapper) reasonText(r recommendation.Reason) string {
+	switch r.Kind {
+	case recommendation.ReasonRatingMatch:
+		axis := messages.Get(m.lang, messages.AxisKey(string(r.Axis)))
+		return messages.Get(m.lang, messages.ReasonRatingMatch, axis, r.Value, r.Target)
+	case recommendation.ReasonNearby:
+		return messages.Get(m.lang, messages.ReasonNearby, r.DistanceKm)
+	case recommendation.ReasonStyleMatch:
+		return messages.Get(m.lang, messages.ReasonStyleMatch, strings.Join(m.styleNames(r.Styles), ", "))
+	case recommendation.ReasonSimilarRatings:
+		axes := make([]string, 0, len(r.Axes))
+		for _, axis := range r.Axes {
+			axes = append(axes, messages.Get(m.lang, messages.AxisKey(string(axis))))
+		}
+		return messages.Get(m.lang, messages.ReasonSimilarRatings, strings.Join(axes, ", "))
+	case recommendation.ReasonSharedStyle:
+		return messages.Get(m.lang, messages.ReasonSharedStyle, strings.Join(m.styleNames(r.Styles), ", "))
+	case recommendation.ReasonSameRegion:
+		return messages.Get(m.lang, messages.ReasonSameRegion, m.regionName(r.Region, r.Region))
+	}
+	return string(r.Kind)
+}
+
+func round3(v float64) float64 {
+	return math.Round(v*1000) / 1000
+}
+
+// regionName은 지역 코드를 요청 언어의 지역 이름으로 변환합니다. 알 수 없는 코드면 fallback을 반환합니다.
+func (m *courseMapper) regionName(code, fallback string) string {
+	if r := m.regions.Find(code); r != nil {
+		return r.Name.In(m.lang)
+	}
+	return fallback
+}
+
+// styleNames는 스타일 slug 목록을 요청 언어의 표시 이름 목록으로 변환합니다.
+func (m *courseMapper) styleNames(slugs []string) []string {
+	names := make([]string, 0, len(slugs))
+	for _, slug := range slugs {
+		if s := m.taxonomy.Find(slug); s != nil {
+			names = append(names, s.Name.In(m.lang))
+		} else {
+			names = append(names, slug)
+		}
+	}
+	return names
+}
@@ -0,0 +1,37 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+)
+
+func TestNavType(t *testing.T) {
+	tests := []struct {
+		typ  string
+		lang i18n.Lang
+		want string
+		role string
+	}{
+		{"출발지", i18n.Korean, "출발지", "start"},
+		{"출발지", i18n.English, "Start", "start"},
+		{"경유지 2", i18n.English, "Waypoint 2", "waypoint"},
+		{"경유지-3", i18n.Japanese, "経由地3", "waypoint"},
+		{"경유지", i18n.English, "Waypoint", "waypoint"},
+		{"도착지", i18n.Japanese, "到着地", "end"},
+		// 알 수 없는 형식은 저장된 값을 그대로 보여준다.
+		{"정상", i18n.English, "정상", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.typ+"/"+string(tt.lang), func(t *testing.T) {
+			n := course.CourseNav{Type: tt.typ}
+			if got := navType(tt.lang, n); got != tt.want {
+				t.Fatalf("navType(%s, %q) = %q, want %q", tt.lang, tt.typ, got, tt.want)
+			}
+			if got := navRole(n); got != tt.role {
+				t.Fatalf("navRole(%q) = %q, want %q", tt.typ, got, tt.role)
+			}
+		})
+	}
+}
@@ -1,35 +1,43 @@
 package query
 
 import (
-	"fmt"
+	"errors"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	appQuery "github.com/sunDar0/winding-road-finder/backend/application/query"
-	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/interfaces/messages"
+	"github.com/sunDar0/winding-road-finder/backend/middlewares"
 	"github.com/sunDar0/winding-road-finder/backend/models"
 )
 
-// ErrorResponse는 API 에러 응답을 정의합니다.
-type ErrorResponse struct {
-	Error string `json:"error"`
+// respondError는 요청 언어로 번역한 에러 응답을 반환합니다.
+// 서버 에러(5xx)의 원인은 접근 로그에 남도록 요청 에러로도 기록합니다.
+func respondError(c *gin.Context, status int, key string, err error) {
+	if status >= http.StatusInternalServerError && err != nil {
+		_ = c.Error(err)
+	}
+	c.JSON(status, messages.ErrorResponse(middlewares.LangFrom(c), key, err))
 }
 
 // CourseQueryController는 코스 목록/상세 조회 요청을 처리합니다.
 type CourseQueryController struct {
 	service    *appQuery.CourseQueryService
 	recService *appQuery.RecommendationQueryService
+	mappers    *CourseMapperFactory
 }
 
-func NewCourseQueryController(service *appQuery.CourseQueryService, recService *appQuery.RecommendationQueryService) *CourseQueryController {
-	return &CourseQueryController{service: service, recService: recService}
+func NewCourseQueryController(service *appQuery.CourseQueryService, recService *appQuery.RecommendationQueryService, mappers *CourseMapperFactory) *CourseQueryController {
+	return &CourseQueryController{service: service, recService: recService, mappers: mappers}
 }
 
 // RegisterRoutes는 Gin 라우터에 엔드포인트를 등록합니다.
 func (ctrl *CourseQueryController) RegisterRoutes(rg *gin.RouterGroup) {
 	rg.GET("/courses", ctrl.GetCourses)
 	rg.GET("/courses/:id", ctrl.GetCourseByID)
+	rg.GET("/courses/:id/route", ctrl.GetCourseRoute)
 	rg.GET("/recommendations", ctrl.GetRecommendations)
 	rg.GET("/recommendations/:id", ctrl.GetRecommendationById)
 }
@@ -39,26 +47,48 @@ func (ctrl *CourseQueryController) RegisterRoutes(rg *gin.RouterGroup) {
 // @Tags courses
 // @Accept json
 // @Produce json
-// @Param region query string false "지역 필터"
-// @Param style query string false "스타일 필터"
-// @Param search query string false "검색어"
+// @Param lang query string false "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름"
+// @Param region query string false "지역 필터 (지역 코드 또는 이름)"
+// @Param style query string false "스타일 필터 (slug, 이름 또는 동의어)"
+// @Param search query string false "검색어 (스타일 동의어 포함)"
+// @Param date query string false "주행 예정일 (YYYY-MM-DD, KST). 그날 계절 통제되는 코스 제외"
+// @Param month query int false "주행 예정 달 (1~12). 추천 시기가 아니거나 한 달 내내 통제되는 코스 제외"
+// @Param dry query bool false "true면 앞으로 6시간 동안 출발지와 정상에 비 예보가 없는 코스만 조회 (보관된 예보 기준)"
 // @Success 200 {array} models.CourseDto
-// @Failure 500 {object} ErrorResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
 // @Router /courses [get]
 func (ctrl *CourseQueryController) GetCourses(c *gin.Context) {
 	region := c.Query("region")
 	style := c.Query("style")
 	search := c.Query("search")
-	courses, err := ctrl.service.GetCourses(region, style, search)
+	date, err := queryDate(c, "date")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	month, err := queryInt(c, "month", 0)
+	if err != nil || month < 0 || month > 12 {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, errors.New("month: 1~12 범위를 벗어났습니다"))
+		return
+	}
+	dry, err := strconv.ParseBool(c.DefaultQuery("dry", "false"))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
 		return
 	}
-	var dtos []models.CourseDto
-	for _, agg := range courses {
-		dtos = append(dtos, toCourseDto(agg))
+	conditions := appQuery.CourseConditions{Date: date, Month: time.Month(month), DryRoads: dry}
+	courses, err := ctrl.service.GetCourses(c.Request.Context(), region, style, search, conditions)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
+	}
+	mapper, err := ctrl.mappers.forRequest(c)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
 	}
-	c.JSON(http.StatusOK, dtos)
+	c.JSON(http.StatusOK, mapper.toCourseDtos(courses))
 }
 
 // @Summary 코스 상세 조회
@@ -66,29 +96,82 @@ func (ctrl *CourseQueryController) GetCourses(c *gin.Context) {
 // @Tags courses
 // @Accept json
 // @Produce json
+// @Param lang query string false "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름"
 // @Param id path int true "코스 ID"
 // @Success 200 {object} models.CourseDto
-// @Failure 400 {object} ErrorResponse
-// @Failure 404 {object} ErrorResponse
-// @Failure 500 {object} ErrorResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
 // @Router /courses/{id} [get]
 func (ctrl *CourseQueryController) GetCourseByID(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := strconv.Atoi(idStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid id"})
+		respondError(c, http.StatusBadRequest, messages.InvalidID, nil)
+		return
+	}
+	agg, err := ctrl.service.GetCourseByID(c.Request.Context(), id)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
+	}
+	if agg == nil {
+		respondError(c, http.StatusNotFound, messages.CourseNotFound, nil)
+		return
+	}
+	mapper, err := ctrl.mappers.forRequest(c)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
+	}
+	dto := mapper.toCourseDto(agg)
+	c.JSON(http.StatusOK, dto)
+}
+
+// @Summary 코스 도로 경로 조회
+// @Description 경로 탐색 엔진으로 내비게이션 포인트 사이를 실제 도로에 맞춰 구한 주행 경로(GeoJSON LineString)와 포인트 문제를 조회합니다.
+// @Tags courses
+// @Produce json
+// @Param lang query string false "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름"
+// @Param id path int true "코스 ID"
+// @Success 200 {object} models.CourseRouteDto
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /courses/{id}/route [get]
+func (ctrl *CourseQueryController) GetCourseRoute(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidID, nil)
 		return
 	}
-	agg, err := ctrl.service.GetCourseByID(id)
+	agg, err := ctrl.service.GetCourseByID(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
 		return
 	}
 	if agg == nil {
-		c.JSON(http.StatusNotFound, ErrorResponse{Error: "not found"})
+		respondError(c, http.StatusNotFound, messages.CourseNotFound, nil)
 		return
 	}
-	dto := toCourseDto(agg)
+	if agg.Route == nil {
+		respondError(c, http.StatusNotFound, messages.RouteNotComputed, nil)
+		return
+	}
+	mapper, err := ctrl.mappers.forRequest(c)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
+	}
+	dto := models.CourseRouteDto{
+		CourseID:              agg.ID,
+		CourseRouteSummaryDto: *mapper.toRouteSummaryDto(agg),
+		ComputedAt:            agg.Route.ComputedAt,
+		Geometry:              models.LineStringDto{Type: "LineString", Coordinates: make([][2]float64, len(agg.Route.Geometry))},
+	}
+	for i, p := range agg.Route.Geometry {
+		dto.Geometry.Coordinates[i] = [2]float64{p.Longitude, p.Latitude}
+	}
 	c.JSON(http.StatusOK, dto)
 }
 
@@ -97,27 +180,24 @@ func (ctrl *CourseQueryController) GetCourseByID(c *gin.Context) {
 // @Tags recommendations
 // @Accept json
 // @Produce json
+// @Param lang query string false "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름"
 // @Success 200 {array} models.RecommendationDto
-// @Failure 500 {object} ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
 // @Router /recommendations [get]
 func (ctrl *CourseQueryController) GetRecommendations(c *gin.Context) {
 	recs, err := ctrl.recService.GetRecommendationsWithCourses()
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
+	}
+	mapper, err := ctrl.mappers.forRequest(c)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
 		return
 	}
 	var result []models.RecommendationDto
 	for _, rec := range recs {
-		var courseDtos []models.CourseDto
-		for _, agg := range rec.Courses {
-			courseDtos = append(courseDtos, toCourseDto(agg))
-		}
-		result = append(result, models.RecommendationDto{
-			ID:          rec.ID,
-			Title:       rec.Title,
-			Description: rec.Description,
-			Courses:     courseDtos,
-		})
+		result = append(result, mapper.toRecommendationDto(rec))
 	}
 	c.JSON(http.StatusOK, result)
 }
@@ -127,76 +207,37 @@ func (ctrl *CourseQueryController) GetRecommendations(c *gin.Context) {
 // @Tags recommendations
 // @Accept json
 // @Produce json
+// @Param lang query string false "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름"
 // @Param id path int true "추천 ID"
 // @Success 200 {object} models.RecommendationDto
-// @Failure 400 {object} ErrorResponse "잘못된 ID 형식"
-// @Failure 404 {object} ErrorResponse "추천 정보를 찾을 수 없음"
-// @Failure 500 {object} ErrorResponse "서버 오류"
+// @Failure 400 {object} models.ErrorResponse "잘못된 ID 형식"
+// @Failure 404 {object} models.ErrorResponse "추천 정보를 찾을 수 없음"
+// @Failure 500 {object} models.ErrorResponse "서버 오류"
 // @Router /recommendations/{id} [get]
 func (ctrl *CourseQueryController)GetRecommendationById(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := strconv.Atoi(idStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid id"})
+		respondError(c, http.StatusBadRequest, messages.InvalidID, nil)
 		return
 	}
 	rec, err := ctrl.recService.GetRecommendationById(id)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
 		return
 	}
 	if rec == nil {
-		c.JSON(http.StatusNotFound, ErrorResponse{Error: "recommendation not found"})
+		respondError(c, http.StatusNotFound, messages.RecommendationNotFound, nil)
 		return
 	}
-	
-	// RecommendationWithCourses를 RecommendationDto로 변환
-	var courseDtos []models.CourseDto
-	for _, agg := range rec.Courses {
-		courseDtos = append(courseDtos, toCourseDto(agg))
+	mapper, err := ctrl.mappers.forRequest(c)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
 	}
 	
-	result := models.RecommendationDto{
-		ID:          rec.ID,
-		Title:       rec.Title,
-		Description: rec.Description,
-		Courses:     courseDtos,
-	}
+	// RecommendationWithCourses를 RecommendationDto로 변환
+	result := mapper.toRecommendationDto(rec)
 	
 	c.JSON(http.StatusOK, result)
 }
-
-// 도메인 모델을 DTO로 변환
-func toCourseDto(agg *course.CourseAggregate) models.CourseDto {
-	navs := make([]models.CourseNavDto, len(agg.Nav))
-	for i, n := range agg.Nav {
-		navs[i] = models.CourseNavDto{
-			Type: n.Type,
-			Name: n.Name,
-			Geolocation: models.CourseGeolocationDto{
-				Latitude:  n.Geolocation.Latitude,
-				Longitude: n.Geolocation.Longitude,
-			},
-		}
-	}
-	return models.CourseDto{
-		ID:              agg.ID,
-		Name:            agg.Name,
-		Region:          agg.Region,
-		Tagline:         agg.Tagline,
-		Characteristics: agg.Characteristics,
-		NaverMapUrl:     agg.NaverMapUrl,
-		ThumbnailImage:  fmt.Sprintf("/images/courses/thumbnails/course-%d.png", agg.ID),
-		DetailImage:     fmt.Sprintf("/images/courses/detail/course-%d.png", agg.ID),
-		Nav:             navs,
-		Notes:           agg.Notes,
-		Styles:          agg.Styles,
-		Ratings: models.CourseRatingsDto{
-			Tech:    agg.Ratings.Tech,
-			Speed:   agg.Ratings.Speed,
-			Scenery: agg.Ratings.Scenery,
-			Road:    agg.Ratings.Road,
-			Access:  agg.Ratings.Access,
-		},
-	}
-} 
\ No newline at end of file
@@ -0,0 +1,160 @@
+package query
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	appQuery "github.com/sunDar0/winding-road-finder/backend/application/query"
+	"github.com/sunDar0/winding-road-finder/backend/domain/drive"
+	"github.com/sunDar0/winding-road-finder/backend/interfaces/messages"
+	"github.com/sunDar0/winding-road-finder/backend/middlewares"
+	"github.com/sunDar0/winding-road-finder/backend/models"
+)
+
+// DriveQueryController는 주행 기록과 통계 조회 요청을 처리합니다.
+type DriveQueryController struct {
+	service *appQuery.DriveQueryService
+}
+
+func NewDriveQueryController(service *appQuery.DriveQueryService) *DriveQueryController {
+	return &DriveQueryController{service: service}
+}
+
+// RegisterRoutes는 Gin 라우터에 엔드포인트를 등록합니다.
+func (ctrl *DriveQueryController) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.GET("/me/drives", middlewares.RequireAuth(), ctrl.GetMyDrives)
+	rg.GET("/me/drives/stats", middlewares.RequireAuth(), ctrl.GetMyStats)
+	rg.GET("/me/drives/:id", middlewares.RequireAuth(), ctrl.GetMyDrive)
+}
+
+// @Summary 내 주행 기록 목록 조회
+// @Description 로그인한 사용자의 주행 기록을 주행일 최신순으로 조회합니다. 궤적 점은 포함하지 않습니다.
+// @Tags drives
+// @Produce json
+// @Security BearerAuth
+// @Param courseId query int false "코스 ID로 필터"
+// @Success 200 {array} models.DriveDto
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /me/drives [get]
+func (ctrl *DriveQueryController) GetMyDrives(c *gin.Context) {
+	courseID, err := queryInt(c, "courseId", 0)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	drives, err := ctrl.service.GetMyDrives(principal, courseID)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
+	}
+	dtos := make([]models.DriveDto, 0, len(drives))
+	for _, d := range drives {
+		dtos = append(dtos, toDriveDto(d))
+	}
+	c.JSON(http.StatusOK, dtos)
+}
+
+// @Summary 내 주행 기록 상세 조회
+// @Description 주행 기록을 궤적 점과 함께 조회합니다.
+// @Tags drives
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "주행 기록 ID"
+// @Success 200 {object} models.DriveDto
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /me/drives/{id} [get]
+func (ctrl *DriveQueryController) GetMyDrive(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidID, nil)
+		return
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	d, err := ctrl.service.GetMyDrive(principal, id)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
+	}
+	if d == nil {
+		respondError(c, http.StatusNotFound, messages.DriveNotFound, nil)
+		return
+	}
+	dto := toDriveDto(d)
+	if d.Track != nil {
+		dto.Track = make([][2]float64, len(d.Track.Points))
+		for i, p := range d.Track.Points {
+			dto.Track[i] = [2]float64{p.Latitude, p.Longitude}
+		}
+	}
+	c.JSON(http.StatusOK, dto)
+}
+
+// @Summary 내 주행 통계 조회
+// @Description 주행 횟수, 완주 코스 수, 총 거리와 시·도별, 추천 카테고리별 완주 현황을 조회합니다.
+// @Tags drives
+// @Produce json
+// @Security BearerAuth
+// @Param lang query string false "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름"
+// @Success 200 {object} models.DriveStatsDto
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /me/drives/stats [get]
+func (ctrl *DriveQueryController) GetMyStats(c *gin.Context) {
+	principal, _ := middlewares.PrincipalFrom(c)
+	stats, err := ctrl.service.GetMyStats(principal)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
+	}
+	lang := middlewares.LangFrom(c)
+	dto := models.DriveStatsDto{
+		TotalDrives:      stats.TotalDrives,
+		CoursesCompleted: len(stats.CompletedCourses),
+		TotalKm:          math.Round(stats.TotalKm*10) / 10,
+		Regions:          []models.RegionProgressDto{},
+		Categories:       []models.CategoryProgressDto{},
+	}
+	for _, p := range stats.Regions {
+		dto.Regions = append(dto.Regions, models.RegionProgressDto{
+			Code:        p.Region.Code,
+			Name:        p.Region.Name.In(lang),
+			ProgressDto: models.ProgressDto{Completed: p.Completed, Total: p.Total},
+		})
+	}
+	for _, p := range stats.Categories {
+		dto.Categories = append(dto.Categories, models.CategoryProgressDto{
+			ID:          p.ID,
+			Title:       p.Title.In(lang),
+			ProgressDto: models.ProgressDto{Completed: p.Completed, Total: p.Total},
+		})
+	}
+	c.JSON(http.StatusOK, dto)
+}
+
+// 도메인 모델을 DTO로 변환 (궤적 점 제외)
+func toDriveDto(d *drive.Drive) models.DriveDto {
+	dto := models.DriveDto{
+		ID:        d.ID,
+		CourseID:  d.CourseID,
+		DrivenOn:  d.DrivenOn.Format("2006-01-02"),
+		Direction: string(d.Direction),
+		Car:       d.Car,
+		Weather:   string(d.Weather),
+		HasTrack:  d.Track != nil,
+		CreatedAt: d.CreatedAt,
+		UpdatedAt: d.UpdatedAt,
+	}
+	if d.Track != nil {
+		km := math.Round(d.Track.DistanceKm()*10) / 10
+		dto.TrackDistanceKm = &km
+	}
+	return dto
+}
@@ -0,0 +1,95 @@
+package query
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	appQuery "github.com/sunDar0/winding-road-finder/backend/application/query"
+	"github.com/sunDar0/winding-road-finder/backend/domain/hazard"
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+	"github.com/sunDar0/winding-road-finder/backend/interfaces/messages"
+	"github.com/sunDar0/winding-road-finder/backend/middlewares"
+	"github.com/sunDar0/winding-road-finder/backend/models"
+)
+
+// HazardQueryController는 코스 위험 신고 조회 요청을 처리합니다.
+type HazardQueryController struct {
+	service       *appQuery.HazardQueryService
+	courseService *appQuery.CourseQueryService
+}
+
+func NewHazardQueryController(service *appQuery.HazardQueryService, courseService *appQuery.CourseQueryService) *HazardQueryController {
+	return &HazardQueryController{service: service, courseService: courseService}
+}
+
+// RegisterRoutes는 Gin 라우터에 엔드포인트를 등록합니다.
+func (ctrl *HazardQueryController) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.GET("/courses/:id/hazards", ctrl.GetHazards)
+}
+
+// @Summary 코스 위험 신고 조회
+// @Description 코스에 신고된 통제, 자갈, 낙석, 단속 등 일시적인 위험 요소를 최신순으로 조회합니다. 기본값은 활성 신고만 반환합니다.
+// @Tags hazards
+// @Produce json
+// @Param lang query string false "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름"
+// @Param id path int true "코스 ID"
+// @Param all query bool false "만료·해소된 신고 포함 여부"
+// @Success 200 {array} models.HazardDto
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /courses/{id}/hazards [get]
+func (ctrl *HazardQueryController) GetHazards(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidID, nil)
+		return
+	}
+	all, err := strconv.ParseBool(c.DefaultQuery("all", "false"))
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	agg, err := ctrl.courseService.GetCourseByID(c.Request.Context(), id)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
+	}
+	if agg == nil {
+		respondError(c, http.StatusNotFound, messages.CourseNotFound, nil)
+		return
+	}
+	hazards, err := ctrl.service.GetHazards(id, all)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
+	}
+	lang := middlewares.LangFrom(c)
+	dtos := make([]models.HazardDto, 0, len(hazards))
+	for _, h := range hazards {
+		dtos = append(dtos, toHazardDto(h, ctrl.service.IsActive(h), lang))
+	}
+	c.JSON(http.StatusOK, dtos)
+}
+
+// 도메인 모델을 DTO로 변환
+func toHazardDto(h *hazard.Hazard, active bool, lang i18n.Lang) models.HazardDto {
+	confirms, dismisses := h.Tally()
+	return models.HazardDto{
+		ID:            h.ID,
+		CourseID:      h.CourseID,
+		Type:          string(h.Type),
+		TypeName:      messages.Get(lang, messages.HazardTypeKey(string(h.Type))),
+		Severity:      h.Severity.String(),
+		Latitude:      h.Location.Latitude,
+		Longitude:     h.Location.Longitude,
+		Note:          h.Note,
+		ReportedAt:    h.ReportedAt,
+		ExpiresAt:     h.ExpiresAt,
+		Confirmations: confirms,
+		Dismissals:    dismisses,
+		Active:        active,
+		Hidden:        h.Hidden,
+	}
+}
@@ -0,0 +1,39 @@
+package query
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+	"github.com/sunDar0/winding-road-finder/backend/middlewares"
+	"github.com/sunDar0/winding-road-finder/backend/models"
+)
+
+// LogQueryController는 서버 로그 레벨 조회 요청을 처리합니다.
+type LogQueryController struct {
+	level *slog.LevelVar
+}
+
+func NewLogQueryController(level *slog.LevelVar) *LogQueryController {
+	return &LogQueryController{level: level}
+}
+
+// RegisterRoutes는 Gin 라우터에 엔드포인트를 등록합니다. admin 권한이 필요합니다.
+func (ctrl *LogQueryController) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.GET("/admin/log-level", middlewares.RequireRole(user.RoleAdmin), ctrl.GetLogLevel)
+}
+
+// @Summary 로그 레벨 조회
+// @Description 현재 서버 로그 레벨을 조회합니다.
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} models.LogLevelDto
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Router /admin/log-level [get]
+func (ctrl *LogQueryController) GetLogLevel(c *gin.Context) {
+	c.JSON(http.StatusOK, models.LogLevelDto{Level: strings.ToLower(ctrl.level.Level().String())})
+}
@@ -0,0 +1,123 @@
+package query
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+)
+
+// queryInt는 정수 쿼리 파라미터를 읽습니다. 값이 없으면 def를 반환합니다.
+func queryInt(c *gin.Context, key string, def int) (int, error) {
+	raw := c.Query(key)
+	if raw == "" {
+		return def, nil
+	}
+	v, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, fmt.Errorf("%s: 정수가 아닙니다", key)
+	}
+	return v, nil
+}
+
+// queryFloat는 실수 쿼리 파라미터를 읽습니다. 값이 없으면 def를 반환합니다. NaN과 무한대는 받지 않습니다.
+func queryFloat(c *gin.Context, key string, def float64) (float64, error) {
+	raw := c.Query(key)
+	if raw == "" {
+		return def, nil
+	}
+	v, err := strconv.ParseFloat(raw, 64)
+	if err != nil || !finite(v) || v < 0 {
+		return 0, fmt.Errorf("%s: 0 이상의 숫자가 아닙니다", key)
+	}
+	return v, nil
+}
+
+// queryRating은 1~5 범위의 평가 점수 쿼리 파라미터를 읽습니다. 값이 없으면 false를 반환합니다.
+func queryRating(c *gin.Context, key string) (int, bool, error) {
+	if c.Query(key) == "" {
+		return 0, false, nil
+	}
+	v, err := queryInt(c, key, 0)
+	if err != nil {
+		return 0, false, err
+	}
+	if v < course.MinRating || v > course.MaxRating {
+		return 0, false, fmt.Errorf("%s: %d~%d 범위를 벗어났습니다", key, course.MinRating, course.MaxRating)
+	}
+	return v, true, nil
+}
+
+// queryDate는 "YYYY-MM-DD" 형식의 날짜 쿼리 파라미터를 KST 기준으로 읽습니다. 값이 없으면 nil을 반환합니다.
+func queryDate(c *gin.Context, key string) (*time.Time, error) {
+	raw := c.Query(key)
+	if raw == "" {
+		return nil, nil
+	}
+	t, err := time.ParseInLocation("2006-01-02", raw, course.KST)
+	if err != nil {
+		return nil, fmt.Errorf("%s: YYYY-MM-DD 형식이 아닙니다", key)
+	}
+	return &t, nil
+}
+
+// queryLatLng는 "위도,경도" 형식의 좌표 쿼리 파라미터를 읽습니다. 값이 없으면 nil을 반환합니다.
+func queryLatLng(c *gin.Context, key string) (*course.CourseGeolocation, error) {
+	raw := c.Query(key)
+	if raw == "" {
+		return nil, nil
+	}
+	parts := strings.Split(raw, ",")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("%s: '위도,경도' 형식이 아닙니다", key)
+	}
+	lat, err1 := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	lng, err2 := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+	if err1 != nil || err2 != nil || !finite(lat) || !finite(lng) || lat < -90 || lat > 90 || lng < -180 || lng > 180 {
+		return nil, fmt.Errorf("%s: 좌표가 올바르지 않습니다", key)
+	}
+	return &course.CourseGeolocation{Latitude: lat, Longitude: lng}, nil
+}
+
+// finite는 v가 NaN이나 무한대가 아닌지 확인합니다. strconv.ParseFloat는 "NaN", "Inf"도 숫자로 읽습니다.
+func finite(v float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0)
+}
+
+// queryList는 쉼표로 구분된 쿼리 파라미터를 목록으로 읽습니다.
+func queryList(c *gin.Context, key string) []string {
+	var items []string
+	for _, raw := range c.QueryArray(key) {
+		for _, item := range strings.Split(raw, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+	}
+	return items
+}
+
+// capitalize는 첫 글자를 대문자로 바꿉니다. (예: access → Access)
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// queryTime은 RFC 3339 형식의 시각 쿼리 파라미터를 읽습니다. 값이 없으면 nil을 반환합니다.
+func queryTime(c *gin.Context, key string) (*time.Time, error) {
+	raw := c.Query(key)
+	if raw == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return nil, fmt.Errorf("%s: RFC 3339 형식(예: 2025-05-01T09:00:00+09:00)이 아닙니다", key)
+	}
+	return &t, nil
+}
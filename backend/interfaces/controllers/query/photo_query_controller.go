@@ -0,0 +1,82 @@
+package query
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	appQuery "github.com/sunDar0/winding-road-finder/backend/application/query"
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/interfaces/messages"
+	"github.com/sunDar0/winding-road-finder/backend/models"
+)
+
+// PhotoQueryController는 코스 사진 갤러리 조회 요청을 처리합니다.
+type PhotoQueryController struct {
+	service *appQuery.PhotoQueryService
+}
+
+func NewPhotoQueryController(service *appQuery.PhotoQueryService) *PhotoQueryController {
+	return &PhotoQueryController{service: service}
+}
+
+// RegisterRoutes는 Gin 라우터에 엔드포인트를 등록합니다.
+func (ctrl *PhotoQueryController) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.GET("/courses/:id/photos", ctrl.GetCoursePhotos)
+}
+
+// @Summary 코스 사진 갤러리
+// @Description 코스에 올린 사진을 경로 순서(출발지에서 가까운 순)로 조회합니다. 경로 위에 배치하지 못한 사진은 뒤에 올린 순서대로 붙습니다.
+// @Tags photos
+// @Produce json
+// @Param id path int true "코스 ID"
+// @Success 200 {array} models.PhotoDto
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /courses/{id}/photos [get]
+func (ctrl *PhotoQueryController) GetCoursePhotos(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidID, nil)
+		return
+	}
+	views, err := ctrl.service.GetCoursePhotos(id)
+	if errors.Is(err, course.ErrCourseNotFound) {
+		respondError(c, http.StatusNotFound, messages.CourseNotFound, nil)
+		return
+	}
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
+	}
+	dtos := make([]models.PhotoDto, 0, len(views))
+	for _, v := range views {
+		dtos = append(dtos, toPhotoDto(v))
+	}
+	c.JSON(http.StatusOK, dtos)
+}
+
+// 도메인 모델을 DTO로 변환
+func toPhotoDto(view *appQuery.PhotoView) models.PhotoDto {
+	p := view.Photo
+	dto := models.PhotoDto{
+		ID:         p.ID,
+		CourseID:   p.CourseID,
+		Caption:    p.Caption,
+		RouteKm:    p.RouteKm,
+		Variants:   make([]models.PhotoVariantDto, len(p.Variants)),
+		UploadedAt: p.UploadedAt,
+	}
+	if view.Uploader != nil {
+		dto.Uploader = &models.PhotoUserDto{ID: view.Uploader.ID, DisplayName: view.Uploader.DisplayName}
+	}
+	if l := p.RoutePoint; l != nil {
+		dto.RoutePoint = &models.CourseGeolocationDto{Latitude: l.Latitude, Longitude: l.Longitude}
+	}
+	for i, v := range p.Variants {
+		dto.Variants[i] = models.PhotoVariantDto{Name: string(v.Name), URL: view.URLs[v.Name], Width: v.Width, Height: v.Height}
+	}
+	return dto
+}
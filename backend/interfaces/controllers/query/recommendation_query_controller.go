@@ -0,0 +1,213 @@
+package query
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	appQuery "github.com/sunDar0/winding-road-finder/backend/application/query"
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/recommendation"
+	"github.com/sunDar0/winding-road-finder/backend/domain/style"
+	"github.com/sunDar0/winding-road-finder/backend/interfaces/messages"
+)
+
+const (
+	defaultRecommendationLimit = 10
+	maxRecommendationLimit     = 60
+)
+
+// RecommendationQueryController는 점수 기반 코스 추천 요청을 처리합니다.
+type RecommendationQueryController struct {
+	recService *appQuery.RecommendationQueryService
+	mappers    *CourseMapperFactory
+}
+
+func NewRecommendationQueryController(recService *appQuery.RecommendationQueryService, mappers *CourseMapperFactory) *RecommendationQueryController {
+	return &RecommendationQueryController{recService: recService, mappers: mappers}
+}
+
+// RegisterRoutes는 Gin 라우터에 엔드포인트를 등록합니다.
+func (ctrl *RecommendationQueryController) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.GET("/recommendations/personal", ctrl.GetPersonalRecommendations)
+	rg.GET("/courses/:id/similar", ctrl.GetSimilarCourses)
+}
+
+// @Summary 개인화 추천 코스 조회
+// @Description 평가 항목별 희망 점수, 위치, 선호 스타일로 전체 코스를 점수화해 순위와 추천 사유를 반환합니다.
+// @Tags recommendations
+// @Accept json
+// @Produce json
+// @Param lang query string false "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름"
+// @Param tech query int false "희망 기술 점수 (1~5)"
+// @Param speed query int false "희망 속도 점수 (1~5)"
+// @Param scenery query int false "희망 경관 점수 (1~5)"
+// @Param road query int false "희망 노면 점수 (1~5)"
+// @Param access query int false "희망 접근성 점수 (1~5)"
+// @Param minTech query int false "기술 점수 하한. 다른 항목도 min<Axis>/max<Axis> 형식으로 지정 가능"
+// @Param maxAccess query int false "접근성 점수 상한"
+// @Param near query string false "사용자 위치 (위도,경도)"
+// @Param radius query number false "거리 점수가 0이 되는 반경(km), 기본 200"
+// @Param styles query string false "선호 스타일 (쉼표 구분, slug/이름/동의어)"
+// @Param ratingsWeight query number false "평가 점수 가중치, 기본 0.6"
+// @Param distanceWeight query number false "거리 가중치, 기본 0.25"
+// @Param styleWeight query number false "스타일 가중치, 기본 0.15"
+// @Param limit query int false "최대 결과 수, 기본 10"
+// @Success 200 {array} models.ScoredCourseDto
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /recommendations/personal [get]
+func (ctrl *RecommendationQueryController) GetPersonalRecommendations(c *gin.Context) {
+	pref, err := parsePreference(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	limit, err := queryInt(c, "limit", defaultRecommendationLimit)
+	if err != nil || limit < 1 || limit > maxRecommendationLimit {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, errors.New("limit: 1~60 범위를 벗어났습니다"))
+		return
+	}
+	scored, err := ctrl.recService.GetPersonalRecommendations(pref, limit)
+	if errors.Is(err, style.ErrUnknownStyle) {
+		respondError(c, http.StatusBadRequest, messages.UnknownStyle, err)
+		return
+	}
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
+	}
+	mapper, err := ctrl.mappers.forRequest(c)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
+	}
+	c.JSON(http.StatusOK, mapper.toScoredCourseDtos(scored))
+}
+
+// @Summary 유사 코스 조회
+// @Description 기준 코스와 평가 점수, 스타일, 지역 근접도, 특징 설명이 비슷한 코스를 점수 순으로 반환합니다.
+// @Tags courses
+// @Accept json
+// @Produce json
+// @Param lang query string false "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름"
+// @Param id path int true "기준 코스 ID"
+// @Param excludeSameRegion query bool false "같은 시·도의 코스 제외 여부"
+// @Param radius query number false "지역 근접도 점수가 0이 되는 출발지 간 거리(km), 기본 150"
+// @Param ratingsWeight query number false "평가 점수 가중치, 기본 0.4"
+// @Param styleWeight query number false "스타일 가중치, 기본 0.25"
+// @Param regionWeight query number false "지역 근접도 가중치, 기본 0.2"
+// @Param textWeight query number false "특징 설명 유사도 가중치, 기본 0.15"
+// @Param limit query int false "최대 결과 수, 기본 10"
+// @Success 200 {array} models.ScoredCourseDto
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /courses/{id}/similar [get]
+func (ctrl *RecommendationQueryController) GetSimilarCourses(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidID, nil)
+		return
+	}
+	opts, err := parseSimilarOptions(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	limit, err := queryInt(c, "limit", defaultRecommendationLimit)
+	if err != nil || limit < 1 || limit > maxRecommendationLimit {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, errors.New("limit: 1~60 범위를 벗어났습니다"))
+		return
+	}
+	scored, err := ctrl.recService.GetSimilarCourses(id, opts, limit)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
+	}
+	if scored == nil {
+		respondError(c, http.StatusNotFound, messages.CourseNotFound, nil)
+		return
+	}
+	mapper, err := ctrl.mappers.forRequest(c)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
+	}
+	c.JSON(http.StatusOK, mapper.toScoredCourseDtos(scored))
+}
+
+// parseSimilarOptions는 쿼리 파라미터로 유사 코스 계산 옵션을 구성합니다.
+func parseSimilarOptions(c *gin.Context) (recommendation.SimilarOptions, error) {
+	opts := recommendation.SimilarOptions{ExcludeSameRegion: c.Query("excludeSameRegion") == "true"}
+	var err error
+	if opts.RadiusKm, err = queryFloat(c, "radius", recommendation.DefaultSimilarRadiusKm); err != nil {
+		return opts, err
+	}
+	opts.Weights, err = parseWeights(c, recommendation.DefaultSimilarityWeights, map[string]recommendation.Component{
+		"ratingsWeight": recommendation.ComponentRatings,
+		"styleWeight":   recommendation.ComponentStyle,
+		"regionWeight":  recommendation.ComponentRegion,
+		"textWeight":    recommendation.ComponentText,
+	})
+	return opts, err
+}
+
+// parsePreference는 쿼리 파라미터로 사용자 취향을 구성합니다.
+func parsePreference(c *gin.Context) (recommendation.Preference, error) {
+	pref := recommendation.Preference{
+		Targets: map[course.RatingAxis]int{},
+		Min:     map[course.RatingAxis]int{},
+		Max:     map[course.RatingAxis]int{},
+		Styles:  queryList(c, "styles"),
+	}
+	for _, axis := range course.RatingAxes {
+		key := string(axis)
+		if v, ok, err := queryRating(c, key); err != nil {
+			return pref, err
+		} else if ok {
+			pref.Targets[axis] = v
+		}
+		if v, ok, err := queryRating(c, "min"+capitalize(key)); err != nil {
+			return pref, err
+		} else if ok {
+			pref.Min[axis] = v
+		}
+		if v, ok, err := queryRating(c, "max"+capitalize(key)); err != nil {
+			return pref, err
+		} else if ok {
+			pref.Max[axis] = v
+		}
+	}
+
+	var err error
+	if pref.Near, err = queryLatLng(c, "near"); err != nil {
+		return pref, err
+	}
+	if pref.RadiusKm, err = queryFloat(c, "radius", recommendation.DefaultRadiusKm); err != nil {
+		return pref, err
+	}
+	pref.Weights, err = parseWeights(c, recommendation.DefaultPreferenceWeights, map[string]recommendation.Component{
+		"ratingsWeight":  recommendation.ComponentRatings,
+		"distanceWeight": recommendation.ComponentDistance,
+		"styleWeight":    recommendation.ComponentStyle,
+	})
+	return pref, err
+}
+
+// parseWeights는 기본 가중치에 쿼리 파라미터로 지정된 가중치를 덮어씁니다.
+func parseWeights(c *gin.Context, defaults recommendation.Weights, params map[string]recommendation.Component) (recommendation.Weights, error) {
+	weights := make(recommendation.Weights, len(defaults))
+	for comp, w := range defaults {
+		weights[comp] = w
+	}
+	for key, comp := range params {
+		w, err := queryFloat(c, key, weights[comp])
+		if err != nil {
+			return nil, err
+		}
+		weights[comp] = w
+	}
+	return weights, nil
+}
@@ -0,0 +1,87 @@
+package query
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestQueryFloat(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tests := []struct {
+		query string
+		want  float64
+		ok    bool
+	}{
+		{"", 0.5, true},
+		{"?w=0", 0, true},
+		{"?w=1.25", 1.25, true},
+		{"?w=1e2", 100, true},
+		{"?w=-0.1", 0, false},
+		{"?w=NaN", 0, false},
+		{"?w=nan", 0, false},
+		{"?w=Inf", 0, false},
+		{"?w=%2BInf", 0, false},
+		{"?w=-Inf", 0, false},
+		{"?w=1e400", 0, false},
+		{"?w=abc", 0, false},
+	}
+	for _, tt := range tests {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest("GET", "/"+tt.query, nil)
+		got, err := queryFloat(c, "w", 0.5)
+		if (err == nil) != tt.ok || got != tt.want {
+			t.Errorf("queryFloat(%q) = %v, %v; want %v, ok=%v", tt.query, got, err, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestQueryLatLng(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tests := []struct {
+		query string
+		ok    bool
+	}{
+		{"", true},
+		{"?near=37.5,127.0", true},
+		{"?near=-90,180", true},
+		{"?near=37.5", false},
+		{"?near=91,127", false},
+		{"?near=37.5,-181", false},
+		{"?near=NaN,127", false},
+		{"?near=37.5,NaN", false},
+		{"?near=Inf,127", false},
+		{"?near=37.5,-Inf", false},
+	}
+	for _, tt := range tests {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest("GET", "/"+tt.query, nil)
+		if _, err := queryLatLng(c, "near"); (err == nil) != tt.ok {
+			t.Errorf("queryLatLng(%q) error = %v, want ok=%v", tt.query, err, tt.ok)
+		}
+	}
+}
+
+// 숫자가 아닌 가중치, 반경, 위치는 추천을 계산하기 전에 400으로 거절한다.
+func TestRecommendationRejectsNonFiniteParams(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	NewRecommendationQueryController(nil, nil).RegisterRoutes(r.Group("/api"))
+	for _, target := range []string{
+		"/api/recommendations/personal?ratingsWeight=NaN",
+		"/api/recommendations/personal?distanceWeight=Inf",
+		"/api/recommendations/personal?radius=%2BInf",
+		"/api/recommendations/personal?near=NaN,127",
+		"/api/courses/1/similar?ratingsWeight=NaN",
+		"/api/courses/1/similar?textWeight=-Inf",
+		"/api/courses/1/similar?radius=Inf",
+	} {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("GET", target, nil))
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("GET %s = %d, want %d", target, w.Code, http.StatusBadRequest)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package query
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	appQuery "github.com/sunDar0/winding-road-finder/backend/application/query"
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+	"github.com/sunDar0/winding-road-finder/backend/domain/region"
+	"github.com/sunDar0/winding-road-finder/backend/interfaces/messages"
+	"github.com/sunDar0/winding-road-finder/backend/middlewares"
+	"github.com/sunDar0/winding-road-finder/backend/models"
+)
+
+// RegionQueryController는 지역 목록/상세 조회 요청을 처리합니다.
+type RegionQueryController struct {
+	service *appQuery.RegionQueryService
+}
+
+func NewRegionQueryController(service *appQuery.RegionQueryService) *RegionQueryController {
+	return &RegionQueryController{service: service}
+}
+
+// RegisterRoutes는 Gin 라우터에 엔드포인트를 등록합니다.
+func (ctrl *RegionQueryController) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.GET("/regions", ctrl.GetRegions)
+	rg.GET("/regions/:code", ctrl.GetRegionByCode)
+}
+
+// @Summary 지역 목록 조회
+// @Description 시·도 목록을 하위 시·군·구와 함께 조회합니다.
+// @Tags regions
+// @Accept json
+// @Produce json
+// @Param lang query string false "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름"
+// @Param boundary query bool false "경계 다각형 포함 여부"
+// @Success 200 {array} models.RegionDto
+// @Failure 500 {object} models.ErrorResponse
+// @Router /regions [get]
+func (ctrl *RegionQueryController) GetRegions(c *gin.Context) {
+	lang := middlewares.LangFrom(c)
+	withBoundary := c.Query("boundary") == "true"
+	regions, err := ctrl.service.GetRegions()
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
+	}
+	var dtos []models.RegionDto
+	for _, r := range regions {
+		dtos = append(dtos, toRegionDto(r, lang, withBoundary))
+	}
+	c.JSON(http.StatusOK, dtos)
+}
+
+// @Summary 지역 상세 조회
+// @Description 지역 코드 또는 이름으로 지역 정보와 경계 다각형을 조회합니다.
+// @Tags regions
+// @Accept json
+// @Produce json
+// @Param lang query string false "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름"
+// @Param code path string true "지역 코드 또는 이름"
+// @Success 200 {object} models.RegionDto
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /regions/{code} [get]
+func (ctrl *RegionQueryController) GetRegionByCode(c *gin.Context) {
+	r, err := ctrl.service.GetRegionByCode(c.Param("code"))
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
+	}
+	if r == nil {
+		respondError(c, http.StatusNotFound, messages.RegionNotFound, nil)
+		return
+	}
+	c.JSON(http.StatusOK, toRegionDto(r, middlewares.LangFrom(c), true))
+}
+
+// 도메인 모델을 DTO로 변환
+func toRegionDto(r *appQuery.RegionWithChildren, lang i18n.Lang, withBoundary bool) models.RegionDto {
+	dto := toRegionItemDto(r.Region, lang, withBoundary)
+	for _, child := range r.Children {
+		dto.Children = append(dto.Children, toRegionItemDto(child, lang, withBoundary))
+	}
+	return dto
+}
+
+func toRegionItemDto(r *region.Region, lang i18n.Lang, withBoundary bool) models.RegionDto {
+	dto := models.RegionDto{
+		Code:       r.Code,
+		Name:       r.Name.In(lang),
+		Level:      string(r.Level),
+		ParentCode: r.ParentCode,
+		BBox:       r.Boundary.BBox(),
+	}
+	if withBoundary {
+		dto.Boundary = toRegionBoundaryDto(r.Boundary)
+	}
+	return dto
+}
+
+func toRegionBoundaryDto(b region.Boundary) *models.RegionBoundaryDto {
+	coords := make([][][][2]float64, len(b))
+	for i, poly := range b {
+		coords[i] = make([][][2]float64, len(poly))
+		for j, ring := range poly {
+			coords[i][j] = make([][2]float64, len(ring))
+			for k, pt := range ring {
+				coords[i][j][k] = [2]float64{pt.Longitude, pt.Latitude}
+			}
+		}
+	}
+	return &models.RegionBoundaryDto{Type: "MultiPolygon", Coordinates: coords}
+}
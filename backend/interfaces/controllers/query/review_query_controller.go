@@ -0,0 +1,119 @@
+package query
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	appQuery "github.com/sunDar0/winding-road-finder/backend/application/query"
+	"github.com/sunDar0/winding-road-finder/backend/interfaces/messages"
+	"github.com/sunDar0/winding-road-finder/backend/models"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+	// maxPage는 page 파라미터의 상한입니다. 이보다 뒤 페이지는 어차피 비어 있습니다.
+	maxPage = 100000
+)
+
+// ReviewQueryController는 코스 리뷰 목록 조회 요청을 처리합니다.
+type ReviewQueryController struct {
+	service       *appQuery.ReviewQueryService
+	courseService *appQuery.CourseQueryService
+}
+
+func NewReviewQueryController(service *appQuery.ReviewQueryService, courseService *appQuery.CourseQueryService) *ReviewQueryController {
+	return &ReviewQueryController{service: service, courseService: courseService}
+}
+
+// RegisterRoutes는 Gin 라우터에 엔드포인트를 등록합니다.
+func (ctrl *ReviewQueryController) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.GET("/courses/:id/reviews", ctrl.GetReviews)
+}
+
+// @Summary 코스 리뷰 목록 조회
+// @Description 코스 리뷰를 최신순으로 페이지 단위 조회합니다.
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Param lang query string false "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름"
+// @Param id path int true "코스 ID"
+// @Param page query int false "페이지 번호 (1~100000), 기본 1"
+// @Param size query int false "페이지 크기, 기본 20, 최대 100"
+// @Success 200 {object} models.ReviewPageDto
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /courses/{id}/reviews [get]
+func (ctrl *ReviewQueryController) GetReviews(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidID, nil)
+		return
+	}
+	page, size, err := queryPage(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	agg, err := ctrl.courseService.GetCourseByID(c.Request.Context(), id)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
+	}
+	if agg == nil {
+		respondError(c, http.StatusNotFound, messages.CourseNotFound, nil)
+		return
+	}
+	result, err := ctrl.service.GetReviews(id, page, size)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
+	}
+	dto := models.ReviewPageDto{Items: []models.ReviewDto{}, Page: page, Size: size, Total: result.Total}
+	for _, r := range result.Reviews {
+		dto.Items = append(dto.Items, toReviewDto(r))
+	}
+	c.JSON(http.StatusOK, dto)
+}
+
+// queryPage는 page, size 쿼리 파라미터를 읽습니다.
+func queryPage(c *gin.Context) (int, int, error) {
+	page, err := queryInt(c, "page", 1)
+	if err != nil {
+		return 0, 0, err
+	}
+	size, err := queryInt(c, "size", defaultPageSize)
+	if err != nil {
+		return 0, 0, err
+	}
+	if page < 1 || page > maxPage || size < 1 || size > maxPageSize {
+		return 0, 0, errors.New("page는 1~100000, size는 1~100 범위여야 합니다")
+	}
+	return page, size, nil
+}
+
+// 도메인 모델을 DTO로 변환
+func toReviewDto(r *appQuery.ReviewWithAuthor) models.ReviewDto {
+	dto := models.ReviewDto{
+		ID:       r.ID,
+		CourseID: r.CourseID,
+		Ratings: models.CourseRatingsDto{
+			Tech:    r.Ratings.Tech,
+			Speed:   r.Ratings.Speed,
+			Scenery: r.Ratings.Scenery,
+			Road:    r.Ratings.Road,
+			Access:  r.Ratings.Access,
+		},
+		Text:      r.Text,
+		VisitedOn: r.VisitedOn.Format("2006-01-02"),
+		CreatedAt: r.CreatedAt,
+		Hidden:    r.Hidden,
+	}
+	if r.Author != nil {
+		dto.Author = &models.ReviewAuthorDto{ID: r.Author.ID, DisplayName: r.Author.DisplayName}
+	}
+	return dto
+}
@@ -0,0 +1,37 @@
+package query
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestQueryPage(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tests := []struct {
+		query      string
+		page, size int
+		ok         bool
+	}{
+		{"", 1, defaultPageSize, true},
+		{"?page=3&size=50", 3, 50, true},
+		{"?page=100000&size=100", maxPage, maxPageSize, true},
+		{"?page=0", 0, 0, false},
+		{"?page=-1", 0, 0, false},
+		{"?page=100001", 0, 0, false},
+		{"?page=9223372036854775807&size=20", 0, 0, false},
+		{"?page=99999999999999999999", 0, 0, false},
+		{"?size=0", 0, 0, false},
+		{"?size=101", 0, 0, false},
+		{"?page=abc", 0, 0, false},
+	}
+	for _, tt := range tests {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest("GET", "/"+tt.query, nil)
+		page, size, err := queryPage(c)
+		if (err == nil) != tt.ok || page != tt.page || size != tt.size {
+			t.Errorf("queryPage(%q) = %d, %d, %v; want %d, %d, ok=%v", tt.query, page, size, err, tt.page, tt.size, tt.ok)
+		}
+	}
+}
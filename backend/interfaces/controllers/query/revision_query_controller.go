@@ -0,0 +1,219 @@
+package query
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	appQuery "github.com/sunDar0/winding-road-finder/backend/application/query"
+	"github.com/sunDar0/winding-road-finder/backend/domain/revision"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+	"github.com/sunDar0/winding-road-finder/backend/interfaces/messages"
+	"github.com/sunDar0/winding-road-finder/backend/middlewares"
+	"github.com/sunDar0/winding-road-finder/backend/models"
+)
+
+// RevisionQueryController는 코스 수정안 조회 요청을 처리합니다.
+type RevisionQueryController struct {
+	service *appQuery.RevisionQueryService
+	mappers *CourseMapperFactory
+}
+
+func NewRevisionQueryController(service *appQuery.RevisionQueryService, mappers *CourseMapperFactory) *RevisionQueryController {
+	return &RevisionQueryController{service: service, mappers: mappers}
+}
+
+// RegisterRoutes는 Gin 라우터에 엔드포인트를 등록합니다.
+func (ctrl *RevisionQueryController) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.GET("/me/revisions", middlewares.RequireAuth(), ctrl.GetMyRevisions)
+	rg.GET("/revisions/:id", middlewares.RequireAuth(), ctrl.GetRevision)
+	rg.GET("/revisions/:id/diff", middlewares.RequireAuth(), ctrl.GetRevisionDiff)
+	rg.GET("/admin/revisions", middlewares.RequireRole(user.RoleEditor), ctrl.GetRevisions)
+}
+
+// @Summary 내 코스 수정안 목록 조회
+// @Description 로그인한 사용자가 작성한 수정안을 최근 수정 순으로 조회합니다.
+// @Tags revisions
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "상태 (draft, submitted, rejected, approved)"
+// @Param page query int false "페이지 번호 (1~100000), 기본 1"
+// @Param size query int false "페이지 크기, 기본 20, 최대 100"
+// @Success 200 {object} models.RevisionPageDto
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /me/revisions [get]
+func (ctrl *RevisionQueryController) GetMyRevisions(c *gin.Context) {
+	filter, ok := revisionFilter(c)
+	if !ok {
+		return
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	filter.AuthorID = principal.UserID
+	ctrl.respondPage(c, filter)
+}
+
+// @Summary 코스 수정안 검토 목록 조회
+// @Description 모든 사용자의 수정안을 최근 수정 순으로 조회합니다. 검토 대기 목록은 status=submitted로 조회합니다.
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "상태 (draft, submitted, rejected, approved)"
+// @Param courseId query int false "코스 ID. 생략하면 전체 (새 코스 제안 포함)"
+// @Param page query int false "페이지 번호 (1~100000), 기본 1"
+// @Param size query int false "페이지 크기, 기본 20, 최대 100"
+// @Success 200 {object} models.RevisionPageDto
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /admin/revisions [get]
+func (ctrl *RevisionQueryController) GetRevisions(c *gin.Context) {
+	filter, ok := revisionFilter(c)
+	if !ok {
+		return
+	}
+	var err error
+	if filter.CourseID, err = queryInt(c, "courseId", 0); err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	ctrl.respondPage(c, filter)
+}
+
+// @Summary 코스 수정안 조회
+// @Description 수정안과 제안한 코스 전체를 조회합니다. 작성자와 editor 이상 권한 사용자만 볼 수 있습니다.
+// @Tags revisions
+// @Produce json
+// @Security BearerAuth
+// @Param lang query string false "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름"
+// @Param id path int true "수정안 ID"
+// @Success 200 {object} models.RevisionDto
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /revisions/{id} [get]
+func (ctrl *RevisionQueryController) GetRevision(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidID, nil)
+		return
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	view, err := ctrl.service.GetRevision(principal, id)
+	if err != nil {
+		respondRevisionError(c, err)
+		return
+	}
+	mapper, err := ctrl.mappers.forRequest(c)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
+	}
+	dto := toRevisionDto(view)
+	courseDto := mapper.toCourseDto(view.Revision.Course)
+	dto.Course = &courseDto
+	c.JSON(http.StatusOK, dto)
+}
+
+// @Summary 코스 수정안 비교
+// @Description 수정안이 바꾸는 필드의 전후 값을 조회합니다. against를 생략하면 현재 게시된 코스와 비교하고,
+// @Description 다른 수정안 ID를 주면 그 수정안과 비교합니다. 새 코스 제안이거나 삭제된 코스면 모든 필드가 추가된 것으로 나옵니다.
+// @Tags revisions
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "수정안 ID"
+// @Param against query int false "비교할 수정안 ID. 생략하면 게시된 코스"
+// @Success 200 {object} models.RevisionDiffDto
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /revisions/{id}/diff [get]
+func (ctrl *RevisionQueryController) GetRevisionDiff(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidID, nil)
+		return
+	}
+	against, err := queryInt(c, "against", 0)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	diff, err := ctrl.service.GetDiff(principal, id, against)
+	if err != nil {
+		respondRevisionError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, models.RevisionDiffDto{
+		Revision:          toRevisionDto(diff.View),
+		AgainstRevisionID: against,
+		Changes:           toAuditChangeDtos(diff.Changes),
+	})
+}
+
+func (ctrl *RevisionQueryController) respondPage(c *gin.Context, filter revision.Filter) {
+	page, size, err := queryPage(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	result, err := ctrl.service.GetRevisions(filter, page, size)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
+	}
+	dto := models.RevisionPageDto{Items: make([]models.RevisionDto, len(result.Revisions)), Page: page, Size: size, Total: result.Total}
+	for i, view := range result.Revisions {
+		dto.Items[i] = toRevisionDto(view)
+	}
+	c.JSON(http.StatusOK, dto)
+}
+
+// revisionFilter는 status 파라미터를 읽습니다. 알 수 없는 상태면 400을 응답하고 false를 반환합니다.
+func revisionFilter(c *gin.Context) (revision.Filter, bool) {
+	filter := revision.Filter{Status: revision.Status(c.Query("status"))}
+	if filter.Status != "" && !filter.Status.Valid() {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, errors.New("status는 draft, submitted, rejected, approved 중 하나여야 합니다"))
+		return filter, false
+	}
+	return filter, true
+}
+
+func respondRevisionError(c *gin.Context, err error) {
+	if errors.Is(err, revision.ErrRevisionNotFound) {
+		respondError(c, http.StatusNotFound, messages.RevisionNotFound, nil)
+		return
+	}
+	respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+}
+
+func toRevisionDto(view *appQuery.RevisionView) models.RevisionDto {
+	r := view.Revision
+	dto := models.RevisionDto{
+		ID:             r.ID,
+		CourseID:       r.CourseID,
+		Status:         string(r.Status),
+		Summary:        r.Summary,
+		BaseVersion:    r.BaseVersion,
+		CurrentVersion: view.CurrentVersion,
+		Outdated:       view.Outdated(),
+		ReviewComment:  r.ReviewComment,
+		CreatedAt:      r.CreatedAt,
+		UpdatedAt:      r.UpdatedAt,
+		SubmittedAt:    r.SubmittedAt,
+		ReviewedAt:     r.ReviewedAt,
+	}
+	if view.Author != nil {
+		dto.Author = &models.RevisionUserDto{ID: view.Author.ID, DisplayName: view.Author.DisplayName}
+	}
+	if view.Reviewer != nil {
+		dto.Reviewer = &models.RevisionUserDto{ID: view.Reviewer.ID, DisplayName: view.Reviewer.DisplayName}
+	}
+	return dto
+}
@@ -0,0 +1,86 @@
+package query
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	appQuery "github.com/sunDar0/winding-road-finder/backend/application/query"
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+	"github.com/sunDar0/winding-road-finder/backend/domain/style"
+	"github.com/sunDar0/winding-road-finder/backend/interfaces/messages"
+	"github.com/sunDar0/winding-road-finder/backend/middlewares"
+	"github.com/sunDar0/winding-road-finder/backend/models"
+)
+
+// StyleQueryController는 스타일 분류 체계 조회 요청을 처리합니다.
+type StyleQueryController struct {
+	service *appQuery.StyleQueryService
+}
+
+func NewStyleQueryController(service *appQuery.StyleQueryService) *StyleQueryController {
+	return &StyleQueryController{service: service}
+}
+
+// RegisterRoutes는 Gin 라우터에 엔드포인트를 등록합니다.
+func (ctrl *StyleQueryController) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.GET("/styles", ctrl.GetStyles)
+	rg.GET("/styles/:slug", ctrl.GetStyle)
+}
+
+// @Summary 스타일 목록 조회
+// @Description 코스 주행 스타일 분류 체계를 조회합니다.
+// @Tags styles
+// @Accept json
+// @Produce json
+// @Param lang query string false "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름"
+// @Success 200 {array} models.StyleDto
+// @Failure 500 {object} models.ErrorResponse
+// @Router /styles [get]
+func (ctrl *StyleQueryController) GetStyles(c *gin.Context) {
+	lang := middlewares.LangFrom(c)
+	styles, err := ctrl.service.GetStyles()
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
+	}
+	var dtos []models.StyleDto
+	for _, s := range styles {
+		dtos = append(dtos, toStyleDto(s, lang))
+	}
+	c.JSON(http.StatusOK, dtos)
+}
+
+// @Summary 스타일 상세 조회
+// @Description slug, 이름 또는 동의어로 스타일을 조회합니다.
+// @Tags styles
+// @Accept json
+// @Produce json
+// @Param lang query string false "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름"
+// @Param slug path string true "스타일 slug 또는 이름"
+// @Success 200 {object} models.StyleDto
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /styles/{slug} [get]
+func (ctrl *StyleQueryController) GetStyle(c *gin.Context) {
+	s, err := ctrl.service.GetStyle(c.Param("slug"))
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
+	}
+	if s == nil {
+		respondError(c, http.StatusNotFound, messages.StyleNotFound, nil)
+		return
+	}
+	c.JSON(http.StatusOK, toStyleDto(s, middlewares.LangFrom(c)))
+}
+
+// 도메인 모델을 DTO로 변환
+func toStyleDto(s *style.Style, lang i18n.Lang) models.StyleDto {
+	return models.StyleDto{
+		Slug:        s.Slug,
+		Name:        s.Name.In(lang),
+		Description: s.Description.In(lang),
+		Icon:        s.Icon,
+		Synonyms:    s.Synonyms,
+	}
+}
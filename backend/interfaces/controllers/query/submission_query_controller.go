@@ -0,0 +1,188 @@
+package query
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	appQuery "github.com/sunDar0/winding-road-finder/backend/application/query"
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+	"github.com/sunDar0/winding-road-finder/backend/domain/submission"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+	"github.com/sunDar0/winding-road-finder/backend/interfaces/messages"
+	"github.com/sunDar0/winding-road-finder/backend/middlewares"
+	"github.com/sunDar0/winding-road-finder/backend/models"
+)
+
+// SubmissionQueryController는 코스 제보 조회 요청을 처리합니다.
+type SubmissionQueryController struct {
+	service *appQuery.SubmissionQueryService
+}
+
+func NewSubmissionQueryController(service *appQuery.SubmissionQueryService) *SubmissionQueryController {
+	return &SubmissionQueryController{service: service}
+}
+
+// RegisterRoutes는 Gin 라우터에 엔드포인트를 등록합니다.
+func (ctrl *SubmissionQueryController) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.GET("/me/submissions", middlewares.RequireAuth(), ctrl.GetMySubmissions)
+	rg.GET("/submissions/:id", middlewares.RequireAuth(), ctrl.GetSubmission)
+	rg.GET("/admin/submissions", middlewares.RequireRole(user.RoleEditor), ctrl.GetSubmissions)
+}
+
+// @Summary 내 코스 제보 목록 조회
+// @Description 로그인한 사용자의 제보를 최근 제보 순으로 조회합니다.
+// @Tags submissions
+// @Produce json
+// @Security BearerAuth
+// @Param lang query string false "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름"
+// @Param status query string false "상태 (pending, accepted, rejected)"
+// @Param page query int false "페이지 번호 (1~100000), 기본 1"
+// @Param size query int false "페이지 크기, 기본 20, 최대 100"
+// @Success 200 {object} models.SubmissionPageDto
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /me/submissions [get]
+func (ctrl *SubmissionQueryController) GetMySubmissions(c *gin.Context) {
+	filter, ok := submissionFilter(c)
+	if !ok {
+		return
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	filter.SubmitterID = principal.UserID
+	ctrl.respondPage(c, filter)
+}
+
+// @Summary 코스 제보 검토 목록 조회
+// @Description 모든 사용자의 제보를 최근 제보 순으로 조회합니다. 검토 대기 목록은 status=pending으로 조회하며, 대기 중인 제보에는 중복 후보가 함께 나옵니다.
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param lang query string false "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름"
+// @Param status query string false "상태 (pending, accepted, rejected)"
+// @Param page query int false "페이지 번호 (1~100000), 기본 1"
+// @Param size query int false "페이지 크기, 기본 20, 최대 100"
+// @Success 200 {object} models.SubmissionPageDto
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /admin/submissions [get]
+func (ctrl *SubmissionQueryController) GetSubmissions(c *gin.Context) {
+	filter, ok := submissionFilter(c)
+	if !ok {
+		return
+	}
+	ctrl.respondPage(c, filter)
+}
+
+// @Summary 코스 제보 조회
+// @Description 제보 하나를 조회합니다. 제보자와 editor 이상 권한 사용자만 볼 수 있습니다.
+// @Tags submissions
+// @Produce json
+// @Security BearerAuth
+// @Param lang query string false "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름"
+// @Param id path int true "제보 ID"
+// @Success 200 {object} models.SubmissionDto
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /submissions/{id} [get]
+func (ctrl *SubmissionQueryController) GetSubmission(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidID, nil)
+		return
+	}
+	principal, _ := middlewares.PrincipalFrom(c)
+	view, err := ctrl.service.GetSubmission(principal, id)
+	if errors.Is(err, submission.ErrSubmissionNotFound) {
+		respondError(c, http.StatusNotFound, messages.SubmissionNotFound, nil)
+		return
+	}
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
+	}
+	c.JSON(http.StatusOK, toSubmissionDto(view, middlewares.LangFrom(c)))
+}
+
+func (ctrl *SubmissionQueryController) respondPage(c *gin.Context, filter submission.Filter) {
+	page, size, err := queryPage(c)
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	result, err := ctrl.service.GetSubmissions(filter, page, size)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
+	}
+	lang := middlewares.LangFrom(c)
+	dto := models.SubmissionPageDto{Items: make([]models.SubmissionDto, len(result.Submissions)), Page: page, Size: size, Total: result.Total}
+	for i, view := range result.Submissions {
+		dto.Items[i] = toSubmissionDto(view, lang)
+	}
+	c.JSON(http.StatusOK, dto)
+}
+
+// submissionFilter는 status 파라미터를 읽습니다. 알 수 없는 상태면 400을 응답하고 false를 반환합니다.
+func submissionFilter(c *gin.Context) (submission.Filter, bool) {
+	filter := submission.Filter{Status: submission.Status(c.Query("status"))}
+	if filter.Status != "" && !filter.Status.Valid() {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, errors.New("status는 pending, accepted, rejected 중 하나여야 합니다"))
+		return filter, false
+	}
+	return filter, true
+}
+
+func toSubmissionDto(view *appQuery.SubmissionView, lang i18n.Lang) models.SubmissionDto {
+	s := view.Submission
+	dto := models.SubmissionDto{
+		ID:            s.ID,
+		Status:        string(s.Status),
+		Name:          s.Course.Name.In(lang),
+		Region:        s.Course.Region,
+		Nav:           make([]models.CourseNavDto, len(s.Course.Nav)),
+		Styles:        s.Course.Styles,
+		Ratings:       models.CourseRatingsDto(s.Course.Ratings),
+		Note:          s.Note,
+		SubmittedAt:   s.SubmittedAt,
+		Duplicates:    make([]models.SubmissionDuplicateDto, len(view.Duplicates)),
+		ReviewComment: s.ReviewComment,
+		ReviewedAt:    s.ReviewedAt,
+		CourseID:      s.CourseID,
+		DuplicateOf:   s.DuplicateOf,
+	}
+	if dto.Styles == nil {
+		dto.Styles = []string{}
+	}
+	for i, n := range s.Course.Nav {
+		dto.Nav[i] = models.CourseNavDto{
+			Type:        navType(lang, n),
+			Role:        navRole(n),
+			Name:        n.Name.In(lang),
+			Geolocation: models.CourseGeolocationDto{Latitude: n.Geolocation.Latitude, Longitude: n.Geolocation.Longitude},
+		}
+	}
+	for i, d := range view.Duplicates {
+		dto.Duplicates[i] = models.SubmissionDuplicateDto{
+			CourseID:        d.Course.ID,
+			Name:            d.Course.Name.In(lang),
+			EndpointsMatch:  d.EndpointsMatch,
+			StartDistanceKm: d.StartDistanceKm,
+			EndDistanceKm:   d.EndDistanceKm,
+			NameSimilarity:  d.NameSimilarity,
+		}
+	}
+	if view.Submitter != nil {
+		dto.Submitter = &models.SubmissionUserDto{ID: view.Submitter.ID, DisplayName: view.Submitter.DisplayName}
+	}
+	if view.Reviewer != nil {
+		dto.Reviewer = &models.SubmissionUserDto{ID: view.Reviewer.ID, DisplayName: view.Reviewer.DisplayName}
+	}
+	return dto
+}
@@ -0,0 +1,167 @@
+package query
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	appQuery "github.com/sunDar0/winding-road-finder/backend/application/query"
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+	"github.com/sunDar0/winding-road-finder/backend/domain/trip"
+	"github.com/sunDar0/winding-road-finder/backend/interfaces/messages"
+	"github.com/sunDar0/winding-road-finder/backend/middlewares"
+	"github.com/sunDar0/winding-road-finder/backend/models"
+)
+
+// TripQueryController는 여러 코스를 잇는 여행 일정 계획 요청을 처리합니다.
+type TripQueryController struct {
+	service *appQuery.TripQueryService
+}
+
+func NewTripQueryController(service *appQuery.TripQueryService) *TripQueryController {
+	return &TripQueryController{service: service}
+}
+
+// RegisterRoutes는 Gin 라우터에 엔드포인트를 등록합니다.
+func (ctrl *TripQueryController) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.POST("/trips/plan", ctrl.PlanTrip)
+}
+
+// @Summary 여행 일정 계획
+// @Description 출발지와 코스 목록(또는 추천 ID)으로 코스 사이 이동 거리가 짧아지도록 순서를 정하고 구간별 예상 거리·시간을 반환합니다.
+// @Description 이동 거리는 직선거리에 우회 계수를 곱해 추정합니다. 시간 예산을 넘으면 빼었을 때 시간이 가장 많이 줄어드는 코스부터 빼며, 코스가 하나도 들지 않으면 400 trip_budget_too_short입니다. format=gpx면 일정을 GPX 파일로 내려받습니다.
+// @Tags trips
+// @Accept json
+// @Produce json,application/gpx+xml
+// @Param lang query string false "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름"
+// @Param format query string false "응답 형식 (json, gpx), 기본 json"
+// @Param request body models.TripPlanRequest true "여행 조건"
+// @Success 200 {object} models.TripDto
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /trips/plan [post]
+func (ctrl *TripQueryController) PlanTrip(c *gin.Context) {
+	format := c.DefaultQuery("format", "json")
+	if format != "json" && format != "gpx" {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, errors.New("format: json 또는 gpx만 지원합니다"))
+		return
+	}
+	var req models.TripPlanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, err)
+		return
+	}
+	if math.Abs(req.Start.Latitude) > 90 || math.Abs(req.Start.Longitude) > 180 || (req.Start.Latitude == 0 && req.Start.Longitude == 0) {
+		respondError(c, http.StatusBadRequest, messages.InvalidParameter, errors.New("start: 좌표가 올바르지 않습니다"))
+		return
+	}
+	if (len(req.CourseIDs) == 0) == (req.RecommendationID == 0) {
+		respondError(c, http.StatusBadRequest, messages.InvalidTrip, errors.New("courseIds와 recommendationId 중 하나만 지정해야 합니다"))
+		return
+	}
+	it, err := ctrl.service.PlanTrip(appQuery.TripRequest{
+		Options: trip.Options{
+			Start:         course.CourseGeolocation{Latitude: req.Start.Latitude, Longitude: req.Start.Longitude},
+			Budget:        time.Duration(req.BudgetMinutes) * time.Minute,
+			ReturnToStart: req.ReturnToStart,
+		},
+		CourseIDs:        req.CourseIDs,
+		RecommendationID: req.RecommendationID,
+	})
+	if err != nil {
+		respondTripError(c, err)
+		return
+	}
+
+	lang := middlewares.LangFrom(c)
+	if format == "gpx" {
+		c.Header("Content-Disposition", `attachment; filename="trip.gpx"`)
+		c.Header("Content-Type", "application/gpx+xml")
+		c.Status(http.StatusOK)
+		if err := it.WriteGPX(c.Writer, lang, messages.Get(lang, messages.TripTitle), messages.Get(lang, messages.TripStart)); err != nil {
+			c.Error(err)
+		}
+		return
+	}
+	c.JSON(http.StatusOK, toTripDto(it, req.DepartAt, lang))
+}
+
+func respondTripError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, trip.ErrUnknownCourse):
+		respondError(c, http.StatusNotFound, messages.CourseNotFound, err)
+	case errors.Is(err, trip.ErrUnknownRecommendation):
+		respondError(c, http.StatusNotFound, messages.RecommendationNotFound, err)
+	case errors.Is(err, trip.ErrTooManyCourses):
+		respondError(c, http.StatusBadRequest, messages.TooManyTripCourses, err)
+	case errors.Is(err, trip.ErrBudgetTooShort):
+		respondError(c, http.StatusBadRequest, messages.TripBudgetTooShort, err)
+	case errors.Is(err, trip.ErrNoCourses), errors.Is(err, trip.ErrNoRoute), errors.Is(err, trip.ErrInvalidBudget):
+		respondError(c, http.StatusBadRequest, messages.InvalidTrip, err)
+	default:
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+	}
+}
+
+func toGeolocationDto(g course.CourseGeolocation) models.CourseGeolocationDto {
+	return models.CourseGeolocationDto{Latitude: g.Latitude, Longitude: g.Longitude}
+}
+
+func minutes(d time.Duration) int {
+	return int(d.Round(time.Minute) / time.Minute)
+}
+
+// 도메인 모델을 DTO로 변환. departAt이 있으면 구간별 시각과 코스 진입 시각의 통제 여부를 계산합니다.
+func toTripDto(it *trip.Itinerary, departAt *time.Time, lang i18n.Lang) models.TripDto {
+	dto := models.TripDto{
+		Start:            toGeolocationDto(it.Start),
+		ReturnToStart:    it.ReturnToStart,
+		BudgetMinutes:    minutes(it.Budget),
+		CourseIDs:        []int{},
+		DroppedCourseIDs: []int{},
+		DistanceKm:       math.Round(it.DistanceKm*10) / 10,
+		TransitKm:        math.Round(it.TransitKm*10) / 10,
+		DurationMinutes:  minutes(it.Duration),
+		Legs:             make([]models.TripLegDto, 0, len(it.Legs)),
+	}
+	for _, c := range it.Courses() {
+		dto.CourseIDs = append(dto.CourseIDs, c.ID)
+	}
+	for _, c := range it.Dropped {
+		dto.DroppedCourseIDs = append(dto.DroppedCourseIDs, c.ID)
+	}
+	if departAt != nil {
+		depart, arrive := departAt.In(course.KST), departAt.In(course.KST).Add(it.Duration)
+		dto.DepartAt, dto.ArriveAt = &depart, &arrive
+	}
+	for _, l := range it.Legs {
+		leg := models.TripLegDto{
+			Kind:            string(l.Kind),
+			From:            toGeolocationDto(l.From),
+			To:              toGeolocationDto(l.To),
+			DistanceKm:      math.Round(l.DistanceKm*10) / 10,
+			DurationMinutes: minutes(l.Duration),
+			StartMinute:     minutes(l.Offset),
+		}
+		if l.Course != nil {
+			leg.CourseID = l.Course.ID
+			leg.CourseName = l.Course.Name.In(lang)
+		}
+		if dto.DepartAt != nil {
+			depart := dto.DepartAt.Add(l.Offset)
+			arrive := depart.Add(l.Duration)
+			leg.DepartAt, leg.ArriveAt = &depart, &arrive
+			if l.Kind == trip.LegCourse {
+				if reason, closed := l.Course.Availability.ClosedReasonAt(depart); closed {
+					leg.ClosedReason = reason.In(lang)
+				}
+			}
+		}
+		dto.Legs = append(dto.Legs, leg)
+	}
+	return dto
+}
@@ -0,0 +1,68 @@
+package query
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	appQuery "github.com/sunDar0/winding-road-finder/backend/application/query"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+	"github.com/sunDar0/winding-road-finder/backend/interfaces/messages"
+	"github.com/sunDar0/winding-road-finder/backend/middlewares"
+	"github.com/sunDar0/winding-road-finder/backend/models"
+)
+
+// UserQueryController는 로그인한 사용자 정보 조회 요청을 처리합니다.
+type UserQueryController struct {
+	service *appQuery.UserQueryService
+}
+
+func NewUserQueryController(service *appQuery.UserQueryService) *UserQueryController {
+	return &UserQueryController{service: service}
+}
+
+// RegisterRoutes는 Gin 라우터에 엔드포인트를 등록합니다.
+func (ctrl *UserQueryController) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.GET("/me", middlewares.RequireAuth(), ctrl.GetMe)
+}
+
+// @Summary 내 정보 조회
+// @Description 액세스 토큰의 사용자 계정 정보를 조회합니다.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param lang query string false "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름"
+// @Success 200 {object} models.UserDto
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /me [get]
+func (ctrl *UserQueryController) GetMe(c *gin.Context) {
+	principal, _ := middlewares.PrincipalFrom(c)
+	u, err := ctrl.service.GetUser(principal.UserID)
+	if err != nil {
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
+	}
+	if u == nil {
+		respondError(c, http.StatusUnauthorized, messages.InvalidToken, nil)
+		return
+	}
+	c.JSON(http.StatusOK, toUserDto(u))
+}
+
+// 도메인 모델을 DTO로 변환
+func toUserDto(u *user.User) models.UserDto {
+	dto := models.UserDto{
+		ID:          u.ID,
+		Email:       u.Email,
+		DisplayName: u.DisplayName,
+		Role:        string(u.Role),
+		HasPassword: u.PasswordHash != "",
+		Identities:  []models.IdentityDto{},
+		CreatedAt:   u.CreatedAt,
+	}
+	for _, id := range u.Identities {
+		dto.Identities = append(dto.Identities, models.IdentityDto{Provider: id.Provider, Email: id.Email, LinkedAt: id.LinkedAt})
+	}
+	return dto
+}
@@ -0,0 +1,93 @@
+package query
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	appQuery "github.com/sunDar0/winding-road-finder/backend/application/query"
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+	"github.com/sunDar0/winding-road-finder/backend/domain/weather"
+	"github.com/sunDar0/winding-road-finder/backend/interfaces/messages"
+	"github.com/sunDar0/winding-road-finder/backend/middlewares"
+	"github.com/sunDar0/winding-road-finder/backend/models"
+)
+
+// WeatherQueryController는 코스 날씨 예보 조회 요청을 처리합니다.
+type WeatherQueryController struct {
+	service *appQuery.WeatherQueryService
+}
+
+func NewWeatherQueryController(service *appQuery.WeatherQueryService) *WeatherQueryController {
+	return &WeatherQueryController{service: service}
+}
+
+// RegisterRoutes는 Gin 라우터에 엔드포인트를 등록합니다.
+func (ctrl *WeatherQueryController) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.GET("/courses/:id/weather", ctrl.GetCourseWeather)
+}
+
+// @Summary 코스 날씨 예보 조회
+// @Description 코스 출발지와 정상(있는 경우)의 기상청 단기예보를 시간별로 조회합니다. 예보는 격자별로 캐시됩니다.
+// @Tags weather
+// @Produce json
+// @Param lang query string false "응답 언어 (ko, en, ja). 생략 시 Accept-Language 헤더를 따름"
+// @Param id path int true "코스 ID"
+// @Success 200 {object} models.CourseWeatherDto
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Failure 503 {object} models.ErrorResponse
+// @Router /courses/{id}/weather [get]
+func (ctrl *WeatherQueryController) GetCourseWeather(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		respondError(c, http.StatusBadRequest, messages.InvalidID, nil)
+		return
+	}
+	result, err := ctrl.service.GetCourseWeather(c.Request.Context(), id)
+	switch {
+	case errors.Is(err, weather.ErrUnavailable):
+		respondError(c, http.StatusServiceUnavailable, messages.WeatherUnavailable, err)
+		return
+	case err != nil:
+		respondError(c, http.StatusInternalServerError, messages.InternalError, err)
+		return
+	case result == nil:
+		respondError(c, http.StatusNotFound, messages.CourseNotFound, nil)
+		return
+	}
+	c.JSON(http.StatusOK, toCourseWeatherDto(result, middlewares.LangFrom(c)))
+}
+
+// 도메인 모델을 DTO로 변환
+func toCourseWeatherDto(w *appQuery.CourseWeather, lang i18n.Lang) models.CourseWeatherDto {
+	dto := models.CourseWeatherDto{CourseID: w.CourseID, DryRoads: w.DryRoads, Points: make([]models.PointWeatherDto, 0, len(w.Points))}
+	for _, p := range w.Points {
+		hours := make([]models.HourlyWeatherDto, 0, len(p.Hours))
+		for _, h := range p.Hours {
+			hours = append(hours, models.HourlyWeatherDto{
+				Time:                h.Time,
+				TemperatureC:        h.TemperatureC,
+				PrecipitationChance: h.PrecipitationChance,
+				Precipitation:       string(h.Precipitation),
+				PrecipitationMm:     h.PrecipitationMm,
+				Sky:                 string(h.Sky),
+				WindSpeedMs:         h.WindSpeedMs,
+				HumidityPct:         h.HumidityPct,
+				Wet:                 h.Wet(),
+			})
+		}
+		dto.Points = append(dto.Points, models.PointWeatherDto{
+			Name:      p.Nav.Name.In(lang),
+			Summit:    p.Nav.Summit,
+			Latitude:  p.Nav.Geolocation.Latitude,
+			Longitude: p.Nav.Geolocation.Longitude,
+			Grid:      p.Cell.String(),
+			IssuedAt:  p.IssuedAt,
+			Hours:     hours,
+		})
+	}
+	return dto
+}
@@ -0,0 +1,591 @@
+package messages
+
+import (
+	"fmt"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+	"github.com/sunDar0/winding-road-finder/backend/models"
+)
+
+// 에러 메시지 키입니다. API 응답의 code 필드로도 사용됩니다.
+const (
+	InvalidID              = "invalid_id"
+	CourseNotFound         = "course_not_found"
+	RecommendationNotFound = "recommendation_not_found"
+	RegionNotFound         = "region_not_found"
+	StyleNotFound          = "style_not_found"
+	InvalidParameter       = "invalid_parameter"
+	UnknownStyle           = "unknown_style"
+	InternalError          = "internal_error"
+	Unauthorized           = "unauthorized"
+	Forbidden              = "forbidden"
+	InvalidToken           = "invalid_token"
+	InvalidCredentials     = "invalid_credentials"
+	EmailTaken             = "email_taken"
+	InvalidEmail           = "invalid_email"
+	WeakPassword           = "weak_password"
+	UnknownProvider        = "unknown_provider"
+	InvalidState           = "invalid_state"
+	IdentityLinked         = "identity_linked"
+	ProviderError          = "provider_error"
+	LoginDenied            = "login_denied"
+	LinkRequired           = "link_required"
+	InvalidRating          = "invalid_rating"
+	InvalidVisitDate       = "invalid_visit_date"
+	ReviewTooLong          = "review_too_long"
+	AlreadyReviewed        = "already_reviewed"
+	CollectionNotFound     = "collection_not_found"
+	InvalidCollectionName  = "invalid_collection_name"
+	InvalidCollectionOrder = "invalid_collection_order"
+	CollectionFull         = "collection_full"
+	DriveNotFound          = "drive_not_found"
+	InvalidDriveDate       = "invalid_drive_date"
+	InvalidTrack           = "invalid_track"
+	HazardNotFound         = "hazard_not_found"
+	InvalidHazard          = "invalid_hazard"
+	HazardTooFar           = "hazard_too_far"
+	OwnHazardVote          = "own_hazard_vote"
+	WeatherUnavailable     = "weather_unavailable"
+	InvalidTrip            = "invalid_trip"
+	TooManyTripCourses     = "too_many_trip_courses"
+	TripBudgetTooShort     = "trip_budget_too_short"
+	RouteNotComputed       = "route_not_computed"
+	InvalidCourse          = "invalid_course"
+	CourseInUse            = "course_in_use"
+	InvalidRecommendation  = "invalid_recommendation"
+	InvalidCourseOrder     = "invalid_course_order"
+	ReviewNotFound         = "review_not_found"
+	ImagesUnavailable      = "course_images_unavailable"
+	ImageGenerationFailed  = "course_images_failed"
+	RevisionNotFound       = "revision_not_found"
+	InvalidRevision        = "invalid_revision"
+	InvalidRevisionState   = "invalid_revision_state"
+	SelfReview             = "self_review"
+	RevisionOutdated       = "revision_outdated"
+	CourseDeleted          = "course_deleted"
+	SubmissionNotFound     = "submission_not_found"
+	InvalidSubmission      = "invalid_submission"
+	SubmissionReviewed     = "submission_already_reviewed"
+	PhotoNotFound          = "photo_not_found"
+	UnsupportedImage       = "unsupported_image"
+	ImageTooLarge          = "image_too_large"
+	InvalidCaption         = "invalid_caption"
+	MediaUnavailable       = "media_unavailable"
+	InvalidLogLevel        = "invalid_log_level"
+)
+
+// 추천 사유 문구 키입니다.
+const (
+	ReasonRatingMatch = "reason_rating_match"
+	ReasonNearby      = "reason_nearby"
+	ReasonStyleMatch  = "reason_style_match"
+
+	ReasonSimilarRatings = "reason_similar_ratings"
+	ReasonSharedStyle    = "reason_shared_style"
+	ReasonSameRegion     = "reason_same_region"
+)
+
+// 여행 일정 GPX 문구 키입니다.
+const (
+	TripTitle = "trip_title"
+	TripStart = "trip_start"
+)
+
+// AxisKey는 평가 항목 이름의 메시지 키를 반환합니다.
+func AxisKey(axis string) string {
+	return "axis_" + axis
+}
+
+// RouteIssueKey는 코스 경로 문제 설명의 메시지 키를 반환합니다.
+func RouteIssueKey(kind string) string {
+	return "route_issue_" + kind
+}
+
+// NavRoleKey는 내비게이션 포인트 역할 이름의 메시지 키를 반환합니다. 번호가 붙은 경유지는 NavWaypointN을 사용합니다.
+func NavRoleKey(role string) string {
+	return "nav_" + role
+}
+
+// NavWaypointN은 번호가 붙은 경유지 이름의 메시지 키입니다.
+const NavWaypointN = "nav_waypoint_n"
+
+// HazardTypeKey는 위험 신고 유형 이름의 메시지 키를 반환합니다.
+func HazardTypeKey(t string) string {
+	return "hazard_" + t
+}
+
+// catalog는 메시지 키별 언어별 문구입니다.
+var catalog = map[string]i18n.LocalizedText{
+	InvalidID: {
+		i18n.Korean:   "잘못된 ID 형식입니다",
+		i18n.English:  "invalid id",
+		i18n.Japanese: "IDの形式が正しくありません",
+	},
+	CourseNotFound: {
+		i18n.Korean:   "코스를 찾을 수 없습니다",
+		i18n.English:  "course not found",
+		i18n.Japanese: "コースが見つかりません",
+	},
+	RecommendationNotFound: {
+		i18n.Korean:   "추천 정보를 찾을 수 없습니다",
+		i18n.English:  "recommendation not found",
+		i18n.Japanese: "おすすめ情報が見つかりません",
+	},
+	RegionNotFound: {
+		i18n.Korean:   "지역을 찾을 수 없습니다",
+		i18n.English:  "region not found",
+		i18n.Japanese: "地域が見つかりません",
+	},
+	StyleNotFound: {
+		i18n.Korean:   "스타일을 찾을 수 없습니다",
+		i18n.English:  "style not found",
+		i18n.Japanese: "スタイルが見つかりません",
+	},
+	InvalidParameter: {
+		i18n.Korean:   "요청 파라미터가 올바르지 않습니다",
+		i18n.English:  "invalid request parameter",
+		i18n.Japanese: "リクエストパラメータが正しくありません",
+	},
+	UnknownStyle: {
+		i18n.Korean:   "알 수 없는 스타일입니다",
+		i18n.English:  "unknown style",
+		i18n.Japanese: "不明なスタイルです",
+	},
+	Unauthorized: {
+		i18n.Korean:   "로그인이 필요합니다",
+		i18n.English:  "authentication required",
+		i18n.Japanese: "ログインが必要です",
+	},
+	Forbidden: {
+		i18n.Korean:   "권한이 없습니다",
+		i18n.English:  "permission denied",
+		i18n.Japanese: "権限がありません",
+	},
+	InvalidToken: {
+		i18n.Korean:   "유효하지 않거나 만료된 토큰입니다",
+		i18n.English:  "invalid or expired token",
+		i18n.Japanese: "無効または期限切れのトークンです",
+	},
+	InvalidCredentials: {
+		i18n.Korean:   "이메일 또는 비밀번호가 올바르지 않습니다",
+		i18n.English:  "invalid email or password",
+		i18n.Japanese: "メールアドレスまたはパスワードが正しくありません",
+	},
+	EmailTaken: {
+		i18n.Korean:   "이미 가입된 이메일입니다",
+		i18n.English:  "email already registered",
+		i18n.Japanese: "既に登録されているメールアドレスです",
+	},
+	InvalidEmail: {
+		i18n.Korean:   "이메일 형식이 올바르지 않습니다",
+		i18n.English:  "invalid email address",
+		i18n.Japanese: "メールアドレスの形式が正しくありません",
+	},
+	WeakPassword: {
+		i18n.Korean:   "비밀번호는 8자 이상이어야 합니다",
+		i18n.English:  "password must be at least 8 characters",
+		i18n.Japanese: "パスワードは8文字以上必要です",
+	},
+	UnknownProvider: {
+		i18n.Korean:   "지원하지 않는 로그인 공급자입니다",
+		i18n.English:  "unknown identity provider",
+		i18n.Japanese: "対応していないログインプロバイダーです",
+	},
+	InvalidState: {
+		i18n.Korean:   "로그인 요청이 만료되었거나 올바르지 않습니다. 다시 시도해 주세요",
+		i18n.English:  "login request expired or invalid, please try again",
+		i18n.Japanese: "ログインリクエストが期限切れまたは無効です。もう一度お試しください",
+	},
+	IdentityLinked: {
+		i18n.Korean:   "이미 다른 계정에 연결된 외부 계정입니다",
+		i18n.English:  "this external account is linked to another user",
+		i18n.Japanese: "この外部アカウントは別のアカウントに連携されています",
+	},
+	ProviderError: {
+		i18n.Korean:   "외부 로그인 공급자와 통신하지 못했습니다",
+		i18n.English:  "failed to communicate with the identity provider",
+		i18n.Japanese: "ログインプロバイダーとの通信に失敗しました",
+	},
+	LoginDenied: {
+		i18n.Korean:   "외부 로그인이 취소되었거나 거부되었습니다",
+		i18n.English:  "external login was cancelled or denied",
+		i18n.Japanese: "外部ログインがキャンセルまたは拒否されました",
+	},
+	LinkRequired: {
+		i18n.Korean:   "같은 이메일로 가입된 계정이 있습니다. 그 계정으로 로그인한 뒤 외부 계정을 연결해 주세요",
+		i18n.English:  "an account with this email already exists; sign in to it and link the external account",
+		i18n.Japanese: "同じメールアドレスのアカウントが既にあります。そのアカウントでログインしてから外部アカウントを連携してください",
+	},
+	InvalidRating: {
+		i18n.Korean:   "평가 점수는 항목마다 1~5점이어야 합니다",
+		i18n.English:  "each rating must be between 1 and 5",
+		i18n.Japanese: "評価は各項目1〜5点で入力してください",
+	},
+	InvalidVisitDate: {
+		i18n.Korean:   "방문일이 올바르지 않습니다 (YYYY-MM-DD, 오늘 이전)",
+		i18n.English:  "invalid visit date (YYYY-MM-DD, not in the future)",
+		i18n.Japanese: "訪問日が正しくありません(YYYY-MM-DD、本日以前)",
+	},
+	ReviewTooLong: {
+		i18n.Korean:   "리뷰 본문은 2000자 이하여야 합니다",
+		i18n.English:  "review text must be 2000 characters or fewer",
+		i18n.Japanese: "レビュー本文は2000文字以内で入力してください",
+	},
+	AlreadyReviewed: {
+		i18n.Korean:   "이미 리뷰를 작성한 코스입니다",
+		i18n.English:  "you have already reviewed this course",
+		i18n.Japanese: "このコースには既にレビューを投稿しています",
+	},
+	CollectionNotFound: {
+		i18n.Korean:   "컬렉션을 찾을 수 없습니다",
+		i18n.English:  "collection not found",
+		i18n.Japanese: "コレクションが見つかりません",
+	},
+	InvalidCollectionName: {
+		i18n.Korean:   "컬렉션 이름은 1~50자여야 합니다",
+		i18n.English:  "collection name must be 1 to 50 characters",
+		i18n.Japanese: "コレクション名は1〜50文字で入力してください",
+	},
+	InvalidCollectionOrder: {
+		i18n.Korean:   "순서에는 컬렉션의 코스 ID가 빠짐없이 한 번씩 있어야 합니다",
+		i18n.English:  "the order must list every course in the collection exactly once",
+		i18n.Japanese: "並び順にはコレクションのコースIDをすべて一度ずつ含めてください",
+	},
+	CollectionFull: {
+		i18n.Korean:   "컬렉션에는 코스를 200개까지 담을 수 있습니다",
+		i18n.English:  "a collection can hold up to 200 courses",
+		i18n.Japanese: "コレクションには最大200コースまで追加できます",
+	},
+	DriveNotFound: {
+		i18n.Korean:   "주행 기록을 찾을 수 없습니다",
+		i18n.English:  "drive not found",
+		i18n.Japanese: "走行記録が見つかりません",
+	},
+	InvalidDriveDate: {
+		i18n.Korean:   "주행일이 올바르지 않습니다 (YYYY-MM-DD, 오늘 이전)",
+		i18n.English:  "invalid drive date (YYYY-MM-DD, not in the future)",
+		i18n.Japanese: "走行日が正しくありません(YYYY-MM-DD、本日以前)",
+	},
+	InvalidTrack: {
+		i18n.Korean:   "GPX 파일을 읽을 수 없습니다",
+		i18n.English:  "could not read the GPX file",
+		i18n.Japanese: "GPXファイルを読み込めません",
+	},
+	HazardNotFound: {
+		i18n.Korean:   "위험 신고를 찾을 수 없습니다",
+		i18n.English:  "hazard report not found",
+		i18n.Japanese: "危険情報が見つかりません",
+	},
+	InvalidHazard: {
+		i18n.Korean:   "위험 신고 내용이 올바르지 않습니다",
+		i18n.English:  "invalid hazard report",
+		i18n.Japanese: "危険情報の内容が正しくありません",
+	},
+	HazardTooFar: {
+		i18n.Korean:   "신고 위치가 코스에서 5km 이상 떨어져 있습니다",
+		i18n.English:  "the reported location is more than 5 km from the course",
+		i18n.Japanese: "報告地点がコースから5km以上離れています",
+	},
+	OwnHazardVote: {
+		i18n.Korean:   "자신의 신고에는 투표할 수 없습니다",
+		i18n.English:  "you cannot vote on your own report",
+		i18n.Japanese: "自分の報告には投票できません",
+	},
+	WeatherUnavailable: {
+		i18n.Korean:   "날씨 예보를 가져올 수 없습니다",
+		i18n.English:  "weather forecast is unavailable",
+		i18n.Japanese: "天気予報を取得できません",
+	},
+	InvalidTrip: {
+		i18n.Korean:   "여행 계획 요청이 올바르지 않습니다",
+		i18n.English:  "invalid trip plan request",
+		i18n.Japanese: "旅行プランのリクエストが正しくありません",
+	},
+	TooManyTripCourses: {
+		i18n.Korean:   "여행에는 코스를 15개까지 넣을 수 있습니다",
+		i18n.English:  "a trip can include up to 15 courses",
+		i18n.Japanese: "旅行に含められるコースは15件までです",
+	},
+	TripBudgetTooShort: {
+		i18n.Korean:   "시간 예산 안에 넣을 수 있는 코스가 없습니다",
+		i18n.English:  "no course fits within the time budget",
+		i18n.Japanese: "時間の予算内に収まるコースがありません",
+	},
+	RouteNotComputed: {
+		i18n.Korean:   "아직 도로 경로를 구하지 않은 코스입니다",
+		i18n.English:  "the road route for this course has not been computed yet",
+		i18n.Japanese: "このコースの道路ルートはまだ計算されていません",
+	},
+	InvalidCourse: {
+		i18n.Korean:   "코스 정보가 올바르지 않습니다",
+		i18n.English:  "invalid course data",
+		i18n.Japanese: "コース情報が正しくありません",
+	},
+	CourseInUse: {
+		i18n.Korean:   "추천에서 사용 중인 코스는 삭제할 수 없습니다",
+		i18n.English:  "the course is used by a recommendation and cannot be deleted",
+		i18n.Japanese: "おすすめで使用中のコースは削除できません",
+	},
+	InvalidRecommendation: {
+		i18n.Korean:   "추천 정보가 올바르지 않습니다",
+		i18n.English:  "invalid recommendation data",
+		i18n.Japanese: "おすすめ情報が正しくありません",
+	},
+	InvalidCourseOrder: {
+		i18n.Korean:   "순서에는 현재 코스 ID가 빠짐없이 한 번씩 있어야 합니다",
+		i18n.English:  "the order must contain every current course id exactly once",
+		i18n.Japanese: "並び順には現在のコースIDをすべて一度ずつ含める必要があります",
+	},
+	ReviewNotFound: {
+		i18n.Korean:   "리뷰를 찾을 수 없습니다",
+		i18n.English:  "review not found",
+		i18n.Japanese: "レビューが見つかりません",
+	},
+	ImagesUnavailable: {
+		i18n.Korean:   "지도 이미지 생성 설정이 없습니다",
+		i18n.English:  "map image generation is not configured",
+		i18n.Japanese: "地図画像生成の設定がありません",
+	},
+	ImageGenerationFailed: {
+		i18n.Korean:   "지도 이미지 생성에 실패했습니다",
+		i18n.English:  "failed to generate map images",
+		i18n.Japanese: "地図画像の生成に失敗しました",
+	},
+	RevisionNotFound: {
+		i18n.Korean:   "수정안을 찾을 수 없습니다",
+		i18n.English:  "revision not found",
+		i18n.Japanese: "修正案が見つかりません",
+	},
+	InvalidRevision: {
+		i18n.Korean:   "변경 요약 또는 검토 의견이 올바르지 않습니다",
+		i18n.English:  "invalid revision summary or review comment",
+		i18n.Japanese: "変更の要約またはレビューコメントが正しくありません",
+	},
+	InvalidRevisionState: {
+		i18n.Korean:   "수정안의 현재 상태에서 할 수 없는 작업입니다",
+		i18n.English:  "the revision cannot be changed in its current state",
+		i18n.Japanese: "修正案の現在の状態ではこの操作はできません",
+	},
+	SelfReview: {
+		i18n.Korean:   "자신이 작성한 수정안은 검토할 수 없습니다",
+		i18n.English:  "you cannot review your own revision",
+		i18n.Japanese: "自分が作成した修正案はレビューできません",
+	},
+	RevisionOutdated: {
+		i18n.Korean:   "수정안을 만든 뒤 코스가 바뀌었습니다. 수정안을 다시 편집해 제출해야 합니다",
+		i18n.English:  "the course has changed since the revision was drafted; edit and resubmit it",
+		i18n.Japanese: "修正案の作成後にコースが変更されました。修正案を編集して再提出してください",
+	},
+	CourseDeleted: {
+		i18n.Korean:   "삭제된 코스입니다",
+		i18n.English:  "the course has been deleted",
+		i18n.Japanese: "削除されたコースです",
+	},
+	SubmissionNotFound: {
+		i18n.Korean:   "코스 제보를 찾을 수 없습니다",
+		i18n.English:  "course submission not found",
+		i18n.Japanese: "コースの投稿が見つかりません",
+	},
+	InvalidSubmission: {
+		i18n.Korean:   "코스 제보 내용이 올바르지 않습니다",
+		i18n.English:  "invalid course submission",
+		i18n.Japanese: "コースの投稿内容が正しくありません",
+	},
+	SubmissionReviewed: {
+		i18n.Korean:   "이미 검토한 제보입니다",
+		i18n.English:  "the submission has already been reviewed",
+		i18n.Japanese: "すでにレビュー済みの投稿です",
+	},
+	PhotoNotFound: {
+		i18n.Korean:   "사진을 찾을 수 없습니다",
+		i18n.English:  "photo not found",
+		i18n.Japanese: "写真が見つかりません",
+	},
+	UnsupportedImage: {
+		i18n.Korean:   "JPEG 또는 PNG 사진만 올릴 수 있습니다",
+		i18n.English:  "only JPEG or PNG photos can be uploaded",
+		i18n.Japanese: "JPEGまたはPNGの写真のみアップロードできます",
+	},
+	ImageTooLarge: {
+		i18n.Korean:   "사진은 20MB, 2천4백만 화소 이하여야 합니다",
+		i18n.English:  "photos must be at most 20 MB and 24 megapixels",
+		i18n.Japanese: "写真は20MB・2400万画素以下にしてください",
+	},
+	InvalidCaption: {
+		i18n.Korean:   "사진 설명은 200자 이하여야 합니다",
+		i18n.English:  "captions must be at most 200 characters",
+		i18n.Japanese: "写真の説明は200文字以内で入力してください",
+	},
+	MediaUnavailable: {
+		i18n.Korean:   "사진 저장소를 일시적으로 사용할 수 없습니다",
+		i18n.English:  "photo storage is temporarily unavailable",
+		i18n.Japanese: "写真ストレージが一時的に利用できません",
+	},
+	InvalidLogLevel: {
+		i18n.Korean:   "로그 레벨은 debug, info, warn, error 중 하나여야 합니다",
+		i18n.English:  "log level must be one of debug, info, warn, error",
+		i18n.Japanese: "ログレベルは debug、info、warn、error のいずれかを指定してください",
+	},
+	ReasonRatingMatch: {
+		i18n.Korean:   "%s %d점 (희망 %d점)",
+		i18n.English:  "%s %d (you wanted %d)",
+		i18n.Japanese: "%s %d点(希望 %d点)",
+	},
+	ReasonNearby: {
+		i18n.Korean:   "출발지까지 약 %.0fkm",
+		i18n.English:  "about %.0f km to the start",
+		i18n.Japanese: "出発地まで約%.0fkm",
+	},
+	ReasonStyleMatch: {
+		i18n.Korean:   "선호 스타일: %s",
+		i18n.English:  "matches your styles: %s",
+		i18n.Japanese: "好みのスタイル: %s",
+	},
+	ReasonSimilarRatings: {
+		i18n.Korean:   "비슷한 평가: %s",
+		i18n.English:  "similar ratings: %s",
+		i18n.Japanese: "似た評価: %s",
+	},
+	ReasonSharedStyle: {
+		i18n.Korean:   "같은 스타일: %s",
+		i18n.English:  "same style: %s",
+		i18n.Japanese: "同じスタイル: %s",
+	},
+	ReasonSameRegion: {
+		i18n.Korean:   "같은 지역: %s",
+		i18n.English:  "same region: %s",
+		i18n.Japanese: "同じ地域: %s",
+	},
+	RouteIssueKey("unreachable"): {
+		i18n.Korean:   "이전 지점에서 도로로 갈 수 없습니다",
+		i18n.English:  "cannot be reached by road from the previous point",
+		i18n.Japanese: "前の地点から道路で到達できません",
+	},
+	RouteIssueKey("off_road"): {
+		i18n.Korean:   "가장 가까운 도로에서 멀리 떨어져 있습니다",
+		i18n.English:  "far from the nearest road",
+		i18n.Japanese: "最寄りの道路から離れています",
+	},
+	RouteIssueKey("out_of_order"): {
+		i18n.Korean:   "이웃한 지점과 순서가 뒤바뀐 것으로 보입니다",
+		i18n.English:  "appears to be out of order with a neighboring point",
+		i18n.Japanese: "隣の地点と順序が入れ替わっているようです",
+	},
+	TripTitle: {
+		i18n.Korean:   "와인딩 코스 여행",
+		i18n.English:  "Winding road trip",
+		i18n.Japanese: "ワインディングコースの旅",
+	},
+	TripStart: {
+		i18n.Korean:   "출발지",
+		i18n.English:  "Start",
+		i18n.Japanese: "出発地",
+	},
+	NavRoleKey("start"): {
+		i18n.Korean:   "출발지",
+		i18n.English:  "Start",
+		i18n.Japanese: "出発地",
+	},
+	NavRoleKey("waypoint"): {
+		i18n.Korean:   "경유지",
+		i18n.English:  "Waypoint",
+		i18n.Japanese: "経由地",
+	},
+	NavWaypointN: {
+		i18n.Korean:   "경유지 %d",
+		i18n.English:  "Waypoint %d",
+		i18n.Japanese: "経由地%d",
+	},
+	NavRoleKey("end"): {
+		i18n.Korean:   "도착지",
+		i18n.English:  "Destination",
+		i18n.Japanese: "到着地",
+	},
+	HazardTypeKey("closure"): {
+		i18n.Korean:   "통행 통제",
+		i18n.English:  "Road closure",
+		i18n.Japanese: "通行止め",
+	},
+	HazardTypeKey("gravel"): {
+		i18n.Korean:   "모래·자갈",
+		i18n.English:  "Gravel",
+		i18n.Japanese: "砂利",
+	},
+	HazardTypeKey("landslide"): {
+		i18n.Korean:   "낙석·산사태",
+		i18n.English:  "Rockfall / landslide",
+		i18n.Japanese: "落石・土砂崩れ",
+	},
+	HazardTypeKey("checkpoint"): {
+		i18n.Korean:   "단속",
+		i18n.English:  "Police checkpoint",
+		i18n.Japanese: "取り締まり",
+	},
+	HazardTypeKey("ice"): {
+		i18n.Korean:   "결빙",
+		i18n.English:  "Ice",
+		i18n.Japanese: "路面凍結",
+	},
+	HazardTypeKey("accident"): {
+		i18n.Korean:   "사고",
+		i18n.English:  "Accident",
+		i18n.Japanese: "事故",
+	},
+	HazardTypeKey("other"): {
+		i18n.Korean:   "기타",
+		i18n.English:  "Other",
+		i18n.Japanese: "その他",
+	},
+	AxisKey("tech"): {
+		i18n.Korean:   "기술",
+		i18n.English:  "Technique",
+		i18n.Japanese: "テクニック",
+	},
+	AxisKey("speed"): {
+		i18n.Korean:   "속도",
+		i18n.English:  "Speed",
+		i18n.Japanese: "スピード",
+	},
+	AxisKey("scenery"): {
+		i18n.Korean:   "경관",
+		i18n.English:  "Scenery",
+		i18n.Japanese: "景観",
+	},
+	AxisKey("road"): {
+		i18n.Korean:   "노면",
+		i18n.English:  "Road surface",
+		i18n.Japanese: "路面",
+	},
+	AxisKey("access"): {
+		i18n.Korean:   "접근성",
+		i18n.English:  "Access",
+		i18n.Japanese: "アクセス",
+	},
+	InternalError: {
+		i18n.Korean:   "서버 오류가 발생했습니다",
+		i18n.English:  "internal server error",
+		i18n.Japanese: "サーバーエラーが発生しました",
+	},
+}
+
+// Get은 메시지 키에 해당하는 언어별 문구를 반환합니다. 인자가 있으면 fmt 형식으로 채웁니다.
+// 등록되지 않은 키는 키 자체를 반환합니다.
+func Get(lang i18n.Lang, key string, args ...any) string {
+	text, ok := catalog[key]
+	if !ok {
+		return key
+	}
+	if len(args) > 0 {
+		return fmt.Sprintf(text.In(lang), args...)
+	}
+	return text.In(lang)
+}
+
+// ErrorResponse는 key 메시지를 lang으로 번역한 에러 응답을 만듭니다. err가 있으면 상세로 포함합니다.
+func ErrorResponse(lang i18n.Lang, key string, err error) models.ErrorResponse {
+	resp := models.ErrorResponse{Code: key, Error: Get(lang, key)}
+	if err != nil {
+		resp.Detail = err.Error()
+	}
+	return resp
+}
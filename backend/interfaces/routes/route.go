@@ -2,11 +2,75 @@ package routes
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+	commandCtrl "github.com/sunDar0/winding-road-finder/backend/interfaces/controllers/command"
 	queryCtrl "github.com/sunDar0/winding-road-finder/backend/interfaces/controllers/query"
+	"github.com/sunDar0/winding-road-finder/backend/middlewares"
 )
 
+// Controllers는 라우트에 등록할 컨트롤러 묶음입니다.
+type Controllers struct {
+	CourseQuery         *queryCtrl.CourseQueryController
+	RegionQuery         *queryCtrl.RegionQueryController
+	StyleQuery          *queryCtrl.StyleQueryController
+	RecommendationQuery *queryCtrl.RecommendationQueryController
+	UserQuery           *queryCtrl.UserQueryController
+	ReviewQuery         *queryCtrl.ReviewQueryController
+	CollectionQuery     *queryCtrl.CollectionQueryController
+	DriveQuery          *queryCtrl.DriveQueryController
+	HazardQuery         *queryCtrl.HazardQueryController
+	WeatherQuery        *queryCtrl.WeatherQueryController
+	TripQuery           *queryCtrl.TripQueryController
+	AdminQuery          *queryCtrl.AdminQueryController
+	CourseHistoryQuery  *queryCtrl.CourseHistoryQueryController
+	RevisionQuery       *queryCtrl.RevisionQueryController
+	SubmissionQuery     *queryCtrl.SubmissionQueryController
+	PhotoQuery          *queryCtrl.PhotoQueryController
+	LogQuery            *queryCtrl.LogQueryController
+	AuthCommand         *commandCtrl.AuthCommandController
+	OIDCCommand         *commandCtrl.OIDCCommandController
+	ReviewCommand       *commandCtrl.ReviewCommandController
+	CollectionCommand   *commandCtrl.CollectionCommandController
+	DriveCommand        *commandCtrl.DriveCommandController
+	HazardCommand       *commandCtrl.HazardCommandController
+	AdminCommand        *commandCtrl.AdminCommandController
+	RevisionCommand     *commandCtrl.RevisionCommandController
+	SubmissionCommand   *commandCtrl.SubmissionCommandController
+	PhotoCommand        *commandCtrl.PhotoCommandController
+	LogCommand          *commandCtrl.LogCommandController
+}
+
 // RegisterRoutes는 모든 엔드포인트를 Gin 엔진에 등록합니다.
-func RegisterRoutes(r *gin.Engine, courseQueryController *queryCtrl.CourseQueryController) {
+// 모든 /api 요청은 응답 언어를 결정하고, Authorization 헤더가 있으면 토큰을 검증합니다.
+func RegisterRoutes(r *gin.Engine, tokens user.TokenService, ctrls Controllers) {
 	api := r.Group("/api")
-	courseQueryController.RegisterRoutes(api)
+	api.Use(middlewares.Locale(), middlewares.Authenticate(tokens))
+	ctrls.CourseQuery.RegisterRoutes(api)
+	ctrls.RegionQuery.RegisterRoutes(api)
+	ctrls.StyleQuery.RegisterRoutes(api)
+	ctrls.RecommendationQuery.RegisterRoutes(api)
+	ctrls.UserQuery.RegisterRoutes(api)
+	ctrls.ReviewQuery.RegisterRoutes(api)
+	ctrls.CollectionQuery.RegisterRoutes(api)
+	ctrls.DriveQuery.RegisterRoutes(api)
+	ctrls.HazardQuery.RegisterRoutes(api)
+	ctrls.WeatherQuery.RegisterRoutes(api)
+	ctrls.TripQuery.RegisterRoutes(api)
+	ctrls.AdminQuery.RegisterRoutes(api)
+	ctrls.CourseHistoryQuery.RegisterRoutes(api)
+	ctrls.RevisionQuery.RegisterRoutes(api)
+	ctrls.SubmissionQuery.RegisterRoutes(api)
+	ctrls.PhotoQuery.RegisterRoutes(api)
+	ctrls.LogQuery.RegisterRoutes(api)
+	ctrls.AuthCommand.RegisterRoutes(api)
+	ctrls.OIDCCommand.RegisterRoutes(api)
+	ctrls.ReviewCommand.RegisterRoutes(api)
+	ctrls.CollectionCommand.RegisterRoutes(api)
+	ctrls.DriveCommand.RegisterRoutes(api)
+	ctrls.HazardCommand.RegisterRoutes(api)
+	ctrls.AdminCommand.RegisterRoutes(api)
+	ctrls.RevisionCommand.RegisterRoutes(api)
+	ctrls.SubmissionCommand.RegisterRoutes(api)
+	ctrls.PhotoCommand.RegisterRoutes(api)
+	ctrls.LogCommand.RegisterRoutes(api)
 }
@@ -1,8 +1,17 @@
 package main
 
 import (
+	"context"
+	"crypto/rand"
+	"errors"
 	"fmt"
-	"log"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -11,10 +20,26 @@ import (
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
 
+	appCommand "github.com/sunDar0/winding-road-finder/backend/application/command"
 	appQuery "github.com/sunDar0/winding-road-finder/backend/application/query"
+	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/photo"
+	"github.com/sunDar0/winding-road-finder/backend/domain/trip"
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+	"github.com/sunDar0/winding-road-finder/backend/domain/weather"
+	"github.com/sunDar0/winding-road-finder/backend/infrastructure/auth"
+	"github.com/sunDar0/winding-road-finder/backend/infrastructure/external/oidc"
+	weatherInfra "github.com/sunDar0/winding-road-finder/backend/infrastructure/external/weather"
+	"github.com/sunDar0/winding-road-finder/backend/infrastructure/logging"
+	"github.com/sunDar0/winding-road-finder/backend/infrastructure/media"
+	"github.com/sunDar0/winding-road-finder/backend/infrastructure/metrics"
+	commandRepo "github.com/sunDar0/winding-road-finder/backend/infrastructure/persistence/command"
 	queryRepo "github.com/sunDar0/winding-road-finder/backend/infrastructure/persistence/query"
+	"github.com/sunDar0/winding-road-finder/backend/infrastructure/tracing"
+	commandCtrl "github.com/sunDar0/winding-road-finder/backend/interfaces/controllers/command"
 	queryCtrl "github.com/sunDar0/winding-road-finder/backend/interfaces/controllers/query"
 	routes "github.com/sunDar0/winding-road-finder/backend/interfaces/routes"
+	"github.com/sunDar0/winding-road-finder/backend/middlewares"
 	"github.com/sunDar0/winding-road-finder/backend/utils"
 )
 
@@ -23,34 +48,78 @@ import (
 // @description 와인딩 로드 파인더 API 문서
 // @host localhost:8080
 // @BasePath /api
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+// @description "Bearer <accessToken>" 형식
 func main() {
 	// .env 파일 로드
-	if err := godotenv.Load(); err != nil {
-		log.Println("No .env file found")
-	}
+	envErr := godotenv.Load()
 
 	// 환경변수 로드
 	config := utils.LoadConfig()
 
+	// 로거 설정 (레벨은 관리자 API로 실행 중에 바꿀 수 있음)
+	logLevel := new(slog.LevelVar)
+	level, err := logging.ParseLevel(config.LogLevel)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "알 수 없는 LOG_LEVEL입니다: %s (debug, info, warn, error)\n", config.LogLevel)
+		os.Exit(1)
+	}
+	logLevel.Set(level)
+	logger, err := logging.New(os.Stdout, config.LogFormat, logLevel)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	slog.SetDefault(logger)
+	if envErr != nil {
+		logger.Debug(".env 파일이 없습니다")
+	}
+
+	// 트레이스(OTLP 수집기로 내보내기)와 Prometheus 지표
+	shutdownTracing, err := tracing.Setup(context.Background(), tracing.Config{
+		Enabled:     config.TracingEnabled,
+		ServiceName: config.TracingServiceName,
+		SampleRatio: config.TracingSampleRatio,
+	})
+	if err != nil {
+		fatal(logger, "트레이스 설정 실패", err)
+	}
+	if config.TracingEnabled {
+		logger.Info("OTLP로 트레이스를 내보냅니다", "service", config.TracingServiceName, "sample_ratio", config.TracingSampleRatio)
+	}
+	appMetrics := metrics.New()
+
 	// 이미지 생성 부트스트랩
 	if config.IsNaverConfigValid() {
-		fmt.Println("네이버 지도 API 설정 확인됨. 코스 이미지 생성을 시작합니다...")
-		if err := generateCourseImages(config); err != nil {
-			log.Printf("이미지 생성 중 에러 발생: %v", err)
-		} else {
-			fmt.Println("모든 코스 이미지 생성 완료!")
+		logger.Info("네이버 지도 API 설정 확인됨. 코스 이미지 생성을 시작합니다")
+		if err := generateCourseImages(config, logger, appMetrics); err != nil {
+			logger.Error("코스 이미지 생성 실패", "error", err)
 		}
 	} else {
-		log.Println("네이버 API 설정이 없습니다. NEXT_PUBLIC_NAVER_CLIENT_ID와 NEXT_PUBLIC_NAVER_CLIENT 환경변수를 설정해주세요.")
+		logger.Warn("네이버 API 설정이 없습니다. NEXT_PUBLIC_NAVER_CLIENT_ID와 NEXT_PUBLIC_NAVER_CLIENT 환경변수를 설정해주세요.")
 	}
 
-	r := gin.Default()
+	// gin의 디버그 출력도 같은 로거로 남깁니다.
+	gin.DebugPrintFunc = func(format string, values ...any) {
+		logger.Debug(strings.TrimSpace(fmt.Sprintf(format, values...)))
+	}
+	gin.DebugPrintRouteFunc = func(method, path, handler string, handlers int) {
+		logger.Debug("route", "method", method, "path", path, "handler", handler)
+	}
+	r := gin.New()
+	// 트레이스 ID가 접근 로그에 남도록 Tracing을 AccessLog보다 먼저 둡니다.
+	r.Use(middlewares.RequestID(), middlewares.Tracing(), middlewares.AccessLog(logger), middlewares.Metrics(appMetrics), middlewares.Recovery(logger))
 
 	// CORS 설정
 	config_cors := cors.DefaultConfig()
 	config_cors.AllowOrigins = []string{"http://localhost:3000"}
-	config_cors.AllowMethods = []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"}
-	config_cors.AllowHeaders = []string{"Origin", "Content-Type", "Accept", "Authorization"}
+	config_cors.AllowMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"}
+	config_cors.AllowHeaders = []string{"Origin", "Content-Type", "Accept", "Authorization", middlewares.RequestIDHeader}
+	config_cors.ExposeHeaders = []string{middlewares.RequestIDHeader}
+	// 외부 로그인의 state·ticket 쿠키를 주고받을 수 있도록 credentials를 허용합니다.
+	config_cors.AllowCredentials = true
 	r.Use(cors.New(config_cors))
 
 	// 정적 파일 서빙 설정
@@ -64,41 +133,288 @@ func main() {
 	})
 
 	// CQRS 의존성 주입 및 라우트 등록
-	// 코스 조회 서비스 및 레포지토리
-	courseRepo := queryRepo.NewCourseQueryRepository()
-	courseService := appQuery.NewCourseQueryService(courseRepo)
+	// 지역 조회 서비스 및 레포지토리
+	regionRepo := queryRepo.NewRegionQueryRepository()
+	regionService := appQuery.NewRegionQueryService(regionRepo)
+	// 스타일 조회 서비스 및 레포지토리
+	styleRepo := queryRepo.NewStyleQueryRepository()
+	styleService := appQuery.NewStyleQueryService(styleRepo)
+	// 코스 이벤트 저장소와 이벤트를 투영한 코스 조회 레포지토리
+	courseEvents := commandRepo.NewCourseEventStore(config.StateDir, logger)
+	courseRepo := queryRepo.NewProjectedCourseQueryRepository(regionRepo, styleRepo, courseEvents, logger, appMetrics)
+	// 관리자 API가 이전 이력 없이 코스 이벤트를 쌓지 않도록 시작할 때 courses.json을 이벤트로 가져옵니다.
+	if _, err := courseRepo.FindAll(course.CourseFilter{}); err != nil {
+		fatal(logger, "코스 데이터 로드 실패", err)
+	}
+	// 날씨 예보 제공자(격자별 캐시) 및 서비스
+	weatherService := appQuery.NewWeatherQueryService(weatherInfra.NewCachedProvider(weatherProvider(config, logger), config.WeatherCacheTTL, appMetrics), courseRepo, logger)
+	if config.WeatherProvider != "none" {
+		go refreshWeather(weatherService, config.WeatherRefreshInterval, logger)
+	}
+	courseService := appQuery.NewCourseQueryService(courseRepo, regionService, styleService, weatherService)
 	// 추천 코스 조회 서비스 및 레포지토리
 	recRepo := queryRepo.NewRecommendationQueryRepository()
-	recService := appQuery.NewRecommendationQueryService(recRepo, courseRepo)
+	recService := appQuery.NewRecommendationQueryService(recRepo, courseRepo, styleService, regionService, logger)
+	// 해석할 수 없는 추천 규칙은 시작할 때 알리고, 조회할 때는 건너뜁니다.
+	if invalid, err := recService.CheckRules(); err != nil {
+		fatal(logger, "추천 데이터 로드 실패", err)
+	} else if invalid > 0 {
+		logger.Warn("해석할 수 없는 추천 규칙이 있습니다", "count", invalid)
+	}
+	// 사용자 계정 저장소
+	userRepo := commandRepo.NewUserCommandRepository(config.StateDir)
+	// 코스 리뷰 저장소 및 서비스
+	reviewRepo := commandRepo.NewReviewCommandRepository(config.StateDir)
+	reviewService := appQuery.NewReviewQueryService(reviewRepo, userRepo)
+	reviewCommandService := appCommand.NewReviewCommandService(reviewRepo, courseRepo)
+	// 사용자 컬렉션 저장소 및 서비스
+	collectionRepo := commandRepo.NewCollectionCommandRepository(config.StateDir)
+	collectionService := appQuery.NewCollectionQueryService(collectionRepo, recService)
+	collectionCommandService := appCommand.NewCollectionCommandService(collectionRepo, courseRepo)
+	// 주행 기록 저장소 및 서비스
+	driveRepo := commandRepo.NewDriveCommandRepository(config.StateDir)
+	driveService := appQuery.NewDriveQueryService(driveRepo, courseRepo, recService, regionService)
+	driveCommandService := appCommand.NewDriveCommandService(driveRepo, courseRepo)
+	// 위험 신고 저장소 및 서비스
+	hazardRepo := commandRepo.NewHazardCommandRepository(config.StateDir)
+	hazardService := appQuery.NewHazardQueryService(hazardRepo)
+	hazardCommandService := appCommand.NewHazardCommandService(hazardRepo, courseRepo)
+	// 관리자 작업 기록 저장소와 큐레이션 데이터(코스, 추천) 수정 서비스
+	auditRepo := commandRepo.NewAuditCommandRepository(config.StateDir)
+	var imageGenerator course.ImageGenerator
+	if config.IsNaverConfigValid() {
+		imageGenerator = utils.NewMapImageGenerator(config, logger, appMetrics)
+	}
+	adminCommandService := appCommand.NewAdminCommandService(
+		courseEvents, commandRepo.NewRecommendationCommandRepository(queryRepo.RecommendationsPath),
+		hazardRepo, reviewRepo, auditRepo, styleRepo, regionRepo, imageGenerator)
+	adminService := appQuery.NewAdminQueryService(auditRepo, reviewRepo, hazardRepo, userRepo)
+	courseHistoryService := appQuery.NewCourseHistoryQueryService(courseRepo, userRepo)
+	// 코스 수정안 저장소 및 서비스 (승인하면 관리자 코스 수정과 같은 경로로 게시)
+	revisionRepo := commandRepo.NewRevisionCommandRepository(config.StateDir)
+	revisionService := appQuery.NewRevisionQueryService(revisionRepo, courseRepo, userRepo)
+	revisionCommandService := appCommand.NewRevisionCommandService(revisionRepo, courseRepo, adminCommandService)
+	// 새 코스 제보 저장소 및 서비스 (수락하면 관리자 코스 추가와 같은 경로로 게시)
+	submissionRepo := commandRepo.NewSubmissionCommandRepository(config.StateDir)
+	submissionService := appQuery.NewSubmissionQueryService(submissionRepo, courseRepo, userRepo)
+	submissionCommandService := appCommand.NewSubmissionCommandService(submissionRepo, courseRepo, adminCommandService)
+	// 코스 사진 저장소(로컬 디스크 또는 S3 호환 스토리지) 및 서비스
+	mediaStore := photoStore(r, config, logger)
+	photoRepo := commandRepo.NewPhotoCommandRepository(config.StateDir)
+	photoService := appQuery.NewPhotoQueryService(photoRepo, courseRepo, userRepo, mediaStore)
+	photoCommandService := appCommand.NewPhotoCommandService(photoRepo, courseRepo, media.NewImageProcessor(), mediaStore, logger)
+	// 여행 일정 계획 서비스 (직선거리 기반 추정)
+	tripService := appQuery.NewTripQueryService(courseRepo, recService, trip.DefaultEstimator)
+	// 코스 DTO 변환기 (스타일, 지역, 커뮤니티 평점, 활성 위험 신고)
+	mappers := queryCtrl.NewCourseMapperFactory(styleService, regionService, reviewService, hazardService, course.NavLinkOptions{NaverAppName: config.NavAppName, KakaoAppKey: config.KakaoAppKey})
 	// 코스 컨트롤러
-	controller := queryCtrl.NewCourseQueryController(courseService, recService)
-	routes.RegisterRoutes(r, controller)
+	controller := queryCtrl.NewCourseQueryController(courseService, recService, mappers)
+	// 지역 컨트롤러
+	regionController := queryCtrl.NewRegionQueryController(regionService)
+	// 스타일 컨트롤러
+	styleController := queryCtrl.NewStyleQueryController(styleService)
+	// 점수 기반 추천 컨트롤러
+	recController := queryCtrl.NewRecommendationQueryController(recService, mappers)
+	// 토큰 서비스 및 인증 서비스
+	tokenService := auth.NewJWTTokenService(jwtSecret(config, logger), config.AccessTokenTTL, config.RefreshTokenTTL)
+	authService := appCommand.NewAuthCommandService(userRepo, tokenService, logger)
+	if config.AdminEmail != "" {
+		if err := authService.EnsureAdmin(config.AdminEmail, config.AdminPassword); err != nil {
+			fatal(logger, "관리자 계정 준비 실패", err)
+		}
+	}
+	userService := appQuery.NewUserQueryService(userRepo)
+	// 외부 로그인(OIDC) 공급자 및 서비스
+	var identityProviders []user.IdentityProvider
+	for _, p := range config.OIDCProviders {
+		if p.Issuer == "" || p.ClientID == "" {
+			logger.Warn(fmt.Sprintf("OIDC 공급자 설정이 부족해 건너뜁니다. OIDC_%s_ISSUER, OIDC_%s_CLIENT_ID를 확인해주세요.", strings.ToUpper(p.Name), strings.ToUpper(p.Name)), "provider", p.Name)
+			continue
+		}
+		identityProviders = append(identityProviders, oidc.NewProvider(oidc.Config{
+			Name:         p.Name,
+			Issuer:       p.Issuer,
+			ClientID:     p.ClientID,
+			ClientSecret: p.ClientSecret,
+			RedirectURL:  p.RedirectURL,
+		}))
+	}
+	oidcService := appCommand.NewOIDCCommandService(userRepo, tokenService, identityProviders)
+	routes.RegisterRoutes(r, tokenService, routes.Controllers{
+		CourseQuery:         controller,
+		RegionQuery:         regionController,
+		StyleQuery:          styleController,
+		RecommendationQuery: recController,
+		UserQuery:           queryCtrl.NewUserQueryController(userService),
+		AuthCommand:         commandCtrl.NewAuthCommandController(authService),
+		OIDCCommand:         commandCtrl.NewOIDCCommandController(oidcService, config.OIDCCompleteURL),
+		ReviewQuery:         queryCtrl.NewReviewQueryController(reviewService, courseService),
+		ReviewCommand:       commandCtrl.NewReviewCommandController(reviewCommandService),
+		CollectionQuery:     queryCtrl.NewCollectionQueryController(collectionService, mappers),
+		CollectionCommand:   commandCtrl.NewCollectionCommandController(collectionCommandService),
+		DriveQuery:          queryCtrl.NewDriveQueryController(driveService),
+		DriveCommand:        commandCtrl.NewDriveCommandController(driveCommandService),
+		HazardQuery:         queryCtrl.NewHazardQueryController(hazardService, courseService),
+		WeatherQuery:        queryCtrl.NewWeatherQueryController(weatherService),
+		TripQuery:           queryCtrl.NewTripQueryController(tripService),
+		HazardCommand:       commandCtrl.NewHazardCommandController(hazardCommandService),
+		AdminQuery:          queryCtrl.NewAdminQueryController(adminService),
+		CourseHistoryQuery:  queryCtrl.NewCourseHistoryQueryController(courseHistoryService, mappers),
+		AdminCommand:        commandCtrl.NewAdminCommandController(adminCommandService),
+		RevisionQuery:       queryCtrl.NewRevisionQueryController(revisionService, mappers),
+		RevisionCommand:     commandCtrl.NewRevisionCommandController(revisionCommandService),
+		SubmissionQuery:     queryCtrl.NewSubmissionQueryController(submissionService),
+		SubmissionCommand:   commandCtrl.NewSubmissionCommandController(submissionCommandService),
+		PhotoQuery:          queryCtrl.NewPhotoQueryController(photoService),
+		PhotoCommand:        commandCtrl.NewPhotoCommandController(photoCommandService),
+		LogQuery:            queryCtrl.NewLogQueryController(logLevel),
+		LogCommand:          commandCtrl.NewLogCommandController(logLevel, logger),
+	})
+
+	serve(r, metricsServer(config.MetricsAddr, appMetrics), logger, shutdownTracing)
+}
 
-	r.Run(":8080")
+// metricsServer는 Prometheus 지표(/metrics)만 제공하는 내부 서버입니다. 공개 API 리스너와 분리해 인증 없이 외부에 노출되지 않게 합니다.
+func metricsServer(addr string, m *metrics.Metrics) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", m.Handler())
+	return &http.Server{Addr: addr, Handler: mux}
+}
+
+// serve는 SIGINT/SIGTERM을 받을 때까지 API 서버와 지표 서버를 실행합니다. 종료할 때 처리 중인 요청과 남은 스팬을 마저 보냅니다.
+func serve(r *gin.Engine, metricsSrv *http.Server, logger *slog.Logger, shutdownTracing func(context.Context) error) {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	srv := &http.Server{Addr: ":8080", Handler: r}
+	go func() {
+		logger.Info("서버를 시작합니다", "addr", srv.Addr)
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			fatal(logger, "서버 실행 실패", err)
+		}
+	}()
+	go func() {
+		logger.Info("지표 서버를 시작합니다", "addr", metricsSrv.Addr)
+		if err := metricsSrv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			fatal(logger, "지표 서버 실행 실패", err)
+		}
+	}()
+	<-ctx.Done()
+
+	logger.Info("서버를 종료합니다")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(ctx); err != nil {
+		logger.Error("서버 종료 실패", "error", err)
+	}
+	if err := metricsSrv.Shutdown(ctx); err != nil {
+		logger.Error("지표 서버 종료 실패", "error", err)
+	}
+	if err := shutdownTracing(ctx); err != nil {
+		logger.Error("남은 스팬 내보내기 실패", "error", err)
+	}
+}
+
+// fatal은 에러를 남기고 서버를 종료합니다.
+func fatal(logger *slog.Logger, msg string, err error) {
+	logger.Error(msg, "error", err)
+	os.Exit(1)
+}
+
+// jwtSecret은 토큰 서명 키를 반환합니다. JWT_SECRET이 없으면 임시 키를 만들며, 이 경우 서버를 재시작하면 기존 토큰이 무효가 됩니다.
+func jwtSecret(config *utils.Config, logger *slog.Logger) []byte {
+	if config.JWTSecret != "" {
+		return []byte(config.JWTSecret)
+	}
+	logger.Warn("JWT_SECRET이 설정되지 않아 임시 서명 키를 사용합니다. 재시작하면 발급된 토큰이 무효가 됩니다.")
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		fatal(logger, "서명 키 생성 실패", err)
+	}
+	return secret
+}
+
+// weatherProvider는 설정에 따라 날씨 예보 제공자를 만듭니다.
+func weatherProvider(config *utils.Config, logger *slog.Logger) weather.Provider {
+	switch config.WeatherProvider {
+	case "kma":
+		if config.KMAServiceKey == "" {
+			fatal(logger, "날씨 예보 제공자 설정 오류", errors.New("WEATHER_PROVIDER=kma에는 KMA_SERVICE_KEY가 필요합니다"))
+		}
+		return weatherInfra.NewKMAProvider("", config.KMAServiceKey)
+	case "stub":
+		provider, err := weatherInfra.NewStubProvider(config.WeatherStubFile)
+		if err != nil {
+			fatal(logger, "날씨 스텁 파일 로드 실패", err)
+		}
+		logger.Warn("날씨 스텁 제공자를 사용합니다. 모든 코스에 실제 날씨가 아닌 고정 예보를 보여주므로 운영 환경에서는 사용하지 마세요", "file", config.WeatherStubFile)
+		return provider
+	case "none":
+		logger.Warn("날씨 예보 제공자가 설정되지 않아 날씨 조회는 503을 반환합니다. KMA_SERVICE_KEY를 설정하면 기상청 예보를 사용합니다")
+		return weatherInfra.DisabledProvider{}
+	default:
+		fatal(logger, "날씨 예보 제공자 설정 오류", fmt.Errorf("알 수 없는 WEATHER_PROVIDER입니다: %s (kma, stub, none)", config.WeatherProvider))
+		return nil
+	}
+}
+
+// photoStore는 설정에 따라 사진 저장소를 만듭니다. local 저장소면 저장한 파일을 MediaBaseURL 경로로 제공합니다.
+func photoStore(r *gin.Engine, config *utils.Config, logger *slog.Logger) photo.MediaStore {
+	switch config.MediaStore {
+	case "local":
+		r.Static(config.MediaBaseURL, config.MediaDir)
+		return media.NewLocalStore(config.MediaDir, config.MediaBaseURL)
+	case "s3":
+		if config.S3Endpoint == "" || config.S3Bucket == "" {
+			fatal(logger, "사진 저장소 설정 오류", errors.New("MEDIA_STORE=s3에는 S3_ENDPOINT와 S3_BUCKET이 필요합니다"))
+		}
+		return media.NewS3Store(media.S3Config{
+			Endpoint:  config.S3Endpoint,
+			Bucket:    config.S3Bucket,
+			Region:    config.S3Region,
+			AccessKey: config.S3AccessKey,
+			SecretKey: config.S3SecretKey,
+			PublicURL: config.S3PublicURL,
+		})
+	default:
+		fatal(logger, "사진 저장소 설정 오류", fmt.Errorf("알 수 없는 MEDIA_STORE입니다: %s (local, s3)", config.MediaStore))
+		return nil
+	}
+}
+
+// refreshWeather는 주기적으로 전체 코스 예보를 받아 캐시를 채웁니다.
+func refreshWeather(service *appQuery.WeatherQueryService, interval time.Duration, logger *slog.Logger) {
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), interval)
+		if err := service.Refresh(ctx); err != nil {
+			logger.Error("날씨 예보 갱신 실패", "error", err)
+		}
+		cancel()
+		time.Sleep(interval)
+	}
 }
 
 // generateCourseImages는 모든 코스에 대해 이미지를 생성합니다.
-func generateCourseImages(config *utils.Config) error {
+func generateCourseImages(config *utils.Config, logger *slog.Logger, m *metrics.Metrics) error {
 	// 코스 데이터 로드
-	courseRepo := queryRepo.NewCourseQueryRepository()
-	courses, err := courseRepo.FindAll("", "", "")
+	courseRepo := queryRepo.NewCourseQueryRepository(queryRepo.NewRegionQueryRepository(), queryRepo.NewStyleQueryRepository(), logger, m)
+	courses, err := courseRepo.FindAll(course.CourseFilter{})
 	if err != nil {
 		return fmt.Errorf("코스 데이터 로드 실패: %v", err)
 	}
 
 	// 이미지 생성기 초기화
-	generator := utils.NewMapImageGenerator(config)
+	generator := utils.NewMapImageGenerator(config, logger, m)
 
 	// 각 코스별로 이미지 생성
 	successCount := 0
 	for _, course := range courses {
-		if err := generator.GenerateImageForCourse(course); err != nil {
-			log.Printf("코스 %d 이미지 생성 실패: %v", course.ID, err)
+		if err := generator.GenerateImageForCourse(context.Background(), course); err != nil {
+			logger.Error("코스 이미지 생성 실패", "course_id", course.ID, "error", err)
 		} else {
 			successCount++
 		}
 	}
 
-	fmt.Printf("총 %d개 코스 중 %d개 이미지 생성 성공\n", len(courses), successCount)
+	logger.Info("코스 이미지 생성 완료", "courses", len(courses), "succeeded", successCount)
 	return nil
 }
@@ -0,0 +1,77 @@
+package middlewares
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+	"github.com/sunDar0/winding-road-finder/backend/interfaces/messages"
+)
+
+const principalContextKey = "principal"
+
+// Authenticate는 Authorization: Bearer 헤더의 액세스 토큰을 검증해 요청 사용자를 컨텍스트에 저장합니다.
+// 헤더가 없으면 익명 요청으로 통과시키고, 토큰이 잘못되었으면 401로 중단합니다.
+// 로그인이 필요한 엔드포인트는 RequireAuth 또는 RequireRole을 함께 사용합니다.
+func Authenticate(tokens user.TokenService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader("Authorization")
+		if header == "" {
+			c.Next()
+			return
+		}
+		token, ok := strings.CutPrefix(header, "Bearer ")
+		if !ok {
+			abortWithError(c, http.StatusUnauthorized, messages.InvalidToken)
+			return
+		}
+		principal, err := tokens.Verify(strings.TrimSpace(token))
+		if err != nil {
+			abortWithError(c, http.StatusUnauthorized, messages.InvalidToken)
+			return
+		}
+		c.Set(principalContextKey, principal)
+		c.Next()
+	}
+}
+
+// RequireAuth는 인증된 요청만 통과시킵니다.
+func RequireAuth() gin.HandlerFunc {
+	return RequireRole(user.RoleViewer)
+}
+
+// RequireRole은 role 이상의 권한을 가진 사용자만 통과시킵니다.
+// 익명 요청은 401, 권한이 부족하면 403으로 중단합니다.
+func RequireRole(role user.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, ok := PrincipalFrom(c)
+		if !ok {
+			abortWithError(c, http.StatusUnauthorized, messages.Unauthorized)
+			return
+		}
+		if !principal.Role.Allows(role) {
+			abortWithError(c, http.StatusForbidden, messages.Forbidden)
+			return
+		}
+		c.Next()
+	}
+}
+
+// PrincipalFrom은 Authenticate 미들웨어가 저장한 요청 사용자를 반환합니다.
+func PrincipalFrom(c *gin.Context) (*user.Principal, bool) {
+	if v, ok := c.Get(principalContextKey); ok {
+		if p, ok := v.(*user.Principal); ok {
+			return p, true
+		}
+	}
+	return nil, false
+}
+
+func abortWithError(c *gin.Context, status int, key string) {
+	if status == http.StatusUnauthorized {
+		c.Header("WWW-Authenticate", `Bearer realm="api"`)
+	}
+	c.AbortWithStatusJSON(status, messages.ErrorResponse(LangFrom(c), key, nil))
+}
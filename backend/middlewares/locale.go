@@ -0,0 +1,47 @@
+package middlewares
+
+import (
+	"github.com/gin-gonic/gin"
+	"golang.org/x/text/language"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+)
+
+const langContextKey = "lang"
+
+// langMatcher는 지원 언어 중 요청에 가장 가까운 언어를 고릅니다. 첫 번째 태그(한국어)가 기본값입니다.
+var langMatcher = language.NewMatcher([]language.Tag{language.Korean, language.English, language.Japanese})
+
+// Locale은 ?lang= 쿼리 또는 Accept-Language 헤더로 응답 언어를 결정해 컨텍스트에 저장합니다.
+// 쿼리 파라미터가 헤더보다 우선하며, 지원하지 않는 언어는 한국어로 대체합니다.
+func Locale() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		lang := negotiateLang(c.Query("lang"), c.GetHeader("Accept-Language"))
+		c.Set(langContextKey, lang)
+		c.Header("Content-Language", string(lang))
+		c.Header("Vary", "Accept-Language")
+		c.Next()
+	}
+}
+
+func negotiateLang(query, acceptLanguage string) i18n.Lang {
+	if lang, ok := i18n.ParseLang(query); ok {
+		return lang
+	}
+	tag, _ := language.MatchStrings(langMatcher, query, acceptLanguage)
+	base, _ := tag.Base()
+	if lang, ok := i18n.ParseLang(base.String()); ok {
+		return lang
+	}
+	return i18n.DefaultLang
+}
+
+// LangFrom은 Locale 미들웨어가 결정한 응답 언어를 반환합니다.
+func LangFrom(c *gin.Context) i18n.Lang {
+	if v, ok := c.Get(langContextKey); ok {
+		if lang, ok := v.(i18n.Lang); ok {
+			return lang
+		}
+	}
+	return i18n.DefaultLang
+}
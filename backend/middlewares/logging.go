@@ -0,0 +1,96 @@
+package middlewares
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/sunDar0/winding-road-finder/backend/infrastructure/logging"
+	"github.com/sunDar0/winding-road-finder/backend/interfaces/messages"
+)
+
+// RequestIDHeader는 요청 ID를 주고받는 헤더입니다.
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength는 클라이언트가 보낸 요청 ID를 그대로 쓸 수 있는 최대 길이입니다.
+const maxRequestIDLength = 128
+
+// RequestID는 X-Request-ID 헤더의 요청 ID를 요청 컨텍스트에 넣고 응답 헤더로 돌려줍니다.
+// 헤더가 없거나 형식이 맞지 않으면(영문, 숫자, '-', '_', '.', ':'만 128자까지) 새로 만듭니다.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(RequestIDHeader)
+		if !validRequestID(id) {
+			id = newRequestID()
+		}
+		c.Request = c.Request.WithContext(logging.WithRequestID(c.Request.Context(), id))
+		c.Header(RequestIDHeader, id)
+		c.Next()
+	}
+}
+
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range id {
+		switch {
+		case 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z', '0' <= r && r <= '9':
+		case r == '-', r == '_', r == '.', r == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
+
+// AccessLog는 요청마다 메서드, 경로, 상태 코드, 처리 시간을 한 줄씩 남깁니다.
+// 5xx는 error, 그 밖에는 info 레벨이며, 핸들러가 c.Error로 남긴 에러가 있으면 함께 기록합니다.
+func AccessLog(logger *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+		status := c.Writer.Status()
+		attrs := []slog.Attr{
+			slog.String("method", c.Request.Method),
+			slog.String("path", c.Request.URL.Path),
+			slog.String("route", c.FullPath()),
+			slog.Int("status", status),
+			slog.Float64("duration_ms", float64(time.Since(start).Microseconds())/1000),
+			slog.Int("bytes", max(c.Writer.Size(), 0)),
+			slog.String("client_ip", c.ClientIP()),
+		}
+		if principal, ok := PrincipalFrom(c); ok {
+			attrs = append(attrs, slog.Int("user_id", principal.UserID))
+		}
+		if len(c.Errors) > 0 {
+			attrs = append(attrs, slog.String("error", c.Errors.String()))
+		}
+		level := slog.LevelInfo
+		if status >= http.StatusInternalServerError {
+			level = slog.LevelError
+		}
+		logger.LogAttrs(c.Request.Context(), level, "request", attrs...)
+	}
+}
+
+// Recovery는 핸들러의 panic을 스택과 함께 로그로 남기고 500으로 응답합니다.
+func Recovery(logger *slog.Logger) gin.HandlerFunc {
+	return gin.CustomRecoveryWithWriter(nil, func(c *gin.Context, err any) {
+		logger.ErrorContext(c.Request.Context(), "panic", "error", err, "stack", string(debug.Stack()))
+		abortWithError(c, http.StatusInternalServerError, messages.InternalError)
+	})
+}
@@ -0,0 +1,164 @@
+package middlewares
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/sunDar0/winding-road-finder/backend/domain/user"
+	"github.com/sunDar0/winding-road-finder/backend/infrastructure/logging"
+)
+
+var generatedRequestID = regexp.MustCompile(`^[0-9a-f]{32}$`)
+
+// newLoggedRouter는 JSON 로그를 buf에 남기는 RequestID, AccessLog 라우터를 만듭니다.
+func newLoggedRouter(t *testing.T, buf *bytes.Buffer) (*gin.Engine, *slog.Logger) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	logger, err := logging.New(buf, "json", slog.LevelDebug)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := gin.New()
+	r.Use(RequestID(), AccessLog(logger))
+	return r, logger
+}
+
+// logLines는 buf에 남은 JSON 로그를 줄마다 읽습니다.
+func logLines(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+	var lines []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var entry map[string]any
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("log line %q: %v", line, err)
+		}
+		lines = append(lines, entry)
+	}
+	return lines
+}
+
+func TestRequestID(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		keep   bool // 참이면 보낸 값을 그대로 쓴다
+	}{
+		{"헤더 없음", "", false},
+		{"그대로 쓴다", "req-01.a_b:c", true},
+		{"128자까지 쓴다", strings.Repeat("a", maxRequestIDLength), true},
+		{"129자는 새로 만든다", strings.Repeat("a", maxRequestIDLength+1), false},
+		{"공백은 새로 만든다", "req 01", false},
+		{"줄바꿈은 새로 만든다", "req\n01", false},
+		{"한글은 새로 만든다", "요청", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			r, logger := newLoggedRouter(t, &buf)
+			r.GET("/courses", func(c *gin.Context) {
+				logger.InfoContext(c.Request.Context(), "handler")
+				c.Status(http.StatusOK)
+			})
+			req := httptest.NewRequest(http.MethodGet, "/courses", nil)
+			if tt.header != "" {
+				req.Header.Set(RequestIDHeader, tt.header)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			id := w.Header().Get(RequestIDHeader)
+			if tt.keep && id != tt.header {
+				t.Fatalf("%s = %q, want %q", RequestIDHeader, id, tt.header)
+			}
+			if !tt.keep && !generatedRequestID.MatchString(id) {
+				t.Fatalf("%s = %q, want a generated ID", RequestIDHeader, id)
+			}
+			// 핸들러 로그와 접근 로그 모두 응답 헤더와 같은 요청 ID를 남긴다.
+			lines := logLines(t, &buf)
+			if len(lines) != 2 {
+				t.Fatalf("got %d log lines, want 2", len(lines))
+			}
+			for _, line := range lines {
+				if line[logging.RequestIDKey] != id {
+					t.Errorf("%s log %s = %v, want %q", line["msg"], logging.RequestIDKey, line[logging.RequestIDKey], id)
+				}
+			}
+		})
+	}
+}
+
+func TestAccessLog(t *testing.T) {
+	tests := []struct {
+		name      string
+		target    string
+		status    int
+		principal *user.Principal
+		err       error
+		level     string
+	}{
+		{"성공", "/courses/7", http.StatusOK, nil, nil, "INFO"},
+		{"로그인한 사용자", "/courses/7", http.StatusOK, &user.Principal{UserID: 3, Role: user.RoleViewer}, nil, "INFO"},
+		{"클라이언트 오류는 info", "/courses/7", http.StatusNotFound, nil, errors.New("코스 없음"), "INFO"},
+		{"서버 오류는 error", "/courses/7", http.StatusInternalServerError, nil, errors.New("저장소 오류"), "ERROR"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			r, _ := newLoggedRouter(t, &buf)
+			r.GET("/courses/:id", func(c *gin.Context) {
+				if tt.principal != nil {
+					c.Set(principalContextKey, tt.principal)
+				}
+				if tt.err != nil {
+					c.Error(tt.err)
+				}
+				c.String(tt.status, "ok")
+			})
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			req.Header.Set(RequestIDHeader, "access-log")
+			r.ServeHTTP(httptest.NewRecorder(), req)
+
+			lines := logLines(t, &buf)
+			if len(lines) != 1 {
+				t.Fatalf("got %d log lines, want 1", len(lines))
+			}
+			got := lines[0]
+			want := map[string]any{
+				"level":              tt.level,
+				"msg":                "request",
+				"method":             http.MethodGet,
+				"path":               tt.target,
+				"route":              "/courses/:id",
+				"status":             float64(tt.status),
+				"bytes":              float64(len("ok")),
+				logging.RequestIDKey: "access-log",
+			}
+			for k, v := range want {
+				if got[k] != v {
+					t.Errorf("%s = %v, want %v", k, got[k], v)
+				}
+			}
+			if _, ok := got["duration_ms"].(float64); !ok {
+				t.Errorf("duration_ms = %v, want a number", got["duration_ms"])
+			}
+			if tt.principal != nil && got["user_id"] != float64(tt.principal.UserID) {
+				t.Errorf("user_id = %v, want %d", got["user_id"], tt.principal.UserID)
+			}
+			if tt.principal == nil && got["user_id"] != nil {
+				t.Errorf("user_id = %v, want none", got["user_id"])
+			}
+			if tt.err != nil && !strings.Contains(got["error"].(string), tt.err.Error()) {
+				t.Errorf("error = %v, want %q", got["error"], tt.err)
+			}
+		})
+	}
+}
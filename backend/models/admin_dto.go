@@ -0,0 +1,103 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// LocalizedTextDto는 언어 코드(ko, en, ja)별 번역 문자열입니다. 한국어(ko)는 기본 언어입니다.
+type LocalizedTextDto map[string]string
+
+// AdminCourseRequest는 관리자 코스 생성/수정 요청입니다. 수정은 코스 전체를 바꿉니다.
+type AdminCourseRequest struct {
+	Name            LocalizedTextDto      `json:"name" binding:"required"`
+	Region          string                `json:"region" binding:"required"` // 시·도 이름
+	Tagline         LocalizedTextDto      `json:"tagline"`
+	Characteristics LocalizedTextDto      `json:"characteristics"`
+	NaverMapUrl     string                `json:"naverMapUrl"`
+	Nav             []AdminCourseNavDto   `json:"nav"`
+	Notes           LocalizedTextDto      `json:"notes"`
+	Styles          []string              `json:"styles"` // 스타일 slug, 이름 또는 동의어
+	Ratings         CourseRatingsDto      `json:"ratings" binding:"required"`
+	Availability    *AdminAvailabilityDto `json:"availability"`
+	Reason          string                `json:"reason"` // 변경 사유 (코스 변경 이력에 기록)
+}
+
+// AdminCourseNavDto는 번역을 모두 담은 내비게이션 포인트입니다.
+type AdminCourseNavDto struct {
+	Type        string               `json:"type"` // 출발지, 경유지 N, 도착지
+	Name        LocalizedTextDto     `json:"name"`
+	Geolocation CourseGeolocationDto `json:"geolocation"`
+	Summit      bool                 `json:"summit"`
+}
+
+// AdminAvailabilityDto는 계절 통제 기간, 추천 시기, 시간대 제한입니다.
+type AdminAvailabilityDto struct {
+	Closures          []AdminPeriodDto `json:"closures"` // MM-DD
+	RecommendedMonths []int            `json:"recommendedMonths"`
+	Restrictions      []AdminPeriodDto `json:"restrictions"` // HH:MM
+}
+
+// AdminPeriodDto는 번역을 모두 담은 통제 기간 또는 제한 시간대입니다.
+type AdminPeriodDto struct {
+	From   string           `json:"from"`
+	To     string           `json:"to"`
+	Reason LocalizedTextDto `json:"reason"`
+}
+
+// AdminRecommendationRequest는 관리자 추천 생성/수정 요청입니다. rule이 있으면 규칙 추천, 없으면 courseIds 순서대로 보여줍니다.
+type AdminRecommendationRequest struct {
+	Title       LocalizedTextDto `json:"title" binding:"required"`
+	Description LocalizedTextDto `json:"description"`
+	CourseIds   []int            `json:"courseIds"`
+	Rule        string           `json:"rule"`
+	PinnedIds   []int            `json:"pinnedIds"`
+	ExcludedIds []int            `json:"excludedIds"`
+}
+
+// ReorderRecommendationRequest는 추천 코스 순서 변경 요청입니다. 규칙 추천은 고정 코스(pinnedIds) 순서를 바꿉니다.
+type ReorderRecommendationRequest struct {
+	CourseIDs []int `json:"courseIds" binding:"required"` // 현재 코스 ID 전체를 원하는 순서로
+}
+
+// ModerationRequest는 리뷰·위험 신고 숨김 요청입니다.
+type ModerationRequest struct {
+	Hidden *bool `json:"hidden" binding:"required"`
+}
+
+// AuditChangeDto는 필드 하나의 변경 전후 값입니다.
+type AuditChangeDto struct {
+	Path   string          `json:"path"`
+	Before json.RawMessage `json:"before,omitempty" swaggertype:"object"` // 없던 필드면 생략
+	After  json.RawMessage `json:"after,omitempty" swaggertype:"object"`  // 지운 필드면 생략
+}
+
+// AuditEntryDto는 관리자 작업 기록입니다.
+type AuditEntryDto struct {
+	ID         int              `json:"id"`
+	Actor      AuditActorDto    `json:"actor"`
+	Action     string           `json:"action"`     // create, update, delete, reorder, hide, unhide, render_images
+	TargetType string           `json:"targetType"` // course, recommendation, hazard, review
+	TargetID   int              `json:"targetId"`
+	Changes    []AuditChangeDto `json:"changes"`
+	At         time.Time        `json:"at"`
+}
+
+// AuditActorDto는 작업자입니다.
+type AuditActorDto struct {
+	ID    int    `json:"id"`
+	Email string `json:"email"`
+}
+
+// AuditPageDto는 페이지 단위 관리자 작업 기록입니다.
+type AuditPageDto struct {
+	Items []AuditEntryDto `json:"items"`
+	Page  int             `json:"page"`
+	Size  int             `json:"size"`
+	Total int             `json:"total"`
+}
+
+// LogLevelDto는 서버 로그 레벨입니다. (debug, info, warn, error)
+type LogLevelDto struct {
+	Level string `json:"level" binding:"required"`
+}
@@ -0,0 +1,57 @@
+package models
+
+import "time"
+
+// SignupRequest는 회원가입 요청입니다.
+type SignupRequest struct {
+	Email       string `json:"email" binding:"required"`
+	Password    string `json:"password" binding:"required"`
+	DisplayName string `json:"displayName"`
+}
+
+// LoginRequest는 로그인 요청입니다.
+type LoginRequest struct {
+	Email    string `json:"email" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+// RefreshRequest는 토큰 재발급 요청입니다.
+type RefreshRequest struct {
+	RefreshToken string `json:"refreshToken" binding:"required"`
+}
+
+// TokenResponse는 발급된 토큰입니다. 이후 요청에 Authorization: Bearer <accessToken> 헤더로 사용합니다.
+type TokenResponse struct {
+	AccessToken  string `json:"accessToken"`
+	RefreshToken string `json:"refreshToken"`
+	TokenType    string `json:"tokenType"` // 항상 Bearer
+	ExpiresIn    int    `json:"expiresIn"` // 액세스 토큰 유효 시간(초)
+}
+
+// UserDto는 사용자 계정 정보입니다.
+type UserDto struct {
+	ID          int           `json:"id"`
+	Email       string        `json:"email"`
+	DisplayName string        `json:"displayName"`
+	Role        string        `json:"role"` // viewer, editor, admin
+	HasPassword bool          `json:"hasPassword"`
+	Identities  []IdentityDto `json:"identities"`
+	CreatedAt   time.Time     `json:"createdAt"`
+}
+
+// ProvidersResponse는 설정된 외부 로그인 공급자 목록입니다.
+type ProvidersResponse struct {
+	Providers []string `json:"providers"`
+}
+
+// AuthorizeResponse는 외부 로그인 인가 요청 URL입니다.
+type AuthorizeResponse struct {
+	AuthorizationURL string `json:"authorizationUrl"`
+}
+
+// IdentityDto는 연결된 외부 로그인 계정입니다.
+type IdentityDto struct {
+	Provider string    `json:"provider"`
+	Email    string    `json:"email,omitempty"`
+	LinkedAt time.Time `json:"linkedAt"`
+}
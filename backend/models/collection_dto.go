@@ -0,0 +1,38 @@
+package models
+
+import "time"
+
+// CreateCollectionRequest는 컬렉션 생성 요청입니다.
+type CreateCollectionRequest struct {
+	Name      string `json:"name" binding:"required"`
+	CourseIDs []int  `json:"courseIds"` // 처음 담을 코스 (순서 유지)
+	Public    bool   `json:"public"`
+}
+
+// UpdateCollectionRequest는 컬렉션 이름/공개 여부 변경 요청입니다. 생략한 항목은 바꾸지 않습니다.
+type UpdateCollectionRequest struct {
+	Name   *string `json:"name"`
+	Public *bool   `json:"public"`
+}
+
+// AddCollectionCourseRequest는 컬렉션에 코스를 추가하는 요청입니다.
+type AddCollectionCourseRequest struct {
+	CourseID int `json:"courseId" binding:"required"`
+}
+
+// ReorderCollectionRequest는 컬렉션 코스 순서 변경 요청입니다.
+type ReorderCollectionRequest struct {
+	CourseIDs []int `json:"courseIds" binding:"required"` // 현재 코스 ID 전체를 원하는 순서로
+}
+
+// CollectionDto는 사용자 컬렉션입니다.
+type CollectionDto struct {
+	ID        int         `json:"id"`
+	Name      string      `json:"name"`
+	CourseIDs []int       `json:"courseIds"`
+	Public    bool        `json:"public"`
+	ShareURL  string      `json:"shareUrl,omitempty"` // 공개 컬렉션의 공유 링크 경로
+	Courses   []CourseDto `json:"courses,omitempty"`  // 상세 조회 시 코스 정보
+	CreatedAt time.Time   `json:"createdAt"`
+	UpdatedAt time.Time   `json:"updatedAt"`
+}
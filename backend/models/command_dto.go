@@ -0,0 +1,6 @@
+package models
+
+// CreatedResponse는 생성 요청의 응답입니다. 생성된 리소스는 Location 헤더의 경로로 조회합니다.
+type CreatedResponse struct {
+	ID int `json:"id"`
+}
@@ -2,7 +2,8 @@ package models
 
 // CourseNavDto는 내비게이션 경로 정보를 담습니다.
 type CourseNavDto struct {
-	Type string `json:"type"`
+	Type string `json:"type"`           // 요청 언어의 역할 이름 (출발지, 경유지 1, 도착지 등)
+	Role string `json:"role,omitempty"` // start, waypoint, end. 알 수 없는 형식이면 생략
 	Name string `json:"name"`
 	Geolocation CourseGeolocationDto `json:"geolocation"`
 }
@@ -25,16 +26,42 @@ type CourseRatingsDto struct {
 type CourseDto struct {
 	ID             int                `json:"id"`
 	Name           string             `json:"name"`
-	Region         string             `json:"region"`
+	Region         string             `json:"region"` // 요청 언어의 시·도 이름 (regionName과 같음, 이전 버전 호환용)
+	RegionCode     string             `json:"regionCode"`
+	RegionName     string             `json:"regionName"` // 요청 언어의 지역 이름
+	SubRegionCode  string             `json:"subRegionCode,omitempty"`
+	SubRegionName  string             `json:"subRegionName,omitempty"`
 	Tagline        string             `json:"tagline"`
 	Characteristics string            `json:"characteristics"`
 	NaverMapUrl     string            `json:"naverMapUrl"`
+	NavigationLinks map[string][]string `json:"navigationLinks,omitempty"` // 앱별 내비게이션 딥 링크. 경유지 제한을 넘으면 주행 순서대로 여러 개
 	ThumbnailImage  string            `json:"thumbnailImage"`  // 썸네일 이미지 URL
 	DetailImage     string            `json:"detailImage"`     // 상세 이미지 URL
 	Nav            []CourseNavDto     `json:"nav"`
 	Notes          string             `json:"notes"`
-	Styles         []string           `json:"styles"`
-	Ratings        CourseRatingsDto   `json:"ratings"`
+	Styles         []string           `json:"styles"`     // 스타일 표시 이름
+	StyleSlugs     []string           `json:"styleSlugs"` // 스타일 식별자
+	Ratings        CourseRatingsDto   `json:"ratings"`          // 큐레이터 평가
+	CommunityRatings CommunityRatingsDto `json:"communityRatings"` // 사용자 리뷰 집계
+	ActiveHazards    HazardBadgeDto      `json:"activeHazards"`    // 활성 위험 신고 요약
+	Availability     AvailabilityDto     `json:"availability"`     // 계절·시간대 이용 정보
+	Route            *CourseRouteSummaryDto `json:"route,omitempty"` // 도로 경로 요약. 경로를 구하지 않은 코스는 생략
+}
+
+// PeriodDto는 통제 기간 또는 제한 시간대입니다.
+type PeriodDto struct {
+	From   string `json:"from"` // 계절 통제는 MM-DD, 시간대 제한은 HH:MM
+	To     string `json:"to"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// AvailabilityDto는 코스의 계절·시간대 이용 정보입니다.
+type AvailabilityDto struct {
+	Closures          []PeriodDto `json:"closures"`          // 매년 반복되는 계절 통제 기간 (KST)
+	RecommendedMonths []int       `json:"recommendedMonths"` // 비어 있으면 연중 추천
+	Restrictions      []PeriodDto `json:"restrictions"`      // 매일 반복되는 통행 제한 시간대 (KST)
+	OpenNow           bool        `json:"openNow"`           // 서버 시각(KST) 기준 통행 가능 여부
+	ClosedReason      string      `json:"closedReason,omitempty"`
 }
 
 // RecommendationDto는 추천 카테고리 응답을 정의합니다.
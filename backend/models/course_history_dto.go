@@ -0,0 +1,27 @@
+package models
+
+import "time"
+
+// CourseHistoryDto는 코스 변경 이력과 조회 시점의 코스입니다.
+type CourseHistoryDto struct {
+	CourseID int              `json:"courseId"`
+	At       time.Time        `json:"at"`     // 조회 시점 (at 파라미터, 생략 시 현재)
+	Course   *CourseDto       `json:"course"` // 조회 시점의 코스, 그때 없었거나 삭제된 상태면 null
+	Events   []CourseEventDto `json:"events"` // 조회 시점까지의 이벤트 (오래된 순)
+}
+
+// CourseEventDto는 코스 변경 이벤트 하나입니다.
+type CourseEventDto struct {
+	Version int                  `json:"version"`
+	Type    string               `json:"type"`  // course_created, details_changed, ratings_changed, nav_updated, styles_changed, availability_changed, course_deleted
+	Actor   *CourseEventActorDto `json:"actor"` // 데이터 파일에서 가져온 변경이거나 작업자를 찾을 수 없으면 null
+	Reason  string               `json:"reason,omitempty"`
+	At      time.Time            `json:"at"`
+	Changes []AuditChangeDto     `json:"changes"` // 이벤트로 바뀐 필드의 전후 값
+}
+
+// CourseEventActorDto는 코스를 바꾼 관리자입니다.
+type CourseEventActorDto struct {
+	ID          int    `json:"id"`
+	DisplayName string `json:"displayName"`
+}
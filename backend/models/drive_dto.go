@@ -0,0 +1,57 @@
+package models
+
+import "time"
+
+// DriveRequest는 주행 기록 작성/수정 요청입니다.
+type DriveRequest struct {
+	CourseID  int    `json:"courseId" binding:"required"`
+	DrivenOn  string `json:"drivenOn" binding:"required"` // 주행일 (YYYY-MM-DD)
+	Direction string `json:"direction"`                   // forward(기본) 또는 reverse
+	Car       string `json:"car"`
+	Weather   string `json:"weather"` // clear, cloudy, rain, snow, fog
+}
+
+// DriveDto는 주행 기록입니다.
+type DriveDto struct {
+	ID        int    `json:"id"`
+	CourseID  int    `json:"courseId"`
+	DrivenOn  string `json:"drivenOn"`
+	Direction string `json:"direction"`
+	Car       string `json:"car,omitempty"`
+	Weather   string `json:"weather,omitempty"`
+	HasTrack  bool   `json:"hasTrack"`
+	// TrackDistanceKm는 업로드한 궤적의 거리입니다.
+	TrackDistanceKm *float64     `json:"trackDistanceKm,omitempty"`
+	Track           [][2]float64 `json:"track,omitempty"` // 상세 조회 시 [위도, 경도] 배열
+	CreatedAt       time.Time    `json:"createdAt"`
+	UpdatedAt       time.Time    `json:"updatedAt"`
+}
+
+// ProgressDto는 코스 완주 현황입니다.
+type ProgressDto struct {
+	Completed int `json:"completed"`
+	Total     int `json:"total"`
+}
+
+// RegionProgressDto는 시·도별 완주 현황입니다.
+type RegionProgressDto struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+	ProgressDto
+}
+
+// CategoryProgressDto는 추천 카테고리별 완주 현황입니다.
+type CategoryProgressDto struct {
+	ID    int    `json:"id"`
+	Title string `json:"title"`
+	ProgressDto
+}
+
+// DriveStatsDto는 사용자 주행 통계입니다.
+type DriveStatsDto struct {
+	TotalDrives      int                   `json:"totalDrives"`
+	CoursesCompleted int                   `json:"coursesCompleted"`
+	TotalKm          float64               `json:"totalKm"` // 궤적이 없는 기록은 코스 추정 거리 사용
+	Regions          []RegionProgressDto   `json:"regions"`
+	Categories       []CategoryProgressDto `json:"categories"`
+}
@@ -0,0 +1,8 @@
+package models
+
+// ErrorResponse는 API 에러 응답을 정의합니다.
+type ErrorResponse struct {
+	Code   string `json:"code"`             // 메시지 키 (예: course_not_found)
+	Error  string `json:"error"`            // 요청 언어로 번역된 에러 메시지
+	Detail string `json:"detail,omitempty"` // 내부 에러 상세
+}
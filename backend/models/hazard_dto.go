@@ -0,0 +1,41 @@
+package models
+
+import "time"
+
+// HazardRequest는 위험 신고 요청입니다.
+type HazardRequest struct {
+	Type      string  `json:"type" binding:"required"`     // closure, gravel, landslide, checkpoint, ice, accident, other
+	Severity  string  `json:"severity" binding:"required"` // low, medium, high
+	Latitude  float64 `json:"latitude" binding:"required"`
+	Longitude float64 `json:"longitude" binding:"required"`
+	Note      string  `json:"note"`
+}
+
+// HazardVoteRequest는 위험 신고 확인/해소 투표 요청입니다.
+type HazardVoteRequest struct {
+	Vote string `json:"vote" binding:"required"` // confirm 또는 dismiss
+}
+
+// HazardDto는 위험 신고입니다.
+type HazardDto struct {
+	ID            int       `json:"id"`
+	CourseID      int       `json:"courseId"`
+	Type          string    `json:"type"`
+	TypeName      string    `json:"typeName"` // 요청 언어의 유형 이름
+	Severity      string    `json:"severity"`
+	Latitude      float64   `json:"latitude"`
+	Longitude     float64   `json:"longitude"`
+	Note          string    `json:"note,omitempty"`
+	ReportedAt    time.Time `json:"reportedAt"`
+	ExpiresAt     time.Time `json:"expiresAt"`
+	Confirmations int       `json:"confirmations"`
+	Dismissals    int       `json:"dismissals"`
+	Active        bool      `json:"active"`
+	Hidden        bool      `json:"hidden,omitempty"` // 관리자 조회에서만 true
+}
+
+// HazardBadgeDto는 코스의 활성 위험 신고 요약입니다.
+type HazardBadgeDto struct {
+	Count       int    `json:"count"`
+	MaxSeverity string `json:"maxSeverity,omitempty"` // 활성 신고 중 가장 높은 위험도
+}
@@ -0,0 +1,31 @@
+package models
+
+import "time"
+
+// PhotoDto는 코스 사진입니다.
+type PhotoDto struct {
+	ID       int    `json:"id"`
+	CourseID int    `json:"courseId"`
+	Caption  string `json:"caption,omitempty"`
+	// Uploader는 사진을 올린 사용자입니다. 계정을 찾을 수 없으면 null입니다.
+	Uploader   *PhotoUserDto         `json:"uploader"`
+	RouteKm    *float64              `json:"routeKm,omitempty"`    // 출발지로부터 경로 거리(km), 경로 위에 배치한 사진만
+	RoutePoint *CourseGeolocationDto `json:"routePoint,omitempty"` // 촬영 위치를 경로에 투영한 지점, 경로 위에 배치한 사진만
+	// Variants는 크기별 사진입니다. 원본이 작으면 여러 크기가 같은 해상도일 수 있습니다.
+	Variants   []PhotoVariantDto `json:"variants"`
+	UploadedAt time.Time         `json:"uploadedAt"`
+}
+
+// PhotoVariantDto는 크기별로 줄인 사진입니다.
+type PhotoVariantDto struct {
+	Name   string `json:"name"` // thumb(320px), medium(1024px), large(2048px)
+	URL    string `json:"url"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+// PhotoUserDto는 사진을 올린 사용자입니다.
+type PhotoUserDto struct {
+	ID          int    `json:"id"`
+	DisplayName string `json:"displayName"`
+}
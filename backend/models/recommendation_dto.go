@@ -0,0 +1,10 @@
+package models
+
+// ScoredCourseDto는 점수와 추천 사유가 포함된 코스 추천 결과입니다.
+type ScoredCourseDto struct {
+	Course     CourseDto          `json:"course"`
+	Score      float64            `json:"score"`                // 0~1 종합 점수
+	Breakdown  map[string]float64 `json:"breakdown"`            // 항목별 0~1 점수
+	Reasons    []string           `json:"reasons"`              // 요청 언어의 추천 사유
+	DistanceKm *float64           `json:"distanceKm,omitempty"` // 사용자 위치에서 출발지까지 거리
+}
@@ -0,0 +1,18 @@
+package models
+
+// RegionBoundaryDto는 GeoJSON MultiPolygon 형식의 지역 경계를 담습니다.
+type RegionBoundaryDto struct {
+	Type        string           `json:"type"`
+	Coordinates [][][][2]float64 `json:"coordinates"`
+}
+
+// RegionDto는 지역 정보를 담는 데이터 전송 객체입니다.
+type RegionDto struct {
+	Code       string             `json:"code"`
+	Name       string             `json:"name"`
+	Level      string             `json:"level"`
+	ParentCode string             `json:"parentCode,omitempty"`
+	BBox       [4]float64         `json:"bbox"` // [서, 남, 동, 북]
+	Boundary   *RegionBoundaryDto `json:"boundary,omitempty"`
+	Children   []RegionDto        `json:"children,omitempty"`
+}
@@ -0,0 +1,52 @@
+package models
+
+import "time"
+
+// ReviewRequest는 리뷰 작성 요청입니다.
+type ReviewRequest struct {
+	Ratings   CourseRatingsDto `json:"ratings" binding:"required"`
+	Text      string           `json:"text"`
+	VisitedOn string           `json:"visitedOn" binding:"required"` // 방문일 (YYYY-MM-DD)
+}
+
+// ReviewAuthorDto는 리뷰 작성자입니다.
+type ReviewAuthorDto struct {
+	ID          int    `json:"id"`
+	DisplayName string `json:"displayName"`
+}
+
+// ReviewDto는 코스 리뷰입니다.
+type ReviewDto struct {
+	ID        int              `json:"id"`
+	CourseID  int              `json:"courseId"`
+	Author    *ReviewAuthorDto `json:"author"` // 작성자를 찾을 수 없으면 null
+	Ratings   CourseRatingsDto `json:"ratings"`
+	Text      string           `json:"text"`
+	VisitedOn string           `json:"visitedOn"`
+	CreatedAt time.Time        `json:"createdAt"`
+	Hidden    bool             `json:"hidden,omitempty"` // 관리자 조회에서만 true
+}
+
+// ReviewPageDto는 페이지 단위 리뷰 목록입니다.
+type ReviewPageDto struct {
+	Items []ReviewDto `json:"items"`
+	Page  int         `json:"page"`
+	Size  int         `json:"size"`
+	Total int         `json:"total"`
+}
+
+// CommunityRatingsMeanDto는 사용자 리뷰의 항목별 평균 점수입니다.
+type CommunityRatingsMeanDto struct {
+	Tech    float64 `json:"tech"`
+	Speed   float64 `json:"speed"`
+	Scenery float64 `json:"scenery"`
+	Road    float64 `json:"road"`
+	Access  float64 `json:"access"`
+}
+
+// CommunityRatingsDto는 사용자 리뷰 점수 집계입니다.
+type CommunityRatingsDto struct {
+	Count        int                      `json:"count"`
+	Mean         *CommunityRatingsMeanDto `json:"mean,omitempty"`         // 리뷰가 없으면 생략
+	Distribution map[string][5]int        `json:"distribution,omitempty"` // 항목별 1~5점 리뷰 수
+}
@@ -0,0 +1,57 @@
+package models
+
+import "time"
+
+// RevisionRequest는 코스 수정안 작성/편집 요청입니다. 코스 내용은 관리자 코스 요청과 같고 전체를 바꿉니다.
+// reason은 변경 요약으로, 수정안에서는 필수입니다.
+type RevisionRequest struct {
+	CourseID int `json:"courseId"` // 고칠 코스 ID, 0이거나 생략하면 새 코스 제안 (편집 때는 무시)
+	AdminCourseRequest
+}
+
+// ReviewDecisionRequest는 수정안 승인/반려 요청입니다.
+type ReviewDecisionRequest struct {
+	Comment string `json:"comment"` // 검토 의견 (최대 500자), 반려 때는 필수
+}
+
+// RevisionDto는 코스 수정안입니다.
+type RevisionDto struct {
+	ID       int    `json:"id"`
+	CourseID int    `json:"courseId"` // 0이면 새 코스 제안, 승인 후에는 게시된 코스 ID
+	Status   string `json:"status"`   // draft, submitted, rejected, approved
+	Summary  string `json:"summary"`
+	// BaseVersion은 수정안이 기준으로 삼은 코스 버전, CurrentVersion은 게시된 코스의 현재 버전입니다.
+	BaseVersion    int              `json:"baseVersion"`
+	CurrentVersion int              `json:"currentVersion"`
+	Outdated       bool             `json:"outdated"`           // 기준 버전 이후 코스가 바뀌어 다시 편집해야 승인할 수 있음
+	Author         *RevisionUserDto `json:"author"`             // 계정을 찾을 수 없으면 null
+	Reviewer       *RevisionUserDto `json:"reviewer,omitempty"` // 승인·반려한 검토자
+	ReviewComment  string           `json:"reviewComment,omitempty"`
+	Course         *CourseDto       `json:"course,omitempty"` // 제안한 코스 (목록에서는 생략)
+	CreatedAt      time.Time        `json:"createdAt"`
+	UpdatedAt      time.Time        `json:"updatedAt"`
+	SubmittedAt    *time.Time       `json:"submittedAt,omitempty"`
+	ReviewedAt     *time.Time       `json:"reviewedAt,omitempty"`
+}
+
+// RevisionUserDto는 수정안 작성자 또는 검토자입니다.
+type RevisionUserDto struct {
+	ID          int    `json:"id"`
+	DisplayName string `json:"displayName"`
+}
+
+// RevisionPageDto는 페이지 단위 수정안 목록입니다.
+type RevisionPageDto struct {
+	Items []RevisionDto `json:"items"`
+	Page  int           `json:"page"`
+	Size  int           `json:"size"`
+	Total int           `json:"total"`
+}
+
+// RevisionDiffDto는 비교 대상에서 수정안으로 바뀌는 필드입니다.
+type RevisionDiffDto struct {
+	Revision RevisionDto `json:"revision"`
+	// AgainstRevisionID는 비교한 수정안 ID입니다. 0이면 현재 게시된 코스와 비교한 결과입니다.
+	AgainstRevisionID int              `json:"againstRevisionId"`
+	Changes           []AuditChangeDto `json:"changes"`
+}
@@ -0,0 +1,33 @@
+package models
+
+import "time"
+
+// RouteIssueDto는 내비게이션 포인트의 경로 문제입니다.
+type RouteIssueDto struct {
+	Kind     string `json:"kind"`     // unreachable, off_road, out_of_order
+	NavIndex int    `json:"navIndex"` // nav 배열의 인덱스
+	NavName  string `json:"navName"`
+	Message  string `json:"message"` // 요청 언어의 설명
+}
+
+// CourseRouteSummaryDto는 도로에 맞춘 코스 경로의 요약입니다.
+type CourseRouteSummaryDto struct {
+	DistanceKm      float64         `json:"distanceKm"`
+	DurationMinutes int             `json:"durationMinutes"`
+	Reachable       bool            `json:"reachable"` // 모든 구간을 도로로 이을 수 있는지 여부
+	Issues          []RouteIssueDto `json:"issues"`
+}
+
+// LineStringDto는 GeoJSON LineString 형식의 경로입니다. 좌표는 [경도, 위도] 순입니다.
+type LineStringDto struct {
+	Type        string       `json:"type" example:"LineString"`
+	Coordinates [][2]float64 `json:"coordinates"`
+}
+
+// CourseRouteDto는 도로에 맞춘 코스 경로입니다.
+type CourseRouteDto struct {
+	CourseID int `json:"courseId"`
+	CourseRouteSummaryDto
+	ComputedAt time.Time     `json:"computedAt"`
+	Geometry   LineStringDto `json:"geometry"`
+}
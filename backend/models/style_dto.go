@@ -0,0 +1,10 @@
+package models
+
+// StyleDto는 주행 스타일 분류 정보를 담는 데이터 전송 객체입니다.
+type StyleDto struct {
+	Slug        string   `json:"slug"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Icon        string   `json:"icon"`
+	Synonyms    []string `json:"synonyms"`
+}
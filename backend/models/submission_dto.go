@@ -0,0 +1,84 @@
+package models
+
+import "time"
+
+// SubmissionRequest는 새 코스 제보 요청입니다. 이름은 한국어로 적고, 평가는 제보자가 제안하는 점수입니다.
+type SubmissionRequest struct {
+	Name    string             `json:"name" binding:"required"`
+	Region  string             `json:"region" binding:"required"` // 시·도 이름
+	Nav     []SubmissionNavDto `json:"nav" binding:"required"`    // 출발지부터 도착지까지 순서대로, 2개 이상
+	Styles  []string           `json:"styles"`                    // 스타일 slug, 이름 또는 동의어
+	Ratings CourseRatingsDto   `json:"ratings" binding:"required"`
+	Note    string             `json:"note"` // 제보 메모 (최대 1000자)
+}
+
+// SubmissionNavDto는 제보한 코스의 내비게이션 포인트입니다.
+type SubmissionNavDto struct {
+	Type        string               `json:"type"` // 출발지, 경유지 N, 도착지
+	Name        string               `json:"name" binding:"required"`
+	Geolocation CourseGeolocationDto `json:"geolocation"`
+}
+
+// SubmissionCreatedDto는 제보 결과입니다. 중복 후보가 있어도 제보는 저장됩니다.
+type SubmissionCreatedDto struct {
+	ID         int                      `json:"id"`
+	Duplicates []SubmissionDuplicateDto `json:"duplicates"`
+}
+
+// SubmissionDuplicateDto는 제보한 코스와 같을 수 있는 기존 코스입니다.
+type SubmissionDuplicateDto struct {
+	CourseID        int     `json:"courseId"`
+	Name            string  `json:"name"`
+	EndpointsMatch  bool    `json:"endpointsMatch"` // 출발지·도착지가 모두 2km 안 (반대 방향 포함)
+	StartDistanceKm float64 `json:"startDistanceKm"`
+	EndDistanceKm   float64 `json:"endDistanceKm"`
+	NameSimilarity  float64 `json:"nameSimilarity"` // 이름 유사도 (0~1)
+}
+
+// SubmissionDto는 코스 제보입니다.
+type SubmissionDto struct {
+	ID          int                `json:"id"`
+	Status      string             `json:"status"` // pending, accepted, rejected
+	Name        string             `json:"name"`
+	Region      string             `json:"region"`
+	Nav         []CourseNavDto     `json:"nav"`
+	Styles      []string           `json:"styles"` // 스타일 slug
+	Ratings     CourseRatingsDto   `json:"ratings"`
+	Note        string             `json:"note,omitempty"`
+	Submitter   *SubmissionUserDto `json:"submitter"` // 계정을 찾을 수 없으면 null
+	SubmittedAt time.Time          `json:"submittedAt"`
+	// Duplicates는 현재 코스 목록과 비교한 중복 후보입니다. 검토 대기 중인 제보에만 있습니다.
+	Duplicates    []SubmissionDuplicateDto `json:"duplicates"`
+	Reviewer      *SubmissionUserDto       `json:"reviewer,omitempty"`
+	ReviewComment string                   `json:"reviewComment,omitempty"`
+	ReviewedAt    *time.Time               `json:"reviewedAt,omitempty"`
+	CourseID      int                      `json:"courseId,omitempty"`    // 수락해 게시한 코스 ID
+	DuplicateOf   int                      `json:"duplicateOf,omitempty"` // 중복으로 반려한 경우 기존 코스 ID
+}
+
+// SubmissionUserDto는 제보자 또는 검토자입니다.
+type SubmissionUserDto struct {
+	ID          int    `json:"id"`
+	DisplayName string `json:"displayName"`
+}
+
+// SubmissionPageDto는 페이지 단위 제보 목록입니다.
+type SubmissionPageDto struct {
+	Items []SubmissionDto `json:"items"`
+	Page  int             `json:"page"`
+	Size  int             `json:"size"`
+	Total int             `json:"total"`
+}
+
+// SubmissionAcceptRequest는 제보 수락 요청입니다.
+type SubmissionAcceptRequest struct {
+	// Course가 있으면 제보 내용 대신 이 코스를 게시합니다. 번역이나 소개 문구를 채워 넣을 때 사용합니다.
+	Course  *AdminCourseRequest `json:"course"`
+	Comment string              `json:"comment"`
+}
+
+// SubmissionRejectRequest는 제보 반려 요청입니다.
+type SubmissionRejectRequest struct {
+	Comment     string `json:"comment"`     // 반려 사유 (필수)
+	DuplicateOf int    `json:"duplicateOf"` // 이미 있는 코스와 같으면 그 코스 ID
+}
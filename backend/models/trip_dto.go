@@ -0,0 +1,43 @@
+package models
+
+import "time"
+
+// TripPlanRequest는 여행 계획 요청입니다. courseIds와 recommendationId 중 하나로 코스를 지정합니다.
+type TripPlanRequest struct {
+	Start            CourseGeolocationDto `json:"start" binding:"required"`
+	CourseIDs        []int                `json:"courseIds"`
+	RecommendationID int                  `json:"recommendationId"`
+	BudgetMinutes    int                  `json:"budgetMinutes"` // 전체 여행 시간 한도(분). 0이면 제한 없음
+	ReturnToStart    bool                 `json:"returnToStart"`
+	DepartAt         *time.Time           `json:"departAt"` // 출발 시각. 있으면 구간별 시각과 통제 여부를 계산
+}
+
+// TripLegDto는 여행 일정의 한 구간입니다.
+type TripLegDto struct {
+	Kind            string               `json:"kind"`                 // transit(이동) 또는 course(코스 주행)
+	CourseID        int                  `json:"courseId,omitempty"`   // 코스 구간의 코스, 이동 구간은 도착할 코스
+	CourseName      string               `json:"courseName,omitempty"` // 요청 언어의 코스 이름
+	From            CourseGeolocationDto `json:"from"`
+	To              CourseGeolocationDto `json:"to"`
+	DistanceKm      float64              `json:"distanceKm"`
+	DurationMinutes int                  `json:"durationMinutes"`
+	StartMinute     int                  `json:"startMinute"` // 출발부터 이 구간 시작까지 걸린 시간(분)
+	DepartAt        *time.Time           `json:"departAt,omitempty"`
+	ArriveAt        *time.Time           `json:"arriveAt,omitempty"`
+	ClosedReason    string               `json:"closedReason,omitempty"` // 코스 진입 시각에 통제·제한 중이면 그 사유
+}
+
+// TripDto는 코스 순서를 정한 여행 일정입니다.
+type TripDto struct {
+	Start            CourseGeolocationDto `json:"start"`
+	ReturnToStart    bool                 `json:"returnToStart"`
+	BudgetMinutes    int                  `json:"budgetMinutes,omitempty"`
+	CourseIDs        []int                `json:"courseIds"`        // 주행 순서
+	DroppedCourseIDs []int                `json:"droppedCourseIds"` // 시간 예산을 넘어 뺀 코스
+	DistanceKm       float64              `json:"distanceKm"`
+	TransitKm        float64              `json:"transitKm"` // 코스 사이 이동 거리
+	DurationMinutes  int                  `json:"durationMinutes"`
+	DepartAt         *time.Time           `json:"departAt,omitempty"`
+	ArriveAt         *time.Time           `json:"arriveAt,omitempty"`
+	Legs             []TripLegDto         `json:"legs"`
+}
@@ -0,0 +1,34 @@
+package models
+
+import "time"
+
+// HourlyWeatherDto는 한 시간 단위 예보입니다.
+type HourlyWeatherDto struct {
+	Time                time.Time `json:"time"`
+	TemperatureC        float64   `json:"temperatureC"`
+	PrecipitationChance int       `json:"precipitationChance"` // 강수확률(%)
+	Precipitation       string    `json:"precipitation"`       // none, rain, sleet, snow, shower
+	PrecipitationMm     float64   `json:"precipitationMm"`
+	Sky                 string    `json:"sky,omitempty"` // clear, cloudy, overcast
+	WindSpeedMs         float64   `json:"windSpeedMs"`
+	HumidityPct         int       `json:"humidityPct"`
+	Wet                 bool      `json:"wet"` // 노면이 젖을 만한 예보인지 여부
+}
+
+// PointWeatherDto는 코스 지점 하나의 예보입니다.
+type PointWeatherDto struct {
+	Name      string             `json:"name"`
+	Summit    bool               `json:"summit"`
+	Latitude  float64            `json:"latitude"`
+	Longitude float64            `json:"longitude"`
+	Grid      string             `json:"grid"` // 기상청 격자 좌표 "nx,ny"
+	IssuedAt  time.Time          `json:"issuedAt"`
+	Hours     []HourlyWeatherDto `json:"hours"`
+}
+
+// CourseWeatherDto는 코스 출발지와 정상의 날씨 예보입니다.
+type CourseWeatherDto struct {
+	CourseID int               `json:"courseId"`
+	DryRoads bool              `json:"dryRoads"` // 앞으로 6시간 동안 모든 지점에 비 예보가 없는지 여부
+	Points   []PointWeatherDto `json:"points"`
+}
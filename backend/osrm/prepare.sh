@@ -0,0 +1,19 @@
+#!/bin/sh
+# Geofabrik 한국 추출본을 받아 코스가 있는 수도권·강원 영역만 잘라 osrm/data/korea.osm.pbf로 저장합니다.
+# osmium이 없으면 자르지 않고 전체 추출본을 사용합니다.
+set -e
+cd "$(dirname "$0")"
+mkdir -p data
+
+if [ ! -f data/south-korea-latest.osm.pbf ]; then
+	curl -L -o data/south-korea-latest.osm.pbf https://download.geofabrik.de/asia/south-korea-latest.osm.pbf
+fi
+
+if command -v osmium >/dev/null 2>&1; then
+	# 경도 최소,위도 최소,경도 최대,위도 최대 (코스 좌표 범위 + 여유)
+	osmium extract --overwrite -b 126.0,36.6,128.4,38.4 data/south-korea-latest.osm.pbf -o data/korea.osm.pbf
+else
+	cp data/south-korea-latest.osm.pbf data/korea.osm.pbf
+fi
+
+docker compose run --rm osrm-prepare
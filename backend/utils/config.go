@@ -2,23 +2,194 @@ package utils
 
 import (
 	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // Config는 애플리케이션 설정을 담는 구조체입니다.
 type Config struct {
 	NaverClientID     string
 	NaverClientSecret string
+
+	// LogFormat은 로그 출력 형식입니다. "text" 또는 "json"입니다.
+	LogFormat string
+	// LogLevel은 시작할 때의 로그 레벨(debug, info, warn, error)입니다. 실행 중에는 관리자 API로 바꿀 수 있습니다.
+	LogLevel string
+	// TracingEnabled는 OTEL_EXPORTER_OTLP_ENDPOINT 또는 OTEL_EXPORTER_OTLP_TRACES_ENDPOINT가 있을 때 켜집니다.
+	// 수집기 주소와 헤더 등 나머지 설정은 OpenTelemetry SDK가 표준 환경변수에서 직접 읽습니다.
+	TracingEnabled bool
+	// TracingServiceName은 스팬에 붙는 서비스 이름(OTEL_SERVICE_NAME)입니다.
+	TracingServiceName string
+	// TracingSampleRatio는 새 트레이스를 기록할 비율(OTEL_TRACES_SAMPLER_ARG, 0~1)입니다.
+	TracingSampleRatio float64
+	// MetricsAddr는 /metrics만 제공하는 내부 리스너 주소(METRICS_ADDR)입니다. 기본값은 외부에서 접근할 수 없는 127.0.0.1:9090입니다.
+	MetricsAddr string
+
+	// StateDir은 사용자 계정 등 실행 중에 쓰는 데이터를 저장하는 디렉터리입니다.
+	StateDir string
+	// JWTSecret은 토큰 서명 키입니다. 비어 있으면 서버 시작 시 임시 키를 만듭니다.
+	JWTSecret       string
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+	// AdminEmail/AdminPassword가 있으면 서버 시작 시 해당 관리자 계정을 준비합니다.
+	AdminEmail    string
+	AdminPassword string
+	// OIDCProviders는 OIDC_PROVIDERS에 나열된 외부 로그인 공급자 설정입니다.
+	OIDCProviders []OIDCProviderConfig
+	// OIDCCompleteURL은 외부 로그인 콜백을 마친 브라우저를 돌려보낼 프런트엔드 주소입니다.
+	OIDCCompleteURL string
+	// WeatherProvider는 날씨 예보 제공자입니다. "kma"(기상청 단기예보), "stub"(WeatherStubFile의 고정 예보, 개발용)
+	// 또는 "none"(날씨 조회 사용 안 함)입니다. 지정하지 않으면 KMAServiceKey가 있을 때 "kma", 없으면 "none"입니다.
+	WeatherProvider string
+	// KMAServiceKey는 공공데이터포털 기상청 단기예보 API 인증키(디코딩 값)입니다.
+	KMAServiceKey   string
+	WeatherStubFile string
+	// WeatherCacheTTL은 격자별 예보를 다시 받기 전까지 보관하는 시간입니다.
+	WeatherCacheTTL time.Duration
+	// WeatherRefreshInterval은 전체 코스 예보를 미리 받아 두는 주기입니다. 비 예보 필터는 이렇게 받아 둔 예보를 사용합니다.
+	WeatherRefreshInterval time.Duration
+	// NavAppName은 네이버 지도 길찾기 링크에 넣는 appname입니다.
+	NavAppName string
+	// KakaoAppKey는 카카오내비 링크에 쓰는 JavaScript 키입니다. 비어 있으면 카카오내비 링크를 제공하지 않습니다.
+	KakaoAppKey string
+	// MediaStore는 사진 파일 저장소입니다. "local"(MediaDir) 또는 "s3"(S3 호환 스토리지)입니다.
+	MediaStore string
+	// MediaDir은 local 저장소의 디렉터리이며, 서버가 MediaBaseURL 경로로 제공합니다.
+	MediaDir     string
+	MediaBaseURL string
+	// S3Endpoint 등은 s3 저장소 설정입니다. S3PublicURL이 비어 있으면 S3Endpoint/S3Bucket 주소로 사진을 제공합니다.
+	S3Endpoint  string
+	S3Bucket    string
+	S3Region    string
+	S3AccessKey string
+	S3SecretKey string
+	S3PublicURL string
+}
+
+// OIDCProviderConfig는 외부 로그인 공급자 하나의 설정입니다.
+// 공급자별 값은 OIDC_<NAME>_ISSUER, OIDC_<NAME>_CLIENT_ID, OIDC_<NAME>_CLIENT_SECRET, OIDC_<NAME>_REDIRECT_URL 환경변수로 지정합니다.
+type OIDCProviderConfig struct {
+	Name         string
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+}
+
+// defaultOIDCIssuers는 잘 알려진 공급자의 기본 issuer입니다.
+var defaultOIDCIssuers = map[string]string{
+	"google": "https://accounts.google.com",
+	"kakao":  "https://kauth.kakao.com",
+	"mock":   "http://localhost:9000",
 }
 
 // LoadConfig는 환경변수에서 설정을 로드합니다.
 func LoadConfig() *Config {
+	stateDir := getEnv("STATE_DIR", "data/state")
 	return &Config{
 		NaverClientID:     os.Getenv("NEXT_PUBLIC_NAVER_CLIENT_ID"),
 		NaverClientSecret: os.Getenv("NEXT_PUBLIC_NAVER_CLIENT"),
+		LogFormat:         strings.ToLower(getEnv("LOG_FORMAT", "text")),
+		LogLevel:          getEnv("LOG_LEVEL", "info"),
+		StateDir:          stateDir,
+		JWTSecret:         os.Getenv("JWT_SECRET"),
+		AccessTokenTTL:    getDuration("ACCESS_TOKEN_TTL", 15*time.Minute),
+		RefreshTokenTTL:   getDuration("REFRESH_TOKEN_TTL", 14*24*time.Hour),
+		AdminEmail:        os.Getenv("ADMIN_EMAIL"),
+		AdminPassword:     os.Getenv("ADMIN_PASSWORD"),
+		OIDCProviders:     loadOIDCProviders(),
+		OIDCCompleteURL:   getEnv("OIDC_COMPLETE_URL", "http://localhost:3000/auth/complete"),
+
+		WeatherProvider:        strings.ToLower(getEnv("WEATHER_PROVIDER", defaultWeatherProvider())),
+		KMAServiceKey:          os.Getenv("KMA_SERVICE_KEY"),
+		WeatherStubFile:        getEnv("WEATHER_STUB_FILE", "data/weather_stub.json"),
+		WeatherCacheTTL:        getDuration("WEATHER_CACHE_TTL", time.Hour),
+		WeatherRefreshInterval: getDuration("WEATHER_REFRESH_INTERVAL", time.Hour),
+
+		NavAppName:  getEnv("NAV_APP_NAME", "winding-road-finder"),
+		KakaoAppKey: os.Getenv("KAKAO_APP_KEY"),
+
+		MediaStore:   strings.ToLower(getEnv("MEDIA_STORE", "local")),
+		MediaDir:     getEnv("MEDIA_DIR", filepath.Join(stateDir, "media")),
+		MediaBaseURL: getEnv("MEDIA_BASE_URL", "/media"),
+		S3Endpoint:   os.Getenv("S3_ENDPOINT"),
+		S3Bucket:     os.Getenv("S3_BUCKET"),
+		S3Region:     os.Getenv("S3_REGION"),
+		S3AccessKey:  os.Getenv("S3_ACCESS_KEY"),
+		S3SecretKey:  os.Getenv("S3_SECRET_KEY"),
+		S3PublicURL:  os.Getenv("S3_PUBLIC_URL"),
+
+		TracingEnabled:     os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" || os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != "",
+		TracingServiceName: getEnv("OTEL_SERVICE_NAME", "winding-road-finder"),
+		TracingSampleRatio: getRatio("OTEL_TRACES_SAMPLER_ARG", 1),
+		MetricsAddr:        getEnv("METRICS_ADDR", "127.0.0.1:9090"),
 	}
 }
 
+// defaultWeatherProvider는 WEATHER_PROVIDER가 없을 때의 제공자입니다. 고정 예보를 실제 예보처럼 보여주지 않도록 스텁은 고르지 않습니다.
+func defaultWeatherProvider() string {
+	if os.Getenv("KMA_SERVICE_KEY") != "" {
+		return "kma"
+	}
+	return "none"
+}
+
+// loadOIDCProviders는 OIDC_PROVIDERS(쉼표 구분)에 나열된 공급자 설정을 읽습니다.
+func loadOIDCProviders() []OIDCProviderConfig {
+	var providers []OIDCProviderConfig
+	for _, name := range strings.Split(os.Getenv("OIDC_PROVIDERS"), ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		prefix := "OIDC_" + strings.ToUpper(name) + "_"
+		providers = append(providers, OIDCProviderConfig{
+			Name:         name,
+			Issuer:       getEnv(prefix+"ISSUER", defaultOIDCIssuers[name]),
+			ClientID:     getEnv(prefix+"CLIENT_ID", defaultMockValue(name, "winding-road-finder")),
+			ClientSecret: os.Getenv(prefix + "CLIENT_SECRET"),
+			RedirectURL:  getEnv(prefix+"REDIRECT_URL", "http://localhost:8080/api/auth/oidc/"+name+"/callback"),
+		})
+	}
+	return providers
+}
+
+// defaultMockValue는 모의 공급자(mock)일 때만 기본값을 사용합니다.
+func defaultMockValue(name, value string) string {
+	if name == "mock" {
+		return value
+	}
+	return ""
+}
+
 // IsNaverConfigValid는 네이버 API 설정이 유효한지 확인합니다.
 func (c *Config) IsNaverConfigValid() bool {
 	return c.NaverClientID != "" && c.NaverClientSecret != ""
-} 
\ No newline at end of file
+}
+
+func getEnv(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
+
+// getDuration은 "15m", "336h" 같은 기간 환경변수를 읽습니다. 형식이 잘못되면 기본값을 사용합니다.
+func getDuration(key string, def time.Duration) time.Duration {
+	d, err := time.ParseDuration(os.Getenv(key))
+	if err != nil || d <= 0 {
+		return def
+	}
+	return d
+}
+
+// getRatio는 0~1 사이 비율 환경변수를 읽습니다. 형식이 잘못되거나 범위를 벗어나면 기본값을 사용합니다.
+func getRatio(key string, def float64) float64 {
+	f, err := strconv.ParseFloat(os.Getenv(key), 64)
+	if err != nil || f < 0 || f > 1 {
+		return def
+	}
+	return f
+}
@@ -1,29 +1,48 @@
 package utils
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"log/slog"
 	"math"
 	"net/http"
 	"net/url"
 	"os"
 	"path/filepath"
 
+	"go.opentelemetry.io/otel/attribute"
+
 	"github.com/sunDar0/winding-road-finder/backend/domain/course"
+	"github.com/sunDar0/winding-road-finder/backend/domain/i18n"
+	"github.com/sunDar0/winding-road-finder/backend/infrastructure/metrics"
+	"github.com/sunDar0/winding-road-finder/backend/infrastructure/tracing"
 )
 
 // MapImageGenerator는 네이버 지도 API를 사용해 정적 이미지를 생성합니다.
 type MapImageGenerator struct {
-	config *Config
+	config  *Config
+	logger  *slog.Logger
+	metrics *metrics.Metrics
+	client  *http.Client
+}
+
+// NewMapImageGenerator는 새로운 지도 이미지 생성기를 생성합니다. m이 nil이면 지표를 기록하지 않습니다.
+func NewMapImageGenerator(config *Config, logger *slog.Logger, m *metrics.Metrics) *MapImageGenerator {
+	return &MapImageGenerator{config: config, logger: logger, metrics: m, client: &http.Client{Transport: tracing.Transport(nil)}}
 }
 
-// NewMapImageGenerator는 새로운 지도 이미지 생성기를 생성합니다.
-func NewMapImageGenerator(config *Config) *MapImageGenerator {
-	return &MapImageGenerator{config: config}
+// GenerateImageForCourse는 주어진 코스에 대해 썸네일과 상세 이미지를 생성하고, 결과를 지표에 기록합니다.
+func (g *MapImageGenerator) GenerateImageForCourse(ctx context.Context, courseAgg *course.CourseAggregate) (err error) {
+	ctx, span := tracing.Start(ctx, "MapImageGenerator.GenerateImageForCourse", attribute.Int("course.id", courseAgg.ID))
+	defer func() {
+		g.metrics.ObserveImageGeneration(err)
+		tracing.End(span, err)
+	}()
+	return g.generate(ctx, courseAgg)
 }
 
-// GenerateImageForCourse는 주어진 코스에 대해 썸네일과 상세 이미지를 생성합니다.
-func (g *MapImageGenerator) GenerateImageForCourse(courseAgg *course.CourseAggregate) error {
+func (g *MapImageGenerator) generate(ctx context.Context, courseAgg *course.CourseAggregate) error {
 	if !g.config.IsNaverConfigValid() {
 		return fmt.Errorf("네이버 API 설정이 유효하지 않습니다")
 	}
@@ -40,18 +59,18 @@ func (g *MapImageGenerator) GenerateImageForCourse(courseAgg *course.CourseAggre
 	// 썸네일 이미지 생성 (500x500)
 	thumbnailURL := g.buildMapURL(courseAgg, 500, 500)
 	thumbnailPath := fmt.Sprintf("public/images/courses/thumbnails/course-%d.png", courseAgg.ID)
-	if err := g.downloadImage(thumbnailURL, thumbnailPath); err != nil {
+	if err := g.downloadImage(ctx, thumbnailURL, thumbnailPath); err != nil {
 		return fmt.Errorf("썸네일 이미지 생성 실패 (코스 %d): %v", courseAgg.ID, err)
 	}
 
 	// 상세 이미지 생성 (800x600)
 	detailURL := g.buildMapURL(courseAgg, 800, 600)
 	detailPath := fmt.Sprintf("public/images/courses/detail/course-%d.png", courseAgg.ID)
-	if err := g.downloadImage(detailURL, detailPath); err != nil {
+	if err := g.downloadImage(ctx, detailURL, detailPath); err != nil {
 		return fmt.Errorf("상세 이미지 생성 실패 (코스 %d): %v", courseAgg.ID, err)
 	}
 
-	fmt.Printf("코스 %d (%s) 이미지 생성 완료\n", courseAgg.ID, courseAgg.Name)
+	g.logger.InfoContext(ctx, "코스 이미지 생성 완료", "course_id", courseAgg.ID, "name", courseAgg.Name.In(i18n.DefaultLang))
 	return nil
 }
 
@@ -185,9 +204,9 @@ func (g *MapImageGenerator) buildMarkers(navPoints []course.CourseNav) string {
 }
 
 // downloadImage는 URL에서 이미지를 다운로드하고 저장합니다.
-func (g *MapImageGenerator) downloadImage(imageURL, filePath string) error {
+func (g *MapImageGenerator) downloadImage(ctx context.Context, imageURL, filePath string) error {
 	// HTTP 요청 생성
-	req, err := http.NewRequest("GET", imageURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", imageURL, nil)
 	if err != nil {
 		return fmt.Errorf("요청 생성 실패: %v", err)
 	}
@@ -197,8 +216,7 @@ func (g *MapImageGenerator) downloadImage(imageURL, filePath string) error {
 	req.Header.Set("x-ncp-apigw-api-key", g.config.NaverClientSecret)
 
 	// HTTP 요청 실행
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := g.client.Do(req)
 	if err != nil {
 		return fmt.Errorf("HTTP 요청 실패: %v", err)
 	}